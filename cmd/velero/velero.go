@@ -19,6 +19,9 @@ package main
 import (
 	"os"
 	"path/filepath"
+	// embeds the time zone database, so that the time zones of schedules can
+	// be loaded in images without one
+	_ "time/tzdata"
 
 	"k8s.io/klog/v2"
 
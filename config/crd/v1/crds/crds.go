@@ -29,17 +29,18 @@ import (
 )
 
 var rawCRDs = [][]byte{
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VAo\xe46\x0f\xbdϯ \xf6;\xec\xe5\xb3g\xb7\xbd\x14\xbem\xd3\x16\b\x9a\x04A\x12\xe4Nۜ\x19mdI\x95\xa8I\xa7E\xff{A\xc9\xcexl'\xb3Y\xa0\xbaY\xa2\x1e\xc9G>ZEQ\xacЩG\xf2AYS\x01:E\x7f2\x19\xf9\n\xe5\xd3O\xa1Tv\xbd\xff\xbczR\xa6\xad\xe0\"\x06\xb6\xdd\x1d\x05\x1b}C\xbf\xd0F\x19\xc5ʚUG\x8c-2V+\x004\xc62\xcav\x90O\x80\xc6\x1a\xf6Vk\xf2ŖL\xf9\x14k\xaa\xa3\xd2-\xf9\x04>\xb8\xde\x7f*?\xffP~Z\x01\x18쨂\x1a\x9b\xa7\xe8<9\x1b\x14[\xaf(\x94{\xd2\xe4m\xa9\xec*8j\x04}\xebmt\x15\x1c\x0f\xf2\xed\xdes\x8e\xfa\xe7\x04t7\x00\x1dґV\x81\x7f_<\xbeR\x81\x93\x89\xd3ѣ^\n$\x1d\ae\xb6Q\xa3\x9f\x19\x88\x83\xd0XG\x15\xdcH,\x0e\x1bjW\x00}\xa6)\xb6\x02\xb0m\x13w\xa8o\xbd2L\xfe\xc2\xea\xd8\r\x9c\x15\xf05Xs\x8b\xbc\xab\xa0\x1c\xd8-\x1bO\x89\xd8\a\xd5Q`\xec\\\xb2\x1d\b\xfb\xb2\xa5\xfe\x9b\x0f\xe2\xbcE\xa69\x980W\x1ec}88:A9\x12\x01\xa3\xb3\x8c\x18\xd8+\xb3]\x1d\x8d\xf7\x9f3\x15͎:\xacz[\xeb\xc8|\xb9\xbd|\xfc\xf1\xfed\x1b\xc0y\xebȳ\x1aʓר\xfdF\xbb\x00-\x85\xc6+ǩ9>\n`\xb6\x82V\xfa\x8e\x02\xf0\x8e\x06N\xa9\xedc\x00\xbb\x01ީ\x00\x9e\x9c\xa7@&w\xe2\t0\x88\x11\x1a\xb0\xf5Wj\xb8\x84{\xf2\x02\x03ag\xa3n\xa5]\xf7\xe4\x19<5vk\xd4_/\xd8\x01\xd8&\xa7\x1a\x99\xfa\x1e9\xaeTC\x83\x1a\xf6\xa8#\xfd\x1fд\xd0\xe1\x01<\x89\x17\x88f\x84\x97LB\t\xd7\xd6\x13(\xb3\xb1\x15\xec\x98]\xa8\xd6\xeb\xad\xe2Av\x8d\xed\xbah\x14\x1f\xd6IA\xaa\x8el}X\xb7\xb4'\xbd\x0ej[\xa0ov\x8a\xa9\xe1\xe8i\x8dN\x15)t\x93\xa4Wv\xed\xff|/\xd4\xf0\xf1$\xd6Y-\xf3Jby\xa3\x02\xa2\x16P\x01\xb0\xbf\x9a\xb38\x12-[\xc2\xceݯ\xf7\x0f0\xb8NŘ\xb2\x9fx?^\f\xc7\x12\ba\xcal\xc8\xe7\"n\xbc\xed\x12&\x99\xd6Ye8}4Z\x91\x99\xd2\x1fb\xdd)\x96\xba\xff\x11)\xb0Ԫ\x84\x8b4\x8b\xa0&\x88N\xd4Жpi\xe0\x02;\xd2\x17\x18\xe8?/\x800\x1d\n!\xf6\xdbJ0\x1e\xa3S\xe3\xcc\xda\xe8`\x18\x81\xaf\xd4k:\xd6\xee\x1d5R>aP\xae\xaa\x8dj\x926`c=\xe0̾<\x81^\x96\xae\xac<\xfc\xee\xd9z\xdcҕ͘S\xa3\xc5\xd8&w\x86\xe0d\xb2d\x19Ӳ\xe1\f\x1b\x80w\xc8#\xfd2*\xf32\x06\x16\xf3y\xa3\b\xa9\x10(r6h\x1a\xfa-u\x94i\x0egr\xba^\xb8\")\xed\xec3\xd8\r\x93\x19\x83\xf6\xb1.dR\x13\xf8h\xde\x15\xec\xe90?\x13\xe6݉1(\xd3J\x1b\xf4\xd3T\x9c\f\xd4K]ɴ\xe0O\xff\x9b\xe3E&vsw\x05<Y\xa7pa\xdfS`\xd5,\x1c|\xf8\xf0\xbe|\x05\xe6\xb2\x15\xa1m\x14\xf9\xb3\x19\x9f\x9a\x0f}\xb6\x89Z\xf7XEc;\x87\xacjM\xcb.e\x89LTF9\xe4Y\xf7\xfd\xfd\xb5\x97\x7f=\xbd\xbc\x0e\xced\xf0xj=\x16J\xdeH\xa1d!\xbeU/\x18\xb4\x11\xc0ٶ\x0f\xa2\xbf\x17$\xbfw\xe4 -\xae<M\xfe\x18\xc5\xf28\x98\xd8,\xa9kb2\xad\xf1\xe4x\xc2\xdf7\x8dKF\x8e\xe1=\x033]\x18\xc8n\xa2\xf7d\xb8\x87I/\x88\xef\x1e\x99\x1a\x03\x8fƅ\xbc\xe6\xcet\xc0\xd5\xfc\xc6\x10\x98\x80\x01\xcb\xc6x\xbe<\xe3\xf4\xaf\x9b\x8a\xb64Y6\xd6w\xc8\xf9\xb9X\b\xd0\xcc\xc2D\xad\xb1\xd6T\x01\xfb8?~k\x8eR\b\xb8=\x97\xddu\xb6ʏ\x8b\xfe\n`m#\xbfB=\xef\xe6Q\xc0\x99r\x9c\x89\xd4\xed0\x9c\x8b\xf3Vl\x96\x1ab\xf2\xbfz+\x84\xd7f\xe6\r=/\xec\xde\x11\xb6s\x1d\x17pcy\xf9\xe8\xd5\f\x17U1\xdb\f\xf2\x0ekGu\x0eY\xc8\xe3\x9dX\xbf\xbc++\xf8\xfb\x9fտ\x01\x00\x00\xff\xff]6D7C\x0e\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec=M\x93\x1b+\x92w\xff\nB{\xf0\xccDK\x1e\xef\xeea\xc37O\xdb\xdeQ\xbc\xf7\xec\x0ew?\xcfe/\xa8*%\xf1\x9a\x82\x1a\xa0\xd4\xd6l\xec\x7f\xdf \x81\xfa\xa4\xaa(Y\xfdֳa.v\x97 I2\x93$3I`\xbd^\xbf\xa0%\xfb\x02J3)\xde\x10Z2\xf8j@ؿ\xf4\xe6\xf1?\xf4\x86\xc9W\xa7\xd7/\x1e\x99\xc8ߐ\xdbJ\x1bY|\x06-+\x95\xc1;\xd83\xc1\f\x93\xe2E\x01\x86\xe6\xd4\xd07/\b\xa1BHC\xedgm\xff$$\x93\xc2(\xc99\xa8\xf5\x01\xc4\xe6\xb1\xda\xc1\xaeb<\a\x85\xc0Cק?o^\xff\xeb\xe6\xcf/\b\x11\xb4\x807dG\xb3Ǫԛ\x13pPr\xc3\xe4\v]BfA\x1e\x94\xac\xca7\xa4\xf9\xc15\xf1\xdd9T\xff\x82\xad\xf1\x03g\xda\xfc\xd4\xfa\xf83\xd3\x06\x7f(y\xa5(\xaf{\xc2o\x9a\x89Cũ\n__\x10\xa23Y\xc2\x1b\xf2\xd1vQ\xd2\f\xf2\x17\x84x\xac\xb1˵G\xf8\xf4\xdaAȎPP\x87\v!\xb2\x04\xf1\xf6n\xfb\xe5\xdf\xee;\x9f\t\xc9Ag\x8a\x95\x06\xc7\xee\x10#L\x13J\xbeఈ\xf2T&\xe6H\rQP*\xd0 \x8c&\xe6\b$\xa3\xa5\xa9\x14\x10\xb9'?U;P\x02\f\xe8\x1a4!\x19\xaf\xb4\x01E\xb4\xa1\x06\b5\x84\x92R2a\b\x13İ\x02\xc8\x1f\xde\xdem\x89\xdc\xfd\x06\x99ф\x8a\x9cP\xadeƨ\x81\x9c\x9c$\xaf\npm\xff\xb8\xa9\xa1\x96J\x96\xa0\f\vtv\xa5%<\xad\xaf\xbdὴ\x14p\xb5Hn\xa5\x06\xdc0<\x15!\xf7D\xb3\xe31G\xa6\x9b\xe1\xa2\x1cu\x00\x13[\x89\n\x8f\xfc\x86܃\xb2`\x88>ʊ\xe7V\xd8N\xa0,\xc12y\x10\xec\x1f5lM\x8c\xc4N95\xe0\x05\xa0)L\x18P\x82rr\xa2\xbc\x82\x1b$IA\xcfD\x81\xed\x85T\xa2\x05\x0f\xab\xe8\r\xf9E* L\xec\xe5\x1br4\xa6\xd4o^\xbd:0\x13&M&\x8b\xa2\x12̜_\xa1\xfc\xb3]e\xa4үr8\x01\x7f\xa5\xd9aMUvd\x062\xcb\xc8W\xb4dkD]\xe0\xc4\xd9\x14\xf9\xbf\x04\x01\xd0/;\xb8\x9a\xb3\x15Fm\x14\x13\x87\xd6\x0f(\xf5\x13\x1c\xb0\x13\xc0ɗk\xeaF\xd1\x10\xda~\xb2\xd4\xf9\xfc\xfe\xfe\xa1-{L\xf7\xa9\x8fto\td\xc3\x02K0&\xf6\xa0\x1c\x13\xf7J\x16\b\x13D\xee\xa4\x0fE\x973\x10}\xf2\xebjW0c\xf9\xfe\xf7\n\xb4\x15r\xb9!\xb7\xa8I\xc8\x0eHU\xe6V27d+\xc8--\x80\xdfR\r\xcf\xce\x00Ki\xbd\xb6\x84McA[\t\xf6+;\xaa\xb5~\b\xbal\x84_N!ܗ\x90u&\x8cm\xc5\xf6,\xc3iA\xf6R5\xfa©\xabM\ad|\xcaڒiv/h\xa9\x8f\xd2<\xb0\x02de\xfa5z\b\xdd\xdeo{\r\x022\x1e5T+\x95\x86\xdcγ'ʌEo\x00\x93X@\xe4\vj\x98\x00\x0f5M\xa5\x89\xa9\x94\xc0Y\xfa\x19h~~\x90\xbfj y\x85\u009a)\xc0!ߐ\x1d쥂\b\\\x05\xb6\xbd\xad\fJY\xc2hDIVfC\x1e\x8e`\xc9H+n\xbc\xdc3M^\xff\x99\x14LT\x066\x03h#\fF\xa2PC\vy\x025C\xafw\xd4\xd0_l\xbd\x1e\x99l{\x82\x00\xecHw\x9ed\xbb\xb3\xfd12*\xcfU\xb2ݷ 2MV+\"\x15Y\xb9%pu\x83\xa0\xed\xa2j\xd6L\xb4\xfa\x88@|b\x9c\x87~\x97\x8d\xdc\x11\xd0\xf1N?\xc8\x0f\xda\t\xe9\x1c!F\x9a\xb5\xe8\xf2t\x04s\x04EJ\x19\x16\x9f\b\xde{Ɓ\xe8\xb36Px\xaa\x04\x95\x1f\x88\x88Ӂs\x0fB[\xa2z\x9c\x87\xe3\x14\x15\xe7t\xc7\xe1\r1\xaa\x1av\xe7Ȱ\x93\x92\x03\xed/?}:|\x06mX6C\x85U\x9f\f\xaeU\x84\b\xca\xff\x80c\x8bID3\xcb\f}\x04B\x035\xec\xb2\xc8y\x8b\x88\x1d\n\x90\xff\x12\xe4\x9d\xd5ٙդCl\x89\xd7\xd9\f8\xae\x13B\x12.\xc5\x01\x94\xebͮ\x87Ar\x14X\xd9ʉU\x95\n\xb8\xd5\xf9d_Y-:\xa43!v\x16\x8f\xca\x00\x13\xda\x00\xcd7\xabk2\b\xbef\xbc\xca!\xbfuFн5\xdf\xf2`\xb4\x0e4a\x8fQ\xef'\x1b\xfb\x15\x94\xb3\fm/of\xad\xd1B\x8c1\xabYH\xcf%8#\xd52\xcecج\x90\xadi\xae\xc1\xd8*\xab?\xadn,?#@\xbb\xbdv\xfbЄ*\xa8)\x10\xd7|\x11\x90P\x94\xe6<\xe4\x1e3PD\b6\xa9&\x12YG\x95\xa2\xe7\x11\xc6Ֆ\xf6e\xac\x1bk\xdec\x9e\b\xd5~g\xf6\xf5\xfb]\xc8\xc0\bD\xa6\xbfW\x06.f\x99Fo\x912aYe\x1d\xb7\x0e\xa74:C\x91\xe1X\x9aY[\x91\t\a\x0f\xfd\x9c\x861\xdf\v]\x96J\xf2\x98\xe8\xd6\x12\xe3E\xd2z\x884j\x15}\xc7D9J\xf98G\x88\xbf\xda:\x8d\xafA2\f@\x90\x1d\x1c\xe9\x89I\xe5\x87\xde\xd8\x01\xf0\x15\xb2\xcaD\xe725$g\xfb=(\v\xa7<R\rڹ\x9b\xe3\x04\x197\x9fIK9D\x7f썣a\xa4\x95T\x1c\xf9\x18\xea\xd6\x10\xe8\xafh\xa1XD\xad\x85\x8b+g\xceN,\xaf(\xc7E\x94\x8a̍\x87\xd6x\xc5\x16\xe3\t&\x0fpvKt\xc0\xdcr\xa2\xe3\x8eH\x01\xd6\x04-\xac\x0f6\xac\x1a[d\\\x19\x1b\xf6\x8eZ;C:\x11U\x15\a\xed\xbbr\x86]\xa3\x03nFA\xd7\x1cq\xfe;\xa7;\xe0D\x03\x87\xccH\x15'\xc7\x1c\x93]I\xd1k#T\x8ch\xb8\xaeC\xd0\fl\x02$A\xa7\xeaȲ\xa33Ӭ\x04!\x1c\x92K\xd08\xcbiY\xf2\xc8\nДI\xce\xfbN\xa6&zSf\xa6|\x1f^l\xf27%A76eFKv)[\x8b\x031rr\xd8\xff?\t\x1b\xd4\xfe\x05B\xbb\x1d4\xbd\xae\xd0Z\x922\xd0h0\xa1\xe5rC\x98\t_\xe7 Z'\xa7\xe9\xff\x9f\x981\xcb%~\xdboyU\x89\x9f\xe4\xca\x1cD˕\xba\xfb\x7fB\xa6\xe0bq\xef\u05cad\x86\xfc\xdcnuCؾfH~C\xf6\x8c\x1bP=\xce|\xd3|\xb9\x061R\xd6;[\nj\xb2\xe3\xfb\xaf\xd6\xf2\xd2\xcd^N\"]\xfa\x8d\x9d\xfd\x1a\xec\xf9\xee\xc2<\x03\x97`P\x98)(\\\xb0\xf9\x01\xa9\xd9|A\x8b\xea\xed\xc7w\xb1hV\xb7$H\xde` o{ȶ\xbb\xf6Fy\xea0\xbc\xe9S\xfb7n\x1b\xe1\x86P\xf2\bgg\xb1PA,s\xa8\xedh\xc4\xd3\x19\x12\a\xf73P\xc8\x1e\xe1\x8c`\xfc\x06\xc5l\xebTQp\xe5\x11\xce)\xd5z\x04\xb481\xed7^,%\xed\a$\x04ƳӉGp\xb3)\xe8\xa2\xf9\xc1\x91tE\x12J\xa0\xfd\x05ì\xd9\xd6ڨCƾԎEv\x16\x1cY\x998P\xbb\xcca(A\xee\xeb\xed\xa6/\x94\xb3\xbc\xee\xc8\xc9\xfdV\x8c[\xc3\xdd\xf2Q\x9a\xad\xb8!\xef\xbf2\xedw\xfc\xdeI\xd0\x1f\xa5\xc1/\xcfBN\x87\xf8\x05\xc4t\rqz\t\xa7\xb6-\x1d\xda\xfbV\t\xc2\xed\xca\xd6yx5{\x98&[a\xfd\x16O\x0f܅t\xddM\xaf\x0f\xddRT\x1a7\xa6\x84\x14k\x17z\x89\xf5䈝\bR\xaa\x0eG\x86\xa8՝\x8e\xc4z\xe2\xe5\xc1\xae$\xae\xbd\xdbW\xe54\x83<\xec\xab\xe0n 5p`\x19)@\x1d\xa6\x16\x8ev)\xad~OC!Q뺲P\xc2Җ\xf6P\xbc\xea\x8e\x06\xbf\xbbemgnB\xad\xc0\xec٪#\x9b\x80\xe3U\xe7G\x84K,\xda\x1f\xb3ԥy\x8ei\x1a\x94\xdf-\xd0\xf8\vx1\\\xfb\x1dbn\x85,(nN\xfc\xb7]\xe6P\xa0\xff\x87\x94\x94\xa9\x849\xfc\x16\xd318t\xda\xfa(V\xbb\x1b\xdb\x03\xd3\xc4\xf2\xf7D\xf9p{928iu\vp\xb7\x90\xcb\xfd\xc0b\xb9!OG\xa9ݚ\x8a\x9b\"\xb3 \x99&\xabG8\xfb\u0378\xb6\x1eXm\xc5\xca-\xf0\x8b\xd5Mm-H\xc1\xcfd\x85mW\xdfb\x04%Jbb\xb5\xaf\xeb\xc7:\xfdd]\xd0r\xed\xa5\xd7Ȃe\xa3\xed0]&\xd5Ķ>h\xb0 l\xc3:GĚ\xc7S\xa3M\x92\xdfR\xea\xc8\xce\xf7\b*wR\x1b\x17\x91옳K\xa2_\xc4ɞ\x8fz\x11\xbawY:R\x85\xfc\v\xab.{\x81Z\xcbm=\xad\x99ݞ\x81\x8f\xa49\xa0\xd6![53\xdf\xe9\xe1\x95۳\xc0Nh\x86F\xc9,\xdcR\xc9\ftt\xb7\xb8)\tZ~&\xb8X\a\x16\xa9s|\\r\xc3t03\x94tC\xd6\x12i\xa1\v\xf0\xfek+\xeai\x95\x86\xfd{N\xf8\x96\xe2Ep\xae\x17\x05\xedg\xf1$\xa1x\xebZ\x86i\xe2\x019\x97B\x1d*T\x11閧\x17\xa4\xefay/\x98\xd8b\a\xe4\xf5\xd5́Z\xb9\xc6r9b\xa5Gr߶!z\xfdA\x8c$s\xc4J)1⯠ùa|\xdc\x1a\x98\x89 \x854\xed0\x84\x85[\xca\xfc\xa5&{\xa6\xb4i#\x9a*\x14\xf1\\\x91XY\xeaq\x89\xf7J]\xe4p}r-[\x01\xb0\xa3|\n\xb9P\xa3\xc9\x13\xb1\x82\x9bI@؞0C@d\xb2\x12\x18\xb6\xb1S\x1d\xbbp,p\n:\x99di\n\xc2\x16\x10U\x91F\x805J\x1d\x13\x93\xf1\x9dv\xf5\x0f\x94\xc5v\xa0\x87e!\xdb\xccX\xcaX\xact\xd8\x16r\xc7\xdaIm\x05\xfdʊ\xaa \xb4\xb0\xa4Ou\x97\xf6.\xe3\xac\xc3\xf1:\xef\f\xe1\xe22b\xa4\x9dT%\a\x93:#]\x86\x99\x9d&\x9a\xe5P/\xcc^\n\xa4 \x94\xec)\xe3#\xe9.ò\x88\xb6K|\x14\xaf,\xae\xe7|\xa4u\xbeFR$\x04p\x13\x8d\xccim]\xaatS\xf1NA\x9ay6\x17\xcc\x0e\xe6Y\xa9\x98\xc4<\xbd+[h^Ĩ8\xff0\xd1\x06凉6S~\x98h\xa3凉6_~\x98h\xbe\xfc0\xd1B\xf9a\xa2\xfd0Ѧ\xaaMi\xeb9\x8c\xdc鸑\x1fg\xb1H\xd8֞Bq\x02\xbe\xcf\xc2\xf0yީ\x99\x99\xdbx\xabH\x1e\x7frn\xb8n-%u\xaa\xa6\x9d A\xbc\xdda\x9f\x99\xe4\xcdoȗ\x0f\x9d^\x94/\xbf\x9dl|\xa5|y\x8fa\xdf\xea\xbeR\xb6|\x18\xff\xb2l\xf9\x1b\x9f\xaaQ\x00\r\xe1y\xb7\x17\x9f\x8fu\xd9\xebm\x00\xf8wο\x1d\xe4\x87]\xc6\xf8g϶\x1fa~bb\xfc\xeaO\xab\xef\x8fҋi;J\xcd\x01\x99\"\x83\n\xc7|\xad_\xd9N\xee\xea&\xd2}\x9f¹T\x1aS3\xe6\xa7\xe85\xd42-\x82}\xaf\x93\xd9@\xf1\xa9\xf4kEډ\xcem\xa4\xc9ܙ\xce\xc8x0\b\xa0\xcf\";*)d\xa5}\xdc\xc0B\x7f\x8b\xe1\v\xbf\x15\x8ai`\x89\n\xf6\xdf\xc9QV\x91\x8c\xed\t\xda\xcd\xe4\xef\x8dg\xed\xf9=j0\xf4\xf4z\xd3\xfd\xc5H\x9f\xc3G\x9e\x989F\xf0|:\x82\xc0\xdduqh'\xe4\x87\t\xe7\x0f\x9c\xf7\x05\x89HE\x04\xe3c\vV}*\xbf\xb34}*]\x90h\xf1\xca?\x1d\xe0H\xcb\xf2\xbb8\xb7\xaf\x9b\xbb7b\x04.\xdd\xccN?\u0090\x9e\xbd7\x9dn\xb7$g\xaf\x9f\x917\nt>S/%65\x93\x95wA.^b\x1e\xf67o\xbd\xa7d\xdb]\x94c7\x9b\xaa\x9c\x98Y\xd7͙\x9b\x06\xb9 \x9f.\x898\xf3\xb9s\x8b3\xe6|\x86\xda\xe48\x92\xf3\xe4\"\x19p\x93\x80G\xb3\xe3\xa6\xf2\xdef\xe2\xdeÜ\xb8\xf4l\xb7IИ\t7\x9f\xe3v\xbdL\xf6kx\xd9\xe3\xaaf6Om\xd6\v\x9f\xc6o6\x13mI\xfe\xd9,\xc5.\xcc5\xabs\xc9F\xfa]\x9aa\xd6\xcd \x1b\x01\x9a\x92W6\x9276\x02q2\x9b,5[l\x04\xf6̲;)%\x93?.\xc9\x12\x8b_\xa2BfWC\xfe{\xc9ߥd\x90\xaac\\\xce94\x9fz\xd5-烍5m\xac\xc6\xecTf\x8eˍբ↕\x1c\xb7\x17O,\x8f\xfa\xec\xe6\b\xe7\xfab\x88\xdf$\x1e\xd7t\x97\x99\x90O\x9fka\xde\xf4Ln\xaa\xc9\x13pNhL\x14\a#\xcf\xdc=@\x99\\\x83]!\xec\xf4\xf4W^\xf8\xeb\x82n\x9c\xbc\xe3\x89\xd4\xd8\x0e\x8c9Ba\xa1\x8c_{2\xaaʧ\xcdIg\xf9ⷿW\xa0\xce\x04\xafg\xa9틙\xf3PnZj\xeb\v\x05Eᵍ\xbb}\xaagf7ӓ\xbc\x15n\xc1\x8b\x82\xed\xe1\x88p\xac\x86\xe05\xaf\xad2\xb4^\xc3H\xd5x V֭#\xbf\xcfY\xaa\xa9\x87\x89\x9e\xd7\xd1X\xeej\xcc.\xf2\xcf\xe2n\\\xeepL\x80L=\x1c\x94\xb6!>{\x18\xe8\xb9\x1c\x8f9\xd7#\xd9\xe6J;\xec\xf3\x1c\x87|\x16\x1c\xeeY\xe0\x82,sB\x92ɔr\x88\xe7Y\\\x91gtF\x9e\xc3\x1d\xb9\xcc!\x99\x01\xd9;\x9c\x93r\xec&)\xd9#y\xbf3%Yc~Kr\xfa8M\xc21\x9a\x84\xcd\xca9L\x13\x8e\xcb,;&\x93@\xc3grU\x9e\xc9Yy\x0ew\xe5y\x1d\x96Y\x97eVrf~^v\xbc\xe5\xe2\xe0\xbdT9\xa8ɽ\x8eTќ\x14ʞ\x7f\xd1\xed\xb3\x17\xf9\x0fw\xca\xd9Z\x1dS6\x16\xb0\xaeO\xbdg\xe4'&\xfc>\xaa\x15\xc2ֺ\xdfـi\f\x91x\xfc\xbf\xb1\xf2\xfcm\xa3n\xd7FCI\x15\xee\xb0\xee\xce.\xb5Bo\xc8{\x9a\x1d{ЏQ\xbfb/UA\rY\xd5[^\xaf\x1cp\xfb\xf7jC\xc8\aYoڷo\x92Ѭ(\xf9\xd9\xfa\r\x11\x98\xab6\x88\xcb\x04\"*|\xa1\xff;\xc9Y\x16\xb1\xb4\xa2\x97\v\xb9ʃ+!\xf0ʣ\xac\xbd\xf5]ڊqC\v\x8d\xb2\xee\xf5\x8a{ɹ|Z\xe8\x8eӒ\xfd'\xde\xd2<\x1f\xc3y{\xb7ŪAR\xf0v\xe7:C\xa8Fz\av\xc5l\x8636\xe3\xb7\xfb\x0e\xc4H\xa6]\xfd'Jk\xbdb\xb3\xb1[\x97\\֟\xd54w[\x87\xdd\x06\x85\x85\x8a3\x91\x98\xeba\x8eL\xe5\xeb\x92*svy\x0575\x0e\xe3q\x9c\xb0nNE[F\x97\x97\xe1u\xbfQچ[\x7fq3\xef\\v\xb7B\xfb\x14\xbd\x04\x8f\xf1\xa3|\xb3\x87\xf8\xae\x88Ǹ\t\xb2FJE>G\x93\x92\xae\x16\xc5\xd2\xfej\xdb_\xe4\t\xdeE\xa3Y\x1d\xf2\xdc\xf7\xaaG҉\x02Dw\xb9\xeb\xd4\xed\xa0x9\xe7e\xba(\x9e\x1f\x14\xba\xf6\xd7w&\x8e\xc5\u05ce\f%\xdc\\\x1a\xe0\xeax\xd4\xc6N\xaf\xbb/\xe8Z\xd5*\xcc\x1b;\xdey\n\xa1\xab\xfe\x05w\x7f\xb9~\x8e\x946R\xd1\x03\xfc,\xdd\xd5\xcbs4\xe8\xd6\xeeܻ\xedM\x9e\x90\xb3\x18fC\xcc\x15\xf0\x97@\xf7\x805\xa9ȃkp-\x96\vo\xf55\x86\xcf\f\xe6\xe1\xe1g7\x00\xc3\nؼ\xab\xdc^\xbe\xd5v\x1a,5\xc3\xc0\\\xa3\x9d\xfd\xef1\xb2^\x10\xbcO\xb6ş\x16\xde\n0\xdd\x19\xd3\xde\x16a_\x95\\\xd2\x1cԭ\x14{v\x98\x19ȯ\x9dʽu2Ï~p\xf5\xea\x13\xe0_y\xd7ݚ1\x9c\x03\xff\xc08h\x87V\x82\x12\xbd\x1b\xb6\xaaujU육\xb6\xb7?\xd6\x1d\x8c\xac>nX\x18\xf3-AY\xc3\xc8E\x87+\x1d\xc4r|\xe0\rG\x980p\x88ě'\xb4\xe8\xa9s\xf1w\x10\xe99\x95\xf2%ު\x15\x0flM*g*F5\xca\x18\x9c\xd6\xdb\a\x18)\xc7\xe3\xe5\u05fd%s\xcc\xf8\x1f\xbb\x1d\x1eoD\x9f\xbf\x1f\xde]\x9c\xee_\x83\xf0\x82\\)\xbc\xe2\xd2_\xaa\x8eWB^tE\xfc\xae\xce㩳\x84\xf4[c\xa0(\xa3\x17HG\xd0\x1bi[\x1b$\xd2Pވnl\t\xa8\x9b`\x86\xd1dj\x91\x9b\xb2\x13\x8c\x9b\x12\xda\xd8Xo}N\xfa%c\xadۦ\x8fUWY\x06Z\xef+\xce\xcfu>\xfc\x92\x81Ǭ\x81+\x91\xe2\x03e\xfc\":\xb8\x86#Dpc\x1b]\xf7\x92\xd8\xec\x93pA\xe4a\xf2\x0e\x96n[\xf0\x94\xc82:x\x16\xf8\xdc8mh1w\xd3\xfd\xed\xb0\x05>C\xa2\xf2V6]}]\xfb\x13\xd5\r\x9bcj\xb6\x01\xe7Z\xa2\xcb`\xa1AN\xe0\x04\x82\xd8u\xca\xd17<\x95\xd3o\x13\x81چ\xe2\x8fQ8]\x1f4\x7f\xf0\xfc\xfd\xf3*\x0fhn\xa9\x13\xa8\x97z\x02f}\x01\x7f\x84\bC\xc9t\xae\xf8\x1bk\xcb\xc2:\n4\xc9T\x8b\xea\xdaL\xb3\xae\x9eOVZ\xb7\xf7۱\x96\xa3\x12\x1c*\xc4\xf87x\xe8\xe2\x1b\x95\xd4pd\xa9*j8\xb29\x05\xd5QG\x91\xc15\n\xea\xea\xc3Ĺ:{C6Vr\x16\x00\x1e\a\v\xcf\x1f\xb8\xf3`\x05hM\x0f\xe1j\xec'k0\x1f@\x00\x06\x8f\"\xa3\xf1\xe1\xf8\xe6\xfcP\xf7bh\xb7oH3SQ\xdfA\xc8\xcel\xd5z\x19S\xc0\\\x1e\xdc\xc3\x15,<X\x14<\x89\x854\xf9Z2\x95\xe2y\xbc\xaf+ZڠQ\x87\x8ch\x1e\x98\x02\xce\x0e̚\xed\x96I\a\xaav\xf4\x00\xebLr\x0e\xa8k\x87x=\xe7d\xf5\xa7\xb4>\x03ճC\xfbЮ\xeb\xf7\x97\x1c\xb7ݽ\x8a\xd4%*\xe3{C\x86)h\x1e\xf0\x1a $\xb1\xe3E\x9e\x86\xa3B\xf4\xa9\xab!\xa6\xed\xbaa\x82y\xbd꣐\xfe\xe5\xab\x1b\xef\xbbƃ+\x05\xfdM\xaa\x1bR0a\xff\xa1\"w\x1b@\xa1\xf1\"\xfc\x8fR>\xdeG\xac\xca\x01\xf2\x7f\xad+6\xd1y&\x1c\xdax\xb4n'+\xbfo[[\x98\xf1\xbd<\xbc\xe6\xfcʞ\x13\u009cP\xe8\xd1\xe1,\xd0㮃\x11\xb7\xe9>\xbc\xbe\xc4\xf9\xf9\xa6\x0f\xb9\xf7B[\x03{\n\"J\xae_\xc4\xdb\xd7B\xf9\xbd\x92\x1e\x10W}\xe2\x8e{ҳ#/\xf1\xde<\x8d\xc7L\xbe8\x81\xa7\xed<\x87|\xcbR\x1bA\xdd\xdbonV_\xd7\xf1\xc4\v\xffg$\xff\xce֩ϊ\xb7\xdc(\b\xeb\xc1X`)~>xM>\xc20\x0e\xe2\x8e\xfcB\x8e;\xfe\xb1\xe7\xedl\x95\xad\xb8S\xf2\xa0@\x0f\x05gM\xfeF\x99a\xe2\xf0A\xaa;^\x1d\x98h\xcc\xedE\x95\xef\xa82̊\xb2\xc3'\x86(\x13\x94\xb3\x7fĔS\xfb\xc7y@\xb5\xb5\x11\xf9-\x01\x8d\xb1\x1fށ\xb54G\xbd\xed\xa8\x1e,=]\xe7d\xc1W\x9bӁa\xedol\x87X\xb8\xc7\x03ې\x8f\xd2@H|a]\x98\xd6Z\x02mְ\xdfKe܆\xe8zM\xd8\xde{\xef\x11\xb8Vq`\x10ǽ\x8fG\x98i2\x9d\x9b\xe5\x06\x03\xa9\nWM\xbcл\xa0g\xb7\xddA\xb3\xac\xb2\x16\xd1+m(\x8f\x18$ߤ\xa81Lb\xe7\v俦\xec\x02m\xdb\xf5\x87a-\x04\xe7(\x87W\x018c,j\x9a\x12\f\x83\x83 O\x8a\x19c\r\xa0vf#1\xd6\xe4\xe1\x9chk\x04\\\x14\xdf\"N\xc1m\xc73-\xba\xf1Ӻ\xf2\x98~\xf4\x83\xc3\xe7\xe0vH\x82Ѹ\x9d\xcf(\xf1m-+\xb3#\x15\a+TJV\x87c\x90\xcb\x11Sv\x04n^\x01\xc6\x02QC\xe8\x90Uf*%Z;\xcf\xf5\xf1\xd6\x06]\x9a=\x8eb\xea3g\xc2\x1b\xad\xaf\xfc;\x0f뽒\xc5\xda\xf3\x02\x93\xc1n\xfcn\xb0b\xd2Z\x13\xe6\x18%9qO\xc0\xf9\v\xd5Q\f\xca\x12\x04\xa1\xda\xe3\x93p\x0f\xceū\x876T\x99\xd40\xc0}\xa7\xf2L\x04\x00!\xc7\xf1\xbd\xf7\xbb\xdd\xee>\xa0[\xff\x02b\r\xf8\x86h&\xc2\xf3\xb0n/݉\x82&R\xe0+rR\xc5\xf3\xfc\x06.}ǁ\xef\xa2\xff\xfb\xfa\xee\xa7zM|\x9f\xe2\x04~\xe9U\xef\x9d\xf9ķ\x00\xeb*\xdeq\x8b\xd0\xe3\x0fl\xefR\x0f3\x8b\xf5\x1f\xff\xcf\xcfr\x9e\x92\x9c\x8c\x97\x93\xfe\x05\xba\x0e\xb5\xa30\xf3\xf2\xdf\x1d\ak\xf9h\x80\xae\xeb\xf2r\x91\x8fz\xba,\xear͐Kx\xb9\xf8:\x81\x88\xd3e\xc1\x96g\x8b\xb4\\wtO\x14_K\x9d\x9bc\x7f\xf3\xd5\"\xa1\x16\x0f!\x12l\x89\f\xa3\x0e\xbf\xcc\x06[Z\xb1\x96\x80\xe3\xc8\xe3f\xbd\xf8˕\xa2-\xd1u`\xf0\x11\x15hޚ۾'\xff\xe5\x7f\x03\x00\x00\xff\xff\x1f\xff\x879X}\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4YKs\xe3\xb8\x11\xbe\xebWt\xed\x1e|YQ3\x9bKJ\x97\x94FN\xaa\xa6\xe2\x89]#ǹ\xe4\xb0\x10Д\xb0\x06\x01\x06\x0fi\x94T\xfe{\xaa\x01\xf0!\x92\xb2\xe4\xa9$\x8b\x8bM\xb2\xd1\xe8\xfe\xfa\r\xcd\xe7\xf3\x19\xab\xe5\vZ'\x8d^\x02\xab%~\xf3\xa8\xe9\xc9\x15\xaf\xbfw\x854\x8b\xc3\xc7٫\xd4b\t\xeb༩\xbe\xa23\xc1r\xbc\xc7Rj\xe9\xa5ѳ\n=\x13̳\xe5\f\x80im<\xa3\u05ce\x1e\x01\xb8\xd1\xde\x1a\xa5\xd0\xcew\xa8\x8bװ\xc5m\x90J\xa0\x8d̛\xa3\x0f\x1f\x8a\x8f?\x17\x1ff\x00\x9aU\xb8\x84-㯡v\xdeX\xb6CexbY\x1cP\xa15\x8543W#\xa7\x13vքz\t݇\xc4!\x9f\x9e$\xff\x14\x99m\x12\xb3\x87\xcc,~W\xd2\xf9?_\xa6y\x90\xceG\xbaZ\x05\xcb\xd4%\xb1\"\x89\xdb\x1b\xeb\xff\xd2\x1d=\x87\xadS\xe9\x8bԻ\xa0\x98\xbd\xb0}\x06ษq\tqw\xcd8\x8a\x19@\x86&r\x9b\x03\x13\"\x82\xcdԓ\x95ڣ]\x1b\x15*ݞ%\xd0q+k\x1f\xc1L\xba@V\x06\x1am\xc0y\xe6\x83\x03\x17\xf8\x1e\x98\x83ՁIŶ\n\x17\x7fլ\xf9?\xf2\x03\xf8\xd5\x19\xfd\xc4\xfc~\tE\xdaU\xd4{暯\xc9FO\xbd7\xfeD\n8o\xa5\xdeM\x89\xf4\xc0\x9c\x7faJ\x8a(ɳ\xac\x10\xa4\x03\xbfGP\xccy\xf0\xf4\x82\x9e\x12B@\x10!4\b\xc1\x91\xb9|\x0e\xc0!q\x89\x18MK\xaaFg\x9d\x89M\xa2\xc0ˀK\x92\x9f\xded\xe9{l\x1b\xff.\xb8Ŗ\xa5\xf3\xac\xaa\xcf\xf8\xaevx\x89\xd9\x19\x14\xf7X\xb2\xa0|_U\xb2\x92\xea\xfb\xe5\xb9Z5\xf2B\xa4]g'ޟ\xbdK\xa7n\x8dQ\xc8\x12\x97Du\xf8\x98\xbc\x90\xef\xb1b\xcbLljԫ\xa7\xcf/\xbfۜ\xbd\x86)G\x1a\x04\x05\x19\x8e\xf5l\xb3G\x8b\xf0\x12\xe3/\xd9\xcde\xd5Z\x9e\x00f\xfb+r\xdf\x19\xb1\xb6\xa6F\xebe\x13,i\xf5rQ\xef\xed@\xa6;\x12;Q\x81\xa0$\x84ɏr\xbc\xa0Ț\x82)\xc1\xef\xa5\x03\x8b\xb5E\x87\xda\xf7\xe1m\x05+\x81\xe9,^\x01\x1b\xb4Ćb9(A\xb9\xeb\x80փEnvZ\xfe\xb3\xe5\xed\xc0\x9b\xec\xbc\x1e\x9d\x1f\xf0\x8c\xf1\xa9\x99\"W\r\xf8\x130-\xa0b'\xb0H\xa7@\xd0=~\x91\xc4\x15\xf0\x85\xfc]\xea\xd2,a\xef}햋\xc5N\xfa&\asSUAK\x7fZ\xc4t*\xb7\xc1\x1b\xeb\x16\x02\x0f\xa8\x16N\xee\xe6\xcc\xf2\xbd\xf4\xc8}\xb0\xb8`\xb5\x9cG\xd1uJ\x9a\x95\xf8\xd1\xe6\xac\xed\xee\xced\x1dEmZ1k\xbea\x01ʘ\xc9\v\xd2֤E\a4\xbd\"t\xbe\xfeq\xf3\f\xcd\xd1\xd1\x18C\xf4#\xee\xddFי\x80\x00\x93\xbaD\x9b\x8cXZSE\x9e\xa8Em\xa4\xf6\xf1\x81+\x89z\b\xbf\v\xdbJz\xb2\xfb?\x02:O\xb6*`\x1d\v\x13l\x11B\x1d㾀\xcf\x1a֬B\xb5f\x0e\xff\xe7\x06 \xa4ݜ\x80\xbd\xcd\x04\xfd\x9a:$N\xa8\xf5>4\xb5\xf0\x82\xbd&\xa3xS#?\x8b\x1f\x81NZ\xf2p\xcf<Ƹ\x18\xe0\x9aC\xfcr1m\xd6tp\xd3b\x9c\xa3s_\x8c\xc0ᗁȫ\x96\xf0L\xc6\x1am%],\x8bP\x1a;\xac\x18\xac\xcd\xc0\xfd\xd5d\xaab\xf4\ru\xa8Ƃ\xcc\xe1+2\xf1\xa8\xd5\xe9§\xbfY\xe9\xc7\a]0$\xad$\xe2\xe6\xa4\xf9\x13Zi\xc4\x15\xe5?\r\xc8[\b\xf6\xe6\betk\xedՉr\x90;i>ζ\xcdZ=}n2o\n\xa0\x1co\x19\xab\x02V9rM\t\x1f@HG\r\x80\x8bL\xc7`\xe9\xa0b\x83\xb0\x04oû\xd4\xe7F\x97r7V\xba\xdf\xd3\\\xf2\x98+\xac\aȭ\xe3I\x94\x9a\xc8;jk\x0eR\xa0\x9dS|\xc8R\xf2,I\xb0\xa9r\x95\x12\x95pcM/DYTŢ\xa0\xa8f\xea\x8a\r\xd7-a쀙\xd4Ƀ;\x061\xd9\xd8*\x97T\xedQ\x8b\xb6\x1b9\x93\xc6Ĭ\xe5P\xc0Q\xfa}J\x87j*\xee\xe0\xcdأ\xf5\x8a\xa7\xa9\xd7\x03ٟ\xf7H\x94\xa9\x80\"8\xe4\x16}\xf46T\xe4>\xe4J\x05\xc0\x97\xe0bB\x1d\xe6\x89f\xc5F\xad\xd9\xfd\x8a\xa71\xd0p\u0378\xb9\x85\xb9.\xf2\x1d\xb5\u038d\xc0\x16K\xb4\xa8\xfddR\xa7\x01\xc4j\xf4\x18\xf3\xba0\xdcQJ\xe7X{\xb70\a\xb4\a\x89\xc7\xc5\xd1\xd8W\xa9ws\x02|\x9e#h\x11Ǌŏ\xf1\xcf\x05\x95\x9f\x1f\xef\x1f\x97\xb0\x12\x02\x8cߣ%\xab\x95A5\x8e\xd6\xebo~\x8a5\xf6'\bR\xfc\xe1\xee{p1u\x8a\x9c\x1b\xb0\xd9D\xef?Q\xa3\x16\x85\"\x886\xc9*\xc6\x02UJ2v\x95\xad\x99r͔#Nu\x98\xfdE\x89\x89*\xc8TF}\xc5q2}#\xcc\x00\xbe\xcd;C\xcd+V\xcf\x135\xf3\xa6\x92|6\xd46\xb6\xc1W\"\xb2i\xbb\xa5\x16\x92S\xdbv\x1eI\xcd8\"κ\xf3\t\x18\x86\xfd\xfa\xa5\xfc1\rSR7W\xcf+\x12?\xf6i\xbb!.%\xb3\\\x11\x1dzj\xb7\x1ch\xa4\x8a\xc9\xec\x18\xe7\x98B\xb8њb\xd7\x1b`mb\xbcsÊ\xf0\xce|\xb2\r\xfc\x15'\x80\x1f\xa9\xf2)\x126\x18\xa7m$Kp\x18S\xf551\xe0zDp\xb6F{\x8b,\xeb\x15\x11\xb6E\x95\xc1z\x05۠\x85\xc2F\xa2\xe3\x1e5\xcd\x13\xb2<M\x9fE\xeb\xf9aӠ\x1a\xfb\x91<\x114\xd8N\xeb\x902\xfe\x12\xb6\xa7\x89\x0e\xe2\x06%k\x8b\xa5\xfcv\x83\x92O\x91\xb0\x01\xbcf~\x0fR;)\x10\xd8\x04\xfc\xa9\xb5\xbb\xa0h\xdb-<\xe6\x9c\xf3\x1d\xe6y+7$qޓ\x1e\x1a\x8c\xaf\xc4\xcfS&kQh\x9es\x9d8\xef\x1c/\xc5\xf1\xa4F\x87\xf6z\xe1O\xa9\x1f\xe3\x13\x85\xf5L\x98\x97\xf1\x8e7\xfa\xba\xe6\x92c*\x98\xa9\x8b0֢\xab\x8d\x164j\xdd\xd6\xd5u\"\xff\xf7z\xbbi\xb3\xceϳ\xdc\xe0[c\x85\x9b\x06\x9bx\xa1\xf3\xee\xd1&]s\xf5\a\a\xb3u4Wv\xd3\xcd@\xc7\xff\xcbP\xf3Co\xaa\xa1\xe9YCб\xaf\x8b\xfdA\x01\x7f\xd7pO\x930U'\xb1$\xb9\xed\x94\x03H\a\xda\x1ci{\x8f_d\x01F\xa7\xeaN\xb3\x1d\xd3\"\x8f\xce\xf1\xd3Q*E\xf5\xddbe\x0e\x93\xf5\x9d\xdaR\x8b\xea\x04̑\xeb\x1c~.>\x14?\xfcf3\x93b\xce\xd3\b\x84\xe2+\x1e\xe4\xf8Nh\x8c\xee\xc3hG\x13\xf8m8\xd0\xc3/\xcdh\xbd\xb0\x99\xec\x97\t0J\xa9\xa8s\x9c\xc8\x13]\xc70\xbe\xbd\xfc\xb4y\xb8s\xb1\xe1G\xed\xa7\x9a\xc4#Z\x8c\xf3\x15\n\xea\xf9M\xbe\xc5\bΣ\x9dp\x80\xd6z\xd1栌\xde\r\x02'\xad|\xa7A\xfd\\r(cA\xa0\xa7Ҥw\xc0\xf7Lﰻ\xb3\xca\xf2\xbf-)\xb9\xcf\xc0g:\x0f\x91\xfa\x92{\xdcd\xd1g9\xd5ԏ\xee\x8b;\xe2\xe9\xbb\xe2F\xfaƲ\x17\x87\xa2+\xb8\x8f\xe8\x9b*M\xa0\xce}w\x7fܭ\xef\x1f\x86Ǘ\xd37 \xf1ޛ\xf37nA\xe0\xc8\\w\x87\xfe\xdb\xe1PQ\xb7z\xb5\x05\xfe\x92\xa8\xd2ec\xde\x02lk\x82\x7f+2\xef\xa6\x1c:\xff8\xf0\x1e\x19\xe3O\x1eך\f\xa2i,\u0083\xa5\xc1\xb3\xbbC\x8bIa\xaa\xb6\xdc~\x19\xb5\x1a\xfc2\xd3\xff6\xfe\xdd\xe6\x06\xbd&k\xed\xe8e\xaa\x97=\xbbf\x90\xfbo¶\xbdW^¿\xfe=\xfbO\x00\x00\x00\xff\xff\x80.\x12\xd3P\x1c\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4\x96M\x93\xdb6\x0f\xc7\xef\xfe\x14\x98y\x0e\xb9<\x92\xb3\xed\xa5\xa3[\xb3\xc9a\xa7mƳ\x9bɝ&a\x8bY\x8ad\x01\xd0[\xb7\xd3\xef\xde!)\xf9E\xb67\xdbCy\x13\t\x02\x7f\xfe@\x80j\x9af\xa1\xa2\xfd\x8a\xc46\xf8\x0eT\xb4\xf8\x87\xa0\xcf_\xdc>\xffĭ\r\xcb\xdd\xdd\xe2\xd9z\xd3\xc1}b\t\xc3#rH\xa4\xf1#n\xac\xb7b\x83_\f(\xca(Q\xdd\x02@y\x1fD\xe5iΟ\x00:x\xa1\xe0\x1cR\xb3E\xdf>\xa75\xae\x93u\x06\xa98\x9fB\xef\u07b7w?\xb4\xef\x17\x00^\r\u0601A\x87\x82k\xa5\x9fS$\xfc=!\v\xb7;tH\xa1\xb5a\xc1\x11u\xf6\xbf\xa5\x90b\aǅ\xba\x7f\x8c]u\x7f,\xae>\x14W\x8f\xd5UYu\x96\xe5\x97[\x16\xbf\xda\xd1*\xbaD\xca]\x17T\f\xd8\xfamr\x8a\xae\x9a,\x00X\x87\x88\x1d|β\xa2\xd2h\x16\x00㱋\xcc\x06\x941\x05\xa4r+\xb2^\x90\xee\x83K\xc3\x04\xb0\x01\x83\xac\xc9F)\xa0\xbe\xf4X\x8e\ba\x03\xd2#\xd4p \x01\xd68*0e\x1f\xc07\x0e~\xa5\xa4\xef\xa0ͼ\xdaj\x9a\x85\x8c\x06\x15\xf5\x87\xf9\xb4\xec\xb3`\x16\xb2~{K\x02\x8b\x92ē\x88\x12\xd7\x06\x0ft\xc2\xf7\\@\xb1oc\xaf\xf8<\xfaSY\xb8\x15\xb9\xda\xec\xee*i\xdd㠺\xd16D\xf4?\xaf\x1e\xbe\xfe\xf8t6\r\xe7Z\xaf\xa4\x16,\x83\x9a\x94fp\x95\x1a\x04\x8f\x10\b\x86@\x13Un\x0fN#\x85\x88$v\xbaZu\x9c\x14\xcf\xc9\xecL»\xac\xb2Z\x81\xc9U\x83\\\xa0\x8d\x97\x00\xcdx\xb0\n\xd32\x10FBF_\xeb\xe8\xcc1d#\xe5!\xac\xbf\xa1\x96\x16\x9e\x90\xb2\x1b\xe0>$gr\xb1\xed\x90\x04\bu\xd8z\xfb\xe7\xc17\xe7s\xe6\xa0N\xc91?\xd3(\x97\xce+\a;\xe5\x12\xfe\x1f\x9470\xa8=\x10\xe6(\x90\xfc\x89\xbfb\xc2-\xfc\x961Y\xbf\t\x1d\xf4\"\x91\xbb\xe5rkej\x1a:\fC\xf2V\xf6\xcbR\xffv\x9d$\x10/\r\xee\xd0-\xd9n\x1bE\xba\xb7\x82Z\x12\xe1RE\xdb\x14\xe9\xbe4\x8ev0\xff\xa3\xb1\xcd\xf0\xbb3\xad\x17\x17\xa4\x8eR\xe8\xafd \x97yM{\xddZOq\x04\x9d\xa72\x9d\xc7OO_`\n]\x921\xa7_\xb8\x1f7\xf21\x05\x19\x98\xf5\x1b\xa4\x9a\xc4\r\x85\xa1\xf8Dob\xb0^ʇv\x16\xfd\x1c?\xa7\xf5`\x85\xa7+\x99s\xd5\xc2}餹\xa8S4Jд\xf0\xe0\xe1^\r\xe8\xee\x15\xe3\x7f\x9e\x80L\x9a\x9b\f\xf6m)8}\x04\xe6ƕ\xda\xc9\xc2Ծo\xe4\xebJ\xd1>E\xd49\x83\x19b\xdem7V\x97\xf2\x80M x\xe9\xad\ue9e2\x9d\xd1=\x14x{\xb6p\xbd\xa0\xf38\xb6\xc9\xf9\xca\xcd\xc3Cɝ%\x9c\xdd\xc2\x06.z\xee\xeb\\J3\xfc\x97dj'\x1e\xd9\xe8D\x84^N\xfa\xb3\xba\xb6\xe9\xad,\x90(\xd0\xc5\xecLԧbT^ze=\x83\xf2\xfbq#H\xaf\x04^\x90r\x19\xe8\x90r\x9fA\x03&]\xf0\x1b\xb1\x9c\xbe%\x91\x82F\xe6\xf6\xc2\xce\n\x0eW4\xbd\x92\x9d<|rN\xad\x1dv \x94\xf0Ff\x15\x91\xda\xcf\xd6ʛ\xf5\x1d\x04\xabls-\a\x87w\xfa\xbbI(\xb8}\x1a.#5\xf0\x19_\xae\xcc>\xf8\x15\x85-!ϯ|^\\Uz\x87\x9f\x817P\xbaz)/&9\xf7;sB\x91%\x90ڞr\xe5\xb4>\xf4\xef\x0e\xfe\xfa{\xf1O\x00\x00\x00\xff\xff\x045\f\xc6i\n\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VM\x93\xdb6\f\xbd\xfbW`\xa6\x87\xb43\x91\x9c\xb4\x97\x8eo\xad\x93\xc3N\xd24\xb3N\xf7NS\xb0\xc4.E\xb2\x04\xe8\xcd\xf6\xd7w@J\xfe\x94\xbd\xdeCu\x13\t\x82\x8f\x0f\x0f\x8f\xac\xaaj\xa6\x82y\xc0Hƻ\x05\xa8`\xf0;\xa3\x93?\xaa\x1f\x7f\xa5\xda\xf8\xf9\xf6\xfd\xecѸf\x01\xcbD\xec\xfb{$\x9f\xa2\xc6\x0f\xb81ΰ\xf1n\xd6#\xabF\xb1Z\xcc\x00\x94s\x9e\x95\f\x93\xfc\x02h\xef8zk1V-\xba\xfa1\xadq\x9d\x8cm0\xe6\xe4\xe3\xd6\xdbw\xf5\xfb\x9f\xebw3\x00\xa7z\\@㟜\xf5\xaa\x89\xf8OBb\xaa\xb7h1\xfa\xda\xf8\x19\x05Ԓ\xbb\x8d>\x85\x05\xec'\xca\xdaa߂\xf9Ð澤\xc93\xd6\x10\x7f\x9a\x9a\xfdl\x86\x88`ST\xf6\x1cD\x9e$\xe3\xdadU<\x9b\x9e\x01\x90\xf6\x01\x17\xf0E`\x04\xa5\xb1\x99\x01\fG̰\xaa\xe1t\xdb\xf7%\x95\xee\xb0W\x05/\x80\x0f\xe8~\xfbz\xf7\xf0\xcb\xeah\x18\xa0A\xd2\xd1\x04\xceD\x9d`\x06C\xa0`@\x00\xecw\xa0@9P\x91\xcdFi\x86M\xf4=\xac\x95~La\x97\x15\xc0\xaf\xffF\xcd@\xec\xa3j\xf1-P\xd2\x1d(\xc9WB\xc1\xfa\x166\xc6b\xbd[\x14\xa2\x0f\x18ٌ,\x97\xef@C\a\xa3'\xc0\xdf\xc8\xd9J\x144\"\x1e$\xe0\x0eG~\xb0\x19\xe8\x00\xbf\x01\xee\fA\xc4\x10\x91\xd0\x159\x1d%\x06\tRn8A\r+\x8c\x92\x06\xa8\xf3\xc96\xa2\xb9-F\x86\x88ڷ\xce\xfc\xbb\xcbM\u0090lj\x15\x8fr\xd8\x7f\xc61F\xa7,l\x95M\xf8\x16\x94k\xa0W\xcf\x101\xf3\x94\xdcA\xbe\x1cB5\xfc\xe1#\x82q\x1b\xbf\x80\x8e9\xd0b>o\r\x8f\xbd\xa3}\xdf'g\xf8y\x9e\xdb\xc0\xac\x13\xfbH\xf3\x06\xb7h\xe7d\xdaJE\xdd\x19F\xcd)\xe2\\\x05Se\xe8.\xf7O\xdd7?ġ\xdb\xe8\xcd\x11V~\x16\x99\x11G\xe3ڃ\x89\xac\xf9+\x15\x10\xd5\x17\xc1\x94\xa5\xe5\x14{\xa2eHع\xff\xb8\xfa\x06\xe3ֹ\x18\xa7\xec\x17\xe5\xec\x16Ҿ\x04B\x98q\x1b\x8c\xa5\x88Yy\x92\x13]\x13\xbcq\x9c\x7f\xb45\xe8N駴\xee\r\xd3(f\xa9U\r\xcbl(\xb0FH\xa1Q\x8cM\rw\x0e\x96\xaaG\xbbT\x84\xff{\x01\x84i\xaa\x84\xd8\xdbJp腧\xc1\x85\xb5\x83\x89\xd1\xc9.\xd4\xeb\xa4\xd5W\x01\xb5TO\b\x94\x95fctn\r\xd8\xf8\bj\xdf\xf9\x03\x81\xf5Q\xe6\xe9\xce\xcd\xe0Tl\x91OGO\xb0|\xcbA\xb2\xfdS\xa7\x8e\x8d\xe6G\xac\xdbZ\xbc\x82\x06 \xc5=~\xaa\xcf2^\xc6\x00\x93\xea\x9dD2\x8aXh\x10^\xc5\nĤ\x0e1\x9do-\x1f\xba\xd4OoP\xc1\xef\x19\xf3g\xdf^\x9d_z\xc7\"\xf7\xabA\x0fަ\x1eWN\x05\xea\xfc\v\xb1w\x8c\xfd\x9f\x01c\xb91\xaf\x86\x8e\x17\xef\ue5ba\x12\x98\xec\xc5}\xefQ\xfc\x1e/\x9ft\b\xb8)\xcb\r\x98\x86ț\x0e\xba\\ݽ\x86\xc2\v\xe1\xaf(ҝ\xdb\xf8\xe9\xb8\v\xed=~\xf9\x1a\x7fY\xab\xf2\x10\x18\xb5*K\xca݆\xf0)\xad1:d\xa4\xbd\xcd>\x19\xee&3\x02<uFwya\x16\xba88\x91\xd7&\xfb\xe1\xeb\xe1\x8b?\x98\x88\x13\xcdV\xe5&\x9c\x18\x16\xf0g\xc3\x17\\\xed\xd2\x06\xd5\xe0479#+N\xf4\no\xcc\xf1#\xd5:ň\x8e\x87,\xf9\xadp\xba\xe0Vs\x1c\x1d\xe5\xaf\xfb\xcf/8\xe4\x87}d~\xf4*\xe3\n\x9a\x10\xb1\"\xd3\xca\vG\xe6\xc4#\xb3w\x9d\x93Q\xbe\xe3\x17\xd71Q\x93\x15\xc5\xef\xc1\x94\xc6z\x01\xe2\xc7]`1rt\xe5\x92>}S\xe6\x84H\xf9\x01\xa4\xd5\xe9\xd3K\xbe5B\x83\x16\x19\x1bX?\x97\x1b\xe9\x99\x18\xfbs\xdc\x1b\x1f{\xc5\v\x90˻b3!#\x97\xacUk\x8b\v\xe0\x98.\xa9l\xf2\xe0\xa1S4цGg\xfe*1S\xc2\xd85\xe3Ue\xc0\xc5{\xa3\x82/\xf841\xfa5z\x8dDx\xdeF\x17O2\xd9\x04g\x83$/\xac急\xe1\xe1>\x8c\xfc\x17\x00\x00\xff\xff\t\x15i;\xcd\r\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4YKs\x1b7\xf2\xbf\xebSt9\a\xfdSe\x0ec\xff\xb7\xb6\xb6x\xb3\xe5͖v\x13Yeʾ\xa4r\x00\a=3\x88f\x00\x04\xc0P\xe2\xa6\xf2ݷ\x1a\x0fr\x1e )\xa9\xd6\u07b9H\x04\x1a\x8d\x1f\x1a\xfd\xc6b\xb1\xb8`Z|Ac\x85\x92+`Z\xe0\xa3CI\xbflq\xff7[\b\xb5ܾ\xb9\xb8\x17\x92\xafષNu\x9fЪޔ\xf8\x01+!\x85\x13J^t\xe8\x18g\x8e\xad.\x00\x98\x94\xca1\x1a\xb6\xf4\x13\xa0T\xd2\x19նh\x165\xca\xe2\xbe\xdf\xe0\xa6\x17-G㙧\xad\xb7?\x14o\xde\x16?\\\x00H\xd6\xe1\n\xb4\xe2[\xd5\xf6\x1dnXy\xdfk[l\xb1E\xa3\n\xa1.\xacƒx\xd7F\xf5z\x05\x87\x89\xb06\xee\x1b0\xdf*\xfeųy\xef\xd9\xf8\x99VX\xf7\xaf\xdc\xecO\xc2:O\xa1\xdbްv\x0e\xc2OZ!\xeb\xbeef6}\x01`K\xa5q\x057\x04C\xb3\x12\xf9\x05@<\xa2\x87\xb5\x00ƹ\x17\x1ako\x8d\x90\x0e\xcd\x15qH\xc2Z\x00G[\x1a\xa1\x9d\x17ʭ\xe2\x10\x00B@\b\xd61\xd7[\xb0}\xd9\x00\xb3p\x83\x0f\xcbkykTm\xd0\x06x\x00\xbfY%o\x99kVP\x04\xf2B7\xccb\x9c\r\xe2]\xfb\x898\xe4v\x04\xda:#d\x9d\x83q':\x84\x87\x06%\xb8FX\b\xa7\x85\af\t\x8eq\xfe\x94\xf9\x8d\xfd<-\xb7\x8euz\x84\xe0\xca ;,\r\x108s\x98\x03\xb0\x97'\xa8\n\\\x83$y\xafXLH!k?\x14n\x02\x9c\x82\rz\x88ȡ\xd7\x19d\x1a\xcbB+^\xc8\xc4t\x04\xebf2zN6D\xff\xdfF5\x02t\xab\xf8\v\xa0<k\xdf@<\xda\xf5\xcbp\xe8\xac~4\xe8i\xd2\xe6\xbdn\x15\xe3hh\xfb\x86I\xde\"\xdd,\x03g\x98\xb4\x15\x9a#0Ҳ\xbb\x9d\x1e\x83\xf9\x9c\xf8\rf\x9e#\x8ch;k\xa7\f\xab\x11~R\xa5wP\xa4\xd2\x06G:m\x1bշ\x1c6i\x17\x00\xeb\x94\xc9*8!\x0e\xab\"\xdf\xc4vbg\xe3=\x8f\xa3\x1f\xf0N\xfe\xb4(\xc9F\x84\x92y\vzWc\xdez\xc2\xf4\xf6MpWe\x83\x1d[EJ\xa5Q\xbe\xbb\xbd\xfe\xf2\xff\xeb\xd10\x806J\xa3q\"\xb9\xcf\xf0\r\x82\xc3`\x14Ƣ\xbe$\x86\x81\n8E\x05\xb4A\a\xc3\x18\xf2\x88!\\\x87\xb0`P\x1b\xb4(\xddP$\xe9S\x150\tj\xf3\x1b\x96\xae\x805\x1ab\x93.\xa6Tr\x8bƁ\xc1R\xd5R\xfc{\xcfے\xaeѦ-s\x18\xbd\xf8\xe1\xf3\x8eV\xb2\x16\xb6\xac\xed\xf150ɡc;0H\xbb@/\a\xfc<\x89-\xe0ge\x10\x84\xac\xd4\n\x1a\xe7\xb4]-\x97\xb5p)(\x96\xaa\xebz)\xdcn\xe9\xe3\x9b\xd8\xf4N\x19\xbb\xe4\xb8\xc5viE\xbd`\xa6l\x84\xc3\xd2\xf5\x06\x97L\x8b\x85\x87.}`,:\xfe\x9d\x89a\xd4^\x8e\xb0\xce\x14#|>\x98\x9d\xb8\x01\ng ,\xb0\xb84\x9c\xe2 \xe8\xe4\x8e>\xfd}}\aik\x7f\x19S\xe9{\xb9\x1f\x16\xda\xc3\x15\x90\xc0\x84\xacȬ\xe9\x12+\xa3:\xcf\x13%\xd7JH\xe7\x7f\x94\xad@9\x15\xbf\xed7\x9dpt\xef\xbf\xf7h\x1d\xddU\x01W>S \xf7\xd4k\xd2\\^\xc0\xb5\x84+\xd6a{\xc5,~\xf5\v I\xdb\x05\t\xf6iW0Lr\xa6\xc4Aj\x83\x89\x94\xa2\x1c\xb9\xafIޱ\xd6X\xd2\xed\x91\x00i\xa5\xa8D\xf4P\x952\xc0\xa6\xe4ňq\xdep\xe9\xcbz\xa7)\xd1\x04\xd9\xfbܚ\x84M\x0e|jr\x98\x81r\xc6\x14\xa0\x9dz\xd9\xfd\x1a\x83ZY\xe1\x94\xd9\x11\xe3\xe0`\x8b\x19\x87#\xd7@\x9fT\x1cϜ\xe3Fq\xcc\xc1\xa6\xa5\xe0\x1a\x16\xb4\x95\xf2+\xf2G\xbd\x94\xf3]\xe8S\xf2Y\xc0\xb4\xe2gp\xc5\x1d\x19\x18\xacР,19\xaeS\xc9C\x06\xd90\xac\xcf1\x1eW\n8\xe1ճ\x88\xdf\xdd^'O\x9e\x84\x18\xb1\xbb\xf9\xbeg\xe4C_%\xb0\xe5>Н\xdf\xfb\xf2\xba\n\x9by\x9f\xe6\x140\xd0\x02C\x1a\xb8\x0f\x12 \xa4u\xc88\xa8*ˑj\x12 \xc37\x18W\xbc\x0e\x1e,\xba\xcaCh!\xd9\x03#\xdf)8\xfcs\xfd\xf1f\xf9\x8f\x9c\xe8\xf7\xa7\x00V\x96h}\x16\xec\xb0C\xe9^\xef\x13s\x8eV\x18\xe4\x94fc\xd11)*\xb4\xae\x88{\xa0\xb1\xbf\xbc\xfd5/=\x80\x1f\x95\x01|d\x9dn\xf15\x88 \xf1\xbd[NJ#l\x10Ǟ#<\b\u05c8i0\xddK\x80\xd4+\x1e\xfb\xc1\x1fױ{\x04\x15\x8f\xdb#\xb4\xe2\x1eW\xf0ʧ5\a\x98\x7f\x90\xed\xfc\xf9\xea\b\xd7\xff\v\xa6\xfd\x8a\x88^\x05p\xfb8<4\xba\x03\xc8`yF\xd45\x1e\xb2\xaa\xe9\xe7\x83\n\xb9\xea\xefA\x19\x92\x80T\x03\x16\x9e1\xdd^p\x94\xc8g\xa0\x7fy\xfb\xebQ\xc4cy\x81\x90\x1c\x1f\xe1-\x88X\xdahſ/\xe0\xcek\xc7N:\xf6H;\x95\x8d\xb2xL\xb2J\xb6\xbb\x90\xe7n\x11\xac\xa2B\t\xdbv\x11\xf2 \x0e\x0flGRH\x17G\xfa\xc6@3\xe3Njk\xca~\xee>~\xf8\xb8\n\xc8H\xa1j\xef\x89)jV\x82\xb2\x19JcB,\xf6\xda8\v\xe6\xe9\xb3}P\x1f\xa7\xa0l\x98\xac1\x9c\x17\xa1\xea):\x16\x97/\xb1\xe3yJ\x92\xbeLj2u\x1c\xff\xb3\xe0\xfe\xc4\xc3\xf9\f\xfa\t\x87\x1bV\x19'\x0fw\xdfo\xd0Ht\xe8\xcf\xc7Ui\xe9h%jg\x97j\x8bf+\xf0a\xf9\xa0̽\x90\xf5\x82Ts\x11t\xc0.}\x99\xba\xfc\xce\xffy\xf1Y|E\xfb\xd4\x03\x8d*\xed\xafy*\xda\xc7._t\xa8\x94\xc3>=\x8e]\xaecf5]Kf\xf1Ј\xb2I\xc5I\xf4\xb1G\x8cIP&̃kfr\xf7\xd5U\x99\x04\xda\x1bB\xb4[\xc4^ڂIN\xff[a\x1d\x8d\xbfH\x82\xbdx\x92\xf9~\xbe\xfe\xf0m\x14\xbc\x17/\xb2\xd5#\tx\xf8\x1e\x17\aX\x8b\x8e\xe9E\xa0fNu\xa2\x9cPSVz\xcdI\xf0\x95@s&\x8d\xfb4\"N\x89f&\xbf\xdd\xd3<+\x8ft\xac\xce$n\xc3\xd6\xe1\xa9\xf4\ue93cƍ\x1bV[`\x06\x81A\xc74\xdd\xf3=\xee\x16!!\xd0LP4\xa7\x80\xbd\xef\x8a\x00Ӻ\x15\xd9\xc0\x1d\xc3~LY\xa3$\xa8,g\xb5=v\xf6쭥.\xd0\x1a\x1d\x95\xad\xdfD\x0e\x9f'{>Y&\xf9\xc4<J)\xc9d\xdf\x0e+\x95\xacD\xdd\x1b_\x17ͅ\"\xfb\xb6e\x9b\x16W\xe0L?w?O\x90\xd9\x1d\x91<\xed\xa8D\x9a\xf4\xf6L\xef\xce59_8\xea\xe8\xcd\x0f\x83\xb2\xef\xe6P\x16p\xaf\xb4`\x99q\x83\xd6\xcdl\x92&^\xcds\xb9\x13\x17\x1b\xf4\xef\x8c\fbK=S\x1eF\xf5\r\xb9\xf4\xbeD\xf4]\xd4\xdcU\x1f/\xc0\x8eB4\xf8{O\x95\xc1\x18\xe2\"_\xacOh\xa8x\x9d\fi\xc5/\xa6\x82\x1c\xba\xa6\xc9\xe4\xa8\xd3;D:\xef`\xf8\x06\xfe3z\x18\xe1a\"\xca4D,\x97\x9e+\xa8\\{i\x17\xa3TT\t\x8d\xba\xa0g\xae\xf7j\xbe\xc27\f\r\x8f\xea.:\xb2\xee\xc13F\xdc#׆\x80\x01\xbb\xb0\xd2\xe7\b\xc4\r\xb9/S\xa8\x8a\xaa\x98h\x91Cz\xab\x9a\xae\xc9p\x1dr\xd9`E\x015\x98^*\xfe#\xbc})\xd0 X߉\xbb\xb4'x\xf6\x16\xb9\xef\x1ae\x840/\x0f*e:\xe6B\xe7x\x91e\xfa$\x9f\x94\xb5\xc4\x0e\xade\xf59S\xfc9P\x85\x9eH\\\x02l\xa3z\xb7o\x8a\x8cBʥ\x8d:\xf5\xbc\xbeL\xb6\xdd0VgF堍eQ\xdb\xfa5CGpx\xc7\xf4\xa86\x98O\xab^\xe2\x13\x00\xfc\x03\xdd9\x84D\x933\xb0\xbd\xf7:iap\xc2)\xdf\xe0Cft\xf6\xb08\x9c\xbcJ&\x93\x99\xfb\xd1[ó\xce\x1f7:'\x82H\x06\x8dj\x931+\xc7Z\x90}\xb7ACr\xd8\xec\x1cڱ;\xcfu\xc0|\xe5|\x10\xe3`}\xba\xbf\xc0)6\x03J&}\xa7چ\xa8΅\xd5-\xdbe\x18\xa7\x83\xf8옌\x8b\\\xc0A\x9f\x93Qk<\x96\x04\x9c\xee\xdcyL\x1f\x94<R\xcb%{\x16\xd2\xfd\xf5/'ri!\x1d֓\xe0\x10\xe7I\x9c\xefi\x97\xaf\xb3É$\xc6J\xa6m\xa3\xdc\xf5\x873Z\xb0\xde\x13&k8\xa4\xd9\xde\xf7\xf9>z$\x8a\xaa\x90\xbb\xaa\xbdoy\x96\xa9\x8e\x9f\xb4\xcfA\x1d\x11\x9f\x89B\xf11=\x17\x83֨\x99!K\xf7\xaf.W\xd3g\xc1\xd7`\x85o%Sf\x1aR\xd5\xd0\xe8\xb1\x14\x9c(\xb5R\x063.\x13\xe6ae\x14D\xc6\xf0\xbfe\xfc\xc8\xea\xc9l\xd0#\xe7\x03\xde\xf19b8\xd2o\xf6Om+\xf8\xe3ϋ\xff\x04\x00\x00\xff\xff{ŋW\xf4\"\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4YKs#\xb7\x11\xbe\xf3Wt\xad\x0f\x8a\xabv\x86\xdeM*\x95\xe2mW\x8aSJl\xadj\xa9\u074b\xcb\apМ\x819\x03\xc0x\x90b\\\xfe\xef\xa9\x06\x06\xe4\xbcHJ\xaaȞˮ\x80F\xe3Ç~\xa1\x99eٌi\xf1\x15\x8d\x15J.\x80i\x81\x8f\x0e%\xfde\xf3\xcd?l.\xd4|\xfbn\xb6\x11\x92/\xe0\xda[\xa7\x9a\xcfh\x957\x05\xde\xe0ZHᄒ\xb3\x06\x1d\xe3̱\xc5\f\x80I\xa9\x1c\xa3aK\x7f\x02\x14J:\xa3\xea\x1aMV\xa2\xcc7~\x85+/j\x8e&(O[o\xbf\xcb߽Ͽ\x9b\x01H\xd6\xe0\x02\xb4\xe2[U\xfb\x06\rZ\xa7\f\xda|\x8b5\x1a\x95\v5\xb3\x1a\vR^\x1a\xe5\xf5\x02\x8e\x13qq\xbbq\x04}\xaf\xf8נ\xe7s\xd4\x13\xa6ja\xdd\x7f&\xa7\x7f\x10\xd6\x05\x11]{\xc3\xea\t\x1ca\xd6\nY\xfa\x9a\x99\xf1\xfc\f\xc0\x16J\xe3\x02\xee\b\x8af\x05\xf2\x19@{\xce\x00-\x03\xc6y`\x8e\xd5\xf7FH\x87\xe6\x9aT$\xc62\xe0h\v#\xb4\v\xcc\x1c\xf4\x80Z\x83\xab\x90\xb6\f\xac2!\x85,\xc3P\x84\x00N\xc1\n\xa1E\u00832\x80_\xac\x92\xf7\xccU\vȉ\xb8\\+\x9eˤ\xb3\x95\x89\x9c\xdf\rFݞ\xcea\x9d\x11\xb2<\x85\xec\xff\f\xaa\x87\xe7^\xf1'\"y\xa80\xc8$4^\u05caq4\xb4y\xc5$\xaf\x11\xc8@\xc1\x19&\xed\x1a\xcd\t\x14i\xd9\xc3^\xf7\x91|I\xfa:3\xcfa\xe79TD\xd9\xde\xf6_\xbbC\x97\xf6\xbdW\xbc]\x00\xadQ\x83u\xccy\v\xd6\x17\x150\vw\xb8\x9b\xdf\xca{\xa3J\x83\xd6N\xc0\b\u2e6e\x98\xed\xe3X\x86\x89\xd7űV\xa6an\x01B\xba\xbf\xff\xed4\xb6vQ\xee\x94c\xf5ǽC\xdbC\xfa0\x1c\x8eh\xc9\xd9\xca\xf6\xfa\xff\x14\xb8+\x82t\xa3d\x9f\u05cf\x83\xd1)\xb0\x1d\xa5)\xde\xe6\x85\xc1\x10j\x1fD\x83ֱF\xf7\xb4~(\xfb\xfa8sq No\xdf\xc5PVTذE+\xa94\xca\x0f\xf7\xb7_\xff\xba\xec\r\x03h\xa34\x1a'Rt\x8d_'ytF\xa1\xcf\xec\x15)\x8cR\xc0)k\xa0\x8dN\x11ǐ\xb7\x18\xa2\xb3\b\v\x06\xb5A\x8b2摞b !&A\xad~\xc1\xc2\xe5\xb0DCj\xc0V\xca\xd7!\x02m\xd180X\xa8R\x8a\xff\x1et[\xf2=ڴf\x0e\xdb\x10\x7f\xfcB\f\x96\xac\x86-\xab=\xbe\x05&94l\x0f\x06i\x17\xf0\xb2\xa3/\x88\xd8\x1c~$\v\x11r\xad\x16P9\xa7\xedb>/\x85KI\xb3PM\xe3\xa5p\xfby\xc8\x7fb\xe5\x9d2v\xceq\x8b\xf5܊2c\xa6\xa8\x84\xc3\xc2y\x83s\xa6E\x16\xa0ː8\xf3\x86\x7fc\xda4k\xafzXGN\x17\xbf\x90\xeb\xce\xdc\x00%;\x10\x16X\xbb4\x9e\xe2Ht\nٟ\xff\xb9|\x80\xb4u\xb8\x8c!\xfb\x81\xf7\xe3B{\xbc\x02\"L\xc85\x05]\xbaĵQMЉ\x92k%\xa4\v\x7f\x14\xb5@9\xa4\xdf\xfaU#\x1c\xdd\xfb\xaf\x1e\xad\xa3\xbb\xca\xe1:T\x12\x14/\xbd&\xcb\xe59\xdcJ\xb8f\r\xd6\xd7\xcc\xe2\xab_\x001m3\"\xf6iW\xd0-\x82\x86\u0091\xb5\xceD\xaa`N\xdcװ*Yj,\xe8\xfa\x88AZ*֢\b\xbeA\xe1\a\xd8H>艹v]\xfaV\xac\xd8x\xbdtʰ\x12\x7fPQ\xe7Ph\x80\xed\xe3Ԛ\x04Nvr^T\x0e6J\x8e\x94\x02\xd4i\xf1\xaeB\x83\xdd5\x06\xb5\xb2\xc2)\xb3'\xc51[\xe6#\r'.\"\x1cY\xf1\vǠp\x1f\x1c\xc2\xe0\x1a\r\xca\x02S\x848W\xc9L\x9c\xa2\x93\xd0\xc7\x10OS\x0fg\xa2\xe7$\xe0\x0f\xf7\xb7)b&\x86[\xe8n\xbc\xef\x05z\xe8[\v\xacyH(\x97\xf7\xbe\xba]\xc7\xcdB\xecp\n\x18h\x81\xb1\"=\x04c\x10\xd2:d\x1c\xd4zR#\xbd\r\x80\x1c\xcc`\xbb\xe2m\x8c\x14mH:\x86p\xa2\x1e\x18\xc5(\xc1\xe1\xdf\xcbOw\xf3\x7fM1\x7f8\x05\xb0\xa2@kC\xbe\xc6\x06\xa5{{\xc8\xd9\x1c\xad0ȩp\xc1\xbcaR\xacѺ\xbc\xdd\x03\x8d\xfd\xe9\xfd\xcf\xd3\xec\x01|\xaf\f\xe0#kt\x8doAD\xc6\x0f\xe1/ٌ\xb0\x91\x8e\x83F\xd8\tW\x89a\xd2:0@\xd6\xd5\x1e{\x17\x8e\xeb\xd8\x06A\xb5\xc7\xf5\b\xb5\xd8\xe0\x02ބJ\xf0\b\xf37r\xac\xdfߜ\xd0\xfa\x97\xe8@oH\xe8M\x04w\xc8w]\x8f<\x82t\x15s\xe0\x8c(K<\x16\xa2\xc3/\x04o\n\x89߂2ĀT\x1d\x15A1\xdd^\x8cG\xc8G\xa0\x7fz\xff\xf3I\xc4}\xbe@H\x8e\x8f\xf0\x1e\x84\x8c\xdchſ\xcd\xe1!X\xc7^:\xf6H;\x15\x95\xb2x\x8aY%\xeb}\xac\xf6\xb7\bV5\b;\xac\xeb,\xd6\x1b\x1cvlO,\xa4\x8b#{c\xa0\x99qg\xad5U\x19\x0f\x9fn>-\"22\xa82\xc4;\xcaNkAU\x03\x95\v1\xe7\x05k\x1c%\xcd\xf4Y\x1f\xcd\xc7)(*&K\x8c\xe7EX{\xcaB\xf9\xd5K\xfcx\x9c\xfa\xd37Q\x02\f\x03ǟ\x96D\x9fx\xb8P\xa9>\xe1pݷ\xd6\xd9\xc3m\xfc\n\x8dD\x87\xe1|\\\x15\x96\x8eV\xa0vv\xae\xb6h\xb6\x02w\xf3\x9d2\x1b!ˌL3\x8b6`\xe7\xe1\xc9<\xff&\xfc\xf3Ⳅ\xd7\xf5S\x0f\xd4{\xf4\xbf\xe6\xa9h\x1f;\x7fѡR\xad\xf8\xf4<v\xb5l\v\x98\xe1Zr\x8b]%\x8a*=\x02\xda\x18{\u0099\x04U\x9c<\x86f&\xf7\xafn\xcaD\xa87\x84h\x9f\xb5=\xad\x8cIN\xff\xb7\xc2:\x1a\x7f\x11\x83^<\xc9}\xbf\xdc\xde\xfc1\x06\xeeŋ|\xf5D\xa1\x1b\xbf\xc7\xec\b+k\x98\u03a24s\xaa\x11\xc5@\x9aj\xbf[Nį\x05\x9a\vU\xdc\xe7\x9ep\xaaB'\xaaȃ̳\xcaH+\x99\xb6\x95r\xb77\x17p,\x0f\x82\t\xc3\xf1\xba\xda\xe21\xe9\x1a4\x81\x9e\x87'\xf8\xcb\xdd\xe9@\xd2\a\u0557NȔ\x11eH[\a\xdf\x0f\xaf\b\xc9\x1a\xd6m\xfeu\xbf\x86i-d\xf9,\xac\xa9\x97\xb6DG\xcfˉ\x02\xb8\xdb\x05=W&\x9f\xb5\xbbމ\xbf\f\xf6\x04f\x10\x18\xc1\xa7\xcb\xd8\xe0>\x8bE\x96f\x82*$*\x82b\xd59]\xd73\xadk*l\xdaR\xea\xd0T,\x94\\\x8bқ\xf0z\x19\x93\"}]\xb3U\x8d\vpƏ\xe9<\xe3)\xdd\xfe\xe3\x85\xcb\xfd\xd2\x11M7{\xa1\x03ꪩ\xbb\xed\xf5EǇA\xe9\x9b1\x94\f6J\v61Nv=\xf2i\x9ax3\xae\x05\xcf\\lt\x9a\v\x1c\xb4\xed\xba\x89\xb7g\xebs\xb1\x16\x0f#\xf4\xde\v\x9e7\x9dĞ\xeb\x8b\x06\x7f\xf5\xf4\xb0\xe8#̦_\xd4\x03\x19\xad\xf8lHZ7\x8c\r&\x8fAh8\xd1\xf7\xef\xc1l\xaf\x8d\xdc=\u0378\x19\x11z\x94\xcfiGľh\xcb{̊.uK\xe9I\xf8\xe2\x86D\xa1\xe8\xb9\xd5ki^\xb0\x81\xeb\xf1\x8a\xd0\xfd3\xbc\xf5\t\xd1`x\xe5ǖ\xee\x8eٴ\xc9\xd4}CG_\\\x1a*\x11R\x87<<\x86譶f\xa2F\x0e\x87_\xa6\xc2\xcf\x0f6\xb4\xc1\xae\xa6j\xff\xa4\xc8[\xe4!\xd6N\x80\x1e\xafK\x9de\xce\x1cf\xa4\xe2e\x81fҽ\x1a\xb4\x96\x95\x97\xfc\xeb\xc7(\x15\xfb$\xed\x12`+\xe5ݡQ\xd2:ZKŕm\xad\xe0y͚\x8a\xd9KP\xeeIf\xca\xe2\x0e.\x7f\xde\xe4\xe0L(\xbb\xc3\xdd\xc4\xe8\xa8\xd3ߝ\xbcN&41\xf7}\xb0\x8eg\x11\xd0nt\x89\x83V\f*U'\xebV\x8e\x12\xb9oVh\x88\x88\xf0\xf3Bb$\x05\x8e\xa9\xceSx\xb1\x1e\x99<jH\xb10\xaaj\xdf\xe0\x05\x93\xa1\x11kc2\xe4\xc2\xea\x9a\xed'\xf4\xa6\x93\x84\xa2\x94̗\xfc\xe8h1\xc9\v\xc9\xfdO$\xcf\xf3\x1d\xb3\xc3\xcf'\xd3%\xf3ԏ1S\xb7\xd0\xfdee0\x7f\xf8\xdd\xe8uv8\x93\xfc\xadc\xc6=5\xec-{\u0097\"^P=\x1dﺡk\x1c\xa8\xfa\xdb\xfc\x911j\x92\xa8\xd1`@\xce;\xba\xdbnswį\x0e\xbf\xa5,\xe0\xb7\xdfg\xff\v\x00\x00\xff\xff~\xab[k\xf5 \x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xdc=Ms\x1b\xb9rw\xff\n\x94spR%\xd2\xcfI\x0e)\xdd\x14\xaf\x9de\xde[[%9\xde38\xd3$\xf1\x84\x01f\x01\fe&\x95\xff\x9eB\xe3c>\x88\x99\xc1P\xe2\xbe}\xc1MC\xa0\x01t7\xfa\x1b\xd0j\xb5zCk\xf6\x1d\x94fR\xdc\x12Z3\xf8a@ؿ\xf4\xfa\xe9\xdf\xf4\x9a\xc9\xf7\xc7\x0fo\x9e\x98(o\xc9\xc7F\x1bY=\x80\x96\x8d*\xe0'\xd81\xc1\f\x93\xe2M\x05\x86\x96\xd4\xd0\xdb7\x84P!\xa4\xa1\xf6\xb3\xb6\x7f\x12RHa\x94\xe4\x1c\xd4j\x0fb\xfd\xd4la\xdb0^\x82B\xe0a\xea\xe3\x9f\xd6\x1f\xfey\xfd\xa77\x84\bZ\xc1-Q\xa0\x8dT\xa0\xd7G\xe0\xa0\xe4\x9a\xc97\xba\x86\xc2\xc2\xdc+\xd9Է\xa4\xfd\xc1\x8d\xf1\xf3\xb9\xb5>\xb8\xe1\xf8\x853m\xfe\xdc\xfd\xfa\x17\xa6\r\xfeR\xf3FQ\xdeN\x86\x1f5\x13\xfb\x86S\x15?\xbf!D\x17\xb2\x86[\xf2\xc5NS\xd3\x02\xca7\x84\xf8\xa5\xe3\xb4+\xbf\xea\xe3\a\a\xa28@E\xddz\b\x915\x88\xbb\xfb\xcd\xf7\x7fy\xec}&\xa4\x04](V\x1bD\x80_\x1ba\x9aP\xf2\x1d\xf7f\x17\x80\xb8&\xe6@\rQP+\xd0 \x8c&\xe6\x00\x84\xd65g\x05\xa2:B$D\xee\xe2(MvJV-\xb4--\x9e\x9a\x9a\x18I(1T\xed\xc1\x90?7[P\x02\fhR\xf0F\x1bP\xeb\b\xabV\xb2\x06eX@\xack\x1dv\xe9|\x1d\xec\xe5\x9dݮ\xebEJ\xcb'\xe0\x96\xecQ\x06\xa5ǐ]\xad90\xddnm\xb8\x1d\xbf%*\x88\xdc\xfe\x15\n\xb3&\x8f\xa0,\x18\xa2\x0f\xb2\xe1\xa5e\xaf#(\x8b\x9cB\xee\x05\xfb\xef\b[ۍ\xdaI95\xe0\xe9\xdd6&\f(A99R\xde\xc0\r\xa1\xa2$\x15=\x11\x05v\x16҈\x0e<\xec\xa2\xd7\xe4\x17$\x8f\xd8\xc9[r0\xa6ַ\xef\xdf\xef\x99\tǤ\x90U\xd5\bfN\xef\x91\xe3ٶ1R\xe9\xf7%\x1c\x81\xbf\xd7l\xbf\xa2\xaa80\x03\x85i\x14\xbc\xa75[\xe1\xd2\x05\x1e\x95uU\xfeC$ۻ\xdeZ\xcd\xc9r\x9e6\x8a\x89}\xe7\ad\xf3\t\nX\x86w\xbc䆺]\xb4\x88\xb6\x9f,v\x1e>=~\xeb\xf2\x19\xd3C\xec#\xde;\xccג\xc0\"\x8c\x89\x1d(GD\xe46\v\x13DYK&\f\xfeQp\x06b\x88~\xddl+f,\xdd\x7fk@[\x86\x96k\xf2\x11e\a\xd9\x02i\xea\x92\x1a(\xd7d#\xc8GZ\x01\xffH5\\\x9d\x00\x16\xd3ze\x11\x9bG\x82\xae\xd8\x1bvvX\xeb\xfc\x10\x84\xd7\b\xbd\xfc\xe9\x7f\xac\xa1\xe8\x9d\x18;\x8c\xed\xfc1';\xa9z\xc2\xc1\x0eY\xf7\x80\xa6\x0f\xadm\xee\xf4[\t6\xfce\xb0\x94\x7f\x8f\x1d-\xff\xd8E4\x82\xfd\xd6\x00\x8a8wb\xe1L\xa4\x9c\x81$a}\xc8\x16\xeb\xb3\xdfGpj\x1b\xfc(xSB\x19\xa5\xed\xd9^\x06+\xfet6\x00\xb5\x0ee\xc2\xf2\xbf\x15\xffv٢\xfdՊ\xd3Ċ\xa9\x02b9\x90\t\a\x8f0\x81\x9bMb\xda6f\xa0J,nrw\x84\x88\x86s\xba\xe5pK\x8cj`\x043T)z\x1aALP\xc1\xb9x\x89\xfd\xbd@\u0b00\xae\xa2p\xa8qJ\x86\xaa\xf3\x15\x91?8V\x98\xb6\xe2,\xec\xf2^rV\x9cfQ\x93\x1a\x14\x8e\x9b?|\x81\x83\xb7p\xa0G&UbK\xf6Dڮ\x1dE\xda\nSie\x99\aR^\xb6\xe1$\xb2\x0eR>\xcd\xd1\xfegۧ\x95ڤ@\xe3-n\xc5S\xdb+\xd1-\x10\xf8\x01Ec\x12\xcb$\xa4lP\x81HEj\xa9\xcd8\xdd\xc7e\x0fq\xe2`\x8ci\xc9\x14Ӝ\xed̋\xca@9\xbbўؔ\x02\xecZ+K\xb9\xb6\xaf\x92\x8d\xeb;\xd4o\x1d\x8c\xa71B\xb6TCI\xa4\xe7\xfa\x86\x83\xf6s\x95H\xfeV\xae܌\x82\x8e\x9bw\x96\x06\xa7[\xe0D\x03\x87\xc2Hu\x8e\xc9\x1c|\xba\x96#+G𘐚}\xf6o76\x01\x92X6\x7f>\xb0\xe2\xe0\x8c\x00˛\b\x87\x94\x124\n\x0ek\xa8\x9e\xc66I\xe6h\xef'\x99\x12\x1dm\x9b9SCx)qҶ\fq۶\x19\xc1{&X\xfc\xf7\xa4\xe6l\xdb\xffO\xc4\x06Mr\x01\xd3nΆ\xbe.ӢSe\x8d\xfd͎@U\x9b\xd3\ra&|\x9d\x83H9\xef\xcc\xffwL\x98\xe5\x1c\xbf\x19\x8e|U\x8e\x9f\xa4\xca\x1cDK\x958\xfd\xdf!QPY<z]\x91M\x90\xbftG\xdd\x10\xb6\x8b\x04)oȎq\x03j@\x99\x17\x9d\x97\xd7@F\x8e\xbe\xb3\xad\xa2\xa68|\xfaa-\x1b\xddƙ2\xf12\x1c\xecL\xe2\xe0#\xf4\x15\xf3\f\\\x82\xee+SP9\xb7\xf8\x1bb\xb3\xfd\x82\xfe\xc4ݗ\x9f\xa0\x9cB\x0f\xc9㼳\x8d\xdc\r\x16\u06dd\xda\xdb\xf9\xb9\xdb\xf0\xa6O\xf4\x99\\\xc0\xe3\x86P\xf2\x04'g\xb1PA,q\xa8A{7\xe9=\x9d#\a#/\xc8dOpB0>\x942;:\x97\x15\\{\x82\x84\xb9\x9fj=\x04\xda5y\a\xd7a\xd2~@D\xa0㝏<\x82a\xb1 \x8b\xe67G\xf2\x05Ih\x01\xf7\x17l3\x92\xad\x13>D¾ӎD\xf6\x14\x1cX\x9d\xb9Q\x8c\x1ej\xc0\xd3\x12\x02c\xdf)ge\x9c\xc8\xf1\xfdF\x8c[\xc3\xfd\xf6E\x9a\x8d\xb8q\x1e\x99F.\xf9I\x82\xfe\"\r~\xb9\n:\xdd\xc2/@\xa6\x1b\x88\xc7K8\xb1m\xf1Ѝ\xb0e0\xb7k\x1b\x17H\x89\xe4a\x9al\x84u\\<>0^ꦛ\xd6\x0f\xfdV5\x1aChB\x8a\x15\xaa\xcauj&\x87\xecL\x90R\xf5(r\xbe\xb48\xa9\x9b0\x13\xec7\xabI\xdcx\x17\x01洀2x\x9b\x18\xb7\xa4\x06\xf6\xac \x15\xa8\xfd\x94\xe2\xe8\xb6\xda\xca\xf7\xbc%dJ]\xd7\x16rX\x9ej\x0f͋\xeer~1+{r3z\x05b\xcfv\x1d\tW\x8ew\x9d\xdf\x11\xaaX\xb4?f\xb1K\xcb\x12SH\x94\xdf/\x90\xf8\vhq\xae\xfb\xdd\u009c\x86\xachm\xcf\xef\xffX5\x87\f\xfd\xbf\xa4\xa6Le\x9c\xe1;L\x13q\xe8\x8d\xf5\x81\xb1\xee4v\x06\xa6\x89\xa5\xef\x91\xf2\xf3@xbs\xd2\xca\x16\xe0N\x91\xcbݙ\xc5rC\x9e\x0fR;\x9d\xbac\xc0S!\x9b~c\x9a\xbc}\x82\xd3ۛ39\xf0v#\xde:\x05\xbfX\xdcDkA\n~\"oq\xecۗ\x18A\x99\x9c\x98\xd9\xed\xc7\xea)\x86\xe4V\x15\xadW\x9e{\x8d\xacX1:N$\xc3\xe3m\xeb\xb1S7D\xde\xc6ƽy<\xb5\xdb,\xfe\xad\xa56?\xa7\x03}#\xeb\xb9\x0f#\xfa6m\"^6k\xeb\xfb\xd8W\x14\xc6\xd6\x02\xdc\x19P>\xf8\xe7\x04t\xf0\x1c^\xe8S\xcd\x05\xf7b`\x8fƀ\xacE\xf0\f7\xb9TI\xce\x12\x97X\x9b\x16/\v\xed\xf4O?:\xb1I{\xb2\xed\xdfݍ\xbc\xb65\\Ȫ\xa2\xc3\xe4`\xd6R?\xba\x91\x81\xa7= G}\xb5o\xf0<盉\x81\x870-\xf8\xcć\tB\x83\xd8\x00\xe5\x19\x8a\x92Z\xceK0\xd7\x0eT\x93-\x80\x881\xf5?\x82\x9e\xaf\x98\xd8\xe0\x04\xe4ë\xdb\x05\xa4E\xd7E\xe4\f\xa8\x8e\x04\x8d\x1fPS\xe5\x9aT\xb2$\xcf\aP\xd0\xe3\x8a\xf3@\xb9\xb543A\ni\xba\xf1\b\v\xb7\x96\xe5;MvLi\xd3]h.\xc35:\x97\x1d\x16R\xd8\xee\xee\x1b\xab@6\xe6\x02\x1a|jG\xf7\xf2\xba\x15\xfd\xc1\xaa\xa6\"\xb4\x92M\x86Q\xe0\x9a\xd5/\xac\x8a\xc9WO\x81g\xcaL\xccCad\xc6HK\xa5\x9a\x83\xc9%\xf1\x16vV\x1c\x15RhV\x82\n\xc5\x01\x8e\xb2Lڃ\xbb\xa3\x8c7\xa9\xb4O\xaa-uo\xc5'\xa5.\xf2n\xbf\xba\x91\x9dh\xe3A>\xf7\x11\x94\x8d\x82\x03=\x02a;\xc2\f\x01QX\xba\x80r\"\x1b\xa7\xf0\xc8@\xd4d\xb3e\x9e\x80\xb7\rDS\xe5!`\x85'\x9b\x89\xc9`Z\xb7\xfbg\xca\xf85\xc8f9\xef\xb3T\x0f@\xcbK\x020\xbfv\x86\x13\x10\xbaQ\x98\xb8w\xe2\xe5\x99\xf1\xbc5[\xca\x11N\x1bQ\x1c\x00\xe5\x94\xe8\x89\x0f\xe2\xc03\xa1\r\xd0\\^\xb0VS#\x04\x13\xfb<\xdae\x878\xdb\xe6P\xbd\x95\x92\x03\x1d\x16<\xa5\x9a\xc5\xf5\xe5b\xe8\xd7v\xf4\xef\"\x86\"\x05\xf2ͅ-xRyYD\x8d\x81\xaav\xe7M\x12Ո\xae\xf6\xb9\x82\x14Z\xe2\x83\xfbU\xbc\xa6s\xcd\x04\xcb \xec \xe7\xc2Lײ\xb4 \xaejY\xda\t\xa2QqI\xf8l\xd3\x03`OgpRp\xed\x91k\x16X\x99[ \xb4,\xa1t\x81Ik\xaax\x9fŕ\x97\x8d\x94*$w\xb7\xdcL̢lh=\x8f\x14C\xb1\xea\b\xabF<\t\xf9,V\xe8\xc9\xeb\xc5\x02$?4\xf8\xaaӛ\x8b%\xd1\xef)\x85\xfa\xfc\x9a\xcfS\xc1x\xba\x82\x94\xc9\xe6\x9bEѐ).\x98\x93k\xaety\xe4\xc7\xd9UL\xcd?1\xd8'\x9a?\xba\x9a\xe3\xdcz\xb6MzT\xc7\xf8{>\x809\x80\n\xc5\xcc+\xac\xdbN\xc9\xe96\x1f\xdd\xfa1\xb1\xc0\xcd\xf2O0\x85]\xe1\xe5\xa0\xe4-\xed\xe8X+\xe0\xc626m\xb8q\xe5ǪI0QV\xe1W\xda2ȩ\x9c\x98\xab\x97\xe8\xd7\x00\xc6z\x85P\x04(\xc3$\x89\x1d:Z\xbaJ\xdfn2\xbe_\xf8\x80!\xbf\xb0ҿyy`FM\xc3L%\xc3t\xd1\xe4\x14\xbe\xce٦\x8b\xb1\x96\a}?_M\xfb\xc7B\x9f\x81\xeak\xed\xcf\xc1\xa8\x01\xda\xc7`bȠ\x1c\x04%\xb7uٱ\xb0\x80\xb2\x94p\xb1\xa7Ї\x03-Ļ\x02O\xa2\f\x805\x86\x9a\xfdi\xf3\xd5\xedL\x93\x7f%\a\xd9$J\xea&\xb03S`1^V\xe1\x93\b`\xe8\xf1ú\xff\x8b\x91\xbe\xc8\x02#_\x89ݡ\xa3\xd2FS\x99(ّ\x95\r\xe5\xbdC\xd6a\x8b\x96{\x88TD0\x9eʯZ\xb6\n\xe3{lD\xbe\xd6.ϲX\x1cM\x9b\x88y\xb5\x18\x17W`\xf4+,F\x94\xd4ҔC~\xa9i~\x8d\xc5tQĒʊa\xdd\xc4(\xd0\xf9z\x8a\x1c\xeb~\xa6v₊\x89\xccj\xb9\x17'Hrj\".\xaa\x84\x98-(ˬ\x7f\xe8W6L\x83\\P\xf5\x90\x85\x9c\xf9\n\x87\xc5u\r\xbe\x8e`r\x1f\xd9\xd5\f\x89:\x85I\xc0\xa35\fS\xd5\t\xd3(OT.\xe4\xd7$L\x82\xc6z\x85\xf9J\x84\u05eb7|\r/`\\\xd4\xccV\x13\xbc\xc8KȨ\x17XR%0\x8b\xb1\v+\x02b\xc6\x7fdޥu\x00\xfd<\xff\bМ\xec\xffHv\x7f\x04\xe2d\xce?7\xa7?\x02{F\xedNr\xc9\xe4\x8fKr\xf9\xd1\r\xf9\x85\xd65\x13\xfbs>\xc9\xe5\xa6IN:+\x04\xe8\xce\xd9c\xa5\xae\xb7\xd0\xf3\xb3RS\xba[\xb9\t\x9f,\x84\xf5\x980rM\xee\xc4\xe9\f.^\tH\xfa \xfdk[vYό\xf3\xee\xdd$\x04\xdb\x05\xe5o\xf9\xe9td\xc0v\x1c\xb3\xb0\x93$\x94\xaag\x1dϹ`_\aݻ\x81\xc2ik;eh3s\xb8\xd0ڮ\x1anX\x9d<\xf2\xb5\x92G\x86a\xc7\x03\x9c\">\xff*\xf1V\xd0\xf6\x84\x90\xbe>\xc4Ӹ\x1e8\x0e4u\x86\x9e\x81sB\xf5\xf9\xf6\vw1\xb6\x90+\xbc\xebf)\x19\xf8\xc1_\xa0\xbd\xc1\x13\x9b\xf2\xd8E\xb8\xb2YY0x\xb9V'\"\"\xa3\xbah\xda\x1ev\xa6;~\xfb\xad\x01u\"\xf2\x889}o ͔\xdd;\xb9\xa2\xad\xff\x16$\x9d\x17\x97\xee:\xf6\xc0Oh\xe5\v\xb9\x13Nc'\xc1\x0eֈp\xac\x88k}#+ͭ\xdb3\xd25\tU\xc88:\xcd\x0f\x93\x8a)\xb7f\xfd\xba\x9e\xd2r_i\xd6J\xb9\x8a\xbft\xb9\xc74\x012\xb7\x06=/'2[s~-\xcfi\xcew\xca6\x1a\xf3jʯQK\xbe\xa0\x86|\x81\x0f\xb5̋\xcaFSN\xad\xf8U|\xa9+zS\xd7\xf0\xa7.\xf3\xa8f@\x0ej\xc0s\xaa\xbb\xb3\xd2x\xd99\x9b\x9c,\xdb|\xe6x\xbaj;\xa3Z;#\x1b4\xb7Ҍ\xaa\xece\xd5\xd8\x198\xbc\x92\xafu%o\xeb\x1a\xfe\xd6u=\xaeY\x9fk\x96sf~^VE}q\x92!\xa4\xa3\xbf\xc8\x12\xee\xa52s\x0e\xc2\xfd\xb0\x7f\"\x05\xd8q\x9a$/\x89\b]S\x99\x06k\xfb{\xbb\xff\xb2M\xa5\xb3u\xc1\xfc\xfdE\x96vms\xb9\x85\x87A\xf7\xb3+\xb4;P \xdc\xc3\x12\xff\xf9\xf8\xf5K\x84\x9f\xb2G\xbd\xd1;x\xd3\xc0\x19\x18\xa5G\x8e\xcf>\xf9\x82\x1b\x87-\xd4ᯜ$\xa05\xfb\x0f|\xb3k> sw\xbf\xc1\xae\xc1Z·\xbebB?\xe6\u07b6`\xb5G\xc4\xc8(\xf7ov=\x88\x89\xb2\xd3\xf8'\xc1\x17\x93\x82\xf6bc5Y\xae\bɞ\xba\xfb\x8d[ݚ|\xb6\xa6\x9b8\x11\xe9\x18\xef\xc0T\xb9\xaa\xa92'\xe4\x0e}\x13\xd70\x1e\x94\t:d*t2*j\xcf߂J\xe26<\t\x85\t\xb8S\xdd\xcff\x0e1z\xc9:\xc6oO\xccޛx\xc5u\x8c\xab\xe3\x15b*\xf19Y\x01\xf1j!)/\x86\xee\xbfω\xb5\x87\xd8qZ\x9eYO6\x84u\x12\xf8\xb1\xe3Q\xa4iAk}HD\x85^&\xd3\xf0\xa1*CM\x93\xb9\x1f\u05f7\xb7%V\x1c:\x02\xe8\x19\x82\x88R\x9dg\xfb\x06k\xb2g\xd5\x01B5\x8c&\xb4`\xfc\xa6\xe3\x98\xff>)\xcf\xccgA.~\x10ġgDT`\xa4Ɋ\xb1\xc0\v-^.HvΚp\x19\x85\xad\xd3vg\xe6\x8b\x12\x17\xbf%1\x8f\xac\x04\xa2ƞ\x91\xc8y*\xe2o\x8a\xcf\t\x91\xa4\x8b\x03\x94\r\x87\x8c\a\xde\x1e;]\xe7\x9fx\v\x80SgR\xf6\x1fy\xb3x\xed\xa8Wk\xf0\xf6\x1f\x93\xf3H\xf7\x90GJ\xbc\xbb \x9de\xef^\x9d*\xac\x1d\xaf\x9b\xa2\x00\xadw\r\x0f\xd52\x85\x02j\xa0\fݓ\x95\xf9a\x0f\x8b\xcaB\x9a\x9aKZ\x82\xfa(Ŏ%\xf2\b=\x9c\xfeW\xaf\xf3\x80a\v\xfcب\xf6\x11\xbf\xc9\xf7\xd3^$\x9d\x9e\x153\xf0XS\xa5\xe13\xe3Y\xe7\xed\xd7\xc1\x10\xe7\x9d\xed8ݻꦒ\x15\xd4@TD8\xc3\xc8\x01\xd8\xe1x\x8d\xb0\xb8+T\x91#a\x98\xec\xa30V#?z\x18\xd2\x06\xc0\xaa\xf3\x02\xe2\x9b\f8:\xa1\xe1&\xb4[Ak\x83\x17\x18\x90\xe0\x8dRȭ\xee7\xb9;{\xb5\xb1\av\x9c\xa2\xbe\x12\xd5\xd7QiC\xab\x84\x11=\xbc\xc37\x1c\x81o\xa3\xaa\xb2Sy\xd5}\xdc.\x16T\xa5\x98\x89\xeaX\f[\xae;\xb0\x1d\x18\xb4[-h(\t\x1cA\x10\xcbݔq(\xa7*\xaf\xbfa\xc4S\x1dA\xbd\xd3\x11\x0eւٓ\xf1h\xa82q\xe9\xe7\x87y'UE\xcd-)\xa9\x81\x95\x1d}\x99q\x93~xR\xa9\xf9\xe4\x14\xde7\xf2g\x04/\t!y9\xf7\xb7\x84*К\xee\x83\xe7\xf5\f\n\xc8\x1e\x84E\xf1\xd4\xdbz\xedE+/|cɠ\xc5\x16-LC\xfd\x04\xceȉi\xbb\x04H\xff`+:s\xfbQ\x91Ǆ\x81\xfdY\xc2\xcc_\xf2z\x00\xaa\x87\x0f\xfc\x9e!\xe2s\xb7\xaf\x0fw:\x1c\xb8\xd7d\xa8\xab\xef\xc3\xf7`\r\x8b\x0e\xe6\x88\"\xb13/\x12\xcf\a)\x9f\xb2\xacПc\xc76\xda\u0084\xe3#\xbcڶ\x95\x8d\xe9ڗr,6\x8b\xef.\xbe\xb2\xbcF\x98w\xee\xae\xcbX\b\xf1\xfca\xc78 \xfas\xd2PNDSmA!C\xc6\x0e\x87\x89\xfbޏ\xe1uY\xceO7Cȃ\xe7\xa6[\xd8S\x10\x91\xf4^\x06t\xee\x00\x87\xd8\xd7\x00\x88\xeb>\xf1\xf4$\xc1\xb7\x82\x83\xca\x1f{\xecn\x8a\xa3#\x8e?\xe3\xa2r\x11\xecz\x8fa\xd7-\u07b91 \xd2^\x18\t\xb9\xe7p,.X\xfa\x84\xbdW\x1f\xa8\x9e3\xf4\xeem\x9fx\xf9\xb7\xa3\x93\xa2\x8d\xf70r$ӗ\x11W\xe4\v<'\xbe:da\x06'\xadIVd#\xee\x95\xdc+\xd0猳\xc2\xdbiL\xec?Kuϛ=\x13\xb1xxY\xe7{\xaa\f\xb3\xac\xec֓\x18\xfb1\xe8\xb2\xc4o\xf3\xa3G~\x98\x90Q\xb5\xdf\xf3l8\xd3u\x9b\x93O^\x80\xbeӭ\xbeH\x85\xfe<\xb45\xf9\"\r\x84,#\xeb\x03e\x9alA\x9b\x15\xecvR\x19\x17}^\xad\b\xdby;%\x15%\xa5\x8c\xa3\x97\xe4^綮S\xac\x8b\x8b6\xbb\x8f\x84)T\n\xe8^U\xf4\xe4\x02j\xb4(\xac\t\fﵡ)S\xfcEb\x14\xdd2\xcf\xcd9\x87|\xd3\xed\x1f\x83Q\xf1\x80#8\x87:\xbc\x15\xec4p\xb2\u0082\xe0\r\xd4Σ\x04D[mv\xc9q'N\xd0l\xc6]\xcc\xfeU\xaa\xd8yLN\xf9m\xf4\xde!\x1e\xaf\xa2f:\f\xb54+\x0eT\xec-\xfb(\xd9\xec\x0f\x81\x05\xc7\f\x95\x11\xa0e\x83\xd1\xea\x1aO\xaa\x0e\xd9z\xd3(\xd1I6\xf9\xfc}\xd9.w\n\xe8\xc5\x12S\xb5w\tZ\x991\xa1yS\xc6\xfe\xc8\xe0\x11\xfc\xa7B\xe1q\b\xd5'QL_pp\x9e\"\x9b\xb8\xc78\x85\x8c\xe4~\xa3\x04\xbcd\xbfqp\xfe~\xbbʻu%\x96l~<B\xf0\n\xe8\x183\n\xe6q1m \xe0\xfe\x12+_D\ueb81\x11L\x89T<\xd2\x1a\x17\xcbp\xa1{N\xd6\\\xb8\xa8\xd7\xf9e\xce$Nl]\xc9?\xae\x13x\x8cf̧\x1cw\xf0\xfb\xa0\xfb\u0d98u\f[\x88ޅK \xe7\x1f\xd9.\xfcC\x97-\x87\x7f:\xeb\xf1;\xdf\xfaz\xa6J0\xb1\x9f\xdb\xfc\xaf\xbe[\xc2\x1b\xf6\x10\x12\xfepb\x13\xd1C^\xe4\x0f\x87E\x8e\xfcς\xe8#\xbf\xc0#N\xaa\x93\xb3\x8f\xc8\xc8e\a\xc9~&\xff\xe5\xff\x02\x00\x00\xff\xffGk6\x9dfi\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec=Mw\xdc8rw\xfd\n<\xe5\xe0\xdd<u{\x9c䐧\x9b#{\x92~;c\xebYZ\x9frX4Y\xadƈ\x04\xb8\x00\xd8rg\xdf\xfe\xf7\xbc*\x00\xfcj\x82\x04\xdbRff#\\l\xb1\x81B\xa1\xaaP\x1f@\x01X\xadV\x17\xbc\x12_A\x1b\xa1\xe45㕀o\x16$\xfee֏\xffn\xd6B\xbd=\xbc\xbbx\x142\xbff7\xb5\xb1\xaa\xfc\x02F\xd5:\x83\x0f\xb0\x13RX\xa1\xe4E\t\x96\xe7\xdc\xf2\xeb\vƸ\x94\xcar\xfcl\xf0O\xc62%\xadVE\x01z\xf5\x00r\xfdXoa[\x8b\"\aM\xc0Cׇ\x1f\xd6\xef\xfee\xfd\xc3\x05c\x92\x97p\xcdL\xb6\x87\xbc.\xc0\xac\x0fP\x80Vk\xa1.L\x05\x19\x02}Ъ\xae\xaeY\xfb\x83k\xe4;t\xc8\xde\xf9\xf6\xf4\xa9\x10\xc6\xfe\xa9\xf7\xf9'a,\xfdT\x15\xb5\xe6E\xa7?\xfaj\x84|\xa8\v\xae\xdb\xef\x17\x8c\x99LUp\xcd>aW\x15\xcf \xbf`\xcc\xe3O]\xaf\x18\xcfs\xa2\b/n\xb5\x90\x16\xf4\x8d*\xea2Pb\xc5r0\x99\x16\x95\xa5\x11\xdfYnk\xc3Ԏ\xd9=t\xfb\xc1\xf2\x8bQ\xf2\x96\xdb\xfd5[\x1b\xaa\xb7\xae\xf6܄_\x1d\x89\x1c\x00\xff\xc9\x1e\x117c\xb5\x90\x0fc\xbd\xbdg7ZI\x06\xdf*\r\x06Qf91P>\xb0\xa7=Hf\x15ӵ$T\xfe\x83g\x8fu5\x82H\x05\xd9z\x80\xa7Ǥ\xffq\x0e\x97\xfb=\xb0\x82\x1bˬ(\x81q\xdf!{\xe2\x86p\xd8)\xcd\xec^\x98y\x9a \x90\x1e\xb6\x0e\x9d\x9f\x86\x9f\x1dB9\xb7\xe0\xd1\xe9\x80\n»\xce4\x90\xdcދ\x12\x8c\xe5e\x1f\xe6\xfb\aH\x00F$\xaaxmH8\xdaַ\xddO\x0e\xc0V\xa9\x02\xb8\xbch+\x1d\xde9\xd9\xcb\xf6P\xf2k_YU \xdf\xdfn\xbe\xfe\xeb]\xef3\x1bȒ\xa7\x14\x13\x86q\xf6\x95&\x06\xd3~\xa62\xbb\xe7\x96i@\u0383\xb4X\xa3Ұ\n\xd4\xcd\x1b\x90\x8c)\xcd*\xd0B\xe5\"\v\\\xa1\xc6f\xaf\xea\"g[@\x06\xad\x9b\x06\x95V\x15h+\xc2\xd4s\xa5\xa3Q:_\a\x18\xbf\xc1A\xb9ZN\x12\xc1\x90\xf0\xf9\t\x05\xb9\xa7\x83\x9b\x1f´\xf8\x13\x93z\x80\x19V⒩\xed/\x90\xd95\xbb\x03\x8d`\x02֙\x92\a\xd0H\x81L=H\xf1?\rl\x83RoI\x18-x}\xd0\x16\x9a\xc0\x92\x17\xec\xc0\x8b\x1a\xae\x18\x979+\xf9\x91i\xc0^X-;\xf0\xa8\x8aY\xb3\x9f\x95\x06&\xe4N]\xb3\xbd\xb5\x95\xb9~\xfb\xf6AؠI3U\x96\xb5\x14\xf6\xf8\x96\x94\xa2\xd8\xd6Vi\xf36\x87\x03\x14o\x8dxXq\x9d텅\xcc\xd6\x1a\xde\xf2J\xac\buI\xdat]\xe6\xff\x148j\xde\xf4p=\x99o\xae\x90\"\x9c\xe0\x00jD'0\xae\xa9\x1bEKh\xfc\x84\xd4\xf9\xf2\xf1\xee\xbe+L\xc2\f\xa9Ot\xefHX\xcb\x02$\x98\x90;\xf03z\xa7UI0A\xe6\x95\x12\xd2\xd2\x1fY!@\x0e\xc9o\xeam),\xf2\xfd\xaf5\x18\x8b\xbcZ\xb3\x1b2/(\x87u\x8530_\xb3\x8dd7\xbc\x84\xe2\x86\x1bxq\x06 \xa5\xcd\n\t\x9bƂ\xaee\x1cVvT\xeb\xfc\x10\xcc[\x84_a\x8e\xdfU\x90\xf5\xa6\f\xb6\x13;\x91\xd1\xc4 \xed٨\x80\x81\x06ue|\xd6\xd2/\xa4\xa6\x86_\ax8]\x16z\x05\x83\xf6\xc3\xee\x89í\x19C\xb9r\xd0P\xa7H5\xe4\xee\x98\x16\xecP\xc2C\x99\xc1\xa4\xaf\xf5R\xed\xdb\tL\xe6U\xdd:\x82\xe3\tW\t\xc5GQm\xca\x12r\xc1-\x14\xc7\x19L\xdf\xdc\xf5\xab\x8fQO\x11L\xb6ujW\xecF\xf0\xecR7\xaf\x81\x89\x0eD\x9aZ\x7f\t5N-\xe4_\xc8\xdav\r[\xb7\x10\x8d\xba\xe0kٲO\xecz?IxZ\xb3͎Y\x8djq\xdb5\xb4=\x90\xa2(p\xa6\xe2\xa8*\xc8{\xc8ƻ\x13;&\xac\x1f\xdf\b\xd0-'\x9c$[;\xefg\xdd\xda\xfa\xc6n#\xca\x03|\x9d\xf6F\x8cF`\xa2\\p\xcb$|\xb3m;$\x16\x8dr\xc7\v\xd3\f\xd3\rʫ ?\xb0\x11\x88IC\xbdb\xdb\xda:\x80c\x18\x8c\x80mp\x82\xb2\xb2\xc7+\xd7v\xa7\x8aB=1C\n\x17m\xddN<\xd4\xda\xe9\x82?\xe4\xb0\xe3ua\xaf\xdd(\xfe\xb8~\xb3h\x1aZ(+4\x8d3\xc2}\xef\xab\xe1\xe0P\a\xe4Md\x10\x9c\xdb\xe0J(\xefA\xb0\x13\x03N\xdd\xed\x01u\xd3A\xe4\xdeB\x8f\x92!\xae\xbd\xb0dF\xdcI^\x99\xbd\xb2(\x0f\xaa\xb6c\xb5\x06\x03\xb8\xb9\xdb\f\x1au\xe6'bE~*\x89\xa7U쉋Sm\xe6\n\xeaޛ\xbb\r\xfb\x8an?\x04\x98\xcc\xcdEfk-\xc9\xe5\xf8\x02<?ޫ?\x1b`yM\x967\xf8\x9eW\x11\xc0[ءaӀ0\xb0\x01h\x8dz\xde\x10j\xaa\xb6kr\xaa=\xbb\xbd!\x17\x86\xbd\xfb\x81\x95B\xd6\x16Nu\x1b\x9b\xd6oD$ny\xa9\x0e\xa0\x13h\xf8\x81[\xfe3\xd6\x1d\x90\x0ea0\x02\xe2\xd9Od\xdc\x1e#\x03\xc5&n\xaa\x91\xa0\xb7P\x85a\x97\x978\xb3/]\xd8wy\xe5\xea֢\xb0+!\xa9\x9f\bL\xd7{PG\xd8\xffy\xd4p\xc4u\xbc5\xf7\xeaG\xe3\xc4:\x858\x91\xa6#f\xa0R9;P\xbd\x98\x8c\x89\x02\x989\x1a\vePJ\xadwN\xc4%\x0f\xa0(<\x18öǀ\xfb\xf8\xb8e]\x14|[\xc05i\xf4\tҌk\x891\xda|\x01cE\x96@\x99\xcb!i\\\xcb\x11\xc2h\xfa!B\x94\x01\x05Э\xe7\x8f\x18Zz\na|P\x14\x1d\xe2\xceS\x85\xb1\xff\x96\xec\x03\xba\xb4\x19:\x9a\xd7ށ\x15P\x90\xd3,\x15+\x94|\x00\xedz\f\xe6\x85\x14\x1c\xa0č\xd9\x05,\xe8Mj4\fB\xb2]\x8d\x8e暡&\x88ʈ\x90\xc6\x02\xcfח/\xc5<\xf8\x96\x15u\x0e\xf9MQ\x1b\v\xfa.S\x15\xe4a\x99gT\xcb\x0e\x98\xf8q\x12\x80\x0f1\n\x91\x01\xf2!s\x95V\xb4\x9a\x12#R\x1bm\x1c+p\x8b;\xc8T\x8fi\x1bFtT\x85\x01\x8bU.\xff\xf92\xa6DQ\x02\xfa\xbd\xf7\xfb1\x8ckh\xa8\xd1Ө\x11\x88\x8d\x9e%\x83<.G\xc2B\x19!\xe2\xac\xcaY\xc0^\xae5\x1fS\xaaa8ͪ\xd5\xf9썁\x180X\x86j\xbf\x12\x8b\x87\xfd\xff\x7fd\xf2Yl5\xb4V˅Dv\x16\xc2\xd8\x1e7\x87A\x7f\x83ٞ[\xa2)z\xc5B:\x98\xa8\xdc:\xcc\xfb-\xd3윙\x10\x13\xfdFҼ8\xefyL\xa8~\x87\x04\xdb+\xf5\x98B\xa4\xff\xc2z\xedb\x10\xcbhۀma\xcf\x0fBi3\\Q\x84o\x90\xd56\xaa'\xb8e\xb9\xd8\xed@#,Z\x04o\xd6̧\x885\x1d&\xb0\x8e\x02\x8aV\x18\x8c\xabe:2\x8f\xa8\x11\x1b\n\x05}Q\xa8\x8c\x10G/\x9e\xac{.\x0e\"\xafyA\x86\x9e\xcb̍\x8f7\xf8\xc5ܓ\x19\x818\xc1߹\x13a\x14ȥ\xdeJ\x92\x92\x80\xeeu\xa9t\xcc\xf3t\xe5\x14L\x9c\fM\xb4>\xbe\xec\xd2\x16]\x17`<*\u0381m\xf5\xceU\xcb)\x17\xc6\x17|\v\x053P@f\x95\x8e\x93'E\b\\I՟\x11ʎh\xd2~\x104\xabDۂ\x01\xe6^d{\xe7n\xa2\x94\x11,\x96+0\xa41xU\x15\x11+ԖY\xc9\xf0\x9d\xcd)\x8d\xb6$\xa8\x8f!ܘ\"iK\xa2\x0enˌ6\xeeS\xbd\x11\x9bW\xa2\xf7Д\xdf%웓\xe6\xcf/\xecHn\x01\xa6\xbb\xd6%l\xf8\x9a\x02\xb5\xe7\a\x9a\x7f0Ɲ7[6\xc3\xd6\xcf>[\x9e\x85k\r\x1a\xff L#cu\xe7m\xd5\"\x86\xfd\xd4myE\x8b\xf0\x9ea\xf9\x15ۉ\xc2\x02\xf9Rs\x88v\x1c\x9dY\xce='\x81Rm/\x96\x92\xdbl\xff\xb1ٺIh1\xa0\xd5\x10\x80\xf3\xcbC\fC<H\x00\xc9\x1a\xa7\x82v\x1d\x85\x86\xd2\xedf\xde\xd3\xfch\xbf\x90\a\xf8\xfeӇ\xd8Jb\xbf$J\xeaɠ\xde\x0f<\x9d.\n4\xc0$\x90\x9dA\x91\x9b\xd6\xc4xn\xcf\xfa\x8aq\xf6\bG\xe7Y\x8d.\x0f\x8d\x15d-o@j\xa0\rtR#\x8fp$P~G<\t\xde\x12Qq\xe5\x11Fv\xdcb\xa5GT\xc4\xcf\xefS8\xea\xe2\a\x1aE\xcaTjKCT?w\x98Ui\x83e˔R(\x81\xe2g\x0e\xbbaX/\r\xe4\x11\x8eo\x8cc\x1fΚ\xbd\x18\xdbǋ\x0eBa\xe8\v4\xc3B\xfe\xc3W^\x88\xbc\xe9\x8c\xe6\xc9\x02\x88\x1by\xc5>)\x8b\xff|\xfc&\f\xa2(s\xf6A\x81\xf9\xa4,}yQ\x12\xbbA\x9cI`ט\xa6\xa5tf\x01鲨\xff\x16\a\xb7\xbd\xba\x87\x96m°\x8d\xc4\xf8\xcc\xd1g\t\x9b\xf6\x10\x90sh\x95\xb5\xa1\xedK\xa9\xe4\xca-i\xf9\xde\x16\x00\xed\xe2\xe5Y\xa5t\x8fSW\v!\x8e\xa2\xe8ѻGk\xe5~9\xc9=\x99*\x1a\xaa\x82g\x90\x87]6Jt\xe1\x16\x1eD\xc6J\xd0\x0f\xc0*\xb4\x1b\xe9B\xb5@\x93\xbbr\x86\x14\xa6\xbb\x16\xa1x\xb30\x92\xb71VV8\xeb\x13k\x066'U\x8fd\xb5LWO\x1b%\x99w\U000874a8\xdfM\xc3\\fY\x16\xf2\xeb\xd4\aqH:\xf7\xa3\xe4\xb4\xf1\xf474\xaf$\xde\x7fO\xb3\x86\\h\xb3f\xef)\t\xb5\x80n\xfb\xb0J\xd8\xe9*\t$b\"\fC99\xf0\x02\xdd\aTޒA\xe1\x9c\t\xb5;\xf1\xa0\xd2T\xcc\xd3^\x19g\U000db371\xcbG8\xfa\xcdٮ\x96\xb8\xdc\xc8\xe8\xaa}\xbf\xa0\xce?QZ\x8dעdqd\x97\xf4\xdb%9fK\xa6\xc8\x19\xce\xdb\x02\xa9^P\xf5\xdb\xea\xb1ނ\x96`\xc1\xacJ^\xad\xfcl\xb0\xaa\x8c\xeeq\xbaB\xa9\xa2K\xc2\b\x8cӃǃ\x8d\x9b\x84Jt\xff\xe7(\x90<\x1f*e\"\x99\x16\x11\xb4n\x95\xb1n\xf1\xb0窏\xac.\xa6D\x8e~ő\xf1\x9d\x05͌U:$/\xa2\xca\x1e,\xae\xa3Ԙy\xb9q\xfbD~%\xd3\x01\xc6\x00\xf5\xb2\xd5.\xce\x1e\\\xba\xbd*\xfc\xff<̌\x1c-\x82]i\x95\x81\x89f#\xb4%\xd1\xea\xcc,\xf66\v\xbd\xdc\x05~\xbb$\xb5\x9e\xb2\f\x1d\xca27\x1eI{FP\xf4\xf1[g\xcd\x1aU\x18\xfe\x9d\"\xca\xe7\xe0\xc8\xe8\xfcBY\xf2a\"m2\xba7\xaeu\x98\x80\x1e\x98\v\xb6\xf4CM\ni\x99\xcf\xedE\xf2\xb7洔Bn\xa8#\xf6\xee\xc5\x1c\x1d\x16\xcc@,#i\xac\f\xd8\xe1۷\fi>\xa4ƾ,\xa4\xaa)\xda\xe7\xd1\xd0\xe3\xec\xe9.H:\xa7\x18:\xe2R\xd9\xeeB\x8f\xef\xe9\x8da;\xa1\x8dm\x11^\x00U\x98\x89\xac\xa7\xd1\xe1\x9d\x11\x9fʏZ\x9f\x1d\x9e~v\xad;K\x92{\xf5䓘\x97\x04\xe5\x81\xf8{~\x00\x9fJ\n2S\xb5\xa4\xc52T\x17\xd8\xcd\x02\x88\x8e\x89Θ$\xda\xccNcY\x97\xe9\x04Y\x91t\n9\xbb\xb2\xd6m\xf2#\x17i+[\xec<\xb6ک$ʱ\xd2\xcf\f\xf5ٔ\xddl\xf5\x92\x7f\x13e]2^\"[\x96Ĝ;\x97\x87\x19R\xdb\x1d\xaf\x9f\xb8\xb0\xfeĐ۔]\xa6M3UV\x05X\b\x19\x96\x99\x92F\xe4и\x0f\x9e\xff\xa3\xf9\xaa\xb1\xc2َ\x8b\xa2\xd6\vt\xf4b\xce,\x8d\xf9\xbczz\xfe@.\x1d\x91\x15\x113q\xc1~\x81\xc3=o?*\xbd\xcce\xbe\xd5\xf0\xfc\xaei\xa5\x85rY\xfe\xd3\xde\xe9,L\xf2^\xfbީ\x17^.\x8f1\xf7t\x16*a\xf2\xea\x9e6\xe5\xd5=}uO_\xdd\xd3AyuO_\xdd\xd3W\xf7t\xbc\xbc\xba\xa7\x9d\xf2\xea\x9e&ۏ\x14\fW\xb4r;Q!\t\xab\xc4\xf4\x8d9\xb4g\xfa\xf2YJ\xfe,Ȓ\xec\xea\xcdxˑ\xb3@\x8bΐ\x98\x8e\xd1kҭqJ\x86\xc9\xe4\xceJ&x\xe1\xcfp\xd6& p\xf6Y\x9b\xcd$\x80g<k\xe31\x1d\xae\x9d?\xe3I\x9b@\x8b\xe5\x870\xae|\x1aS\t<l\t\xb9\x1c\x94<\xd6m̋\xed\xe11Z\xe7Wκ?\xc9\xd6<_d\xfeO\xce\xefD\xc4\xe6\xe4tj\x04\xaa0(W\xbf\x0fN\x9cE\xfb(\xb5\xdd\xffb\xa3k\t\xeb\x14\xaf\xbbJ\xa3\x9bj\xd9Oy\xfd\xfd\b\xf69\x92\x9cz\xfe&\xe6\x9c\xc7u[\x87\x98\xbaw\xde\xe3\xb7MK\v\xe5\xe7\xca[\xb2\xf4s\uf6d1f\xdfq\U0009d6e3\xcc\xf6ZIU\x1b\xbf\u0083=\xbc\xcf\xdcU\x00\xa1#\xb3D\x19\xfc\x1b۫:r\xc6c\x86\xae\t\x99\xb7\xf1|[\x9f\xc1\x01\x96\x1fޭ\xfb\xbfX\xe5\xb3o#X?\t\xbbw\xb7>\xf0<GG\xbds\xc4'L^\x7f\xef\xd0P\xf0\"\x10\x95fR\x14N*\x03\x84\xbe\x01\xfd\\\xb9%\xbf\xb3\xfd\x96\xf9\x85\xa7\xf4\x1cݥ\x99\xb9M.弗\xfc\x1d\xf9\xb8\xcb\x0eK\xcd\xe6ަ \xcdR2n\xc7sig\xa0.ɳM]SLȩMϤM#\x0f\xa3\xdb\xc2R\xf3g\x93\xa3\xd0\xd4\\ٗɐM̋\xedd\xbb\u0382<3\x1b6\x99`i\x99\xaf\xc9\xf9\xae\x9d,\xd6yjMd\xb9\x8e\xe7\xae\u0382\x1c\xcbmM\xc9XM\xc259O\xb5\xc9>\x9d\xdf\x19\xf9\xae\xec\xd4\xe7?\a\xf3\x9c\xeb\x16ӹ\xa6I\x19\xa6Ik\x1b\xf38'\xe5\x90.\xcd\x1cM\xa2\xea\xd2,\xd1&\x03t\xa2\xe3\xa4\xdc\xd0Ӽϩ\xa1\xccf\x84Ƴ=\xa7\xc0\x8e\xe5\x81&\xe4xN\x80\xecf\x7f.v\x03f\xa5i\xb6\xc2\xd2\xdc\xcd\xf1;\x00C\x99\xb7\xceů!\xb3\xdfK&\xa5{NsJp\xf7y\xd0\x04\xa5%\xf8\x89c\x8ex<Tv\xee\xf9\x19\x8ex\x04\xe4f\xc7ʺ\xb0\xa2*:\x17\x94\xd9=\x1c\x9b+\x7f~Qtp}{$h\x9f\xbf4\"\x1f\x03\xd9\x0f)\xb8aOP\x14\xf8\xef\t\x152w\xe5e\xa6V\x80V*\xbe\x11\xe8\xaf:\xf2\xf7e^\xb9e1:\xd5O\x16\xb0DH\xd3\x17`M\x9a\x92i\xf7\xd8y\xf5\xf4\xed\xaf5\xe8#\xa3;\xb7\x82\x1f\x14\x15\xb3\xf6\xb4\xa7\x9f\xcc\x06c\u00a0|\xbc\x16s\x17\xaf\xf6\x95Q|64*\x80\xbd\x97\xce0\x0fq%X\xa8u\xdapjJ\xd9b\xf4\x14\x03!U\x03!\xd2>\xc5\xfb^r\xfc\xf1%\x82\xab\xe7\b\xaf\x92\x1c\x91\x97\b\xb1^*\xc8Z\x1af-I\xdeH:\xbe\xf8\x12\xc1֒pk\x91Ϙ~<\xf1\xa5\x8e%\xbe@\xd8uvൈt\xa9\xc7\x0e\x17\x87_\t\xe3\x9b9fx\xe2\xa3%\x80\x8c\x1e/\x1c\x0f\xc1\x12 \x9e\x1c+\x9c\r\xc2R\xe6\xc10L\xfb\xeeC\x82ɉL\x8bv\xd3S\x93\x90\xd26\xba\xe7\x0f\xff%\x1e\xfaK\xdc\x06O\xc1>\xf1p\xdf\xf2C}\x89t>3<\x9b\xec:\xf1\xf0ޢ\x00\xed\xcc\x10m\x12\xe2\xd4a\xbd\xe9 mz\x01nxH\xef\fw\"A\xc2\x12\xaa,?h\xf7ݛ1J\xe7\xa0g\xf7\xb5\x96\x88\xf3\xac \x0f\xe2\xa8~\xff\x83\x1d\x9dp#*\xd6\xea\xee\x99\xc58\xaa\x9a{G2\xf6'!\xfdn=\nn\xc7'\xe9m\xbc\xb5\x0eS|_\xa7\xf5R\xfd\xe3\x01n\xc7\xce@\xc55\xed\xe3o\x8f.)Ȭ\xd9G\x9e\xed\x9b\x1e\" \xa9\xdf=7l\xa7t\xc9-\xbbl\xb6Bߺ\x0e\xf0\xef\xcb5c?\xaa&}\xa4s\xafX\x04\xaa\x11eU\x1c1bb\x97]0\xdf'8Q\x81\r\xf8ܪBd\x11\x0fq\xf4J:\xd7\xe0\xe4B\x1f\xba4/\xebdA\xc4b\x14l.\xfc\x85\x85\x83K\x85\xdd\xf5\xe2g.]\xf0J\xfc'\xbdՓ\xb66\xf6\xfevCՃT\xd1;?M\xf6\\#c[\x98V\xe8\xed\xc0\xc9\xf5\xe8B\x1d\xc9^m\xfe\x9c\x80H/e\x04?ë\xf1L\xa1\x16\xbb\xdd8,\xd7$X\\\x1e\x99\xf2o!\b\x9d\xaf*\xae\xa3\x9bz\xcc˃\xb9\xeaa\x18\xec\xf8\xdc\n֤Y;}\xf9\xa3[z4\x0f\x8f\x80\xd0f\xef\xb1\xeao\xa3\x13\xa5;\xf4\xfc\x1e\x9c\xa6\x0f.\xcf\x1eY~\x01\x9c\xa6]\xa6\x15Q1\xf2S4\x1d\xef\xd9W\x0f\x8d\xbfD\xfegu\x80\x0f\xd1U\xc4\xfe\xb3\x18\x83&#\tt\x01\xeaԵ\xe9m\xd6\\\xfc:\xebgȈ\v\xa8\xf8\x8b\xaf\x17\x8cϷ\x18\x7fK\x83\xee\xff\x0e\xb0'l\x1bN\xd9ۯ\x14n6\xea\xd2\xcfp\x1fL\x86\xa5\xc2\xc1U\xad\x11\x90\xb1\xa7D\x9e\x8bZVi\xfe\x00?)\xf7\xdaK\n\xb5\xfa-z\x0f\xfexg.d\x13\xfb\xb9\x16S\xe6~lC\x80\xed!\x83\x93\xeb\xe8\x11\xdb3o۷\xb6H\x18\xdc\xfd\xfdOn@\xf44\xc6\a\xff\xee\x05\xea]\x03H\xe90P\xd7h\x1b\xd7N{\xf5D\xf7\xb9w\x9f\xab\xe8<z\x05t\xa8\x81\xd2F\xcf\x1aM]\x15\x8a\xe7\xa0o\xe8y\x8e\x84\x81\xfd\xb9\xd7``\xd9\xfb\x8f|x\xfb\x18\x19X\xe8\xf9\x053C\xd0e+\n(~\x14\x05\x18\x87x\xa2\xa2\xbf=m\xd9\xe8\xfd\xba\xdc:\au\x87?6\x9dLXS7TZӯ@\xa3#\xe8V\xffk\x13\x04|\x9a\x18\xacᣐ\x16\x1e\"\t>3\x1a\xfe\xd0{\xfa#L\x92\x14\xb5\xf6u\xbce\xc7[\xeeLשdA\xb5\x8b\xc2\xe2ƨL\x90\x83M\xbb#tj\xe4\xe5\ue71e\n\x94&\xe8X\x1b\xf8\xfc$A\x7f\t*\xd9ld쭍\xfe\xac9i\x18}g\xc3*r\xeb\a\xd5\xc7\xc29\xe9\td\xdc+-a\x9bG\x98\xe6\xfd\xa9S\xd2\xcd̫\xb8\x96\x1f\xf7IV\xe3\xcfᬚ\x17z.\x12(\xeb^\xa1\xe9\x03\x1e\x7f`\xcc=W\x93\xf1\xca\xd6:\xa8\x9cZ\xd3\x05\xdc\b\x04\xdc\xfd\xd4\xe7=1\xd6>H5\xc3\xcb\xf6a\xa7v\xe1{\xf6\xc9\xc8\x11\xfe5\x8f\x84E_\x14r!\xa3{\xd2q\x85\xf0\xcfc\xe7\xe8<@\x9c\xef\xdc;Q\t\xe3\xbd\vOe\x9d\x0e\xb8\x19\x06\x0e9\xf6\xf2\xd4K\x8e\x84\xae^\x9f\x19\xc3-\xd6i\xce.z\x91\xa1\x86\xe1\xca\xf6\xbb\x18\x13\xc6\x0f\xa3\xad\xd8'8\r>W\xec\xa3\xc4A\x9c\x12\xc0\x9d8\x83\x9c\x16\xfe\xc7\x1e\x8a\x9c\x1c\xe2\xa1iE\xc7\xfdF\xf4^_a\x0f\xaa\x0f\x92\x91\xe9\t\x9e\xa6\x8a;\xda7&\xa0\x7f\x10;\xb7+\x93\xe1\x98\xfexR#\xaa\x82'\xd5oL\xf5\x8e*\x87\x93\x8f\xf4\x9aX\xde\x11\x12\xefwv\xbf\xd4\xdb\xf6\x06}\xf6\xb7\xbf_\xfco\x00\x00\x00\xff\xff}\xef\x8a\xf1Mx\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4V\xcfo\xeb6\f\xbe\xe7\xaf \xb0û\xcc\xce\xebv\x19r\x1b\xba\x1d\x8am\x0fE\xf3л\"\xd3\tWY\xf2H*]\xf6\xd7\x0f\x92\xec&\xb1\x9d\xb5\x1b0\xdd\"\xf1\xc7Ǐ\xe4\xe7TU\xb52==#\v\x05\xbf\x01\xd3\x13\xfe\xa9\xe8\xd3/\xa9_~\x90\x9a\xc2\xfax\xb7z!\xdfl\xe0>\x8a\x86\xee\t%D\xb6\xf8\x13\xb6\xe4I)\xf8U\x87j\x1a\xa3f\xb3\x020\xde\a5\xe9Z\xd2O\x00\x1b\xbcrp\x0e\xb9ڣ\xaf_\xe2\x0ew\x91\\\x83\x9c\x83\x8f\xa9\x8f\x9f\xeb\xbb\xef\xea\xcf+\x00o:܀ \xa775\x1a\x85\U0004f222R\x1f\xd1!\x87\x9a\xc2Jz\xb4)\xfe\x9eC\xec7p~(\xfeC\xee\x82{\x9bCms\xa8\xa7\x12*\xbf:\x12\xfd\xe5\x96ů4X\xf5.\xb2qˀ\xb2\x81\x1c\x02\xeb\x97s\xd2\nD\xb8\xbc\x90\xdfGgx\xd1y\x05 6\xf4\xb8\x81\xec\xdb\x1b\x8b\xcd\n` $Ǫ\x06.\x8ew%\x9c=`gJ\x12\x80У\xff\xf1\xf1\xe1\xf9\xfb\xed\xd55@\x83b\x99zʹ.T\x06$``@\x01\x1a\xc0X\x8b\"`#3z\x85\x82\x12ȷ\x81\xbb\xdcɷ\xd0\x00f\x17\xa2\x82\x1e\x10\x9e3\xe5Ce\xf5\x9bIϡGV\x1a\xd9\x18\xdc\xceCvq;\xc1\xfa)\x95S\xac\xa0IӅ\x923\r\x94`30\x00\xa1\x05=\x90\x00c\xcf(\xe8u\x8a2\xf3ӂ\xf1\x10v\xbf\xa3\xd5z\xe0AR\xb3\xa2k\xd2P\x1e\x91\x15\x18m\xd8{\xfa\xeb-\xb6$BRRgt\x9c\x93\xf3!\xaf\xc8\xde88\x1a\x17\xf1[0\xbe\x81Μ\x801e\x81\xe8/\xe2e\x13\xa9\xe1\xb7\xc0\x98\xc9\xdc\xc0A\xb5\x97\xcdz\xbd'\x1d\x97ˆ\xae\x8b\x9e\xf4\xb4\xce{B\xbb\xa8\x81e\xdd\xe0\x11\xddZh_\x19\xb6\aR\xb4\x1a\x19צ\xa7*C\xf7y\xc1\xea\xae\xf9\x86\x87u\x94OWX\xf5\x94&K\x94\xc9\xef/\x1e\xf2B\xfcC\a\xd2:\x94\xf9(\xae\xa5\x8a3\xd1\xe9*\xb1\xf3\xf4\xf3\xf6+\x8c\xa9s3\xa6\xecg\xdeώrnA\"\x8c|\x8b\\\x9a\xd8r\xe8rL\xf4M\x1fȗ鲎\xd0O闸\xebHe\x9c\xddԫ\x1a\xee\xb3\xe2\xc0\x0e!\xf6\x8dQljx\xf0po:t\xf7F\xf0\x7fo@bZ\xaaD\xec\xc7Zp)\x96S\xe3\xc2\xda\xc5\xc3(s7\xfa\xb5\xb0\xdd\xdb\x1em\xea`\"1ySK6\xaf\a\xb4\x81\xc1,\xb9\xd4\x1fB\x92=\xfe%\x96AI\n\x9a\x89\xbe\xa4\xfd|\x1fͲ\x9c䗃\x11\x9c^N0=&\x9bi~G-ړuXB\x145\xc1\xf7\xa1\xa4\x83>v\xf3\x9c\x15|\xc1ׅ\xdbG\x0eIY\xb3\xae_\x9f\x1b\xb3\x01\xe5{\xb3'?+wZY\xb1\xca߰K\xa9\xbe\x10\xe8!\x10p\xf4>\xed\xedL!3\x90\xa9\x92\xcflH\xb1[@\xb3\x88\xe7\xc1\xb7!\x7f\xf0MJl\xb4\xec\x13\x0e\xcd\x1e\xf2\x14\\\v\x01o\xf7\xba\x9c\xb9x}\x88\xd0r\xf2\x97\xf4\xbf9'\xb9!\xc6\xc5\xdcUF\xb5\xf8\x902.1\xbe\xbc_\x03\xca\xe8\x9c\xd99܀r\x9c{\x17_\xc3lNө\x19G\xed+u(j\xba\xfe\xbd\x01\x9a9\xa4=y=\xa0\xbf\xb5\r\xf0j\xa6*\x7f\x95\x19v\xa7[\xae\xf7o\xff\x01\xe7+UFw\x03I\xbb+\xa5\x05\xce>D\xcab\xf7\xcaH/\xfe\xf3\x98\x11\xb2\xbd\xb4\x1d5\xe3j5\xc6?\"\xf3\x1anBXl\xf6\xec2\x87o.\xca\x13\rl\xf6c\xc1\x7f\a\x00\x00\xff\xff\xb1J-\xe7\xa6\v\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VA\x93\xdb6\x0f\xbd\xfbW`&\x87\xbdDr\xf2}\x97\x8e/\x9d̦\x87L\x93f'N\xf7N\x8b\x90\x8d\x9a\"U\x10\xd4\xc6\xfd\xf5\x1d\x90\xd2\xdak\xcb\xc9n\xa7\xd5\xc5c\n\x04\x1f\xde\xc3\x03UU\xd5\xc2\xf4t\x8f\x1c)\xf8\x15\x98\x9e\xf0\x9b\xa0\xd7\x7f\xb1\xde\xff\x14k\n\xcb\xe1\xedbOޮ\xe06E\t\xdd\x17\x8c!q\x83\xef\xb1%OB\xc1/:\x14c\x8d\x98\xd5\x02\xc0x\x1f\xc4\xe8rԿ\x00M\xf0\xc2\xc19\xe4j\x8b\xbeާ\rn\x129\x8b\x9c\x93OG\x0fo\xea\xb7\xff\xab\xdf,\x00\xbc\xe9p\x05Cp\xa9\xc3\xe8M\x1fwA\\hJ\xcez@\x87\x1cj\n\x8b\xd8c\xa3Gl9\xa4~\x05\xc7\x17%\xc5x|\x81~\x9f\xb3\xad\xc7l\x1f\xc7l9\xc0Q\x94_\xbf\x13\xf4\x91\xa2\xe4\xc0\xde%6\xee*\xb2\x1c\x13w\x81\xe5\xb7\xe3\xe9\x15\fѕ7\xe4\xb7\xc9\x19\xbe\xb6\x7f\x01\x10\x9b\xd0\xe3\n\xf2\xf6\xde4h\x17\x00#?9]5Q\xf3\xb6dlvؙr\x0e@\xe8ѿ\xbb\xfbp\xff\xff\xf5\x93e\x00\x8b\xb1a\xea%\xb3<_\"P\x04\x03\x13\x12x\xd8!#\xdcg>!J`\x8c#\xe8Ǥ\x00\x13\xfeX?.\xf6\x1czd\xa1\xa9\xf8\xf2\x9c\xf4\xd7\xc9\xea\x19\xae\x1b\x85^\xa2\xc0jca\x04\xd9\xe1T>ڱZ\b-Ȏ\"0\xf6\x8c\x11\xbd\x1c\x85<>\xa1\x05\xe3!l\xfe\xc0FjX#k\x1a\xd5&9\xab\xfd8 \v06a\xeb\xe9\xaf\xc7\xdc\x11$\xe4C\x9d\x11\x1c5?>\xe4\x05\xd9\x1b\a\x83q\t_\x83\xf1\x16:s\x00F=\x05\x92?ɗCb\r\x9f\x02#\x90o\xc3\nv\"}\\-\x97[\x92\xc9WM\xe8\xba\xe4I\x0e\xcbl\x11\xda$\t\x1c\x97\x16\at\xcbH\xdb\xcap\xb3#\xc1F\x12\xe3\xd2\xf4Te\xe8\xbe\xf8\xa0\xb3\xafxtb\xbcy\x82U\x0e\xdaEQ\x98\xfc\xf6\xe4E6\xc2w\x14P\x0f\x94F([K\x15G\xa2uI\xd9\xf9\xf2\xcb\xfa+LGg1\xce\xd9ϼ\x1f7ƣ\x04J\x18\xf9\x16\xb9\x88\xd8r\xe8rN\xf4\xb6\x0f\xe4%\xffi\x1c\xa1?\xa7?\xa6MG\xa2\xba\xff\x990\x8ajU\xc3m\x1e6\xb0AH\xbd5\x82\xb6\x86\x0f\x1enM\x87\xee\xd6D\xfc\xcf\x05P\xa6c\xa5\xc4>O\x82\xd39y\x1e\\X;5\xd88ޮ\xe85\xef\xe4u\x8f\xcd\x13\x03i\x16jitv\x1b\xf8\x8cW3\xf9|>_\xfd$|\xde\xe0P\x86|K\xdb\xf3U\x00cm\xbe\"\x8c\xbb\xbb\xba\xf7;\x84\xcd\xd4}\x9bO\xd2Fm\x03+\xa2\x81,r5\xd59\"I<\x16L\xe8l\xac/R^\xe1<\x97\xc2hUc\xe3.\x81>E\xf2\x18\x98\xef8C\xbeP~L\x90[\x8f\xbbq\xc6zAo\xf3P\xbf@\x13r\x0fG\xb4\xf0@\xb2+\xe6p\xa7\x97\xd4\xf3T\xd0g\x8f\x87\xb9\xe53\xec_w\xa8\x91e\x9c\"Dl\x18EqDtj^uf\r\xf0)\xc5l/3\x9b\x11tD\x90\x9dv\xef\xf1pI4\xfcH\xdc\xf1\xbe\xff1\xe4\x1b\xbd\x17'\xc0\x8c-2z\x99\xb5\xb8~b\xb0G\xc1\xecr\x1b\x9a\xa8\x06o\xb0\x97\xb8\f\x03\xf2@\xf8\xb0|\b\xbc'\xbf\xad\x94\xf0\xaa4B\\\xe6\xef\x86\xe5\xab\xfcs\xa5䯟\xdf\x7f^\xc1;k!\xc8\x0eYUk\x93\x9b\x1a\xed\xe4\xb6{\x9d'\xeekHd\x7f\xbe\xf9'\xbc\x84\xbe8\xe7\x19ܬs\xf7\x1f\xf4\xe6Π\x94\xa2uQ%0\xe8\xdcT\xb1\xbbQ\xcd2\x1f\xe6\x1aq´\t\xc1\xa1\xb9l=\x9d\xbe\xc4h/!Uz\xc2Kl\x06\xf0\xad:\nUu\xa6\xafJ\xb4\x91\xd0Qs\x16=\xf9\xfc\a\x96\xbc\x1b\xc3t<(\aӶ\xa9m\xcaWL\xfe\xa61[\xbc6\x16f\x14\x99/\xbcz<\xe0Y\x03]\x8c\xa4\xf8\U000917b7\x8d\x91\x9bq\xac7\x89\xb5\xfdǜ3\x9f?\xff\xceX\xefw&\xcex\xf3\x19\xa8\xeft\xe7$\x83\xa3\x16\x9bC\xe3\xb0$\x84\xd0\xce\xf4ދ \xeb\x83>us\x8d\xf8n0\xe4\xcc\xc6\xe1̻߽\xb9\xfa\xf6\xaa\xf8\xb3z^,F\xfdƱ+\x10N%\xf7\xd8e\xe3\xca\xdf\x01\x00\x00\xff\xff\xec\xa0\xe0\xa1k\r\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VAs\xdbF\x0f\xbd\xebW`\xf2\x1dr\xf9H%\xed\xa5\xc3[\xea\xb63\x99&\x19\x8f\x9d\xf1\x1d$!i\xe3\xe5\xeev\x81\x95\xabv\xfa\xdf;X\x92\x16%Җ\x9d\x99\x9a:xw\x81\xb7\xc0\x03\x1eȢ(V\x18\xcc\x1dE6\xdeU\x80\xc1ПBNW\\\xde\xffĥ\xf1\xeb\xfd\xfbսqm\x05W\x89\xc5w7\xc4>ņ~\xa1\x8dqF\x8cw\xab\x8e\x04[\x14\xacV\x00\xe8\x9c\x17\xd4m\xd6%@\xe3\x9dDo-\xc5bK\xae\xbcO5\xd5\xc9ؖb\x06\x1f\xaf\u07bf+\xdf\xffP\xbe[\x018쨂\x1a\x9b\xfb\x14\"\x05\xcfF|4\xc4\xe5\x9e,E_\x1a\xbf\xe2@\x8d\xa2o\xa3O\xa1\x82\xe3A\xef=\xdc\xdcG\xfds\x06\xba\x19\x81\x0e\xf9\xc8\x1a\x96\xdf\x17\x8f?\x19\x96l\x12l\x8ah\x97\x02\xc9\xc7l\xdc6Y\x8c3\x83\xc3\n\x80\x1b\x1f\xa8\x82/\xd8\x11\al\xa8]\x01\f\x99\xe6\xd8\n\xc0\xb6\xcdܡ\xbd\x8e\xc6\t\xc5+oS7rV\xc07\xf6\xee\x1aeWA9\xb2[6\x912\xb1_MG,\u0605\x1c\xc8H؇-\rk9\xe8\xe5-\n\xcd\xc1\x94\xb9\xf2\x18\xeb\xd7C\x18\xbdz\x94#\x1109\xeb\x11Y\xa2q\xdb\xd5\xd1x\xff>/\xb8\xd9Q\x97\x8b\xaf+\x1f\xc8}\xb8\xfex\xf7\xe3\xed\xc96@\x88>P\x143\x96\xa7\x7f&\xed7\xd9\x05h\x89\x9bh\x82\xe6[\xc1[\x05쭠վ#\x06\xd9\xd1\xc8)\xb5C\f\xe07 ;\xc3\x10)Dbr}'\x9e\x00\x83\x1a\xa1\x03_\x7f\xa3FJ\xb8\xa5\xa80\xc0;\x9fl\xab\xed\xba\xa7(\x10\xa9\xf1[g\xfez\xc4f\x10\x9f/\xb5(4\xf4\xc8\xf1\xc95tha\x8f6\xd1\xff\x01]\v\x1d\x1e \x92\xde\x02\xc9M\xf0\xb2\t\x97\xf0\xd9G\x02\xe36\xbe\x82\x9dH\xe0j\xbd\xde\x1a\x19e\xd7\xf8\xaeK\xce\xc8a\x9d\x15d\xea$>\xf2\xba\xa5=\xd95\x9bm\x81\xb1\xd9\x19\xa1FR\xa45\x06S\xe4Н&\xcce\xd7\xfe/\x0eB\xe5\xb7'\xb1\xcej\xd9\xff\xb2X\x9e\xa9\x80\xaa\x05\f\x03\x0e\xae}\xa2G\xa2uKٹ\xf9\xf5\xf6+\x8cW\xe7b\x9c\x80\xc2\xc0\xfbё\x8f%P\u008c\xdbP\xcc~\xb0\x89\xbeˌ\x93k\x837N\U000a2c46\xdc9\xfd\x9c\xeaΈ\xd6\xfd\x8fD,Z\xab\x12\xae\xf2,\x82\x9a \x05UC[\xc2G\aWؑ\xbdB\xa6\xff\xbc\x00\xca4\x17J\xec\xcbJ0\x1d\xa3\xc7?E\xa9\x06\xd6&\a\xe3\b|\xa2^\xe7c\xed6P\xa3\xe5S\x06\xd5\xd5lL\x93\xb5\x01\x1b\x1f\x01gc\xb0<\x81^\x96\xae>\xfd\xf0\xbb\x15\x1fqK\x9f|\x8fyn\xb4\x18ۙ\xcf\x18\x9c\x8e!U\xa8\xfe\xbfh8\xc3\x06\x90\x1d\xcaD\xbf\x82\xc6=\x8e\x81\xc5|\x9e)\x82\xfe:T9;t\r\xfd\x96;\xca5\x87\v9}^pєv\xfe\x01\xfcF\xc8MA\x87Xg\x88\xa0\xbd\x1a\x93{U\xb0\xa7\xc3\xfcB\x98\xc7\x02\xab1\x18\xd7j\x1b\f\xd3T/\x19\xa9\u05fa\x92k'\f\u0380ɥn~]\x01\xf7>\x18\\؏\xc4b\x9a\x85\x837o^\x97\xaf\xc2|lUh\x1bC\xf1bƧ\xe6c\x9fm\x92\xb5\x03V\xd1\xf8.\xa0\x98\xda\xd2\xf2\x95\xfa\xa8LL\x7f顟u\xdf\xdf_{}\xd7\xd3\xe3\xd7\xc1\x85\f\xeeN\xad\xa7B\xc9\xee}\xabk\xc1Rx\xae^0j\x83!\xf8v\bb\xf0c\x1d\x03\xaf\xc8AUa\"\x9d\xbd1\n\xa8/*\xb6XTי\xc9y\x8dώ\xcf\xf8{Ѹ\x14\x94t6\xbd\x9e\x1f\x98\xd9a$\xbbI1\x92\x93\x01FE\xf2\xfd#\xd3\"\xcbd\\\xe8\xd7܅\x0e\xf84\xf7\x18\x03S0\x10\xd3\xd1\xc9|y@\x9e!\xc2\xf2d\xd9\xf8ء\xf4\x9f\x8b\x85\x02\xcd,\\\xb2\x16kK\x15HL\xf4\xf2\x1e\xd1\x17\x1a3n/e\xf7\xb9\xb7Ҍpt\x01\xac}\x92'\xa8\x97\xdd<\n\xb8P\x8e\v\x91\x86\x1d\xf2\xa58\xaf\xd5f\xa9!\xce\xdeWυ\xf0\xd4\xcc\xfcB\x0f\v\xbb7\x84\xed\\\xc7\x05|\xf1\xb2|\xf4d\x86\x8b\xaa\x98m\xb2~\n\xb7\x93:s/\xe4\xe9N\xaa\x1f\xbf++\xf8\xfb\x9fտ\x03\x00]6D7C\x0e\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec=]s\x1b9r\xef\xfc\x15]ʃ\xef\xaeD\xfa\xf6.\x0f)\xbd\xf9d;\xa7ܮ\xad\xb2\xb4\xbe\x97\xbc\x803M\x12\xa7\x19`\x16\xc0H\xe2\xa6\xf2\xdfS\x8d\x8f\xf9\xc4|\xd1\xd2Ɨ\x90tծ8@\x0f\xd0\xddh\xf4\x17\x1a\xeb\xf5z\xc5\n\xfe\x15\x95\xe6R\\\x01+8>\x1b\x14\xf4\x97\xde<\xfc\x9b\xdep\xf9\xf6\xf1\x87\xd5\x03\x17\xe9\x15\\\x97\xda\xc8\xfc\vjY\xaa\x04\xdf\xe3\x8e\vn\xb8\x14\xab\x1c\rK\x99aW+\x00&\x844\x8c~\xd6\xf4'@\"\x85Q2\xcbP\xad\xf7(6\x0f\xe5\x16\xb7%\xcfRT\x16xx\xf5\xe3\x1f7?\xfci\xf3\xc7\x15\x80`9^\xc1\x96%\x0fe\xa17\x8f\x98\xa1\x92\x1b.W\xba\xc0\x84@\xee\x95,\x8b+\xa8\x1f\xb8.\xfeun\xa8\x7f\xb1\xbd\xed\x0f\x19\xd7\xe6o\x8d\x1f\x7f\xe4\xda\xd8\aEV*\x96Uo\xb2\xbfi.\xf6e\xc6T\xf8u\x05\xa0\x13Y\xe0\x15|b9\xea\x82%\x98\xae\x00\xfc\xa8\xed+\xd7~\xc0\x8f?8\b\xc9\x01s\x8b\t\xfaK\x16(\xde\xdd\xde|\xfd\xf3]\xebg\x80\x14u\xa2xAx\n\x03\x03\xae\x81\xc1W;-P\x1e\xcb`\x0è\xc2B\xa1Fa4\x98\x03B\xc2\nS*\x04\xb9\x83\xbf\x95[T\x02\r\xea\n4@\x92\x95ڠ\x02m\x98A`\x06\x18\x14\x92\v\x03\\\x80\xe19\xc2\xef\xde\xddހ\xdc\xfe\x03\x13\xa3\x81\x89\x14\x98\xd62\xe1\xcc`\n\x8f2+st}\x7f\xbf\xa9\xa0\x16J\x16\xa8\f\x0fxv\xdf\x06\xf34~\xedL\xef\ra\xc0\xb5\x82\x94\xb8\x06\xdd4<\x161\xf5H\xa3\xf9\x98\x03\xd7\xf5t-\x1f\xb5\x00\x035b\xc2\x0f~\x03w\xa8\b\f\xe8\x83,\xb3\x94\x98\xed\x11\x15!,\x91{\xc1\x7f\xad`k0Ҿ4c\x06=\x03\xd4_.\f*\xc12xdY\x89\x97\x16%9;\x82BB\x11\x94\xa2\x01\xcf6\xd1\x1b\xf8I*\x04.v\xf2\n\x0e\xc6\x14\xfa\xea\xed\xdb=7a\xd1$2\xcfK\xc1\xcd\xf1\xad\xe5\x7f\xbe-\x8dT\xfam\x8a\x8f\x98\xbd\xd5|\xbff*9p\x83\x89)\x15\xbee\x05_ۡ\v\x9a\xb0\xde\xe4\xe9\xbf\x04\x06\xd0oZc5GbFm\x14\x17\xfb\xc6\x03\xcb\xf5#\x14\xa0\x05\xe0\xf8\xcbuu\x13\xad\x11\xcd\xc5\xdeb\xe7ˇ\xbb\xfb&\xef\xf1&[\xd1\xd7\xe1\xbd\xee\xa8k\x12\x10¸ء\xb2\xfd`\xa7dna\xa2H\x1d\xf7\xd1\x1fI\xc6Qtѯ\xcbm\xce\r\xd1\xfd\x97\x1251\xb9\xdc\xc0\xb5\x95$\xb0E(\x8b\x948s\x037\x02\xaeY\x8e\xd95\xd3\xf8\xea\x04 L\xeb5!v\x1e\t\x9aB\xb0\xfe\x10\x94+\x8f\xb5ƃ \xcb\x06\xe8\xe5\x04\xc2]\x81Ik\xc1P/\xbe\xe3\x89]\x16\xb0\x93\xaa\x96\x17N\\\xd5\xcbux\xc9\xd27a\"\xc1\xac\xfbkg\x10\u05f6Q\x83(D>\xfb[\xe6\x06`\x97+\x86W\xc3\xfd\x01\x81\x1b̻,Cߧ\x03O\x0ep`\x8f(\xde\x18\xd8\"\n\xdb\rS(\v8\xa2\x01\xa6\x10\xf4\x03/\nL/-\xd4BV\xa2\xc8\v\xe8\xcb\b\\B8\x94E&Y\xea\x04Y\x91\x95{.\x80&n\a\xa9I\xe6\x15J\xee\x15jm\xdf\xe2\xe6N\xafa\"\x8d\x80\xac\xa7\x04(R۟~r\xd8\xc80\x85\xe2@\xec\xd7\xeb)\xca,c\xdb\f\xaf\xc0\xa8\x12{\x8f\x1d#l\xa5̐\x89\xce\xd3D\xe6$\xdf\xfbb\xb4O\x93\xbae\xe0\x06\xcf\x1b\r\x18\xc0\xb2\xbdT\xdc\x1cr(5\xc6\xe6H\xacӘ\xa7aj˲l\x037;\xa05\xa7\xd18\"h+]\xdfh\xe2BVf\xa6\xf9\x96\bX\xae\xed\v\xfb\xb8AQ\xe6\xfd\x99\xada\xff+/\"?\xff\xaaM\x7f\xd4k\x10R\fᵷ\x1a\xe9_\xa2\xf9\x9d`\x85>Hs\xcfs\x94\xa5\x99\xc2\xee\xddM\xa7C\a\xc9v\xf3\xa4)\xd2n\xf2ĸ\xa1E\u0603\t\x04\b\xbe\xda}4\xc0\xb3\xfbi\xa9\xc1\x94J\x90|\x83/\xc8\xd2\xe3\xbd\xfcY#\xa4%\r\x1e\x12\x85\x96e/a\x8b;\xa9\xfaS\x05PH\xfd\xa91*E\xcb_\xdb\xfd\\\x96\xc6-\xbf@&'ݹ\x86\x1f\xfe\b9\x17\xa5\xc1\xcd\x12\xc4Ѫ\xca\xe5#\xaa\t|\xbdg\x86\xfdD\xed:h\xa2\xfe`\x01\xd0L\xb7\x1ee\xdb#=\xecA\x04υ\x96\xfbj\x88\\\xc3\xc5\x05H\x05\x17Nѻp\x1cI\xaa\xa3Ys\xd1xG\x04\xe2\x13ϲ\xf0\xdee3w\bt\xb4\xd3\xf7\xf2\xa3v\xa2x\n\x11\x03\xdd\x1axy:\xa09\xa0jȵ\x1eH\x80\x1d\xcf\x10\xf4Q\x1b\xcc\xc3\xda\xf4\x8aM@\"\xad\\\x96e\x1e\x84\x86\xed1\x8c\xf9E%R\x17\x0f_P\x1b\x9eL`ᢋ\x06\xd7+\x82\x04\xe5\x1fع\xf5\x80B\xc52\xa4\xb3\xb1\a\x04\x16\xb0A\xca_\x965\x90\xd8\xc2\x00\xfc\xa7\x80\xf7\xa4\x99$\xa4/\xf4G\v^3\xe1\x98YmHHȤأr\xb8\xa5\xfd#p\x8eB\xe2\xdf\x14H!P\x98\x91f\x03\xbb\x92\x94\xb5>\x9e\x01h\x15\x0f\xf2\x00\x17\xda K7\x17/J u\xfcRN\xed\x16\xefm\xa3\b\xfe\x1b\xb2_\x8a\xec\b\x85\xc2G\x8eO\x1a\x9e\x0e\xac\xab\x9c\xd1\xf7)\xf0\xa0\xb7<\xd2\r\xbc\x83T\x1dת\x14\x01PB6\x1e\xd9\x13V\a\xb0\x9b1\x92\x1cbm\xdb$|+%\xb3\x90\x19O8\xba\xed\xbb\xb5\xe3C\x8e\xe6 S}\t\xdb\xd2XF\xb0$\xd3^\xa4F\x15\x02U\n\xdb\xe8 \xe5\x83\x03\x19\xb4\x03!\xeb}\x8ede5\x002\x10\x1b/\x8f\x00%-_\xe5V8[\x15\xa2 \xc3E\x1b\xbf\x1cS\xf9$\xe8\x15/\xba\x00\xf19\xc9\xca\x14\xd3kg\xcaݑ\x11\x9a\x06\xd3[O\xd0\xfd\xc3hgo\ad<\xb1\x16\xa47\x16\xd7\xd6\xceM\xc7(E\xfch\x8d]\xbb\x81\xf9\x11\xd6z~C\x8ck4\xd4\xe4\xe2\x0f\x17\x97\xb4^#@\xdbom\xbf\xc3\xe9i\x01\x03\xf1\x9d-\x02\x12\xf3\xc2\x1c\xfbD\xb0\xec\xd8G\xd8\xe860\x93tL)v\xec<\vî\xfc\x05\xa7\x91n\xa8{\x87x\"4\xfb\x8d\xc9\xd7}\xefB\x02F r\xfd\xbd\x12p1\xc94\xb9!\f\xe3\x82He\xa5K\x93R\xa4\xaeG\x85,ጴo.\x1c\xbc`yx\xc2|/xY\xca\xc9C\xac[q\x8cgI\xf2s\xb1\xa8\xd6\xfb\x1d#\xc5n3\x13\x88\xf8+\xb5\xa9=&\x90X7*l\xf1\xc0\x1e\xb9T~굞\x87Ϙ\x94&\xba\x96\x99\x81\x94\xefv\xa8P\x18g\x87\xea\x8e\x15\xde\xeb4\xec\x04h\n\x87\xe8\xc3\xce<jB\x12\xa7ڙ\x0f\r\x1d\x9e\x0e\xd8\xdd\xd1\u0087\x06J\x16\x8cՌR\xfe\xc8ӒeVI\"\v\xdb·U\xe3\xea\x13x\x94Ƚ1;\x15,\x8c\x9c(\xd1r\xaaH\x81db\xe4\xe4\xca\xeb7\x8dm2\x9e!\x06\xa6\xbde\xa4GJǢ\xaa\xccP\xfbW9M\xa1\x96\x011եC\x11럀\x8cm1\x03\x8d\xa4ZI\x15G\xc7\x14\x91\xe7˵\x01,F$\\\xadS\xd2T뉍\x80\x04\xdaS\x9c#Ȫ\xe1\xc4AV7\x85T\"\xe9g\x06XQd\x91\x1d`&\xe5g,\xf4\xd9K~\xce\xe2\xef\xe36p\xcfr\xd4V=\x1bںih\xa9\xb4g\x8f\xc0\x84\xff\xa3\x88\xe5\xa2\xcby\xb31{\xd3\xeb\xfa\xb2LK(娭\xc2d5\x97K\xe0&\xfc:\x05\x91L\xf8\xfa\xfd\xffĄY\xce\xf17ݞ/\xca\xf1\xa3T\x99\x82HT\xa9^\xffOH\x14\xbbY\xdc\xf9\xbdb6A~l\xf6\xba\x04\xbe\xab\b\x92^\x92Gʠ\xeaP\xe6\x9b\xd6\xcbK c\xce~Gߜ\x99\xe4\xf0\xe19\xb8\xac'Zw\xf0\xd2\xed\f\xbc\xa9Ϸ7\xe6\t\xb8\xb4\xad\xffRr\x85\xb9\v\x99\x91A\xd4\xfc\xc5\x1a\xbc\xef>\xbd\x8fy+\x17s^o\"\xef:\x83m\xbe\xda+\xe5s\xa7\xe1U\x9fʾ\xb1֜\xbe\x04\x06\x0fxt\x1a\v\v\xd1\x17\xa9\x86,\x9d\xeeG\xa1\x8d\xca\xda\xe5\xff\x80G\vƇY'{\xcfe\x05\x1f'\xc5\xe3\x9cf\x1d\x04Ҙ\xb8\xf6\xe1c\";\xfd`\x11A?\xcd\xe6\x01/d*Y4E\xebE\x82$|\x03\xeeO\x98fE\xb6:\xba\xeb\b\xfb\x86B\xb3.\xe8\xa7\x0f\x91\x88M\xfck$\x99{hWK\b\x9a\x7fe\x19O\xab1:K\xe2F\\\xaef\x01\x84O\xd2܈K\xf8\xf0̵\xcf[x/Q\x7f\x92\xc6\xfe\xf2*\xe8t\x03?\x01\x99\xae\xa3]^\u0089m\xc2C3\xfa>\x83\xb9ݿ\x9b\x9de\xbd\x8a<\\S$\\\xaa\x80\x0fz\xe8_7\xbe?\xb4?y\xa9)\x14Ka\xb5\xb5\xdd*7\xb17Y\xd4\xea\xd5\fx\x94\x1d\xa0Z\x14\xe9\x0f\xadz逯'\xfe\xbd'\xcd\xcbN\x8d\xf0\xa9\xb0\xc8(\x0f'\xc4\xcdlN\x033\xb8\xe7\t\xe4\xa8\xf6\xb8\x9a\x04h\xff\x15$\xdf\xe7\ra\xa6\xd4=\x89\xc3\xe6m\xed\xe1\xe3Ew4\xb8\xd1\xfe\xaei\xe5\xceh\x15\x88=\xd9t \x95\xe1[fd\xb7X\xab\x7fLb\x97\xa5\xa9M6c\xd9\xed\x02\x89\xbf\x80\x16\xad\xd5\xdb\x18\x18\xb1\x1c\x83\x9c\xd9\xe0\xd3\x7f\xd16g\x19\xfa\xbf\xa1`\\\xcdX\xc3\xeflRY\x86\xad\xbeދ\xd5|\r\xbd\x81\x9c\xa0\xbf\x94\xfc\x91e\xfd$\x99\xfe\x87\x04\xac\x00̬\x0eA\xa3\xebj,\x97\xf0t\x90\x1a\x89\x11\\\xd0k\x12$E]\x1f\xf0xqٓ\x03\x177\x82\xbc\xc1\"].n*m\xc1ƚ.,\xfa.\xbeE\t\x9aɉ3\x9b=\xaf\x1f\xaa$\xbauΊ\xb5\xe7^#s\x9e\f\xf6#\xeb\xedj5\x93\x9d\xc8|\r\x1a\x04u\xac2\xddȜܬ\xbe\x91\x7f\v\xa9\xcd\xd5\xe0\xd3\xcePn\xa56ֹ\xd5Vg\x97x\xbf<\xefy\xaf\x17\xb0\x9d\xcb5\x94*d\x91\x91\xb8\xec8j\x89\xdaz\\23\xd5\xf0\xa49\xa0d\x90]\xd4+\xdfy).\\̂\xfe\x1fXBOƇJp\v%\x13\xd4\xd1l\x80ER\xbe\x85\xca>\xce*\xc7\"s\x86\x0f9\xfd\xa6\x9c\x99\xcb\x15YB\xd2T\x9b\xceP?<7\xbc\x9eLX\x10\x93̷t\\>\x95)g\xdd\\\xc4YC\xbcv=\xc32\xf1\x80\xac\xc4aj_\x92\x8cӫ\x19@[\xcc\xf9=l\xef9\x177ķW\xf0ì\xf6s7ϖp\x8d\xe5\xea\xcc@\xb9\xef[#\xbd\xfaA\f$\xeb\xc4>\x94\x8e\xf1t@\x85-\xca\xf5\xfd\xe3\xa4`\xce\x04INˆ\x1b\xc2g\x04\xbeѰ\xe3JW\x06(\xaax(8\xf6\x8d\xe7\x02\xbd\x00\x85\xa5\xf8@\xc9X'\xe0\xff\xb3\xebYM\x94܋O!\xa3s09&\xf6\xb5\xc1$$\xdf\r7\x80\"\x91%e4[\xdb\xc3e\x8a9\x128\x01=\x1be\xf3\x04\xc4p\x82_쳶\\\xc7Ũ\x7f\xa7\xfe\xae\xe1#\xe3\xd9j\xa2\xd5)d\xf3\x89s'\x90-\xe4\x06\x06yJ̙\xb3g\x9e\x979\xb0\x9cP?\v&оK\xa3hS\xbc\xca+\xb4\x8b\x89H@\xf2\x8c\x12/34sW\xa4\xcb \xa4e\xa2y\x8a\xd5\xc6\xec\xb9@\n`\xb0c<\x1bHg\xfaF\xdc.\xb1Q\xbc\xb0\x98l9S\x97\xa3\x7f\x94\x8d\x7f\xb5ZDѿ\xde\xdf\xdf6\xb7G\xfb\xf7kl\x8f\xf8\\`b0\xbd\xb3٠\xd72E}\x02\x03~\xe8C\xb1ʳw\xd2\x16Rh\x9c\x05\x15BZjba8\xbfm\x8eLԼ\xa7\xcb$AL\xe7\xcaN\xa8r\x88\xbdL\xb3y\xd6G\xf8\xd3\xf3s\xf3]62\xf5\n\x9b\xb3\xcbӺ\x02.̟\xff4\xb3\x8fc-:\x02\xb2\x8f&\x93~\xeb\x0e}@\x96\xa2\xd2w\x98(4W3:t\x99\xb3ٿkE\x90\x87\x8d~\x9f\x05\x16\x82R\xee\xb6\xd2*\x00U\x9b\x89>\v\x8f,\xb4\xb9\x9b\x041\x9e\xcdk`!J`\x0fq\xbc\xd1a\xe2\xaf ah\"\x1a\x93R\xe1\xdd\x03/\xee\x7f\xbc\xfb\x8a\x8a\xefNq\xed\xde\xc4\xe0@\xca5\x05e\xf4\x02\x1d\xe8\x11U}4C\xee\xda\xe9\xf3\tI\b{p\x03\a2\xc6c_\x92Bw\xd5\xf9\x8b%h\x8c\xe7\x14\xc6>.\xc5\xf2\x04\xc4\xfdd;\x06v\xa4\xa1zX~\xf2\xb3 B\x98\xdd&\xa4\xcfR\x9c\x1bn?\xdfݟU\xb5\xb3\xaa\x16T\xb5\x82\x99\xc3\t4\xbbe\xe6\x10\x18\x94@\x84e\xe9y\x0e\xf4\x1c'\x9b\x1f\xb0\xac\x0e%Qj\x13\xfc\xfc\xe5G\x82\xdc\xda\xe8j\x1e\x9e\x0f\xf4\xe2\xedū0z!\xd5)[ͭT\xd5\x0eC \x02\xc6\xc8\xc0k`n\x16` @\x84b\x9a\xe70\xd2V\xaf\xb3\xad/\xdd\xd4\xed\tT\xbc\x9aѲ\x832{\xb0\xb7r\xee90\xd5\xe1\x05\x85,9̂\t\xaf\xc3_\xa4\v\xbf\xbcX \xa8\v\x9a\xea\xd7\xe0\xf0\x7f\x0e\xf3m\xa16\xfe\xbfj\xb6\x01\x94*r&t\x12\x9f\x9eWi\xba\xf4\xbfM\t\xeb%\xc0,\x98į\xf5\t\xc4\xc0\xf4\x971xSy<\xbdE\xf5F\xc3\xcd-H\xe1\x04\x1c\xa9\xb8\xb47\xbc\x02\x0eg\x9b\xa93\x1bα4\n5?\x10q\xabp\x9e\xf3\x7f\n\xc5ޥ\a\x85\xe2R\x91\x84\x7fa\xff\xbf_\tL\x1c\xcf\x01\x80s\x00\xe0\x1c\x008\a\x00\xce\x01\x80s\x00\xe0\x1c\x008\a\x00\xce\x01\x80s\x00\xe0\x1c\x008\a\x00\xce\x01\x80s\x00\xe0\x1c\x008\a\x00\xce\x01\x80s\x00\xe0\x1c\x008\a\x00\xce\x01\x80s\x00\xe0\xffc\x00`\xca\xd8v\xf5\x89W'\x8ebƑܱ!\x8e\xc0\xf7'\xc8}\x8d\xaa\xe0D\x8f\x18z\xb1\xd3\xe3\xdd^\x91\x1ag\xb3\xebZUŃ\xb7X\x1dk\xa7\r\xa7Z\x85.\xa7\xa7\x1d\xcfX-DԘZ\x1e^\xea'\xb5\xac`\xd4\xcdh\xe7N͝\xd98\xe9\x14\x8b\xf2#\xec\xe0\xe0\xa5*}\x85\xf9/\xab\xf4u\xd9\xf0V\xf8\xa3E\xf6\x90*\xa6C\xaf\xec\xbcm5\xdb\xd10*\x01f\x11>\xb6:x\xb7@\xc5i\x84\x1f\xea\xde!}e\xeb{\xac|3\xf1g\x16\xf5\xba\xf8\xc3\xc5\xf7\x87\xe9Ÿ\x1d\xc4f\x0fM=\xc0\xa1f\xb6\xb6ǖ\x9a\x85)\xdaE@\xbeO\xe6\\ʍC\xecW\xf1\xd6\f|\xf5\xa5L\x03a\xdf\xebb6\x98\x7f.\xfc^q?\xa4\x9a\xb6Q\x16\xe92Uo\xb8\a\xd1z<\x81\xe9\xa3H\x0eJ\nYj\x1f\x95\xbe1\x98\xbf\xb3\xa7\xe3\xfc1N:'7W\xc0\xfe+\x1cd\x19\xa965\x82\xbb\x89\xda#\xc3\x15G\xdcʢ\xea\xe9\x8f?l\xdaO\x8c\xf4\xf5G\xe0\x89\x9b\x98\x19e\xadJJ\x0f\x10\xfbf1\xb1\xb0\xe0\x8c\x8c2\x12\x1dS\x17<\x1bڰB\xef\x16\x7f\xc1g;v\x96m\x96\xf2\xccx|\xa0{d7֦\x83\xbdn\x97\xb1\xba$A;\xb4\xc1\xf3\xcdj\xe8x\xfd\xb2\x83\xb8\x83K\xeb\x1b*\x8f\x8c\x97\nYRo\xa4[Md\x10h\xed\xe8ެN\x0f\xedLT\x14i\xa1c^\x1d\x91P!d\x04*LT\x0f\x19\x95q\xe1\x1b\xb06{\xf8s\xeb\x83LYgs\xab\x82\xb4\xeb}\x8c\x83\\P\vd\x16r\xa6\xeb~\xb4P3\xa7ڇ\xaf\xae\xb1\x9aS\xbde\xb2\xc6G\xa4z\xc7ja\r\x11_Fe\xa4f\xc7(\xc4X=\x8f\xf9\x95:FA\xdb*\x1e\xd3\xf59F\xe5\xd0\x02Z\x8f\xed\xeb\xe13me\x0f\x8b\x9a\xc9\x1a\x1b\x93V\xf8\xf8\xf8\x1aU$\xe2\xc3[R;c\x12c-\xbe\x9f_'\xa3\xaa\x831\xf0ޥ\xd51\xda\xd5/\x06\x80Ω\x891P\xf3b\x00\xe2h%\x8c\xb9\x95.\x06`Ol\xbb\xa3\\2\xfapI\x85\x8b\xf856ӻa\xf6[\xf1ߩh\x90\xaa\xa5\\F\x06\xd0\xe2\xecϝ\xe6\xc4&A\xc7\x1aWV{p\xc1\xaa\xaf˕ռ\xcc\f/2\x9b\xbc\xfa\xc8Ө\xcdn\x0ex\xac.-\xf8\x87䢺h\x03>\x7f\xa9\x98y\xd3Q\xb9\x99\x86'\xcc2`1V\xec\xcd<q71%r\x8d\xb4e\x00\xafj\xfe{\xb7\xec\xa5s\xbf\xd8j\xba\xb1\xfc>s\xc0\x9c\xae\x0e\x1a\xbe\x92cP\x94\x8f\xab\x93V\xe4X\u0383_JTG\xa0\xfb@j\xfd\xa2\xb2\x15\xe3\v\xca-K]fu\xf1\x1c/mH5\xec\xa9\xd9\xf5\xf2\x84w\xc2\xd9\xf0Q\xb0\x9d1Z8\xa8\xc9\xd8\b\xb4\xa6\xdb\x13\xc8j\x18h\x1a\x85*d\xd5{\xb5\\S\xedN&ު\x83\xee\x1774\x96\x9b\x1a\x93\x9b\xfc8\x7f\x9chn\x9cnp\x8c\x80\x9c[\xd8p\x8a\x94\xb3̎\x0eb^\xd0\xf0\x982=fHp/\x8f=\x0e\x17Lc\xae\x01\xb2z\xb1\u0084\vL\x90eF\xc8l4\xcd)@\xd8B\xd2K\x99\"\xafh\x8c\xbc\x869r\x9aA2\x01\xb2SXp\xda$\x99\x94W\x8bh?\xa5\xf8\xcf3M\xa6J\x01\xce(\x018\xaas\xcd\x1bic{\x1d\x1a\xe8\x125q\x16\x0e[\xeb\xe2\xe5L\x95W2V^\xc3\\y]\x83e\xd2d\x99䜉\xc7\xcbJ\xf3\x9d켗*E5\x1a\xeb\x98˚\xa3L\xd9b\xc7ϝwv<\xff^\xc1\xb6#k\xa9\xb2\x91\x97ʪbw\x02tѫ38\xa9\x9edc\xdf\x0f\x00l\xc0\xaaVD\xe2\xfe\xffZ\xcb\xf3\xf7\xbdR'J\xbd(\x18\tDkb\xd8S>z\x03\x1fXr\xa8\x86\xe7\xa0\x1f\xa2v\x85K͆\x8b*\xe4\xf5\xd6\x01\xa7\xbf/6\x00\x1fe\x15\xb4\xaf\xa7{\t\x9a\xe7Ev\xa4\x8c\xaa\b̋&\x88\xd3\x18\"\xca|\xe1\xfd?ɔ\xb2P\xa6\x02\x1a_:\xcd;\xc4Th\xafl\xa1;\x98$\xfc\xc7\xdd\xe7O\xd5\xfcV\x03eiQw\xef\x18qNa\x9fT\xe6\x91\x13҅\xac\x11HK\xf1Iqc\xa27\xafpѶ/\x97\xe2j\\+e\x05\xffw{+w\xe4Y\aU\xefnol\xd3\xc0\x97{\xfbG#]\xcaN\r\xb6H\xb9O\x15\xe2\x06\xe5\xcbͮ\x051\x92:T\xfdi\xd7F\xa5\x1f\xf0\x18\x96\xbc\xb1\n\ta\x95\xeeȶ\xa3\xdbX֤\xa3\xa8\xd2ߞ\xc7U\xba.\x982G+T\xf4e5\x86\x01\x98V\xf5p\xbb\xf4fu\xc2fֿ\xde9\x8a\xdbp\xcb3M\x81 6\x05G\x0f\xa3\xa7\x8cc\xb8\xe8\xe9d\xb9\xd3\x17\x1cG@e\x7f$k\x8b\xa9\xd5\xcc\x14\xa8\x17\U000d9179\xdd\xd2U\x86\x11\xbb,*+\\\xe3!I\x91\xf6/H\xec\x81%^e&\xf8b\xbc\xa8\xd8\xc9,\x93O\xe7\xf5{^\xbf\xe7\xf5;w\xfd\x86\x1bE\xe9\xe6\xe1\xf7Q\xdfw\v=w\x9d\xe6\x91\xe4\xc3\x00\xd1]S<x<d\x8b\xf6\n\xe3t\xe9n<\x96M\x18^\xed/\xa2\x9d9\x17\xdf:2\x95p\ao\x80\xab\xe3>^\xda\x1eo\xbf\xbe\xd1\r\xce\b\xa6\x91w\xb5x\xf7e\x95S\x11\x1e\xff\xe5\xe53*\xa9\x18\x05\xdb\xe3\x8f\xd2\x1dǚ\xc2A\xbb\xb5\xf7\x14\xda=0\x18H!\x11;\xac\x86\x98\xe3\xc0_\xda\xdf\x01V\x1f\x8bo\xcb\xe9-\xdaQ\xc6\x04\xca\xc8\xe21&\x9b\x98\xcc\xfd\xbdM\xc6f6wh\xf3\xbet\x99?$\xed4\x126\xc3\xc4\x1c\x06\xb6\xf4\xbf\x87\xc8~\x01\xf6f\xe4\x06}\x1a\xe3VH(qI\xb2\x8bF\xef\xae\xe2Eu-Ŏ\xef'&\xf2s\xabq\x831}\x9d\x82\x1d\xdf\xfb\xc9U\xbbO\x80\xbf\x98\x97\xc6wG2z\xb2\f\xb3\x8f<C\xed\x86\x15k\xd6\x19\xffm\xbfW%S\xcb|\x8b\x8a\xf8\x88.\xfc\xd6\xd5\v\xa2@\x03\xdal\x84\xa8@Ef\x14-N\x01\xa5\x0el9<\xf1\xe9\xa30#R\xf4\xb1u\x85}`i=A\xb8\xaf\xf1^\x8d\xe8AcQт\x1a\x90(Cp\x98\xd62\xe1\xd6\f\xb5q5:$\xe7\x17W\x7f\xfe\x83\xee\xb8\x116\x1dv\x15\f\xe0\xca\x156\xbdZ\r\xa2$\x88\x06j\x16\xee\xd0\xf6\x8c\\*{\x99\xa7?\x1am\x0f\xf4\xfa\xe3$\xb1)\r3\xea\xb6\xca\xfa\xabr\n\xf5;c\xc8\r\x8a\xe9\x04\xc5\xfe2\xd670\xad\x91\x86e5\xeb\xf6 \x02\xb0\xaa\x8b\xcdG\x1cMDtKv\x84pcL\x1b\x9b뵯\x8fp\xca\\\xab\xbe\xf3\xe7jO\xc5k\xbd+\xb3\xecX\xd5fX2\xf1\b̗B\x05\x9d\xc2<\x89\xe6\xae\xe3\x00\x12\xdc\xdc\x06\xf7\xbdYd\xf6)\xfb(Ұx{[7\xfd\xb3\xc7`\x97\xe1\xc1\x93\xc0g\xd2j\xc3\xf2b\x02\x01\xd7\xfd\x1e\xa00\x91*\xf5ӧ\xdc[V\r\x9c\xe9\x9a\xcc\xfd\xa1A\x03\x9c\xddy\t\x89\x0e\x1a\xa6\x80\x8f(\xe8\xf0\x11\x1dݢ\xf8\xbc\x05\xa97\xdd>\x11\xa8M(\xde\xd9\xe3d}\x90\xfc~xN$9\xc7_u\xe8|\x18&\x05\xe1\xed\xae\x19A\x82^\r\x1d\xbeL\x99\xc1u\x14\xe8,U-*k\x13\xcd\xdbr~\xb6к\xbe\xbb\x19\xea9\xc8\xc1\xa1A\x0f2\xc0\xf5\xddMg\xe7\xeaq\xefB\x8e\xec\xcd\xcc#\xfb\x84\x99U=\x87f\xd6\x14G=\xe0\xd5\xea\xc0\xf4\xe5\xa7iת\x9e\x98\x91\xadr\xe4\xc3.\xf6\xbc;\x8d\x99\x12\"lo\xc8Qk\xb6\x0f>\xcf'R\x98\xf7(H\x9cEI\xe5\x83w\xf5\xa1\xc8\xf6\x15\xd8.ˀ%\x86\xb2k\xec\vB.w\xa3՛\x98\x00\xce\xe4\x9e\x12\xcemS\xef\xf8\xf6\x96\xc4B\x9c<\x17\\ͱ<>T\r\t7V\xa9\xb3\xfc\xe6Un\xba\x0e*\xe3{Nj;\xf1➩-\xdb\xe3:\x91\x19E\xec\xb9\x14\x9b\xdft\xb1\xfa\xa3\xa7_\x90\xe9ɩ}l\xb6\xf5\xd1hK\f\x7f\x83$\xb32\x88\b\x82\xc2p\x15\xe8\xd2\x03J\xf9\x06\xb6T\xd1f\xd1Hm\xac\xe1+*=M\x84\x8fͶa\x81y\xb9\xeac\x16\x8f\xee\u1977]\xfb\xef\xa3o\xce\xfeA\xf7\xa7\xe6\\\xd0\x7f(\xc2b\xc3š\xf3\xa2\xf1S须\x88V\xd9\x1b\xfc_\xab\x86u,\x8f\v7lb+\xb6\xa5S%4\xa3ZÌG\xfe\xe9\x95z\xb3\x94[\xc6-'\vsD\xa0G\xa7\xb3@\x8e۪\x17\xb1\x95L\xdf;\x1f\x13cYv\xbc\xecBn\\qٶ\xc8\xc6 Z\xce\xf5\x9bx]b\xb0\x8a\xacv\x808F\x0f\xa5\xef\x06@6\x05w\x1f\xf9S\x82\xa6\xc2\xf1\x90\xca\x17G\xf0\xb8\x9eg\x0165\xb5(T\x9f\xd4\x18V\xf5\tC\x1f1<\x8b\x03\xd3\x11Gek&\xb7\xd4&̡iF\xf9\xdbՆ\x1dK\xf1J\ak\xf8\x84}?\x88\xabi\x82\xa9\xbd\xb8\xd4.\xa9H\x93\x1bq\xab\xe4\x9e\xd2\xe4\"\x0f\xff\xce8\x95\xdd\xfb(\xd5mV\uee68\xd5\xedE\x8do\x992\x9cXٍ'\xd2\xf7#\x17,\xe3\xbfƄS\xf3\xe14\xa0Jۈ<\x9b1\x8cA\xb0L$\x98ş\xbdG\xd2B\xc5~\x89\x8c,<Χ\xf8\xc47\x9b\x92\x8fA/\xf0+6F\xe9\xfa\x9d\x1bJ\fÐB\xc7\xdb0IaDmָ\xdb\xd9\x12*\x94Z\xb1^SmFg\xd9G\xe0\x92P\xb1\x0e\x9e\xb2\xa0\x8d\x9b*\x03\x85\x14\xa5\xc6Vdc\x18\xca\xee\xa8\xf6\xb2\xf9\x9c\x1d](\x84%\t\xf9\xc2\xf0\xad6,\xc3\x17\x16\xe2օBk\tӟ#FU\x0f\xe17\xcd\xf6a\x81\xd6\xe2łs\x98\xb3%+\x9d\xa2\x16U[\xe9\xdf\x16Q\x84\xb8v;G\x1a\f\xa9CY\x06Z\u008e\x9d\xe4\xfb\"\xe5°\xecf8g\xab5\xb3\xfb\xaa\xf1\x90\xec\xf4\x93\x93D\x96\xadEY\x14*\xf9\xf4|n\x9a\xefK\xa4L\x0eL쉩\x94,\xf7\x87\xc0\x97\x03j\xee\x00ܴ\xa4AAa\xa5\x87W\xa8\x15\x9aR\x89Fz\x95\xcfXM\x1b\xc3e\xc9\xc3\xe0H}\x0e\x9e\xe5\xdd\r\x97o\xf1\xd9\x1e\x8a\\\xd3y浧\x85\xcd\x06\xbe\xf4y%\x8a\xd39T\x1b,\x1f\x00Z_+o٠(\xe8\x1c\xa7\xf6\xe3\x99Q\xaf\xf9\xe4\x9dE\x1b\xa6Le\xeb^\xadF\xe9}\xd7j\xec-\xf1!\uf005\x1c\x1f\xef\x9dϛ\xb1'\xc0\xe1Zau\xe4\xd7\x02\xa6\x1c\x17\x91xib\xd37=+\xd09\x12\x8a\x03S\x9c \x9a1\xdc3\xf7[\xc6}{\xf8\xfa75\x15\x1e\xab\xfd\xf2\xc3\x1c\x03\xb1\xde^\x9b\xa6buz\x9cL\xc5\x1a\xa27\xeaz\x10\x01~\xc7w.\x899\xa1Q\xff\xfe\xa5\xdc\xc1''\x96y\xd5\x7fb\xf2oFm\x0fkVTF\x04\xbc'\xc55a\x03\xea\xf4m\x86\xa4\x15iĶY\xf3f\xb5d\x05\xb5\xfd\xfe\xb5\xd6<1\x8f\xaf\x03\xdd&\xd5\xf8\x1e\xd80\x04\xd0/\xe3\xa4x\x1cp\xa7,\x9bP\xd5훽0/;\xbb'\xa6(\x962\xb5\xc6\xfe\xee\x9bE\xdc0\x1eB\xc4\x11\xd3\x03\t\xb5k&\xa8(\x03;Ԧ\xe9\x87\tc\x04\x16\x85\xd9\xf1ͼ\x90'&\xba\x0f\xf4~\xb4\x024m\xacm\xff\xa6+0\xaa\xc4\xd5\xff\f\x00\xc0\v6E$\xa3\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xecZ_\xaf۶\x15\x7fק8h\x1f\xeeK$\xa7\xe9Z\f~\x19\x9c\x9b\x0e\rz\xb3\\\xc4\xd9\xdd\xcb\x1eJ\x8bG\x16{%R#);ް\xef>\x1c\x8a\x94dK\xb2\xe4\x16Y7 \xd6\x05\x12\x89\xe4\xe19\xbf\xf3\x97\x7f\xe28\x8eX%\x9eP\x1b\xa1\xe4\x1aX%\xf0\x93EIo&y\xfe\xa3I\x84Z\x1d\xbe\x89\x9e\x85\xe4k\xb8\xaf\x8dU\xe5\a4\xaa\xd6)\xbe\xc1LHa\x85\x92Q\x89\x96qf\xd9:\x02`R*\xcb賡W\x80TI\xabUQ\xa0\x8e\xf7(\x93\xe7z\x87\xbbZ\x14\x1c\xb5#\x1e\xa6>\xbcL\xbey\x95\xbc\x8c\x00$+q\r;\x96>ו\xb1J\xb3=\x16*mH&\a,P\xabD\xa8\xc8T\x98\xd2\f{\xad\xeaj\r]CC\xc1\xcf\xdep\xfe\xda\x11\xdb6\xc4\x1e<1\xd7^\bc\x7f\x9a\xee\xf3 \x8cu\xfd\xaa\xa2֬\x98b\xcbu1\xb9\xd2\xf6/\xdd\xd41\xecLѴ\b\xb9\xaf\v\xa6'\x86G\x00&U\x15\xae\xc1\x8d\xaeX\x8a<\x02\xf0\xd08Ab`\x9c;\xb0Y\U00068174\xa8\xefUQ\x97\x01\xe4\x188\x9aT\x8b\x8a\xba\x04Y\xc0\v\x03A\x1a0\x96\xd9ڀ\xa9\xd3\x1c\x98\x81́\x89\x82\xed\n\\\xfdU\xb2\xf0\x7f\xc71\xc0/F\xc9Gf\xf35$ͨ\xa4ʙ\t\xad\x84\xf0\x1a\x1e{_\xec\x89\x040V\v\xb9\x1fc\xe9\x81\x19\xfb\xc4\n\xc1\x9d\xc8\x1fE\x89 \f\xd8\x1c\xa1`Ƃ\xa5\x0f\xf4\xd6 \x04\x04\x11B@\b\x8e\xcc\xf8y\x00\x0e\r\x15䓜\x16\x83\xb9|׆mb\x05\x9e.\xa84\xfc\xd3\x17\xcf}\x8fl\xb0\xef$\xd5ؒ4\x96\x95\xd5\x19\xdd\xcd\x1e\xa7\x88\x9dA\xf1\x063V\x17\xb6/*\xdbw\u008e\x88Ua\x9a\xf0f\x94om$ys\xf6\xad\x99u\xa7T\x81LF]\xaf\xc37\xeeŤ9\x96\xceG\xe9MU(7\x8fo\x9f\xbeݞ}\x861C\xbap\nR\x1c\xeb\xe9&G\x8d\xf0\xe4\xfc\xafћ\xf1\xa2\xb54\x01\xd4\xee\x17Lm\xa7\xc4J\xab\n\xb5\x15\xc1Y\x9a\xa7\x17\x8bz_/x\xba#\xb6\x9b^\xc0)\bacG\xde_\x90{IAe`sa@c\xa5Ѡ\xb4}xã2`ҳ\x97\xc0\x165\x91\x01\x93\xab\xba\xe0\x14\xbb\x0e\xa8-hL\xd5^\x8a\x7f\xb6\xb4\rX\xe5\x8dע\x0f\x11\xdd\xe3\xfcS\xb2\x82L\xb5\xc6\x17\xc0$\x87\x92\x9d@#\x81\x00\xb5\xec\xd1s]L\x02\xef\xc8ޅ\xcc\xd4\x1ark+\xb3^\xad\xf6\u0086\x18\x9c\xaa\xb2\xac\xa5\xb0\xa7\x95\v\xa7bW[\xa5͊\xe3\x01\x8b\x95\x11\xfb\x98\xe94\x17\x16S[k\\\xb1JĎuI\x02\x9b\xa4\xe4_k\x1f\xb5\xcd\xdd\x19\xaf\x03\xafm\xfe\\Լ\xa2\x01\x8a\x98\x8d\x154C\x1bA;\xa0\x85\xdc;t>\xfc\xb0\xfd\baj\xa7\x8c3\xa2\xc1,\xba\x81\xa6S\x01\x01&d\x86ڍ\x83L\xab\xd2\xd1D\xc9+%\xa4u/i!P^\xc2o\xea]),\xe9\xfd\x1f5\x1aK\xbaJ\xe0\xde%&\xd8!\xd4\x159&Oୄ{Vbq\xcf\f~v\x05\x10\xd2&&`\x97\xa9\xa0\x9fS\xbb\x1fQY{\xd4z\r!\x17N\xe8kԋ\xb7\x15\xa6g\xfe\xc3\xd1\bM\x16n\x99Er\x1evF\x11\x82\x8b\x8fR;\xeb:\xee\xdc\xf4\xb04Ec\xde)\x8e\x97-\x17,oڎg<V\xa8Ka\xc8\xf5\rdJ_f\f\xd6F\xe0\xfe\x13\"U2hCY\x97CFb\xf8\x80\x8c\xbf\x97\xc5i\xa2\xe9oZ\xf8Ⱦ@\x91\xf4װ\xb8=\xc9\xf4\x11\xb5P|F\xf8\xd7\x17\xdd[\bru\x84̙\xb5\xb4ŉb\x909\xc9ԓ\x1f\xd0\x04\xd8<\xbe\xf5\xc6\xe2\x1d\xc8\xfb\x9b\xc7*\x81\x8d\xf7\\\x95\xc1K\xe0\xc2P\x01`\x1c\xd1!X\xb2.\\\xb1\xb0\x06\xab\xeb\x9b\xc4O\x95\xcc\xc4~(t\xbf\xa6\x99\xb2\x98\x19\xd2\x17\xc8ݻ\x99(4\x91uTZ\x1d\x04G\x1d\x93\x7f\x88L\xa4\x14\xd03\xb1\xaf\xb5\xb3Y\xc8\x04\x16\xdc\f%\x9d\xf02\xfaK5r\x94V\xb0b=\xc3Iۑ&\xb5L\xc8&Ku\x04\\\xb0ѥO\xa9Ң\xe4m5\xd2\x7f\xacrQ\xcb \x87\xa3\xb0y\x13\x0e\x83M\x0f\xfaO\xfb\x1e=\xcfx\x1a\xfb|\xc1\xfb\xc7\x1c\xe1\x19O\x14\x03\x88e\x83\xa9F\xeb\xac\r\vJ`dJ\t\xc0\xbb\xdaXb\xed2N\x84\x9f+\xd4\xc2\xe8g<\r\x81\x9eU\xae/a\xe6Y\xbe\xa3\xd290\xac1C\x8dҎ\x06uZ\x80h\x89\x16\xdd↫\xd4PNM\xb1\xb2f\xa5\x0e\xa8\x0f\x02\x8f\xab\xa3\xd2\xcfB\xeec\x02<\xf6\x1e\xb4\"V\xcc\xeak\xf7\xcf(G\x00\x1f߿y\xbf\x86\r\xe7\xa0l\x8e\x1aj\x83Y]\x04C\xeb\xd57/\x80R\xc1\v\xa8\x05\xff\xd3]4Bi\x0e\x17\xe5tŊ\x05\xd8P\xa4\x17\xd9\t\x8e9:\xa6\b\xa2m\xa3\x15\xa5\x812%)\xbb\xf4\xdalb\r\xbf\xa2\xab~\x85\xd9\xffQ`\xa2\f2d)&s\xba\xc5\xcd\x00>ŝ\xa2\xe2\x92Uq37\xb3\xaa\x14\xe9Eo_\x1a\xaf\xa3\xab0\x84\xb2[H.Rfќ{RX\x8exb\xd3A\xd5\a\xcfv`\x12\xdd\x02ScL>{\xcep\xfc\xbe\xdf7dZ\xf0\xc1\xccgD\x83\xd6\n\xb97 \x912&\xd3C\x9c]\bI\x95\x94\xe4\xbbV\x01k\x03\xe3\x9d\xf1\xfc\x04\xa1\x92\x1b\xe3ɮN\x9fю\xb5\\\x88\xf2\xdau\f\x187È\xadڠK\xe4sl,\xf0\x88\x94ݣ^\xc2\xcb\xfd\x86:\xb6I\x95\xc1\xfd\x06v\xb5\xe4\x05\x06\x8e\x8e9JZ\x7f\x8b\xec4>\x17=\x1f\x1f\xb6\x01UW\x8f\xf8\x15A\xc0v\\\x86&\xe2\xafaw\xb2\xf8k\x84D\x99\xeaS\x83鼠?\xb4\x9d\xa7\x8c\x86\xa0\x0f$'\x05\r\x15\x84\x92\xbd\x9a\x1b\x8c\xe0\b;\xcch\xddbs<\x01\xd3T[\x17\x8aq\xe4ay4\xe1\xdcg\x8e4\x0e\xd4L\xb51o\x9aW\xd3\xdd\x00\xaa\x9f\xf0\x14\x8cӇF\x8a\x89\xb9*xX˼\xfa\xee\xfbx'\xech$\xeb~.M[\x15@\rE\xab\xcf!@\x15=Q0\x89K\xb2M\xf1E\x91\xf7\n\xc9\x1d·\xaf\x9c\xc1\x98\x17\x80\u0085p͎\xa04\xec\x98\xc1\xef\xff\x10\xa3L\x15G>\x0e\xe42\xa4f\xd1\xfa-E\xc2U\xa2\xe0J\x88\x85\xc5\xc2B/\x99/\x1eFE\xfa\x1f)\">C1q#n\u05cb\x8bQ\xec\x96\x17\x19Wi\xc2\\\t\xb2$\xc7.)I\xae\x97&\x8bJ\x94_S\xaa,ak\x9a\xa5\x19vh\xdfi\xaf\x85\x9dp\xe33}\xbd\r}\xaf\xa5\x06RbKt\x94&@ɤ\xc8\xd0X8ja-\xcaf\x91\x82,\xcd}\t\xf5\x19\xe3\xbb\x11{)\xe4\xfe\xa7\xc5a~\xdb\x0e\x98\x89\xf6ˢ<\xcd\x7f\x0eR\a\x87\xca\xfa 8T\xaeP\xfc\xf1\xdd\xe6>\xde\xfe\xb8y\xf5\xdd\xf7_\xc2\xf8\x970\xfe%\x8c\xff?\x84\xf1\x19\xb2\x95\xc6L|ZG\xb3\xa0?\xba\x8e!\"U\xcc\xe6 \xa4\xab\xaf\xd9\xc8R\xa9ن\x1d\xa5\xda\xd5\xd4\xf0\xde\xeb>\x89n6\xa0i\xb4c\xcfNt\x03\x12a=\xb4\x8ef0h\xba\xb5(\xf8a\xc1\x8f\xcfwy\x93\xe8\x06\x89\xfc\x81\xa1P\xf2\xcf$\x1a\xca\xf44\xc3\xcc\xd3p\x84\xb7\xe6\xb1=\xd8p 9\xa0\t\xce}R\xa55\x9aJI\x97\\\x96\xed\xc0v,'э\x99s\x12\x88q\xb5Ơ\xfa\xbb\f\x17mAy\xd1\x02e7\x87\xaf\xebh\x12\xd5у\x83\xad\x1bբK\x80\xa9\x9dA}\xe8\x9dD\x9c\x91\x84\xff\xce\x01\xc4W\xbd\x13\b:\xe9\x92PK\x97\xf6]\xdcN\xe0\xef\x12\xdeЩ\x15\xed$\xf15)Z\x0fu\x01d\xcdR\x1dix\x8f\x9e#\x11\x96\xd3T8\xbb\x13B\xb7\xaf\xdb4\x1dEQP!\xac\xb1T\x87\xd1\bJ[\xc8\x1a\x8b\x13\x1d\xe3\xab\f\x0e\xaf\x92\x97\xc9W\xbf\xdb\xf9\x06\x1d\xb8\xd3q\x05\xf2\x0fx\x10\xc3\xf3\xdb!\xba\x0f\x83\x11\xc1\xf1[w\xa0\x97\x9f\xc31\xd8J\xfbn?\x0f\b\x03d\xa2\xa0:u$Nt\xbb{Û\x06\xaf\xb7\x0fw\x86vp,\xca\xde\xc9t\xf7\x1c\xe9\\\x9b\xceB\x90S\x81\xa7\xfc\xeeGm,\xea\x11\x03h\xb5\xe7t\x0e\x85\x92\xfb\v\xc7\xf1\xc5cs\xfeHˢƠ\x94\x06\x8ettH\xf1!͙\xdccw\xbe\xec\xf9\xbf\xce)\x93\x03\x9b\xe9,D\xc8)\xf3X\xa4Q\xba>1\xa3\xcdN\x99\xd3\xf7:\x02\xf7A\xb3A1\xb7\xe2\x1eM\xed\xa8\x11\xa8\xb1\xed\xeez\xfc\xf6\x80\t0\xbcH\xb2\x00\x89\xf3\x01\xe3h\xf4\xac\xf4ډ%\xdd{i\xd3\v\xff\xfdp(ј\xf9\xed\xeawM/\x92\x98\x85!\xc0v\xaa\xb6\xd7<\xf3n̠\xfdE\x9e[xtדf8t\x17\x96\x82F\xd2Z\xd3\u00a0;憐\xa3\xb9%Y\x1cX\xdb\x1bU#m\xc3;V\v\xe4\x1a͵\x83\x8fM\xbe\xec\xe9Ճ\xdc\xffR\xef\xda; k\xf8\u05ff\xa3\xff\f\x00'\xc1\xe4u\xfc'\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4U=s\xdc6\x13\xee\xf9+v\xe6-ܼ\xe4YI\x93a\x17\xcb.4I<7\x92\xc7=\x0e\xd8#a\x81\x00\xb2\v\x9c\xa2d\xf2\xdf3\v\x92\"\xefKV\x8a\x90l\b\xecǃ\xe7\xd9]\xd4u]\xa9h\xbf\"\xb1\r\xbe\x05\x15-\xfe\x91\xd0\xcb\x1f7\x8f?qc\xc3\xe6pS=ZoZ\xb8͜\xc2p\x8f\x1c2i\xfc\x88{\xebm\xb2\xc1W\x03&eTRm\x05\xa0\xbc\x0fI\xc92\xcb/\x80\x0e>Qp\x0e\xa9\xee\xd07\x8fy\x87\xbbl\x9dA*\xc1\xe7ԇ\xf7\xcd\xcd\x0f\xcd\xfb\n\xc0\xab\x01[0\xe80\xe1N\xe9\xc7\x1c\t\x7f\xcfȉ\x9b\x03:\xa4\xd0\xd8PqD-\xf1;\n9\xb6\xb0l\x8c\xfeS\xee\x11\xf7\xc7\x12\xeaC\tu?\x86*\xbb\xcer\xfa\xe5\x9aův\xb2\x8a.\x93r\x97\x01\x15\x03\xb6\xbe\xcbN\xd1E\x93\n\x80u\x88\xd8\xc2g5 G\xa5\xd1T\x00ӱ\v\xcc\x1a\x941\x85H\xe5\xb6d}B\xba\r.\x0f3\x815\x18dM6\x8aI\v_z,G\x84\xb0\x87\xd4#\x8c\xe9 \x05\xd8\xe1\x84@2\xc8\xfb\x8d\x83ߪԷ\xd0\b_\xcdh*@&\x03\x89\xd3\u0087\xd3\xe5\xf4,\x809\x91\xf5\xdd5\b\x9cT\xca<\x83(ym\xf0\xb0\x1c\xfb\x14@\xb1ob\xaf\xf88\xfbCٸ\x96y\xb49ܔ}\xd6=\x0e\xa5\xca\xe4/D\xf4?o\xef\xbe\xfe\xf8p\xb4\f\xc7X/H\v\x96A\xcdH\x85\xb8\x82\x1e!x\x84@0\x04\x9aY\xe5\xe6%h\xa4\x10\x91\x92\x9dKk|WͳZ=\x81\xf0NP\x8eV`\xa4k\x90\x8brS\x11\xa0\x99\x0e6\x92i\x19\b#!\xa3\x1f\xfb\xe8(0\x88\x91\xf2\x10v\xdfP\xa7\x06\x1e\x90$\fp\x1f\xb23\xd2l\a\xa4\x04\x84:t\xde\xfe\xf9\x12\x9b園ԩ\xb4\xe83?\xa5\xe8\xbcrpP.\xe3\xffAy\x03\x83z\x06B\xc9\x02ٯ\xe2\x15\x13n\xe07\xa1\xc9\xfa}h\xa1O)r\xbb\xd9t6\xcdCC\x87a\xc8ަ\xe7M\xe9\x7f\xbb\xcb)\x10o\f\x1e\xd0m\xd8v\xb5\"\xddۄ:e\u008d\x8a\xb6.н\x1c\x98\x9b\xc1\xfc\x8f\xa61\xc3\uf3b0\x9e\x15\xc8\xf8\x95F\x7fE\x01i\xf3Q\xf6\xd1u<\xe8B\xb4\xf5]\x91\xe4\xfe\xd3\xc3\x17\x98S\x171\x8e\x82\xc2\xc4\xfb\xe2ȋ\x04B\x98\xf5{\xa4\xe2\a{\nC\x89\x89\xde\xc4`}*?\xdaY\xf4\xa7\xf4s\xde\r6\xf1\\\x92\xa2U\x03\xb7e\x92JS\xe7hTB\xd3\xc0\x9d\x87[5\xa0\xbbU\x8c\xff\xb9\x00\xc24\xd7B\xec\xdb$X_\x02\xcb#Qډ\xb5\xd5\xc6<\xbe\xaf\xe8u\xa1i\x1f\"jQPH\x14o\xbb\xb7\xba\xb4\a\xec\x03\xc1Sou?7\xedQ\\X\x1a|i\xe6\xeb\r-\xef2&Ow\xae\x1e\x1e\x8av\x96\xf0\xa4\n\xebU\xb07\xf1R\x86\xe1\xbfd\xa6\xf8\xcc\xdc\xe8L\x84>\xad泺\xe4\xf4V.\x90(\xd0\xd9\xea\t\xa8O\xc5H\x86OR\xd63(\xff<9B\xeaU\x82'$\x04\xf4:d\x993h\xc0\xe43\xfe&Z\xd6wI\xa4\xa0\x91W3x~m\xc2\xe1\x02\xa6Wԑ\xcfg\xe7\xd4\xcea\v\x892VG{/\x8a(\"\xf5|\xb2W\xee\xac\xefP\xb0\x15\x9bK\x1a\xe0|E~W\x04\xf9\xd0\xe7\xe1<S\r\x9f\xf1\xe9\xc2\xea\x9d\xdfR\xe8\b\xf9\xb4\xe4\xc5e;\xb2\x87\xa6:\xdax\x8d\xa5\x8bEy\xb6\xc8r\xe5\x98\x15\x8b\x9c\x02\xa9n\xcd+\xe7\xdd\xcb\xfcn᯿\xab\x7f\x06\x00\x045\f\xc6i\n\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4WM\x93\xdb6\x0f\xbe\xebW`\xe6=\xe4\xedL$'\xed\xa5\xa3[\xbb\xc9Lw\xb2Iw\xec$wZ\x82$v)\x92%@;\xdb_\xdf\x01%\xf9S\xf6z\x0f5s\x88H\x10x\xf0\xe0\x8b\x9b\xe7y\xa6\xbc\xfe\x8e\x81\xb4\xb3%(\xaf\xf1\a\xa3\x95/*\x9e~\xa5B\xbb\xc5\xe6}\xf6\xa4m]\xc2]$v\xfd\x12\xc9\xc5P\xe1\al\xb4լ\x9d\xcdzdU+Ve\x06\xa0\xacu\xacd\x9b\xe4\x13\xa0r\x96\x833\x06Cޢ-\x9e\xe2\x1a\xd7Q\x9b\x1aCR>\x99\u07bc+\xde\xff\\\xbc\xcb\x00\xac걄\xdam\xadq\xaa\x0e\xf8wDb*6h0\xb8B\xbb\x8c<V\xa2\xbb\r.\xfa\x12\xf6\a\xc3\xdd\xd1\xee\x80\xf9èf9\xa8I'F\x13\x7f\x9a;}У\x8471(s\x0e\"\x1d\x92\xb6m4*\x9c\x1dg\x00T9\x8f%|Q=\x92W\x15\xd6\x19\xc0\xe8b\x82\x95\x8f\xdem\xde\x0f\xaa\xaa\x0e\xfbD\x9b|9\x8f\xf6\xb7\xc7\xfb￬\x8e\xb6\x01j\xa4*h/\xa4\x9ea\x06M\xa0`D\x00\xecv\xa0@YP\x81u\xa3*\x86&\xb8\x1e֪z\x8a~\xa7\x15\xc0\xad\xff\u008a\x81\xd8\x05\xd5\xe2[\xa0Xu\xa0D\xdf \nƵ\xd0h\x83\xc5\xee\x92\x0f\xcec`=\xb1<\xac\x83\x1c:\xd8=\x01\xfeF|\x1b\xa4\xa0\x96\xe4A\x02\xeep\xe2\a\xeb\x91\x0ep\rp\xa7\t\x02\xfa\x80\x84vH\xa7#\xc5 Bʎ\x1e\x14\xb0\xc2 j\x80:\x17M-9\xb7\xc1\xc0\x10\xb0r\xad\xd5\xff\xect\x930$F\x8d\xe2)\x1d\xf6?m\x19\x83U\x066\xcaD|\v\xca\xd6Ыg\b\x98x\x8a\xf6@_\x12\xa1\x02>\xbb\x80\xa0m\xe3J\xe8\x98=\x95\x8bE\xaby\xaa\x9d\xca\xf5}\xb4\x9a\x9f\x17\xa9\f\xf4:\xb2\v\xb4\xa8q\x83fA\xba\xcdU\xa8:\xcdXq\f\xb8P^\xe7\t\xba\x15\x87\xa9\xe8\xeb\xff\x85\xb1\xda\xe8\xcd\x11V~\x964#\x0eڶ\a\a)\xe7\xafD@\xb2~H\x98\xe1\xea\xe0\xe8\x9ehm\xdb\x14\x92\xe5\xc7\xd5W\x98L\xa7`\x1c)\xdde\xce\xee\"\xedC \x84i\xdb`H\xf7\x86\xcc\x13\x9dhk\xef\xb4\xe5d\xa02\x1a\xed)\xfd\x14\u05fdf\x9a\x92YbU\xc0]j(\xb0F\x88\xbeV\x8cu\x01\xf7\x16\xeeT\x8f\xe6N\x11\xfe\xe7\x01\x10\xa6)\x17bo\v\xc1a/\xdc\xffDK9\xb2vp0u\xb2\v\xf1:)\xf5\x95\xc7J\xa2'\x04\xcaM\xdd\xe8*\x95\x064.\x80\xdaW\xfeH\xe0\xbej/W\xae,V\xa1E>\xdd=\xc1\xf25\t\x89\xf9m\xa7\x8e\x1b\xcd\xff\xb1h\v\xe9\x154\x02\x19\xba\xc7O\xc7\xf6\xafc\x98\xcf\xdeY$S\x12\v\r«\xb4\x02iR\x87\x98\xceM\xcbB\x1b\xfby\x039\xfc\x9e0?\xb86;;<8\xbfs\x96%ݯ\n}w&\xf6\xb8\xb2\xcaS\xe7^\x90\xbdg\xec\xff\xf4\x18R\x1c\xaf\x8bN\x83w7\xa5\xae\bFs\xd1\xee\x12\xa5\xdf\xe3eOG\x81\x9b\xb4܀i\x94\xbc\xc9ѻ\xd5\xfdk(\xbc \xfe\x8a \xdd\xdb\xc6]\x97{t\xf5\x00f\xf8|-\x14\xa3\x88\xf0\xba\x85\xcf\xca\xea\xe6|\x18\x1d\v\xfd\xe1\xdc\xd3M\x11\xb9Y\xf01\xe0F\xe3vV\xe8Bo\x9bVzü\\\xa8\xf2\n\x9a\nU\xaeH\xa1\xca\xff?\xc55\x06\x8b\x8c\xb4\x9f1[\xcdݬF\x80m\xa7\xab.M\x8dT\xe52\xbe\x88\\\xa5\xd30x=|i\x8e:\xe0L\xa7\xc9S\a\x9a\xd9\x16\xf0g\xdb\x17Z\xfa%\x03\xf9\xd8f\xb3\x1bt\x10+\x8e'-\xf2\xea`H\xf2\x13\xd5U\f\x01-\x8fZ\x84tuz\xa1\xc8n\xeb\xcaS;\xfd\xb6|(\xb3\xab\xb1\x9e\f|[>\xc8닕\xb6\x03\x1a\x1f0'\xddZ\xacA\xced@\xc8\xf6\f\x19ÿ\xe3\xe7\xe6\r\x11\xc5\x1f^\x0f\xed\xf3\x05\x88\x1fw\x82\xc2ԶC;\xbcPN\xb8\x19\x14\"\xa5\xd7_\xa5Nߝ\xb2\xd6\b5\x1ad\xaca\xfd\x9c\xbc\xa4gb\xec\xcfq7.\xf4\x8aK\x90\x97K\xcez&\x8dl4F\xad\r\x96\xc0!\xe2k\x1c\xf7\x9d\"|\xc1\xe7G\x91\x99K\x8c]1\x9ex_d\xb7\r\xcd\x1c\xbe\xcc\xf4\x8e\x1c\x1e\x83\xab\x90\b\xeb\xdb=\x99-\x82\xb3M\x92\x17~}\xc0\xd2\xf8WK\t\x1c\"f\xff\x0e\x00.Hռ\xca\x0e\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Zߏ\xdb\xc6\xf1\x7f\xd7_1\xb8<\xdc7\x80I%\xfe\x16E\xa1\xb7\xf8\xdc\x14\xd7&\xf6\xc1:\xfb%\xc8È;\x946G\xeenw\x97:\xabA\xfe\xf7b\xf6\x87D\x8a\x94tw\xad]I\x80\x8f\xfbc\xf63\xb3\xf3\x9b.\x8ab\x86F~\"\xeb\xa4V\v@#\xe9\xb3'\xc5O\xae|\xf8\x8b+\xa5\x9eo\xbf\x9f=H%\x16p\xd39\xaf\xdb\x0f\xe4tg+zK\xb5T\xd2K\xadf-y\x14\xe8q1\x03@\xa5\xb4G\x1ev\xfc\bPi\xe5\xadn\x1a\xb2ŚT\xf9Эh\xd5\xc9F\x90\r\xc4\xf3\xd1\xdb\xef\xca\xef_\x97\xdf\xcd\x00\x14\xb6\xb4\x00\xa3\xc5V7]K+\xac\x1e:\xe3\xca-5du)\xf5\xcc\x19\xaa\x98\xf6\xda\xea\xce,\xe00\x11\xf7\xa6s#\xe6;->\x052o\x02\x990\xd3H\xe7\xff15\xfb\x93t>\xac0Mg\xb1\x19\x83\b\x93N\xaauנ\x1dM\xcf\x00\\\xa5\r-\xe0\x1d\xb6\xe4\fV$f\x00\x89\xc5\x00\xab\x00\x14\"\b\r\x9b;+\x95'{\xc3\x14\xb2\xb0\n\x10\xe4*+\r/\t\xe8!\x02\x84\x88\x10\x9cG\xdf9p]\xb5\x01t\xf0\x8e\x1e\xe7\xb7\xea\xce\xea\xb5%\x17\xe1\x01\xfc洺C\xbfY@\x19\x97\x97f\x83\x8e\xd2,\x8bh\x01\xcb0\x91\x86\xfc\x8eA;o\xa5ZO\xc1\xb8\x97-\xc1\xe3\x86\x14\xf8\x8dt\x10o\x04\x1e\xd11\x1c\xebI\x9c<8\xcc\xf3v\xe7\xb15iYDpc\t\x0f[#\x04\x81\x9e\xa6\x00\xec\xe5\t\xba\x06\xbf!\x96|P,\x94J\xaau\x18\x8a\xda\x02^Ê\x02D\x12Й\td\x86\xaa\xd2hQ\xaaL4\xad\xe1\xe7\xdeQO\x94\r\xaf\xffo\xa3J\xd3\xfcgЁ\x17@yֹqq\x9a\x8c\xa7~\xea\x0f]:\xf8~C\x01\\>\xbc3\x8dFA\x96\x8fߠ\x12\r\x01\xbb\a\xf0\x16\x95\xabɞ\x80\x91\xb7\xdd\xef\xcc\x10\xcc\xc7L\xaf7\xf3\x1ca$\xdbYzmqM𓮂\x83b\x95\xb64\xd0i\xb7\xd1]#`\x95O\x01p^\xdbI\x05\xe7\v\x8b\xbb\x12\xddL\xf6\xc8Άg\x9eFߣ\x9d\xfdiY\xb1\x8dH\xad\xa6-\xe8\x875M[O\x9c\xde~\x1f\x1e\\\xb5\xa16\xb8f~҆\xd4\x0fw\xb7\x9f\xfe\x7f9\x18\x060V\x1b\xb2^f\xf7\x19\xbf\xbd\xe0\xd0\x1b\x85\xa1\xa8\xaf\x99`\\\x05\x82\xa3\x02\xb9\xa8\x83q\x8cD\xc2\x10\xafC:\xb0d,9R\xbe/\x92\xfc\xd55\xa0\x02\xbd\xfa\x8d*_\u0092,\xfb\xcf|1\x95V[\xb2\x1e,Uz\xad\xe4\xbf\xf6\xb4\x1d\xeb\x1a\x1fڠ\xa7\xe4\xc5\x0f\xdf\xe0h\x156\xb0Ŧ\xa3W\x80J@\x8b;\xb0ħ@\xa7z\xf4\xc2\x12W\xc2\xcf\xda\x12HU\xeb\x05l\xbc7n1\x9f\xaf\xa5\xcfA\xb1\xd2m\xdb)\xe9ws6x+W\x9d\xd7\xd6\xcd\x05m\xa9\x99;\xb9.\xd0V\x1b\xe9\xa9\xf2\x9d\xa59\x1aY\x04\xe8\x8a\x19ve+\xbe\xb1)\x8c\xba\xeb\x01֑b\xc4_\bfgn\x80\xc3\x19H\a\x98\xb6FF\x0f\x82\xce\xee\xe8\xc3_\x97\xf7\x90\x8f\x0e\x9a? \nI\ue1cd\xeep\x05,0\xa9j6k\xb6\x98\xda\xea6\\3)a\xb4T><T\x8d$u,~\u05edZ\xe9\xf9\xde\xffّ\xf3|W%܄L\x81\xddbgXsE\t\xb7\nn\xb0\xa5\xe6\x06\x1d}\xf1\v`I\xbb\x82\x05\xfb\xb4+\xe8'9\x87\x0fSY$\xa9\xf5&r\x8ar⾎\U0008e961\x8ao\x8f\x05\xc8;e-\x93\x87\xaa\xb5\x05<NS\xca\x01\xe1i\xc3\xe5\xef\xa4w:^t\x84\xec\xcdԞ\x8cM\xf5|jv\x98\xd1\xf7\x8d\x88\x024ys\xf6\xb2\xfb=\x96\x8cv\xd2k\xbbc\xc2\xd1\xc1\x0ey:s\r\xfc\xabPU\xd4\\\xe0\xe4&,\x02\xa9\x04\v\x93\xf6\xdaǎ\"\x12\b\n\xab\xd5Z\xb3u\x9c\x95q\xfc\xddz\xa8P\xb1\xc6:\xf29\x1f\xa2\xe3\x9d̓T\x9c\x99\x81\xb6pHΠ\x9f\x84\x1d>\x91͕\xd6\r\xe1\xb1+TZ\xd0\x05.\xdfiAS\xd7\xc3[\xc1o\xd0g\x88\xbc\xc8vJ\x8d\xa5\xc9?\xad\x9eu\x01F\x8b\v\xb8҉\b\x96j\xb2\xa4\xd8\xdb\xe8\x8bI҈&\fҗ1\xc6\xd3\xca\x7f.zM\"\xfe\xe1\xee6G\xac,ĄݏϽ \x1f\xfeՒ\x1a\x11\x02\xfa峯o\xeb((\xa6łB0\x92*\x1a\x04C\x90\xcayB\x01\xba\x9e\xa4ȵ\x17\xb0\x83\xb3\x94v\xbc\x8a\x9e:\x85\x84C\b\xf5(\x15 \xc7\b)\xe0\xef\xcb\xf7\xef\xe6\x7f\x9b\x12\xfd\x9e\v\xc0\xaa\"Ǆ\xd0SKʿ\xda\x17 \x82\x9c\xb4$\xb8\x9c\xa0\xb2E%kr\xbeLg\x90u\xbf\xbc\xfeuZz\x00?j\v\xf4\x19[\xd3\xd0+\x90Q\xe2\xfb𓕆U\x9bű\xa7\b\x8f\xd2o\xa4\x9aM\x92\x04\xe4\xca \xb1\xfd\x18\xd8\xf5\xf8@\xa0\x13\xbb\x1dA#\x1fh\x01W\xecf{0\x7fg\xdb\xf9\xe3\xea\x04\xd5\xff\x8b.\xec\x8a\x17]Ep\xfb|\xa3ot\a\x90\xd1\xf2\xac\\\xaf\xe9\x90=\x1e\x7fx\vmI\xf9o\xd9S\xc8\x1a\x94\xee\x91\b\x84\xd9?ƀ@b\x04\xfa\x97\u05ff\x9eD|\xa0\xc3\xf2b/H\x9f\xe15\xc8T\xc2\x19-\xbe-\xe1>h\xc7Ny\xfc\xcc\xee\xa1\xdahG\xa7$\xabU\xb3c\x9e7\xb8%p\x9a\vBj\x9a\"\xe6{\x02\x1eq\xc7R\xc8\x17\xc7j\x8c`\xd0\xfa\xb3ښ\xb3\xbc\xfb\xf7o\xdf/\"2V\xa8\xb5b8\x9c\x1dԒ\xb36N\xd7\xc2d\xd4F\xe9NPt]\xa0\xc70\xab\r\xaa5\xe7o\xe1\x92\xea\x8eӰ\xf2z6\xb1\xe9\x92\x1d\x8fS\xafi\x13\x0e)ر\xe3\xf8\x9f%1Od\x8e\x95\xec)\xcc\xf5\xab\xa9\xb3\xccq{\xc7*\xf2\x14\xf8\x13\xbar\xccZEƻ\xb9ޒ\xddJz\x9c?j\xfb պ`\xd5,\xa2\x0e\xb89Cq\xf3o\xc2?/\xe6%T\xeeOeh\xd0Q\xf8\x92\\\xf19n\xfe\"\xa6r\xae\xfe\xf48v\xbdL\x19\xe4\xf1^6\x8bǍ\xac6\xb9\bK>v\x92$\xb0\x05\xb6(\xa2kF\xb5\xfb\xe2\xaa\xcc\x02\xed,#\xda\x15\xa9gX\xa0\x12\xfc\xb7\x93\xce\xf3\xf8\x8b$\xd8\xc9'\x99\xef\xc7۷_G\xc1;\xf9\"[=Qh\xc4\xdf\xe7\xe2\x00\xabh\xd1\x14q5z\xdd\xca\xeah5g߷\x82\x05_K\xb2\x8b\xd9Y\xb1|\x18,Ή\xe6D\x1e\xbf_SΞ\xc1\x96\xc7\xf5D\xe2\xd6o\x91\x9eK\xef\xce\xcak\xc0\xc6=\xae\x1d\xa0%@h\xd1\xf0=?Ю\x88\t\x81Ai\x99-\xf4\xb9ɰ\"@c\x1a9\x19\xb8\xbd\ue9ecI\x12\xe8\x02+\xe5sn-w\xbb\x96\xe4\xbdT_G\x0e\x1f\x8f\xce|\xb2L&N=H)\xa7B\x99#Nbj\xb9\xeel\xa8\xff\xc6BQ]\xd3ડ\x05x\xdb\xd1Kd\xc6}\xc0\xc5\xd3X\xe5\xa5Yo/\xf4(\xfdf\f\x06\x86\x9d\xcb13\xa4\xbav\f\xa5\x80\am$N\x8c[r~d\x93\xbc\xe1\xeaj\xf6\x8c\x8b\x8d-\xdb\v2H\xaf\x0e\xa4\x1be\xaaI}\xd9?\xa5\x14\x89\v\xb6Х\x1e\x91\x84s\x05\xd8I\x88\\mse0\x84X\xc0j\xaa\xc1p\xb4\x86\x8bף!\xa3\xc5\xd1\xc8Џ\x1dM\x0e:\xdagՊk\x9a\xeeȬ\x06B<*\xef\xb9\xd2\xe9\\֨\x18\xb1|~-\xc3\xe5\xdaK\xbb5\x95\xe6Jh\xd0\xed\xbdp\xbd7\xe3\x1d\xa11jERw~m\x83\xd9G\xf1\xeb\x9at\xc6T\xbb\x05z\xe4\xe2Nn\x18\x04j$B\x99\xc2UT\x8d\xb2!\x91H\xba\xf2x\xcf\x04\xd5>\x95\x15՜\x0eG\xd3\xcb\xc5\x7f\x82\xb7/\x05\xb8\a\x16:\x8e\xd7\xee\f\xcdΑ\bݱ\t!\x8c˃Z\xdb\x16}\xec\x90\x17\x93D\x9f\xe4\x93&-\xb1%\xe7p}\xc9\x14\x7f\x8e\xabXo0o\x01\\\xe9\xce\xef\x9b\"\x83\x90r\xed\x92N\x95\xcf\xc1b&\xdb\r\x03 ܑ\xc8\xda[wM\x13\xf6\xa4\xa2z_\xc4\xc6\xf7\xb5\\KÊ\xc6Ǽ\xd4'@\xec\x81]B\xc8k\xa6\fl\xef\xbd\xceZ\xd89\xa7\xfc\x8e\x1e'FG/P\x0f\xdf\"\xeb\xd7D.P\xc0\x8f\xc1\x1a\x9e\xc5\x7f:\xe8\x92\b\xd22\xd8\xe8&\x1b\xb3\xf6\u0600\xea\xda\x15Y\x96\xc3j\xe7\xc9\r\xdd\xf9\x88&\xa4\xca\xf9 \xc6\xde\xfe|\x7f\x91Rj\x06\xa4\xfef\xb0.\xafAHg\x1a\xdcM\x106\x19!\u05f6l\\\xec\x02\x0e\xfa\x9c\x8d\xdaЩ$\xe0|\xe7.`z\xabՄ\xae\xf4\xedY*\xff\xe7?M\xae\x88F\xc2\xef}\xd6G\xc1!ͳ8\xdf\xec\xfc\xf4\xf1\xff\xf9\tg\x92\x18\xa7и\x8d\xf6\xb7o/h\xc1r\xbf0[\x83\xdc\xc7;\x06\x18\xae>SK\xaa0\xa2\b=\xdfR>GU\x87\xaf\xee/A\x1d,\xbe\x10\x85\xd2\x7f\x1a\x18\xa3\x01X\x92A˖\x1e\xde.\xdd\x1c\xbf\xfe|\x05NrW0d\xa61U\x8d\x8d\x1e\xc7\xc1\x89S+mi\xc2e\xc28\xac\f\x82\xc8\x10\xfe\u05cc\x1f\x93z2\x1a\f\xc8E\x8fvz\xed\xd2\x1f\xe9V\xb9\xdew\v\xf8\xfd\x8fٿ\a\x00X\x05\xd8\xf2\xdc#\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xc4Y_s\x1b\xb7\x11\x7f\xe7\xa7\xd8Q\x1e\xd4\xcc莱\xdb\xe9t\xf8f\xcbMGmbk,\xd9/\x99<,\x0f\xcb;Dw\x00\n\xe0H\xb3\x99|\xf7\xce\xe2\x00\xf2\xfe\x89\x94\xd4:\xe1i\xc6>\xfcY\xfc\xf6\x87\xdd\xc5b/˲\x05\x1a\xf9\x99\xac\x93Z\xad\x00\x8d\xa4/\x9e\x14\xbf\xb9\xfc\xe1o.\x97z\xb9}\xb5x\x90J\xac\xe0\xbau^7\x1f\xc9\xe9\xd6\x16\xf4\x8e6RI/\xb5Z4\xe4Q\xa0\xc7\xd5\x02\x00\x95\xd2\x1e\xb9\xd9\xf1+@\xa1\x95\xb7\xba\xae\xc9f%\xa9\xfc\xa1]Ӻ\x95\xb5 \x1b\x84\xa7\xa5\xb7\xdf\xe5\xaf^\xe7\xdf-\x00\x146\xb4\x02\xa3\xc5V\xd7mC\x96\x9cז\\\xbe\xa5\x9a\xacΥ^8C\x05\v/\xadn\xcd\n\x8e\x1d\xdd\xe4\xb8p\a\xfaV\x8b\xcfA\xce\xc7NN誥\xf3\xff\x9a\xed\xfeA:\x1f\x86\x98\xba\xb5X\xcf\xe0\b\xbdN\xaa\xb2\xad\xd1N\xfb\x17\x00\xaeІV\xf0\x1e\x1br\x06\v\x12\v\x80\xa8g\x80\x96\x01\n\x11\x98\xc3\xfa\xd6J\xe5\xc9^\xb3\x88\xc4X\x06\x82\\a\xa5\xe1!=9\xa07\xe0+\xe2%\x03\xab(\x95Teh\xea\xa8\x02\xafaM\x10\x91\xf0\xb2\xfc\xfcⴺE_\xad g\xe2r\xa3E\xae\x92\xcc8\x86\xdf{+\xc5V\xbfg=\x9c\xb7R\x95\x8f!\xfb?\x83\x8a\xdd\x1d\x9e[-\x9e\x88侢0&\xa1iM\xadQ\x90eF*T\xa2&`\x03\x05oQ\xb9\r\xd9GP\xa4i\xf7{CqH\x87\xe4S\x92\xd7\xeby\x0e;ϡ\xa2\x1b\x1b;\xbb\xe5?\xf7\x9bέ{\xabE\x9c\x00Ѩ\xc1y\xf4\xad\x03\xd7\x16\x15\xa0\x83\xf7\xb4[ި[\xabKK\xce\xcd\xc0\b\xc3sS\xa1\x1b\xe2\xb8\v\x1d_\x17\xc7F\xdb\x06\xfd\n\xa4\xf2\x7f\xfd\xcb\xe3\xd8\xe2\xa4\xdck\x8f\xf5۽'7@z?n\xeeXcg+\xc9\xfeqp\u05cc\xf4\x9dVC^ߎZ\xe7\xc0\xf6\x84\xa6x\x9b\x17\x96B\xa8\xbd\x97\r9\x8f\x8d\x19H}S\x0e\xe5\t\xf4]C\xb7\xe8\xf6UxqEEM\b\xdd\xfc\xa6\r\xa97\xb77\x9f\xff|7h\x060V\x1b\xb2^\xa6\xe8\xda=\xbdã\xd7\nCf/Y`7\n\x04\x9f\x1a\xe4\xba\xf8е\x91\x88\x18:g\x91\x0e,\x19K\x8eTw\x8e\f\x04\x03\x0fB\x05z\xfd\v\x15>\x87;\xb2\x1cZ\xc1U\xba\xadC\x04ڒ\xf5`\xa9Х\x92\xff9\xc8v\xec{\xbch\x8d\x9eb\x88?>̴UX\xc3\x16떮\x00\x95\x80\x06\xf7`\x89W\x81V\xf5\xe4\x85!.\x87\x1f٠\xa5\xda\xe8\x15T\xde\x1b\xb7Z.K\xe9ӡY\xe8\xa6i\x95\xf4\xfb%\aE+\u05ed\xd7\xd6-\x05m\xa9^:Yfh\x8bJz*|ki\x89Ff\x01\xbab\x85]ވol<f\xdd\xe5\x00\xeb\xc4麿p֝\xd8\x01>\xec@:\xc08\xb5S\xf4Ht\n\xd9\x1f\xff~w\x0fi\xe9\xb0\x19\x03\xa1\x10y?Nt\xc7-`¤\xdapЭ\xa4\x83\x8d\xd5M\xd8fR\xc2h\xa9|x)jIjL\xbfk\u05cd\xf4\xbc\xef\xffn\xc9yޫ\x1c\xaeC&\xc1GGk\xd8rE\x0e7\n\xae\xb1\xa1\xfa\x1a\x1d}\xf5\r`\xa6]\xc6\xc4>m\v\xfaI\xd0\xf1\xc7RV\x91\xb5^G\xca`\x1eٯqVrg\xa8\xe0\xedc\x06y\xaa\xdc\xc8\"\xf8\x06\x87\x1f\xc0I\x16\x93\x0fDϻ.?k,\x1eZs\xe7\xb5Œ~Н\xcc\xf1\xa0\x11\xb6\xb7ss\x128\xd5;\xf3:\xe1\xc0\x80\xf0\x10\x89\xfaO\x9d&\xef*\xb2ԟc\xc9h'\xbd\xb6{\x16\xcc\x12H\fu:\xb1\x11\xfc'UQ\xb7\x82\x04\aLwF\xa1\x9b\xfeX^\x0fC~\xc8j\x18n\xba\x02K5z\xb9\xa5\x14C\xac\xd6c\x13\x8e\x91\xe9x\xd6_\x8d\x0e\xfb<\xe4(\xdaWda#kri\xb8Sh\\\xa5=`LN\x87\x8f\"\x19\xe6XB\x01J۞\xc0\x9b\rPc\xfc\xfe*\x80\xdaU\xba>$\x1a\xd2\x1d\xc7M\x84JO\xcd\f)'\t\x05Pm]㺦\x15x\xdbN\x91vs\xd1Z\u070f\xfa\x8c\x16gv\x80\x8f\xde\xc0\xbb\xa5\rYRŁ\xe9SY\xe5D&\f\xf8\x9et?\xee\x06\xa7N\xb2Y\xc0ono\xd2镶1B\xf7S\xba\xcf2\v\xb0\x91T\a\xfb{\xc2ڗ7\x9bn1\x96\xc5<!\x18I\x05\r\x0eF\x90\xcay\xb6\x18\xbd\x99\x95\xc8\xf74\xe0`g)\xce`#\n\xbe\x16\xc4\x1e\x8fS\x8fR\x01\xf2y!\x05\xfc\xf3\xee\xc3\xfb\xe5?\xe6\x98?h\x01X\x14\xe4X\x10zjH\xf9\xabC\xfe$\xc8IK\x82\x93H\xca\x1bTrC\xce\xe7q\r\xb2\xee\xa7\xd7?ϳ\a\xf0\xbd\xb6@_\xb015]\x81\xec\x18?\x1cE\xc9f8\x061\x1d\a\x89\xb0\x93\xbe\x92j1+\x12\x90/RQ\xed]P\xd7\xe3\x03\x81\x8e\xea\xb6\x04\xb5|\xa0\x15\\p\xc4\xed\xc1\xfc\x95\x83\xdco\x17\x8fH\xfdS\x17\xcc.x\xd0E\a\xee\x90{\xf4\xa3\xe3\x11\xa4\xafЃ\xb7\xb2,\xe9x)\x18\xffx\nmI\xf9oA[f@鞈 \x98w\xaf;\x1bHL@\xff\xf4\xfa\xe7G\x11\x1f\xe50_ \x95\xa0/\xf0\x1a\xa4\xea\xb81Z|\xcbы\xe5\xef\x95\xc7/\x1c#\x8bJ;z\x8cY\xad\xea=\xeb\\\xe1\x96\xc0\xe9\x86`Gu\x9du\xb9\x9f\x80\x1d\ue645\xb4qlo\b\x06\xad?i\xad)\xe3\xbb\xff\xf0\xeeêC\xc6\x06U*\x86Ù\xc2Fr\x06ǩ[\xe8\xec\xacQ\xbaG$\xba6\xc8c\x98E\x85\xaa\xe4\\.lҦ\xe5\x94,\xbf\\\xccL:\xe7\xc7\xd34lޅC:6\x0e\x1c\x7fXB\xf3D\xe5\xd8Ȟ\xa2\\\xff\xde{R9.\x05YE\x9e\x82~B\x17\x8eU+\xc8x\xb7\xd4[\xb2[I\xbb\xe5N\xdb\a\xa9ʌM3\xebl\xc0-\x19\x8a[~\x13\xfey\xb1.\xa1\xd2\xf1T\x85\x06\x05\x98\xaf\xa9\x15\xaf\xe3\x96/R*\xe5\xedO?\xc7.\xefb29\x9e\xcbn\xb1\xabdQ\xa5\vY\x8c\xb1\xb3\"\x81=\xb0AхfT\xfb\xafn\xcaLhk\x19\xd1>\x8b\xf5\xc5\f\x95\xe0\xff;\xe9<\xb7\xbf\x88\xc1V>\xc9}?ݼ\xfb}\f\xbc\x95/\xf2\xd5G.\x1d\xddߗ\xec\b+k\xd0d1s\xf3\xba\x91\xc5h4\xe7\xe17\x82\x89\xdfH\xb2\xab\xc5IZ>\x0e\x06\xa7\x1b\xc1LF\x7f\x18\x93/\x9e\xa1Vʓoޝ\xc1qw\x18\x980\x1c\xb7+&\x8f\x87\x9c{\x94\xa3?\vO\xf0\x97Cl8\aj8:!\xd3V\x96\xe1\xd8:\xf8~\xb8\xd1)l\xb0_\x88\xed\xff\x1a4F\xaa\xf2Yܥ\xba\xe6\x1dy/U9\x93\x00\xf7+ҧ\xd2\xe4\x13\x8b\x8c4\xfe4Z\x93\xef7\x80Р\xe1\xcdx\xa0}\xd6%Y\x06\xa5e2\xd0\xc7\"\xce̪k\x024\xa6\x96$R*\x954\xe2$h#\xcbֆ\x9bd\xfe\xb2[ˬ\xa7\xa4\x15\xb8\xe2\xbbz\x9a\xaa<4\xed\xec\x99j\xb4\xaf\xe6\xf6vP\xa3\x9e*C\xaam\xa6P2x\xd0F\xe2L;\xdb\xf5ħy\xc2\xc5\xc5\xe2\x19\x1b\xdb9\xcd\x19\x0eb\xe9T\xbaI\xa6\x1b}\x8e\xe3[L\xb1\xf8\xbe\x17<o\"\x12^\xe2\x8b\\6\xe2\x8b\xc5\x10a\x06\xeb\xb9J\xc5h\x8c\xd1b\xd42\x8cy\xa3\xcec\x10\x1aw\f\xfd{\xd4;(韴<\xbe6\xb5#\xcf;]\x1a\n\x13\x92\xd5u\xa7\xa2O\x95k\xbd\xf9\x1f\x8aC\x85\xe6\xeb֠\xbc|\xc6\x06\xae\xa73B%֊\xe8\x13\xb2\xa1p\xcb\x0f8`\x87.-2\xb7\xdfГ\a^\xa6\xaaF\xa1\xad \x11.C|W۠\xacI$\x99\x8e/*\x04.\x94$/\xe7r\xff$\xa8u$B\xac\x9d\x01=\x9d\x97\xaa\xfc\\\x88\xccX\xc4\xcb\x02ͬ{5\xe4\x1c\x96\xe7\xfc\xeb\xc7n\x14C\xc74\x05p\xad[\x7f(\x94DG\x8bT\\\xbah\x05\xf9s\xc0\x84o>g\xa0\xdc\xf2\x989\x8b;\xb8\xfci\x93;\x15\xca\xde\xd3n\xa6u\xf2\xd5\xe5\xf8d\xc9Jf\xae\xce\x19|\x1f\xac\xe3Y\x04ą\xceq\x10\x87A\xa5\xebd\xdd\xfc\xc9\tT۬\xc92\x11\xe1SOb$\x05\x8e\x89T\x887\xd6#\x93G\tq'E'*\xde\xc1\vT\x9c\xb3\x04\xfb\xf5\x1a\x84t\xa6\x9e\xd4\xdc\xfa\x9a\x84\xa4\x94͗K\xadG\x8b\x89\u0081O\xfbG\x0e\xcf\xd3\x15\xb3ç\xac\xb9\xce\xf9\x0fc\xc3\xdf\xf4+\xd7\xf0w\xfc\xb4\xf7uV8q\xf8;\x8f\xd6\x1f\xe2\xc1\x19[\xb8\x1b\f>\x17\xf1\x82\xe8\xf9x\xd7\x0f]\xd3@5\\\xe6\xf7\x8cQ\xb3DM\x1a\x03rѓ\x1d+\xff\xfd\x96v\x9d.\x9an\x05\xbf\xfe\xb6\xf8\xef\x00\xb4\"Z9\x81\"\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec=]s\xdc8r\xef\xf3+PN\xaa\x94Ti\xe8ۻ<\xa4\xf4\xe6x\xbdY\xe5vm\x95\xe4\xf3=cȞ\x19\x9c8\x00\x17\x00%OR\xf9\xef\xa9n\x00\xfc\x04Ip,\xdd\xee\xa6nF\x0f6\ah\x02ݍ\xfe\x02\xba\xb1\xddn7\xbc\x12_@\x1b\xa1\xe4\r㕀\xaf\x16$\xfe\xcfd\x8f\xffn2\xa1\xde>}\xb7y\x14\xb2\xb8a\xefkc\xd5\xe9\x1e\x8c\xaau\x0e\xdf\xc3^Ha\x85\x92\x9b\x13X^p\xcbo6\x8cq)\x95\xe5\xf8\xd8\xe0\x7f\x19˕\xb4Z\x95%\xe8\xed\x01d\xf6X\xef`W\x8b\xb2\x00M\xc0ë\x9f\xfe\x90}\xf7\xc7\xec\x0f\x1b\xc6$?\xc1\r\xd3`\xac\xd2`\xb2'(A\xabL\xa8\x8d\xa9 G\x98\a\xad\xeaꆵ?\xb8>\xfe}n\xac\xf7\xae;=)\x85\xb1\x7f\xee>\xfdI\x18K\xbfTe\xadyپ\x8c\x1e\x1a!\x0fu\xc9u\xf3xØ\xc9U\x057\xec#?\x81\xa9x\x0eņ1?tz\xed֏\xfa\xe9;\a\"?\u0089Ё\xffS\x15\xc8ww\xb7_\xfe\xf4\xd0{\xccX\x01&עBd5cc\xc20ξ\xd0\xdcp\x00\x84kf\x8f\xdc2\r\x95\x06\x03\xd2\x1af\x8f\xc0xU\x95\"'T7\x10\x19S\xfb\xa6\x97a{\xadN-\xb4\x1d\xcf\x1f\xeb\x8aY\xc58\xb3\\\x1f\xc0\xb2?\xd7;\xd0\x12,\x18\x96\x97\xb5\xb1\xa0\xb3\x06V\xa5U\x05ڊ\x80X\xf7\xed\xb0K\xe7\xe9`.W8]\u05ca\x15\xc8'\xe0\x86\xecQ\x06\x85\xc7\x10\x8e\xd6\x1e\x85i\xa76\x9c\x8e\x9f\x12\x97L\xed\xfe\x06\xb9\xcd\xd8\x03h\x04\xc3\xccQ\xd5e\x81\xec\xf5\x04\x1a\x91\x93\xab\x83\x14\xff\xdd\xc068Q|i\xc9-xz\xb7_!-h\xc9K\xf6\xc4\xcb\x1a\xae\x19\x97\x05;\xf13Ӏoa\xb5\xec\xc0\xa3&&c?\x13y\xe4^ݰ\xa3\xb5\x95\xb9y\xfb\xf6 lX&\xb9:\x9dj)\xec\xf9-q\xbc\xd8\xd5Vi\xf3\xb6\x80'(\xdf\x1aq\xd8r\x9d\x1f\x85\x85\xdc\xd6\x1a\xde\xf2Jli\xe8\x12'l\xb2S\xf1O\rٮzc\xb5g\xe4<c\xb5\x90\x87\xce\x0f\xc4\xe63\x14@\x86w\xbc人\x89\xb6\x88\x16\xf2@$\xb9\xff\xf0\xf0\xb9\xcbg\xc2\xf4\x802\x8f\xf7\xb6\xa3iI\x80\b\x13r\x0f\x9a\xfa9nC\x98 \x8bJ\ti\xe9\x05y)@\x0e\xd1o\xea\xddIX\xa4\xfb/5\x18dh\x95\xb1\xf7$;\xd8\x0eX]\x15\xdcB\x91\xb1[\xc9\xde\xf3\x13\x94﹁W'\x00b\xdal\x11\xb1i$芽\xf6\xe3\x1a;\xacu~\b\xc2k\x82^~\xf5?T\x90\xf7V\fv\x13{\xbf\xcc\xd9^\xe9\x9ep@a\xd6.\xd8\xe9E\x8b_\xb7\xfaQ\x82\r\x7f\x19\f\xe5?\x9a\x86\xc8?H\xc2Z\x8a_j \x11\xe7V,\x8cD\xca\b$\v\xe3#\xb6\xe8\x0fr\x06\xa7\xf8\x97s\x99C\xb90\xca\xf7Ԩ\xc3@\xc8j\xf4\xact\xb8\xf2\x03\rXb\x9f\x8f0\x82Ș\xb0p2\xec\xf9(\xf2#;\xf2'\x90W\x96\xed\x00d\x18}\xc1\xce`\x19\xd7\xc0̣\xa8*(\xae\t*\x92\x9d\x15\xeaY\x96\x8a\x17\xc3\x15\x83_\x94'UY\x1f\x84dH\x10\x1a\x92aB\xb2J\xab\x83\x06c\b\xa6\x9b)\x02\xc5\xf6\x9d\xe1F \x82,\b\x00\xb6r\x93/\xa1`\xd5\x11Wƨ\xb9\xac˒\xefJ\xb8aV\xd7\xe3y;\xe4\xef\x94*\x81\x0f\xa5-|\xcd˺\x80\xa2\xd1uf\x81\x12\x1fF\x1dP([.$J\x1fT\xbe\xc84\xb2\xfd\x15\x95\xd9\b$#\x84\xe0\xfa\x17\xd2\xc1\v\x93\xf5(\x19O\x92h7\x1e\xdc,o%\xa2\x86k\xcd\xcf\x13\x88\t\x06P*^\x9a\xf6^\x1c\x97\"\x87\xae\x9a\xa6u\x85\f̉\xd1F@\xd9o\x1c+\xc2X!\x0fa\x96w\xaa\x14\xf9y\x115\xb1NA\u0601\xe9ΐ\xed\xe0ȟ\x84\xd2#\x90\x8c\xe4!\"\xa3cƴ\xaaL\xb1]\x03\xa4\xb8l\xc2Qd\xc5g\xfc\xe9\t\xb4\x16E\x8c+xQ\x90\x9d\xcc˻I\xe9<B\x91\x83\xfa\xf9\\\x01;BY\x19\x8f\x9c3\xa1&\x8e\xbf\xb54O I3+\xa6\x9a\x7f%\xbf\x1c\xa9\x13\xf4W\xc3\xed\x86$1{\x84\xb3\x13\x81\r\xbdh\x15\\\a\xf66\xfc4&\n\x11\xfcĸa\xb7~5\x841\x98k\x06\xd9!co\n\xa8Ju>\xa1\x91\x9c\xf1\xaa2o\x98\xd2썁\\\x835o\xb2\xcb\xd8`\xa4\xcc\xf1\xef\xa8ԣ\xb9\x99G\xea\x8fئ5\x9dXN\x1eT\xc3\xd1~\xd1{Kv\a\f\xbeB^\xdb\b\xb72V\xd4Ȋ8\x9bJ\x19;\xbd\xfc\xa7\r\x00\xaf\x93\xa7d\u05ec옲W\x02\xfeq\xa2=\xdbEI\xc0\xb1\x9e\xd0dn\xdbjU\xbb\xb61\x95\xe91\x1e\xc7\b\xdbq\x03\x05S^\xf8\xd5%\x18\xff\xae\x02\x99\xa2\xa3^\xae'A7\x93w\xea\xb6\xe4;(\x99\x81\x12r\xab:~\xcf\x1a|\xa6\xab\xcc\t<F\x94g_\n\xb6\x13\x9b\x01\xc9P\xda9#\x86,q\xe4M\x12\x18\xacP`H\x7f\xa0\xb7x\x9e\x9a\xe4\"\xed\x13\xa4I\xf2\x9aJ\xd1*c\xdc6+}5j\x9b\x9e\x03\xcc6\xec\x107_\xdb\xcf\xffO\xc4\n9\xe4\xbcd\xccގ\xba\xbe,\xd3\"J\x05\x98\x8c\xdd\xee\x19\x9c*{\xbef\u0086\xa7K\x10yYv\xde\xff;&\xccz\x8e\xbf\x95\xaf\xc9\xf1\xb3TY\x82\x88Ti^\xff;$\n)\x8b\a\xaf+\x92\t\xf2S\xb7\xd75\x13\xfb\x86 \xc55ۋ҂\x1eP\xe6\x9b\xd6\xcbK #E\xdf\xe1\xf7\xc4m~\xfc\xf0\x15#\x92M\x14\x94\xb1D\xbc\f;3\xd1u\x15\xfb\x8ay\x01.\xda4\xbf\xd4B\x83\xb3\xf9ȸ\xec>!#\xf3\xdd\xc7\uf858\xe3\xbaD\xce\x1bM\xe4\xdd`\xb0\xdd\xc1xw/u\x1a\xde\xf4i\\g\x8aיk\xc6\xd1Vv\x16\v\x0f\xc1\x04\xa5\xa7\x9c\xe8\xe1G\x03\x85?I*?\u0099\xc0\xf8x\xe6b\xefTV\xf0\x01I\x88x}\x8b\b\xc41\xf9(\x93\xc3$> D\xe0\x88\x93y\xc0\v\x99F\x16-\xd1z\x95 \t߀\xfb\v\xa6ِ\xad\r\xa3:\xc2^a\f\xd4E\xac\xccQTI\x90Iq\"g\xd1j\t\xd1\xe9/\xbc\x14E3F\xe7\\\xdd\xca\xebM\x12@\xf6Q\xd9[y\xed\xbc@C\\\xf2\xbd\x02\xf3QYz\xf2*\xe8t\x03\xbf\x00\x99\xae#-/\xe9\xc46\xe2\xa1\x1b\xe6N`n\xf7w\xbb'\xd6k\xc8#еD\xc7\xc5\xe3\x03\x7f\xf4\xaf\x9b\xd7\x0f\xfdϩ6\x18FdR\xc9-\xa9\xca,\xf6&B\xad\xd9$\xc0\xc3M\x10ݣ\xc8xh\xcdK\xdd\v\x13\xc1~F\x1dOSC|j\xa8J\xdc\xdd\n\xde&m\x1ep\v\a\x91\xb3\x13\xe8\x03l\x16\x01\xd2_\x85\xf2=m\b\x89R\xf7\"\x0eKS\xed\xe1\xe3E\xf7`W%\xf6\xdd\xe2\xcaMh\x15\x88\xbd\xd8t&\xccp\xe9\x8cHŒ\xfd\xb1\x88\xdd\xd4\xf8\xd4Ŵ\xe8\xad\xde\xce\xc0\x90\xe58;\xf1\n\xd7\xef\xff\xa0\x9a#\x86\xfe_Vq\xa1\x13\xd6\xf0;ګ-\xa1\xd7\xd7\a\x90\xba\xaf\xc17\bÐ\xbeO\xbc\x1c\xefF\x8d?(`%\x83\x92l\b\x1c\xdd\xd0b\xb9f\xcfGe\x00\x19\x81\xed\x05\x94\xc5f\x01\"\xce\xf5\xcd#\x9c\xdf\\\x8f\xe4\xc0\x9b[\xf9\xa6\xdd\x01X%n\x1akA\xc9\xf2\xcc\xdeP\xdf7\xdfb\x04%rbb\xb3\xaf\xdb\xc7&2\xbb=\xf1j\xeb\xb9ת\x93\xc8'\xfb\xc9\xe8\x1e\xd5\x04;u\xf7\xa9\xda\r*o\x1eg\x9bo\xe4_\x8c\xb5\xfd\x18\x0f\xf4M\x8c\xe7.\xf4\xe8۴\x91x٢o\xecc_\x8d0\x96\x05\xe3{\v\xda\a\xff\xe8Y\xe39d\x9bo\x92\xb1\xbd9D\x06\xdb\x04\xf6x\b=\x12\x82ga2\xbf_\x992\xc45\xd6&\xe2e\xa9\xcd`F\x1f\xbevb\x93\\R\xa0\xb57\x91\x97\xb6\x86q3\x9a\x0fw蓆\xfa\xde\xf5\f<\xed\x01\x91x\xe0\xfaP\xa3@J\xb5\x19:<\x84\x9b\xb0\xecYأ\x90\x8c\x87\xfd9О\xa18\xabԲ\x04\xf3qon\xfa;\xa4\xbf\x05=\x7f\x12\xf2\x96\f\t\xf6]R\xfbT-ړ\xb2p\x89\xe5\xff\xbeAuC\xd0\xe6\x01i\xaa$\x90\f\tĞ\x8f\xa0\xa1\xc7\x15\xe3@9Z\x9a\x89 1zىG \xdcJ\x15W\x86\xed\x856\x8d'J#O\x84X\x9bTvXIa\x9c\xddgq\x02U\xdb\vh\xf0\xa1\xed\xdd\b\x01\x9c\xed\x89\x7f\x15\xa7\xfa\xc4\xf8I\xd5Ҧ\x1a\xe2{fũ9\x01\xe1)\xf0̅m\xb6#Q2\xa2\x8f\x96\xabSU\x82M\xb5\x9aw\xb0\xc7\xed\x92\\I#\n\xd0\xe1\x84\x0eνFfb\x9c\xed\xb9(\xebض\xcf\v\xe0X\xc9\x0fZ_\xe4\xdd~r=\x1bfB\xe5\xfb\xdcGP\x12PD\x01\x1e\xc2\xc0@\x99\xb0\fd\x8et\xc1\x18\x19\x8alz\x85G\x86<Ď*M}\xd2\x04<~A֧4\x04lie\v9\x1bLk\xbf[\xf6\x03\x17\xe5k\x90\r9\xef\a\xa5\xef\x81\x17\x97\x04`\xfe\xda\xe9\xce@\x9aZ\x83i\xc4˳(\xd3ƌ\x94c%\xafe~\x04\x92S\xb2'>\x98\x03/\xa4\xb1\xc0SyA\xed\xd9}-\xa5\x90\x874\xda%\x878\xd3\xce\xc1\xc4>\x88k/H.D\xf5\xdfS\f5\x14H\x04\xe9NL8RyYĭ\xc5p\x02\x89\"\xc5t-\xbb\xda'{yv^\xe3\x83\xfbQ,\xb6L\xf4U\xf0\x0f\x8fu\xdelV\x11\xf5\xc7ϟ\xef\x1ajr\xe9\xfe\xff\xba\x96\xa5\xa7\xea\x05\x1c\xf8\xb2\xc6\b\xeeD\x84\xa0\x93v+\xf5\x1a\xe3T\x9a8H\xec{\xb2%\x11\xb20\x18\u05fc\x0e\xfcg\xbd#\vƢ\x18\xc1C\x14h\xe0\fL\x97D\xd8s\x06\xcek\x9a.\x15\xe4\x16\x8a\a\xcbmmޫ\xe8\x11\xa1E\xca}\x18C!\xa7\xdeo\x1eUJ\x9aT\xe2\x19\x1a\b\xcbq$\x81\x8a\xc0ek\xb9\x98:\xcf\x01\x8aT|\xb01A\x18\x97g\xf6ǯ_\xbb\xef\xa2\x1d\xf3W\xf0\x15\xf0H\x10\xb77LH\xfb\xa7?&\xf6q$\xc43\xe0\aЯ\xe00\x1c\x81\x17\xa0\xcd\x03\x1d;\xba\x80\xda?v\xfb\x0f\xa3\x1b\x18\xf9\xc7\xe7I`\x99_؞\xf1\x9b\x8d\xf16|e:{B\x89 \x91\xf1p)\xe2I\xac\xce\n\xbd2a⯲\x90\x844\x90\xd7\x1a\x1e\x1eE\xf5\xf9\xa7\x87/\xa0\xc5\xfe\x12\x8b\xe76\x06\x87\x15\u00a0\xf1`VH\xc1'\xd0\xed\xd1l\x7f\xdc\xd8Pv\u0095a9Jt:\xb8\r\xe8\x17$\x82D\xed\xf1\x10\xf0i\xb2W1bN`\x8f\xea\x92\xc8\xc4\xcf\xd41\xb0#\x0e\xd5\xc3\xf2\x93O\x82\xc8\xc2\xec2\xf6=\xecy]\xd2\xe1\x7fv\xf7\xe9\xe1\xf3?\xbc\x9a\x7fx5\xc1\xab\xa9\xb8=^@\xb3;n\x8f\x81A\x11DX\x96\x9e\xe7\x98I\t\xfe\xfb\x01\xab 7\x9d?\xf3\x97\xfb\x9f\x10rOѵ<\x9c\x0e\xf4\xcd\xdb\xc81ԗ\xc0\x98җ\xc4F\xee\x94n4L\x85\xff\xf6\x18C\x13\xaf\x83\xb95\xe6\x1b&\xfe\xa8\x19\xa4m^G\xad\xafUꔂ\x067\t-\a(\xa34\xbef\xd3\xc1\x81!\xfb\x11\xa7\xad\x81\xe7\xc7u\x06\xe9\xcb\xf2\x17\xfa0//\x16\x10ꊦ\xe658\xdc^\xecy\xff=\xbd\xee\x95\xd6\xf8\xaf\x1c\xf4\xabu$\xd1j\x11\x9f\x9eWq\xba\xf8ψ\x97\x96\x04\x13\x85lĝ\x8b\xc1[:_8ZTW\x86\xdd\xde\xe1yq\x12ph\xe2\xa2n\xc8~/\x11\xb8\x0e\n\x92 2\x8aա'N\xd8\"\x892p\xf0\xff\x11\x85\xfb\xbdF\xe1\f\xc8\"\b\x06\xcf\x14\xaf\xc0\xc8+\xe2d\x98\xf8\x7f\xb3Y\x85\xf6[)Z|sI ^u\a\x16_\xd0Ļ\xcc\x05\x8cr\xdb\x03\x80\x82(l\xe6#薮+t\xf3\x0e\x18/\n(POӞk\xd8\xdbw\xb9\xd0\x13)=\xdf\x1c\"YA\xd9\xe8\xc9\r:\xb2\xa8\x9f`[\xcbG\xa9\x9e\xe5\x96N\xbc\x98\xd5K<5|\xf2¯\xff}\xd8\r}~M\x84\xdb\xd9d\xfc5%Bb\xc3e.X\x8a\xff\xbb:\x1b\x9b\vG1\xf7\xfe\x99\xce>!\xe3\xbd+\x90\x11N\xc5DV\xdf@|D{5v\x0ef\x9d\x83=\x82\x0e\x957\xb6Td$\xa6\x97\xc3\x01\x9a\xa6\xe8\xc5\x0e\x9a,\x11t\x94\x1a\xebх\xa2|\xc4/ȓ\xf8\x81\x00\xdc-\xbbfE'\x04\x83\xab)۬\xd4\xe7s\xba[\x8c҄n6k\xf3\x8a\xfa)\xd3m\xf8\xd2\xe7L\xab\xf0\x92\x11\xe0P\xb8\xc2\x15A\xe9&\xad\xf4\x13\x84(\x8a\x1eF\x9am\x92\xe5\xec\xecBJBZ\x8c\x0f\xc3@V2Yr\x8e\xf9\x1c\xbe\xc6l\xd3\xc5X˃\xbe\x9d/\xfd\xf0\xdbB\x9f\x85ӧʯ\x03/\xbc\x970\x18\xe9\xd2Y\xa3(\x99\xad\xe8\xf8\xf7h|\x8e \xba\x93n\xfe\xd8ܭ\x85ӻ\x1c\xc1\xf9S\x9ex^\x94\x8ed\xfa\xd5\xe6K\xb1\b\xc3\xfe\x8d\x1dU\x1dI=\x9d\xc1\xceB\"\xd2t\xfa\x91\xe3\f\xacY\xf2\xf4]\xd6\xff\xc5*\x9f\x8cD'\xc4F01\x03\xb29\xefE֊,ē(j^\xf6\x16Y\x87-Z\xee\xc1\rA)\xcaX\x1e\x02/\xdb\xfe=6b\x9fh\x02\xbc\xccֲƼ\x898<\xc4\x1bk3@\xe1\x9aL\xa5ޑ\xdbl3u\xe0~\xdd\xd1\xdc\xc9\x15\xf4\r\xb9H\xf3\xc9Ck2\x90\x86\xf9E\x93@\x97\xf3\x8eR\xac\xfb\x85\x1c\xa3\x1e:\xd22\x8bB\xce\xd0\fT\xb6\x90O4+\xca\xc27`-y\xf8\xa9\x19C\x8b\x89\x97\x89yB\xfd\f\xa0y\x90+\xb2\x83\x92\x90\xb3\x9c\t\xd4CMJ\xfe\x8fϷ٤\xe4s-f\xfdD\xf2y6+\xb3\x8a|b\xd5L\x16\xcf,\xc4X\x86Oz\xee\xce,h\xca\xebY\xceؙ\x95C+h=\xa7\xbe\xc3g\xd9\v\x98\x165\x8bY7\xdf\xe4%$\xe4լɦY\xc4X\x8f\xef\xd33g\x9a̘\x89\xf7\xae͗\xe9\xe7\xc3L\x00Mɒ\x99Ȃ\x99\x808\x9b\x1b\x93\x9a\xfb2\x01{A\xed\xcer\xc9쏽\xd0\xc5B\xceK\xe3\x86\xfc̫J\xc8\xc3\xcd\xe6Rn\x9a\xe5\xa4\x1e\x17}\x1c\xbc\xb3\xc7J]o\xa1\xe7g\xc5^\xe9JH\x8e\xdb\x06\x17\x02\xf7\xeeT\xc6\xde\xc9\xf3\b.m\tF`\x06\x13\xb0\xe5ʪ\tl{\xa8\x05\x81\xed\x82R\xfb\xb9\x92A\xd80[CB9@\xd0\x1d\x1e\x82\xd41kq\x16\xaf\xa1[\xdfb\xac\xc2ӓ\x03>\x82ɦi\x90\x8a\xf1\bL\\\x14ͫ\x91\xf5\xad\x16\x0e\xcbJ\x17\xa0\x9b\x05\xe6N\xfe\x93\xa0A\x1d\x82U|p\xf8d#EWJ3k\xf2\v\x878@\xe3\x16+\xea\xe1d\xb1\x86\xcf9l\xcc\x13\x0e\xb2M\xb2\x92I\xc14\x8a-\xbf%;\xe4\xb6\bD/\xc9q\x96<\x8ch\x1a\xc7\xd9f\xbd\xc1Zi؋\xaf\xf1\xdf\x063\xba\xa3\xa6\xc8)\x95\x86\n\xa4\x0f\x11\xe3\\\xa2\xe3\x89\rgQ\n\xe0\x9f\x86\x03\xa4\r\xe9\x1e[\xe2\x880\x7f\x8b\xea\xe12h\x04{\xf7\xf0'\xa1q\x02\xa2ۍ{>\xaarL\x14\xfaW7\xca\x00O\xa0\xcf\xed\xef\x93 \xe9\x85`\xbe\x01\ad(\xa1&K\xc4D\xd3>8\x14Q\xa2P\f\x80O@\x8c,\xeb\x86\xff\b\xd5\xdd\x12\xb4TQU\xb9\xe7WS\n\f\xebdVXK\xd6\xd5Cn*\xa0\xfd\xf3wo\xbaX\x8d\xad\x87I\x88\xd2'S\xce\x1d\x86]į\xa9\xf7\xa9|\xff@M\xbd\x88y5\xb6\x9fU\xd7\x17ǚ\x94\xee\x05Q\"B\xa07\xd5O\x83\xe6\xdd\xfd\xa4\xf9\xa0\xcc\b.\xee\xf5\xda\xe3\x85A\x99S]ZQE-\xc3J\xab'A48¹Q\xbb\x7fSB\xb6\xb2\xfb\xd3}c\xb4e\x83\xf8\x12\x8fq\xea3\x94%\x1e\x13\x1dM?w\xc5~s\xb5\xa5z\x8a\xa8=\x82\x12\xf3[\x9c\xd7d\xd8E`\xa2Vr:\xff\x84%_\xd1#B\x86\xbdP\x9b\x8c\xc2&ȍ\xfe\xd9/5\x8a$,}\xd8\xfa\xd1M 4\u038d\xa8i5\x98\xbal\xd3ƽU\xed\xd6\xf7 \x9cԚ\xa1\xec\x9dtk6\nv0F/\x03\xbb!\xb4\x8c\xbd#f\x9eh\x1a\x85*U\xd3\xfb\x02\x057\x9cL\xbc\xd5\x00\xdd/\x1eP[\x1fR\x9b\xe1\x8c\x14\xfe\xb80\xacvy`m\x06djI\x9f%R&\x85\xd7^/\xc0\xb6\x14b[\x14\xf1\xe1\x1bp\xb8b\x1a\xa9\x81\xb6͋\x95\xe4Y\x11j[\x17lKFSJ\xe9\x9d\x1e\x92^*\xe4\xf6\x8aA\xb7\xd7\b\xbb]\x16x[\x009(\xa9\xb3\x1cz[\x94W\xabh?gӴ\x9f\xa5\x10\xdcR\x11\x9c\x84\xe27\xb3fY\xdaH;\xeauj\xa0k\xc2qI8쭋\x97\vɽRP\xee5\xc2r\xaf\x1b\x98[\f\xcd-r\xce\xc2\xcfk\x02t\xdf\xe0\x1f\x84SK\x1fU\x01x\x065\xc2u=V\xba\x1b\xb6\x8f\x9c\x14\xe9DzTY0\x19\x9a\x8e 3g\xfb{\xbb\xff\xb2I\xc5\x0fuT\x1a\x9e\x04</O\x06[Ŧ\xd0\x1e1p\xfc\xa1\x01\xd3\x0e\xf04L\xd4x\x12\x96=ә\x97B!\xc3\xe3\xe9z\x12\x87\xd7\xe4\x04\xe1f}\xae\x81[_\x1b\x9b.\x0e\xc1\x7fsy\xb6\xc7\xf8\"\xf6kkt\xdf\xcd\v '\xf8\x06?\xab\x02S\x1d\xf4\x02\x96\xee\a\xcd;\xe8B\xab\x8a\x02\x01 ]-\xfb\xffz\xf8\xf4\xb1\x81\xbf\x99(\xb9\x06fX?ۇ\xee|\x8cП\xe0\xf0\xc7J\x9d\xbfEg\x86Vcaޠ\xe4\x95\xf8O\fJ\xc4~\x1b\xe0\xe0\xdd\xdd-5\r\xa6$\x053\x9aCqa\xccl\aH\xd5\x06#\x93\xa2\xe1v߃\x189X\xde\xfc\x97\xd1\x159A\xb5\v\xb9\x89\x02\xf4gxѣ\xb8\xbbu\xa1\x96\x8c\xfd\x80\xee\xae<3\xe5YZ\xe8b[qm\xcf\xc4\x1d\xe6\xba\x19\xc3\x04L\xb2\x1a\x9c\x82\xcd6\x17\xe8\xa1\xf1\xe5?Q܆;\x80p\n\b\xb1w\"h\x88\xd1K\xc61]\xa9k\xb1F\xd7\v\x8e#\xa0r<\x92-aj\x93x\x8a\xf0Ŷu\xbc|\xbb\xfb\xb2$\xf3\xfd\x89\xa1\xbb/\v\xc2\x1e\xdd\xfc\xb052\x82\xc8\x18\xf6'yo$\xaf\xccQٵ\xabyA\xa6\xe1\x18\\\xe2y\xda|\\\xdbޔ\xb0V@ \xb9a\xcf\x10D\x94\x87>\x02\xeb\"\xc7>}\x9c\xce\xfbR\xf4\nO\x121\xa9\xfe\xbeǆ\x12K\xd0_\\|ޡg3\x93ցb\xccc\xaa\x83\x97\xb8\xe8\x98\xf5\x15\x16\xd6\xf3\"\xa2\xe6M\x9e\xc4\x13\x8c\t\xa7\x18\xbf\x05Y\x11DM\x95,O)K\xfe\xab\xe2sF$ᝊh\xdf}\x92\x98\x83[\xeb\x88 \xee!\xf9\xea~\xd8!&s:\xd6\x19*)\xbc\xb71&q\xf0\xc5\xd8I\">\xe9f\xaa;\xae\xad\xe0ey\xc6\xd1\xe0M\x1d\x9a*\x1eAqC@\t\x8b\xceT\xa3`r\x04f\xf7\xdd\xe8\x02\x14\x80\xb5\xb3:wc9\x18\xfen8\fH\xe3\xa5\x00d\xc7\xe0\r\x80\xd1q\xba\xdd\x04\xa1\xc3̓!\xa3\xa6\xf3\xae\xecj\xb3\x92hs\xd2\x12\xb3A\x8b\xba\x84\x84{\xd6\x1e:M\x97oZ\v\x80G0YWQ4g\x9d\x03i=\xfa\xfaw\xba\xf9\xa5\xe0!O\x14yꂤ\x81\x9cܽ39\xc6=\xa9D\x881\xfb\xba\xf4>FCZ\xdf<\x9a\xc5\x1e\xe6\x90mV\xac\xa3\xba\xc2;\xd6@\xbfWr/\x0e\v8\xfdK\xaf\xf1@\xe6\xe6\xf4\xb0\xd6\xed]z]6X\xcb\x05\xf3:#\x88AL:\x9c\x90\x1eQ\x11H\xedGG\x06\x8e\xe6\xda\xc7\"\x9f\xc0\xef\x90EA2\xa6\x95jR\xb8\x9fTYӝJ\xfd\xab\xb0Z\x8ab\x02\x92kĦ\v)\xd1Uw'\xda\x03\t\xe6EO\xa0\xb6;\xbc\xfe}\xe1Z\xa7\x99\u243f\xb2\x8ez\xd6\xc2\xc2Cŵ\x81\x1fD\x99\xa4\xa2\xfe:\xe8\xe2H\xb4/9\x15\xc6½7\xaa\xf4\x11\xe4(\xbd!\n\x95\xe1Qk\xd2p\b\xab<\xa3\xa0\x94\xcaf\xdf6Ӹ0\x9a\xd1\x1fq\x9by\xebW\xf3ǡy<\x01\xc7D\x8c\xc2\x19\x83\xd0oX\xfb\xd5XkM\xa2\x84` \xcf\x0eo\xb6ܤ-7\x9f\x00\xe5\x8f\xef\x1b\xcbO\x11\xbf\xb37\xaa\xf7\xe3\x1et\x7f\xac.\xbc\xaf$N=\x15\xe1\x03b\xe3\x9bi\xf1\xfb\xccM\x93\x83Ud\x1dخL$jQ\x02\r\x05\x83'\x90\x98\x92\x8cU\x1c\xa1\xb1}c\xa4\xff\xdc-%\x13\xe0\xe0&'\x89\xad\a˵m\x86n6S\xb5\x13PQn\xb1\xf7f%c\xcd,A*Xb\x16\x10L\xe5 }D\x94\xaa\x9d\x10y\xcbҗ;9\x811\xfc\x10\x82\x15πg\x18@b\xb88\xaa\xc4}\\\xbdM\x89W\xfb.uܑ?\x9e[\xccG\xa0\x17` \x12XsZ,\x02\xd2_j\x8bM\xf8aR\x1f\xc5kI\xf8t\xfc{\xe0F\xc9\x05DxK˵\xf5\xdb'4D\x7f\xd9\a'\x9a\"\xab\xe1=\xb4\xad\xdc\x1cA%-\x8fo\xce\xd6\x10\v\xcby%9n?6\r\xdb譐\x8e\x8f\x10\xe3|\x87\x91\xb6֢\xf6$\x18\x01\xf5\xd7\xe2ek\x19n^\x99\x12\xccw\xae\x14a\xcc͏N\xa7\xed\x10\x8c+\xab,/\x99\xacO;\xd08\x01_\xdc\x10\n7\xe8(X\xc6\x1e\xc2\r\xbcey\xbe\x1eB\xee\xec\x19\xe2\x1bZ\xd8s\x10\x89\xf4^\x06tJ4\a3w\x00\xc4qJ(\xef;\x01\xb2\xb5Ǧ\xee\"[\xaa\x8eB\xef\xf2\x16{\"\x82\xbd\xa5?\x81]\x02\xe8=\x7f:\xda\x13\x85\xeaϲ\x84eq\xc1\xd0'U\x1cs\x97\xe3\xdelfgr\x87m\x02\x87tuRc\x80{\x1d\xb6I+\x9f\xb2e\x1f\xe19\xf2\xd4!\x8b\x92/\xe2\x9ad\xcbn坿\x1c8\xf2#\x96-\x10\xf2\xf0\x83\xd2wt\xadp\x93\xb3\xb6\xae\xf1\xc0M\x8b\xf4\xf5\n,\xfa\xdbr\xefi\xb0\xe1\xca\xe25\xf2+\\\x96\xbcDC\xdflIvy\xe1ze\xfcr\x8a+\xf4\xf0\xd2\f\xf7\xe9!\x9ch\x10}\xa0\x02\x8b\xa0\x1b\xbb\x85\xfd\x1e\xeb\x8b\xd0N\xc6v\x8b\x85\x8b\x9d\r\x13\x81\x8b+\x9e\x82\x0e\u0383E\xcf9\xec\x18\x87\x91\x91v\xc7ډ\x9a\x14\x06]{w\xe2X\a\x82\t\xc9\xf3\x1c}\x17xk,/\xe1\x85E,Y\xe4\x9e\xd3S\x04\xc0m\xb7}X>\xed\xe2'p\x0euT\xd0\xd9i\xe7\xe8i.\xfc\xeb߸m\x14\xdb\xf3KD\x01*I\xcb\xcb\xdbi\xef\xa27\x87\xcfM\xe3)\x19\xe6\xa7\xd1s\x9f\xe2\xe2\x15M6\xac\xde\xe40\x804ˏ\\\x1e\x90}\xb4\xaa\x0f\xc7\xc0\x82SF\xcc\x04Т\xc6A\xf9\x9bĽ\xbd\xa4\xc1\xd6Zv6\xb6\xfdY\xa1\xa2\x1d\xee\x1cЋ\xa5\xa9\a\xdaK\x98mU\xe1\xcdf\x16\xd7\xf7\xb3\x9d'\xf0?\x02\xc9::\x9b\x9b\xb3\xcc\xe7snq5aA\xbd\x80\x8fl\xb3\x06\x19\xd1\xf96\xd2\xf1\x92\xf96\x9d\xd3\xe7\xdbU쭛\xb1f\xf2\x11\xa0/\x87\x8e)\x83a\x19\x17\xf3\xc6\x03\xcdo\x04\x95\xa5\xcd8\f\xb5k|\x043#\x02\x93\xec\xf1\x95\xb8\xf0\xa1ԥ\x89\xfbf\x8bz)\xb4\x9b\xb4\xaa\xfd\x8c\xe8\nIa\xaf\xc2\r\x1c\x14\x9e%\x9f\xfd\x85Ձ\xe7\xb4y\x9fz4\xdf\xf7\xe3^\x13~\xb5\x9fp\x14\xe4Х\xde̕\a\x9cvq\x13p\xb0`}̹\xbb\xe9.o\x1b\xb9v\xec\x98\xe3ּ\xbc\x1aJ\xd7\xf0\xd9A\x8f\xac\x13\xbe\xad\x90\xfdj\xb3\xa5\x9a(\xcf5\xc7\xc5\t\xce\xecZ\x87\x16O\x87\x80D\t\x97@\xe6!\x82|\x06\xc3\x0e\xc8-\xee \xces\x7fA\xa1\x02\xcc'\n\x11\x95\xec\x12\x92Nx\x05\x17x\x06\xb3\U000dbbae8k\xe9/\x19\xe1i\x86x\x02\x1aL/\x88\x94\x80\x8f~\xd4i~a\xe3\x12\x8eB\xf4\xef\xfdu\x97\xf5\x8c\x91\xb3\x84\x95\xf5\x18\xf1\xba\xa8\xd1S#\x90N\xde\x05\xb4\xfc\x86C\x7fO\x8d\xf3\xfa!%\b\xd8\xfa\xba]\xd9ؔ\xa6A\xd9\xd8B\xf4\xc2m\x04\x91\xb1\x7f\x11{\x97\x80\x98#\xc9\xffu\x93\xbc\x970\xcb\x02IX\x88\xed\x1f<s\x8d\x17\x1d,M\xfe\xaf\xbeYD!x\b\x91(\xe8\b$k\xe3\xa2\xc1WL\x8a\x82\x86A2\x1e\x05ꥩ\x90~\r\\\x12\a\x8d\xae\xa1\xd1C\x8aa\x17\x1d$\xfb7\xdd0\xabk\xd8\xfc\xdf\x00\v\xee\xa8m\x80\x90\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xec}[s㸱\xf0\xbb~\x05\xca\xdf\xc3$)K\xb3\x93\xa4R)\xbfy=\xb3\x89\xbf\xcc\xc5g\xec\x9d\xd49u\x1e\x02\x91-\v1\tp\x01в6\x95\xff~\xaaq\xe1M\x00\tj콜#q\xabv,\x01;\xa1\xd1\xe8n6\x97\xcb\xe5\x82V\xec\vH\xc5\x04\xbf \xb4b\xf0\xa4\x81\xe3_j\xf5\xf0g\xb5b\xe2\xf5\xe3\x9b\xc5\x03\xe3\xf9\x05\xb9\xaa\x95\x16\xe5gP\xa2\x96\x19\xbc\x85\r\xe3L3\xc1\x17%h\x9aSM/\x16\x84P΅\xa6\xf8\xb5\xc2?\t\xc9\x04\xd7R\x14\x05\xc8\xe5=\xf0\xd5C\xbd\x86u͊\x1c\xa4\x01\xeeo\xfd\xf8\xcd\xea\xcd\xefW\xdf,\bᴄ\v\xa2\xb2-\xe4u\x01j\xf5\b\x05H\xb1bb\xa1*\xc8\x10\xe8\xbd\x14uuA\xda\x1f\xec$wC\x8b쭛o\xbe*\x98\xd2\x7f\xeb}\xfd\x9e)m~\xaa\x8aZҢs?\xf3\xadb\xfc\xbe.\xa8l\xbf_\x10\xa22Q\xc1\x05\xf9HKP\x15\xcd _\x10\xe2\xf07\xb7^\x12\x9a\xe7\x86#\xb4\xb8\x91\x8ck\x90W\xa2\xa8Kω%\xc9Ae\x92U8\xe4\x82\xdcj\xaakEĆ\xe8-t\xef\x83\xd7?\x95\xe07To/\xc8J\x99q\xabjK\x95\xff\x15\xa9\xf5\x00\xdcWz\x8f\xb8)-\x19\xbf\x0f\xdd\xed\x92\\I\xc1\t<U\x12\x14\xa2Lr#@~Ov[\xe0D\v\"knP\xf9\x96f\x0fu\x15@\xa4\x82l5\xc0\xd3a\xd2\xffr\n\x97\xbb-\x90\x82*M4+\x81PwC\xb2\xa3\xca\xe0\xb0\x11\x92\xe8-S\xd3<A =l-:\xef\x87_[\x84r\xaa\xc1\xa1\xd3\x01\xe5\x95w\x95I0z{\xc7JP\x9a\x96}\x98\x97\xf7\x90\x00\f5tU\xd1ZAޛ}\xd3\xfd\xca\x02X\vQ\x00\xe5\x8bv\xd0\xe3\x1b\xf3\aR]\x9a\xb5\x84\x7f\x89\n\xf8\xe5\xcd\xf5\x97?\xdc\xf6\xbe&}\x8ez\xb5&L\x11J\xbe\x98\x85A\xa4[\xa9Do\xa9&\x12P\xf2\xc05\x8e\xa8$,=w=Zx\tI*\x90L\xe4,\xf3R1\x93\xd5V\xd4ENր\x02Z5\x13*)*\x90\x9a\xf9\xa5g\xaf\x8eE\xe9|;\xc0\xf8\x15\x12eGYM\x04e\x94\xcf-(ȍ\xf4Kj\xd7\aS-\xfeFH=\xc0\x04\aQN\xc4\xfa\x9f\x90\xe9\x15\xb9\x05\x89`<֙\xe0\x8f \x91\x03\x99\xb8\xe7\xec\xc7\x06\xb6B\xadǛ\x16T\x83\xb3\a\xede\x160\xa7\x05y\xa4E\r\xe7\x84\xf2\x9c\x94tO$\xe0]H\xcd;\xf0\xcc\x10\xb5\"\x1f\x84\x04\xc2\xf8F\\\x90\xad֕\xbax\xfd\xfa\x9eioI3Q\x965gz\xff\xda\x18E\xb6\xae\xb5\x90\xeau\x0e\x8fP\xbcV\xec~Ie\xb6e\x1a2]KxM+\xb64\xa8s$X\xad\xca\xfc\xffy\x89\xaaW=\\\x0f֛\xfd\xcf\x18\xc2\x11\t\xa0E\xb4\nc\xa7ZB[F3~oD\xf2\xf9\xdd\xed]W\x99\x98\xb79\xfec\xf9\xdeNT\xad\b\x90a\x8co\xc0\xad\xe8\x8d\x14\xa5\x81\t<\xaf\x04\xe3\xda\xfc\x91\x15\f\xf8\x90\xfd\xaa^\x97L\xa3\xdc\x7f\xa8Ai\x94Պ\\\x99\xed\x05\xf5\xb0\xaep\x05\xe6+r\xcd\xc9\x15-\xa1\xb8\xa2\n^\\\x00\xc8i\xb5DƦ\x89\xa0\xbb3\xb6\x1f\x84r\xe1\xb8\xd6\xf9\xc1oo\x11y\xf95~[A\xd6[28\x8fmXf\x16\x86\xb1\x9e\x8d\t\x18XбU\x8b\u05fa\xa0ك\xa8\xf5\xdf\x19\xcf\xc5\xee\xe0\xe7\x01B\xdf\xf6G\x13*\x01\xd7X-\x8d2\x19\xdb.)\xbf\auNT\x9dm\tU$\xdb\xe2\x17\a`\t\xd9H\x80\x1fAY\x03D\x1fȺVH\x9f\"[QKuN\x18'\xbb-˶\x9d\rJ\x91\xbc\x06\xbc)\x7f5\xd4\x1d\xbcz\x86\xca_LC\x19 +\xc2\xe9>\x81v\xa9\x84\b$\x8c\a@\x92Q\x8c\xc3\xf8\x8d\t\xc7!ZK#\xe7\xf0\xaf\x03B\u07ba\xc1\x88\xfaV\xecH!ܒ\xde\x19\x91\x99MX\x85\xb0\x18Q\xe9\xf62\x1bc\n\x1a\xe81!\n(;\x9c\xe4}\x1e\x8b\xc59\xda\xe9\x1dG\x11\xe3\x97\x12\xa8\x12<\x02\x96\xf8\xa9\xea\x81U\x15䞱G\xd3P\x89\x82e\xfb$fޘ\xa1\xcd\xca\xdbᾸ\xa5U\x05\xbc\xd9G:b\x8e@$\x9eLK\xfb\x8a\\o\b\x94\x95ޟ\xe3\xb7{T\x0eO[\x8c&\xe0u\x19CxIn\x1fX\xb5\b\xfcb<\x95\xb7\xb0\x01y,\xab\x94\xa6R'q\xea\x16G\xa2\xc0\xe9\x94\xd3\xd90\"\x02\xd6\xdd\xf5X\xf9\xe2\xde\xc1$\fvAύܭ\x8e\xe0\x8f澁_\"\xb6ۭ\x88\xba(躀\v\xa2e@\x05\xec\\*%\xdd\x0f~\x13\x8f \vZ\xddD\xb4\xb1\xc7\xddOݱaul\x98\xeb-\xd9\x01D\x82\xe2A\xfb\xb9۲\xa2\xe3\x833M\x8c\x1f\f9\x0eP\x9a\x15\x05\xe1\xb0C\xbb\xcc8\x1a\xa7{<>\xa0\xda\x06@\xb6\x8a\x8c\xd6\r\x01\xfcPC\x1d\xd2\xe4\xb0\x0eG\xb4wI\xfe\x03\xc1\x04\xbe\xbf,\x8a\x80\xea\x8c(\x85u\xcd'\x18l\x9du\xbf\xad\x1aނނ\xec\x9dӐ:\v\ry\xc3š2\x1c\xba\xf9\xedG\x82\xb6^\xc5\x04*\x9f\xfd8o>\xff\xf2\xdd-\xf9ͽ\xa4<\xdfP\xc4i\xe9\xfe\xa7\x04\xffm\vu\x113t\xde|\xae\x9d\xa1\xf2\xb2^\xef\xad\x7f\xe6\xf5\x05\x05L\x14hc\x95H&ʪ\x00\ry\x00\xae\x87$6=\x853\x86,\a3\x8b\b\x9e\x01\xe1x\xc0,\x1a\xdb\xef\xf0\x91\xa0)\xe3C\x87\x12/\xbd\x85\x12\xf7}\xa5\x81\xe68\xcb+5\x93\xe4\xee\xee=\x9ed\x99\x84\x80e\x98X\x84\xe3\x1b\xec\x03@\xf5\x96\xb2\"\xb2%\xf4\x84\xf37?\xb6\xd9\xdb\xear\r\x12q\xb5\a\n\x92ӽ\xd9\x1b\x10*\xa1A\x88\x9e\x83\xe8\xb7\x1d҂W\xc98+\xeb\xf2\x82|\x13\xfc٪\x19\x1eV\ue0f6\x1d\xef\xfdWQ\xcbd\x92\xec\xe0(M\xc6!\xf3D\x05!\x12B\x7f\n\xa2\xf0\x94\x9fH\x12\x0e\x8d\x12\xe45ؑ\xf4b\xf8~\x10\\o\x93\xa5\xe0FG\xb1.\xf1\xf7\x06\xe9\x9fS\x0e\x7f\axH&\xcb\x0e\x8eRu}\xfb\x89\xfc\xf9O\u07fc!;\x80\x87\x90U\xc0\xcb\xd1\xfc\xd3P\xf7\x9f@ӗ\x8e\x1d\x1c\xa5n\x0f\xf4\xe7^:#\xee\x8b\xdf\xd9.\x16\xa3t6\x16>ſk\x82\x8a\a0\x89\xf38Vs\xb6o\xf4\x8c\xaf\xcb\x12rF5\x14\xfb\tL_\xdd\xf6\x87\x87vta\xbcm\xcfs\x16\xf2j\xba;>zL\xac\x03\xd1\xc43\xfe\xe1G\x1c\x86%\xffA\xf4 \x9aؽ\f\x8f\xba\xe0k\u07ba\x14lӻ3\x87\x9dٔѱ<w\xf8\x86@\xa2ön\xce\x10=d\xe3\xb7c\x1b´\xa3/\x00tMq\x90\xe0deCΫ6\xc0\xda\x04K\x11\xe5\x01\xbe6d\xb6cE\x11\x80\x89zA5\xe1\xf0\xa4\xdby\xc8,C\xe5\x86\x16\xaa!\xd3\x12\xe5\xe2>\x8e\xb0\x00\xc4$R\xcfɺ\xd6\x16`\b\x83\x00\xd8\x06'\xe7ݚ\xb9\x1b\x81\x9e'Q&Ј)\x8e\r\xbb\xf7g\xed\xdf䰡u\xa1/,\x15\xbf]\xbd\x8a\xa8x\xd854\a\x0f\xc6\xef\xdf\x02\xcd\v\xc6'\x97\xe3`xsԧ\x1a\b\xddh\x90\x04\xa3h\x9e\xc0\xdc(\xe4\x01HҞ\x002ʝߏ\xdcF萟\xa3wB\xe0\x89\xa2\x1f蠢\x85\xf3Q&Æ\x00P\x8c\xe8\xe7b\xc7Q9\xecAß\rv\xb49\x1c\x98t\x80\xac\xb9r\xf1\x92\x8cbx$v\xfe\xc2\xe33F\x96п,\x99R\x90w\x85\x83\x86\x16M\x8e2\xfe'-v\xe8\x809\"f{\x8a#\x86HCY\xe1\xbd&\x84s\xe7\x86\xf9\x1d!orf\xde\rvL\xd7\xc2\xc5\xd6I\xd0yǑ\x95\x14\x8f,\x87<\xae\xab\xe3\x9emFy\x06E\xe8\x97\x01\xd2Wf`'\xee\x8a\x11Z\xf3]A\xbb\xa8[\x1bd\xc4\x17\x04\xea\xa2mN\xaa[\xfa\b(\xd75\x007\xeb\x1arRWd\x0f\xba\x1b\xf38wg\x83\xd0I\x03\xafĢ\xf9[\xabs\x82\xb1URW\x85\xa0\xb92֦*\xea{\xc6\t2\xc2 \xab\xba\xc7\xd6\bP\xbc\xbf\xe5\x0e\"\x80PZ\xf2\b\xf0\\\xf9\x98\x8d\xe5\f.\"\x93\x8c;T\xa8\x04\xa5\x9aZ\xfex\xe1y\xcbm\xa8)\xf2jGwv8\x7fn\xf3\xbf\xd0\xe2^H\xa6\xb7%\xe9d\xa2\x86\x17\xae\xf2\x0e\xed\x9a\xca5-\n\xb3\xc0\xd0\xfc6\aBk\xf7^)\xe2L]\xf7N\x11\xd0h\x83Uh\x11\xc6\xc3\x01x-\xc9\xfd\x8f\x91\x80֒\xfc\xa8t\x98\x92%ႏ\xf1>\xb8\xa8\xf1\xbfL\xb1[N+\xb5\x15\x1a\xb76Q\xa7\x1c1\xaen\xaf\a\x93\x06\x82@\x9bk\xc8G\x87cG\x99\x1e\xe1\xff\xd5\xed5\xf9b\xd4\xdc\xc3D+\x8c\x99b]K\x1bj\xfc\f4\xdf߉\xef\x15\x90\xbcFB\x88\xcf]\x9eG\x00\xafa\x83\x99)\t\b\x03'\x80\x94\x98'PƘ\x8aZ\x9be܈\xd3&\x82\x98\"o\xbeAw\xb3ְ:\x86\x99\xb8:K\fm%\xf0\xf0-\xd5\xf4\x03\x8e\x1d\xb0\x0ea\x10\x03\x04)_;6\xae\x87\x014\xffi\xb5\xd7hm\v\x95)rv\x86\xfbЙ-\x1b8\xb3\xa6\x06K\x11\xf4\x92qcI\"0\xedݽg\x15\xd7\xe2)nX\xe6Z٪;\xf1\x9d\xb2\xc6?\x859\x91\xa9\x01\x8f\xb6\x12\xb9\xb3\x92A\xb0\x84lp\x1bV{\xa5\xa1\xf4\xeb\xbc\xcd\xee\"qf\xaf\xa7E\xe1\xc0(\xb2\xde{\xdc_\xcc\xe2\ry\xf3\x19\x94f\x83dX\x903gC\xd6ؙ\x01\xc6H\xf3C\x10\"\x19r\x00\xc3\xf9\xf4\x01\xda\xc3\x18旋\xa2\xc3\xdci\xae\x10\xf2ߜ\xbcŔh\x86\xe1\xd4\v\x97\x00eP\x98\xd0*\x17&\x1b\x03\xd2\xde\xd1{\xca(\x04\t\xa8q1\x1b\x8d\x11>\x89>.\xe3dSc\xa6xE\xd0\x12Duą\xd0Vg/&<\xb9\xff\\\xf3\x04a\xbd5\x03\x03\xb2\xe9\xec9\x82\x17{RIxd\xb0\x8bm\xd8&\xf5\xb2\xf3\x12\xcbh\x85\\\xc8W\xe4\x92\xe4r\xbfD/\xca\x01˰\xee(\xd3\xcay\"\x94G\x99\x8a\x16\x0fcvm\x9e\xdb\x04(\x19X\xb7\xa2\xe7w\x90\x12\xf4V\xe4ʞ%4}pEC\x87\x17\x17D9#\xaeέ_\xca\x05\xd9\n\xf1`\xc1zυ\x8bv\xafE;쑈\x80\xc5r\xa6.ZXp K\xe3\xef\x18g\xaa\xc2\x1a\n\xa5\xddRF\a\x1co\xf3b\x8b\x17\x9e\xb2\xa2\xce!\xbf*j\xa5A\xdeb\x99T\xee\xcb\xc4T\x82^\xbc\x1b\x05\xe0J\x14\n\x96\x99\xe0qf\a-M5VL\x9e\x8d\x14QwMy\x8d\xd98\x1d\xa6m\x19Bg\xabP\xa0q\xc8\xd9\xef\xceb\x9b(\xda\xc4\xfe\xdd\xfb\xf7\xb1\x87\x0eύގ\x1a\x81\xd8\xec\xb3\xe6l\x19\x16P4e\x9d\xb0\xe5\xcc\x10o(+\xd5\x15nS\xf5v\xbcxc \x06\x02\xe6~\xd8\xcf$\xe2\xe1\xfd\xff/\n\xf9(\xb1*\f\x84\x98t\x0e\xa1\xd6Fu\xa5\x19\xb3\x91\xa6\xbe\fy\x8a'\f\xc6-L\x7f\xe2r\xc2\xfb%\xf3옕\x10S\xfdFӜ:oiL\xa9~\x85\f3\xdb^\x02\x93\xfe\x8a\xe3\xdab2\x92\x99\xb2c\xb2\x86-}dB\xaaaE\"<AV\x87\x93\x93xQMr\xb6ـ\x04\xae\xed\xb9\xbdIV\x8e1k<\x98\xd25@\xd1\x01\x03\xbaZ\xa1\xa3\xf0\f7b\xa4\x98\xf8e\x14\xaaM\x19\xe2)\xcexw9{dyM\v\x93+\xc5\b\x85\xa1\x8f6\xf8\x85\xe9\x9bT\x88\x03\xfc\xad;\xe9\xa9@)\xf5*\xd1\x04\a<^\x95B\x86\x95\xc3\x7f\x0e\xc1D%\xda\x06\x9e\xc3\x19\x84\xf6\x83\x99e\xe5P\xb1^Okw\xce[I\xd9\xe8NA\xd7P\x10\x05\xe8\x1aƒ,\xa9J0\xcf~F8\x1b\xb0\xa4\xad\x8f싦F\x8dh{iє\x9d\x99H\xb0x0\xfe6\xc9\x05\xa0\x9f\xa9\t\xad\xaa\"\xb2\v\xcdЌD\xa31\xcb|\xa4\x1a\x92C\xbe{m:\x8e\xed\xcd\xec\xce\xc9Dw\xbc\xf0\x13\xd3{Lg|\xa8\xad\xb3\xb8~}0\xfd\xf9\x95\x1du\x9c\x81\xeaf\x06\x98\xf6ߦ@\xed\xf9\x81\xd1\xea\xb3_\xa9\xe0\x8e[-\xd7\xc3\xd9ϾZ\x9eEj\r\x1a\xffK\x84f6\xab[\xb7W\xcd\x12\xd8\xfb\xee\xccs\xc26\x8d\xc00\x9f\xc7\n\x8d\xf5\xf9S\x1bk\xcfљ\x94\xdcs2(u\xefū\xa4:۾k\xaa\x10\x12f\fx5\x04@X\xf7\fcd\x90\x00\x924N\x85\xaf<-\xed\xd3\x10xH\xec~c\x02\x05\x97\x1f\xdf\xc6\"\xc9Gi\xea\x01Q\x97\x03O\xa7\x8b\x82!0\td\x87(\xe3\xa65g<s\xaeU焒\a\xd8[\xcf*\x18\x1e\n](Zڀ\x94\x80\xb9T\xa3\x8c\bˀrO\xd4$\xc1\x9b\xa3*\xbe\xb2'R\xd23\xc9\xd4\ah\xea{,w\xf1\vCE\xcaR\n0խ\x1d|\xbc%y\xfa\f\xa34\xe4\xf8\x91d7\x02k\xcee\xb8@\x1e`\xff\n\x9fб\x89a\xb5\x8dd\xea\xc2\x17\x1al\x13\x92\x11\x9b\xe6\xf9\xa9/\xb4`y\x83\xab9)̀x\xcd\xcf\xc9G\xa1\xf1\x7f\xef\x9e\x18\xe6\xaeQ\x93\xde\nP\x1f\x856\u07fc(\x8b-\x11G2\xd8N6˒\xdbm\x01-Ϭ\xfb\xb78\x18\xc7\aWS#6\xa6\xf0A)!\x1d\x7ff@D0\x0e9\x8bVYc\xd1$\x86\x1f\xf8\xd2l\xd3\xfen3\x80v\xf1r\xa2\x12\xb2'\xa9\xf3\x99\x10\x83(:\xf4\xee\xd0;\xb4\xc8\x1f<\xbb6vI\xa8\n|\xce\xd7gY̓rT\xc3=\xcbH\t\xf2\x1eH\x85\xfbF\xbaRͰ\xe4Gka\xbak\xe1?cOI\x1c~\x96h\xa2\x13Gz1'\r\x1f}\xb2\xe2\xeb\xa84ۻ\U000474b8\xdf}\x8c{\xde\xce2S^=\v\xd0A\x12\x97\x05%%\xad\xd0\x06\xfc\v\xb7W\xa3\xde\xffN¡\xa2L*L\x86\xe1C\xec\x05t\xe7\xfb(a\xe7VI \x11\x13\f`\xffP\xb3GZ` \r\x8d7'P\x18\x7f\x06\xb1\x1czP\xe7\x8b\x04\xb8d\xb7\x15\nP\xa1\xda\xc4\xe8\xd9\x03\xec]r\xbek%ήy4j߿|\x01O\xcf\"4^\x8b\xc9/\x9e\x99\xdf\xce\xc6j\x97\x82K\xe4\b\xe7m\x86V\xcf\x18\xfa\xb4\xc4>\n\x92\x83\x06\xb5,i\xb5t\xabA\x8b2\x9a\xe3\x9e~h/\xa8\x96\xe1\x87\xf7\x9c\xfb\xbfZ<\xd3z\xa8D\xac\x98?\x82֍P\xda\x06\x0f{\xaez \xba8\x01\xd58\".\xe2\xe8J\x1b\x95\x16\xd2?\xfc\x8c&{\x10\\G\xadiZ1\xc4/*;\x91L\v\x18\xc3\ng\xadu\xb1)\x8c3\x9b\xab\xc2\x7fO\xc3\xccp\xa6U\xc1J\x8a\fT\xb4\x1ae\xf6\xae\xd3c\xef!\x1f\x9b@/5\x92\xc7 \xeb$H,+\xf5\xa7\xc7\xd5\xe2y\xddxdmʸ\x01a\xef\x9e:1k\x8a\xb5\xeb\x90%\xa9\xf218\xbab\xbe\x92\x0e\x1f\xc4OF\xf7\xca\xce\xf6\v\xd0\x013'$*\xefkc\x90\x92!wU\xfd\x97洔\x8c_\xe3j\xb8 o\x92\xe7\xccq\x01\xbc0\xcc6\x10\xabHK\x10\x87\x9b\xdf\n\xa4\xf9\x82\xcft\xaa\xb1\x98h\xb7\x05\t=\xc9\x1efA\xd2%E\x9aB\xcd6\xd0\xe3\xee\xf4\nK\x8f\xa4j\x8e\xef\xc1rmB\x8e\xa8\xdd|&\r\x10\xfc\x1d\x96$\x1e)\x97OvvC8\xeeN;W\x9e\x9e\f\xb1S\x06\x86\xd5\xca\xee\xa9\b\xe0\x99\xa8\xb1\x15\x889\x99\x99\xba\xc9\x19\x10\xad\x10\xedf\x92\xb8g\xa6\x94ņ>K\xa3\x9d\x8cOF\xd6\xdakI\xbe\xa3\xacXL\x8c\xfa\x1a\xb1\xba\xf2\xd2#\xc5\xea\xabi\xbd\xbdFe.\xe9\x13>\xfeDh\x89bI\x86K\x8c\xdf\xc2\xca\xf6\xa1\x05\xbbа\x1a\xb7\xa9{\xc6}`\x06D-\x9aGc}\x85m&\xb8b94\ue0d3\x7f\xb4,:tQ\xb2\xa1\xac\xc0¾\x97\x93\xcc\xdc3\x9f3OI\xa3g\xf8\xb1\xf8\x1f6ʹX\xcc֍\xbf\xde\xdd\xddt7r\xf3\xf7Kn\xe4\xf0TA\xa6!\xb7\xcf@]\x89\x1cԑj\xfd\xee\x10\x92\xf1\xe8\\\x1a\xa5\x12\\A2d\xe2\xcb\xc33\x03\xc7\xc6\xe7K\xa0\xbc\xd1h쾒\x01\xe4_\xbb\x95P\xbe'\xbf\x7fz\xea\xdeϤ\x95_Е\xb0u\x8d\xe6q\xc6?\xfc~Ƽ\xa9';\x9f˟\xd8\x02\xcdA\xaa[\xc8$\xe8\x8b\xc4ICE\xee\xc2\x18\x9c\xb4\x92!\xa2\xd5P\x0e\x02\xefl\xfaM\x12\xb3=jω\x80\xb5\x91x\xa3\xa0\xa6\x1e\x87\xfa|\x9f\xe9\xcb\xf4Jy&\xbc\xa0\xb5\xc2Ǿ\x146\xde\x01|\xa4\xf3\xee\xfd\xed\x17\x90lsl\b\xff:\x04\x8b\xe4La\xf2n\x0ew\\\xbf\xb2\xb6\xf7\x92\xd8\xf4\x1f\x8f\xc9\xd0\u0098_\xe7\xacg܍К\xdd6\xcf`\xcdem\xbcV7\xf4\xb1\xe5\xccG2\xf3\x83\x99\xec\xd5\x16\xd1v\xf0\xe6ioG\xa3V\xbe\x94\xdd\xd4s\xde|\xba\xbd;9\x9e'\xc7s\xae\xe3YaC\xc6\xe3d\x8a\x8d!\xbdB#\x18\xbf\xac\x9d~&\x03\xc5$\x9f\r\x95:{l\xca\xfa\xc8\xf7\x9f\xdf#\xf4\xde\xe6\x9a.\x1a\xd2[\x1dg\xaf\xcfV/\xcaE\x11o\xf94\xc5E!\x9bݬ\xc2\x7f;.\"\x1f\xe6\xa5v\x1c\xdf\x11\x98g\xe830\xf28\xd7\xe2\x18\xc7\x02\x9f\xbd\x9e\x0e\xbaF؈\xed\x16\xda\x00\xac\x05\xe5\x1fRJ\x86\x88<\xa4\xd9\xf6\xe5\xf4\x10}\xf8\x973/\b}\xe6p\xf5\x92\xab\xe2\xd7u\xa8=\xe2D1u\x98\xfdI\x8e\xa8\x84\xd42\xf2\xbc\xfa$\x8f\x9dn#\xf9\xf8ώ\xf5\x9e\x97\x01v\xf6\xa6}\x02\xda/\x94\xf3\xaf\x86\xe9\x17\xe3+E\xaeo\x88\xe0\xd6`\xa2Í\xfb\xcf\v\xf2u\xd6\xf1|\xc6\xe0\xd4\xd3S%\xe7%\xa0n$<\x7f\xa2\xa7\x92\fc>b*\xd73\t\xd3\xe4\x82\xfa\xb9\x1e\xb7z\xf0\xb8\x1cI\xf6LBű\xa7d\xcf)\xd9sJ\xf6\x9c\x92=\xa7d\xcf)\xd9sJ\xf6\x9c\x92=\xa7d\xcf)\xd9sJ\xf6\x9c\x92=\xa7d\xcf)\xd9sJ\xf6\x9c\x92=\xa7d\xcf)\xd9sJ\xf6\x9c\x92=\xa7d\xcf)\xd9sJ\xf6\x9c\x92=\xbf\xc0dOJ\xe0ai\x1a~,\xbe\x12\xab\xc4\xd6\x02ShO\xdc\xcbu\xd0p\x8d\x0e}\xc2$r\xc8\ru\xcf\x18\xce\f\xf4\u009c\xd5߰y\xc7\xe5\x1a\xdaV`x\xa4\xf0\xabٽ\xfem:\xa7\x95\xc0\xc0\xa9\xe3\x86G\xc0\x119\xbfQ\xe0\xf5(\x80A\xaf\xb4Y|\x1a4\tt\x98\x0e\xf8\xf2\x9c] =/\xe67\b<\xefDu\xdc\xe3\x8a\xe6\x01{\xc8c\xb7\x8d٣\x1e\x1e\x8b\xd9!\x9aI[\x93\xac2\xb1\xf5Ɔ\xad\x80\x8eW\x99\x18\x88\x81\xd24\x11\x12\xc7\xc3gQ\x9b\x8e\x84m\xfc$\x02\x15[P\xff\xee\xec\xd7!\x89\xa3x\x1f\xe5\xb6ea\x10\"\xe92\xd6\x1a^er\xe4\xdd6@\xfdvL\xbf\x1e\xc5>F\x93c\xaa\xdb\xe8\xa4W\xc7 H\x12S\xd2>3=\xb0_\x03/5\x94\x9f*\xb7\x93ݍ9\xe3}v\x06\xa6}EW~\xaa\xf6<\xdbJ\xc1E\xad\\\xbdĵ\x86\xf2Ҕh\xb86\x1b\xa6Xc\x861\xf8\xa3y\xb9\xedjq\x04_\x13\xbaB\xc5{A\xd9U\x8a\xaf&~|\xb3\xea\xff\xa2\x85\xeb\f\x15\x04IȎ\xe9-z*ܼ\xea\x9e\xdfw\xdbO\xfaūEP\xf1\"\x10\xf1剬\xb0Z\xe9!\xf4t\x92|24\xd0bu\xac~Mg\x7f\x86\xcd\vb\xe3\x06\\\x1dN\xebW(\xf5\x9b/M{\xc9_\xd1+jt\x89\xce\xef\v\x95\x824I\xe9\x06\x15\xee\xf34\x01uN\x0f\xa8\xd4\xc4^B\xbf\xa7\x1e\x8bF\xbb<\xa5\xb1\a\xaf\xf4\xdeN\x93v\xd4_\x9e\xa3\xb3\xc8y\xb6\xeeM\x89=\x9b:\x9d\x98&A\x1e٩)\x99ai]\x99z\xec\x1a\xeb\xc5Ԑ\x1d|\x03n\xff\x1a\xeb\xc0tآ\x04\xfb*M\x82\f\xf5]J馔\x84kr\x0f\xa5\xa63\xd2$د\xeb\x9c4i\xd7f\xea\u0094\xaf\xe1?iq\x8b\xf1>HIݏ\x92b\x1b\xd38w\xfa\xf9\xc4Q\x9e\xdb\xd5(\x89\xab\xbdu\xd3A#\xd6\xc1\xa8\xe9N4r㤾E\x87=\x89F Nw+\x8aw\"Z\xa4\xafoӣ(\xa1\xff\xd0\b\xc8ng\xa2\xd9n\xc0\xa46M\x0e\x98\xdbW\b\x9dH|s\xd2\xc5\xe2\xb8ݹ\xf89t\xf6k\xd9$d\xcfi\x8e \xd4[\x19\x9f\x06SP\xbd\xbc\x9f\x18ră\x10I\xeb\x9e\x1f\xe1\x88G@^oHY\x17\x9aUE\xe7\x15\x83z\v\xfb\xe6uD\xff\x14\x8c\xfbW\x84\x03\xf9\xf4\xb9Q\xf9\x98\"\xf6(\xc1\n\x92\x1d\x14\x05\xfe\xff\x80\v\x19嘑\xca\xc4\x12pۊ\x97պ\x17\xef\xb8\x10\xfc\xb9YE\xb6\xe3\xbc\xc93\x96\xf8\x82B\xff\xf6\xa6\xd5b\xf6V2\xee\x1e\x1bSf4\x95\xfcP\x83\xdc\x13\xf3>0\xef\aE@\xb6A\xa4ƧWu\xd1\x1a\x1fg\xc5\xd0X\f\x8dQ\x14bk\x02\xc8%\xb7\x1b\xf3\x10W\x03\vT\xf785fl\xf1\xf4\x14\x03\xc1E\x03aq\xbc\xf7=$.>r \x86g:\\=\xc7\xf1*\xc9\x11\x19ס\xe3\x8eX/uȚ{\xccJ\x13\xf5\x8cֺ=f=\xd3ak\xceq+q\xa7\x98w\xe4\x1a\x90\xf5l\x87\xae\x179v\x1d}\xf0\x9aźԖ\xb8=ƥ\x1c\xbf&!\x92\xa9\x16\xb8\a>Z\x02\xc8h\xeb\xdb\xf0\x11,\x01b\uf416t\bK\x00zpL\xfb\xea\x06\xb6\t\xf6o\xb6n\xa4\x1clҏc)\x8di\x13\x1b\xd2N\xfa\x87\xe9\xd8w\xb6\xfa1\xe4纹\xc9|\ueb6b\xf4\xe3\xd9\xe8\xad/_\xe0\x80v\xe4\x11m\x14\xe2X#\xd9\xf1C\xda(\u0603\x06\xb2G\xb8\x13\t\x1a\x960d~\x13دN\xc6\b\x99\x83\x9c\xcck\xcdQ\xe7IE\xee\xa9\xf0\xa7\xc1\xfd\a\x19\x1dwL0Xvsf1\x89\x8a\xe6\x9d\x18\x19\xf9\x1b\xe3.[\x8f\x8a\xdb\xf1I<\x10\x93\xc4l\x1d\xa6\bȞ\x97j\xc5\xe7\x12\xc8\n*\x8a\xc6\xd7\x1c\xa5\xcc#6jE\xdea\xa5\x9e\xbfC\x04$N'[\xaa\\\x15#9kR\xa1\xaf\xed\r\xf0\xef\xb3\x15!߉\xa6|\xa4%=\xe6\n(VV\xc5\x1e[I\x90\xb3.\x98\xafS\x9c\xa8\xc2z|>\x88\x1c\x8b\x0f\xe5Ŵ\xb0?\x0f\xa6\f\x84-\xc1\xbc\xd4\r\xdf\x00)\xc8\xff\xbf\xfd\xf4q1~\x0e\xb3!G\xf7Z\xbdN\u074c\xf5\x1a\xb1\x9fF\xcb4W\x14\x17\x81h\x8eǸ\xd0w\x92i\rX_\x93p\xd6N\xe0ᴗM+\xf6\x17)b/\x8d>`\xe1\xe5͵\x19\xeeu\xf9\xde\xfc\xd1)\x164\xe4\x925\x8co#\r\xabs\x13s\xeeB\r\x14\xca5\x7f\x8e@\xc4\xd5\xd6x7n\xf3\xc8\xf0\x99\xba˛k\x8b\xe5ʨ3>\x86$\xdc;\x82\x99̗\x15\x95\xd1T\xa2\xd7Bu\xde\xc3\xd0{\x0f\xab\xc5ؤ\x89\xcd\xf4\x81\xf1<\x91\xe7\x864\xc7o\x84\xdcK\xde\x1bNw\xf8\xf958\x8d\xb7\xf2\x9el\xe2\xfd\x028yV\x87\xb1Z\x1a..f\x16\x01Nn\x84s\xb7AO\xf7\r\xbe\xe09rV\r\xda!;!f\x85\xdaz\xac DҾPڜ\xe9\xddV\xe5\xcc\xd0F\x14\x85؝l\xc2\xc9&\x9cl\xc2\xcfa\x13\xfc[\xda?\x88Gx\x1b\xcdg\xf4\xd8w;\x98\x12(\xe5\xf5P\t\xa6H\x16\x13\x0f\x83\x91\xf8K\xff\x9f\xa16ף\xf2ż1^͠\xcf\xcd\b\x90\x87>\x0f}\x80\xf6\x15\xf7A\xa0\x04\xf5\n\xb7\xf1\x9b/\xafTG\xa3\xfc\nwa-\x17jn\xea~\xdc\xcf\x11\x90߾l%36\x05\xa2\xf7\xf0^d\xa6~:\x85[\xfd\x19.\xc6kvo\x7f\xac\xf4\x0fV\xb8\xb5\x16\x84\x89O\xf6Zچ\x00\xdbN\xf1\xfd\x9dc\r\xa6\x85Q̔M,O\xad\x8b\x04\xe2\xee\xee̳W\xd4Ṱ\xdeֶ\xd6\r\xed\xae\x02\xe4\xb4'\xd4rd\x1d\xbe\x15^\xf8\xb8d!\x1c\x1f\xbe\x1d\xd2!\x01\xd9d\v؏\xa2\xa6\xae\n\x81O'_\t\xbea\xf7\t\x84}ߛ\xd0Qq\xd7\xede\xc3\xee\x1d\xb1~\x7f\f\xc2l\xef|\xb4FN\xef\xf2xx,\n(\xbec\x05(\x8bxl\xe8\x80ʛÙ\x8dݯ\xcb5H\\\xa1\x1b\xfc\xb1\xb9I\x14\xb0'\x15\x83\xec\xa4\x02\x89GR4\b\x9c\xd4\xca+\xf883\xd2\x1e\xac\x9b\xb0\xf0\x8f\xc6(y\x13\xe5\x17I\x8aY\xfb\x12\x9e\xd9\xc9.u\x96\xebXٲ\xd8DaQ\xa5D\xc6\xccQ\xdf\xe4iM7\x98\xb1\xa3\xe1hxuB\xe9\xc7C6#|\xc4\xc5\xfc_\x82\a܅\x1e\xc3\xee\xdc0\xaf2ח\x1f/\x1b\x7f\xa1)\xa3\xfd\x11\x93\xb1\xf8\x17\xf6\xab\xcc\xeb\"\xa4\xe5\x18:aZ\x91uA\xb3\a,\xc7\xdd1\x9e\x8b\x9d\x8d\x99\x01F\xd4\f\xcb\x18?7\xab\r\x9e(\xb6[!g\xefj\\\x1c\xafo\xa8d*\x18\x9fhk\x9b\xbf\xbf\xbb\x8a70\x1aad\xad\xe0ӎ\x83\xfc\xec\xb7'uͭ}\x9a\xe0\xce\xf7\a\x13\xbdY\vm\x97\x18l\x19\f?\x00\x8f\x0f\xa9;\x1b\xafH&\xc1G\x8c\x8c\x12y\xee\xae\x163mL|\xc7\v\xfbgK\xa2B\x82\\\x12\reU\f[\x1cD\xb4\xcc\xf6\x0e\xb9XD\xb9\xe7ɹuMFh\xa5k\xe9\xcdo-\xcd+\xfb\x11\x88\xd15\xda<\xf7\x18\xc2,n@\v\xaat\x92,\xdf7\x03\xbd\xae\xe3T\xb3\xe95\xdb2\xd9QEd\xed\xf7\x83`\b\xdaS\x15F\xb4\xfb8rN5,\x11\xfeq\xe2\f\xaa2\xe2\xfc\x81a\xab\xc0\xcf5O\xa0\xb8\x19\x1b\"\x1a)u\xcb\xdcSu\x00\x91\x18\x9e\x94\x06\xcc\xcfG\xe9g\xa0J̢\xd7\xce@\xaaw\xdb}\x98V\xa4\xec\x00\"\xbe\xab\bi\xed3k.\xe2؏\xa4\x82<\x01a72$\x9e.\x9eD\xd9q?\xb9\b\x1c~\xc9\x02\xe8\x8d\xf7\xec\xef8\x84\x1dZ\x1a\x82\x0f\xa0\x92\xd9L/\xbd\xdc\xd5\x04\x8e\x8d\x82(\xcf\xf2\xd6K\x925W\x87J\x82\xcf\xeb\x1d\xc0$d\x87\x1e\xfbĺ`\\\xff鏋9\xfeQ\xb5\xa5jjϾ\xc11\x84\xf5\r\xa9\x998D~\x91\xf6\xb8\xfe\x92|\x84\xc3\xf0Ԓ\xbc\xe3\xb8\xe7\x1c\x92g{\xcdAn\x8a\x14h\xb0%ڈ\xa8\x1e\x9bY\xa6\xdfʔ\xc0ڛ\xd8\xe1\x83\a\xa7\xb0\x14\xaa\x85h{\xab\x84V\xf5o\xd8\xc6V\x90dH\xd3o\x17\xc9N\xda\b%q\xe7,\xb8e\x1e|i\x9a\v\xe5\x9du\xe9N\xa6\xddo굏ڨ\v\xf2\xaf\x7f/\xfeg\x00\xcc#\xae\x1cճ\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4V\xc1\x8e\xe36\f\xbd\xe7+\b\xf4\xb0\x97\xda\xd9m/\x85oE\xdaà\xedb0Y\xcc]\xb1\xe9\x84\x1dYRI*\xd3\xf4\xeb\vI\xf6$\x13;۴@\x13_,\x89\xe4\xe3#\xf9\xac\xaa\xaaV&\xd03\xb2\x90w\r\x98@\xf8\xa7\xa2KoR\xbf\xfc 5\xf9\xf5\xf1\xd3\xea\x85\\\xd7\xc0&\x8a\xfa\xe1\t\xc5Gn\xf1'\xecɑ\x92w\xab\x01\xd5tFM\xb3\x020\xcey5iY\xd2+@띲\xb7\x16\xb9ڣ\xab_\xe2\x0ew\x91l\x87\x9c\x9dO\xa1\x8f\x1f\xebO\xdf\xd5\x1fW\x00\xce\f\u0600 \x1f\x91E\x8dFa\xfc#\xa2\xa8\xd4G\xb4Ⱦ&\xbf\x92\x80m\xf2\xbfg\x1fC\x03\xe7\x8db?\xc6.\xb8\xb7\xd9\xd56\xbbz*\xae\xf2\xae%\xd1_n\x9d\xf8\x95\xc6S\xc1F6v\x19P> \a\xcf\xfa\xf9\x1c\xb4\x02\x11.;\xe4\xf6\xd1\x1a^4^\x01H\xeb\x036\x90m\x83i\xb1[\x01\xa4\xa4'\U000aa44b\xe3\xa7\xe2\xae=\xe0\x90INo>\xa0\xfb\xf1\xf1\xe1\xf9\xfb\xed\xbbe\x80\x0e\xa5e\n\xa9\x04\x8b\x99\x01\t\x18\x18Q\x80z0m\x8b\"\xd0Fft\n\x05%\x90\xeb=\x0f\xb9\x92o\xae\x01\xcc\xceG\x05= <g\xca\xc7\xcc\xea\xb7#\x81}@V\x9a\xd8\x18\xcd\xceMv\xb1z\x85\xf5CJ\xa7\xa4\x0f]\xea.\x94\x1ci\xa4\x04\xbb\x91\x01\xf0=\xe8\x81\x04\x18\x03\xa3\xa0\xd3k\x94\xe9\xf1=\x18\a~\xf7;\xb6Z\x8f<\b\xc8\xc1Gۥ\xa6<\"+0\xb6~\xef\xe8\xaf7ߒ\bIA\xadѩO\xce?r\x8a쌅\xa3\xb1\x11\xbf\x05\xe3:\x18\xcc\t\x18S\x14\x88\xee\xc2_>\"5\xfc\xe6\x193\x99\r\x1cT\x834\xeb\xf5\x9et\x1a\xae\xd6\x0fCt\xa4\xa7u\x9e\x13\xdaE\xf5,\xeb\x0e\x8fh\xd7B\xfb\xcap{ \xc5V#\xe3\xda\x04\xaa2t\x97\x12\x96z\xe8\xbe\xe1q\x1c\xe5\xc3;\xaczJ\x9d%\xca\xe4\xf6\x17\x1by \xbeR\x814\x0e\xa5?\x8aiI\xf4L4\xb9}.\xc9\xd3\xcf\xdb/0\x85\xce\xc5x\xe7\x14F\xdeφr.A\"\x8c\\\x8f\x9c\xed\xa0g?d\x9f\xe8\xba\xe0ɕ\xeej-\xa1\xbb\xa6_\xe2n \x95\xa9wS\xadj\xd8dŁ\x1dB\f\x9dQ\xecjxp\xb01\x03ڍ\x11\xfc\xdf\v\x90\x98\x96*\x11{_\t.\xc5\xf2\xfcK^\x9a\x91\xb5\x8b\x8dI\xe6n\xd4ka\xba\xb7\x01\xdbT\xc1Db\xb2\xa6\x9e\xda<\x1e\xd0{\x06\xb3dR߅$[\xfcK,\xa3\x92\x144W\xfa\xe2\xfb{\xd0,\xcbI\xfa\x87\x83\x11\xbc^\xbc\xc2\xf4\x98\xce\\Ƿ\xd4c{j-\x16\x17EM🡤?\xba8\xcccV\xf0\x19_\x17V\x1f\xd9'eͺ\x0epGo\x8cߛ=M\x1f\xcfۙ\x95S\xf9\x1bv)\xd5\x17\x02=:\x02\x8eΥ\xb9\x9d)dzfJ>;C\x8a\xc3\x02\x9aE<\x0f\xae\xf7I[դ\xc0F\xcb<\xe1X\xec1N\xc1\xb5\xe0\xf0v\xado\x89\xd7]\x84\x96'\x7fI\xff\x9bq\x92\x1bb\\\x8c]eT\x8b\x1b)\xe2\xc2ƍ\xf9\x1aQFk\xcd\xceb\x03\xcaqn]l\r\xb39]텩վЀ\xa2f\b\xcd\xea\xeb\x05\x9b\x19\xa49y=\xa0\xbb5\r\xf0jd\xe6\xf3\"2\xecN\xb7L7ow\xc0\xf9H\x95[F\x03I\xbb+\xa5\x05\xce\xee\"e\xb1z\xe5r\xb2x\xf3\x98\x11\xb2\xbd<;iƻј\xeef\xf5\xfd\x10\x16\x8b=[\xcc0\xbb\x8b\xf4D=\x9b=6\xa0\x1cq\xf5\xf7\x00\xb1J-\xe7\xa6\v\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4XA\x8f\xdb\xca\r\xbe\xfbW\x10\xe9a/+m\xd2\x16E\xe1[\xb3I\x80E\x9b\xc0\xc8.\xf6>\x96h\x8bYiF\xe5Pv\x9d\xe2\xfd\xf7\a\x8e4\x92mIko\x1e\x9e\xe5\x8bf8$\xbf\x8f\x1c\x92v\x92$\vS\xd33\xb2'g\x97`j\xc2\xff\tZ}\xf3\xe9\xcb?}J\xeen\xf7a\xf1B6_\xc2}\xe3\xc5U\xdfѻ\x863\xfc\x84\x1b\xb2$\xe4\xec\xa2B1\xb9\x11\xb3\\\x00\x18k\x9d\x18]\xf6\xfa\n\x909+\xec\xca\x129٢M_\x9a5\xae\x1b*s\xe4\xa0<\x9a\u07bdO?\xfc5}\xbf\x00\xb0\xa6\xc2%xkj_8Y\xb3\xdb{d\xfco\x83^|\xba\xc3\x12٥\xe4\x16\xbe\xc6L-l\xd95\xf5\x12\x86\x8dVCg\xbd\xf5\xfc\xb1S\xf61(\xfb\xde*\v\xfb%y\xf9\xf7\xbc\xcc\x7f\xa8\x93\xabˆM9\xe7V\x10\xf1\x85c\xf96\x98N\xc0\xaf\xb9\xdd!\xbbmJ\xc33\xc7\x17\x00>s5.!\x9c\xaeM\x86\xf9\x02\xa0\xa3&\x00I\xc0\xe4y ۔+&+\xc8\xf7\xael\xaaHr\x029\xfa\x8c\xa9V\x91V\x0f\xb8\rH\x81\xb06\xd9KS\a?\x00~xgWF\x8a%\xa4\xca_\xdan\xaax'\xa0\xd4-\xe1\xe3\xf1\x199\xa8k^\x98\xecvʘ\xea\x8bƔN\xcc!'\xc6L\x1c\x1ff\xcc\xd6F\x8an\xab5\xb8\x1a\x16.\x9a+\x8c\xef\xc1\r\f\x9e\x9b\x11#\x8dOk\x15>\xb5t\xb422\xd5:\xb3\xfb\x10^|V`\x15rZ\xdf\\\x8d\xf6_\xab\x87\xe7\xbf=\x9e,és\x93I\x04\xe4\xc1DWA\\`)\xb8\x8fV\x98\xd0+\x1a3\"M\xbfd=\xe5\b\x06j\x97\xc3N#\x8e\xe0\x18\xf4\xb2A\xe5v\xc8}F\xb5:\xdax\xa6\xbd\x86\x9a]\x8d,\x14s\xb2}\x8e\xae\xfc\xd1\xea\x19\x94\x1bE\xdbJA\xaew\x1d}\xf0\xb9KK\xcc;\x82\u0530\x14䁱f\xf4h\xdb\xdb\x7f\xa2\x18T\xc8Xp\xeb\x1f\x98I\n\x8fȪ\x06|\xe1\x9a2\xd7\x12\xb1C\x16`\xcc\xdc\xd6\xd2\xcf^\xb7W\xb6\xd4hid\bs\xfc\x84k`M\t;S6x\v\xc6\xe6P\x99\x030\xaa\x15h쑾 \xe2S\xf8\xea\x18\x81\xec\xc6-\xa1\x10\xa9\xfd\xf2\xeenK\x12K]檪\xb1$\x87\xbbP\xb5h݈c\x7f\x97\xe3\x0e\xcb;O\xdb\xc4pV\x90`&\r㝩)\t\xae[\x05\xec\xd3*\xff\vw\xc5\xd1ߜ\xf8:J\xb4\xf6\x1b\x8a\xd3+\x11\xd0\xc2\xd4&O{\xb4\x05:\x10Mv\x1bB\xf2\xfd\xf3\xe3\x13D\xd3!\x18'J\xa1\xe3}8\xe8\x87\x10(ad7\xc8\xe1\x1cl\xd8U]j\xe6\xb5#+\xe1%+\t\xed9\xfd\xbeYW$>&\xb6\xc6*\x85\xfbP\xffa\x8d\xd0Թ\x11\xccSx\xb0po*,\xef\x8d\xc7?=\x00ʴO\x94\xd8\xebBpܺ\x86\x8fjYv\xac\x1dmĖ3\x13\xaf\xc9\xcb\xffXc\xa61T\x1a\xf5<m(\v\x17\x046\x8e\xc1LW\x8c\xe1\x02\xcf_b}\x86\xf2}\xbes\xe6\xda\xc7^0\xfabG-\x02\xf6\x05e\x85^F1d\x83\xd4H)\xf4\xf5\xe6\xd4\xc5W\x18\x8eu5\xf4\xb5\vn\xf6\xfd\xef\xd8\xcbv\xa1sU\xeb\xa0\xe3\xf8\xb6z\xbe\x87}\xe1\xfa\x82~\xfct\xd5ro|h\x81\x98\xc3qa\xbc\xc2imR\x17\xfc\xd5f\x13]\xad\x8f\xda`_\xcac\xb5\xbf\x05\xc6\xd2\b\xed\x10čtB\x80\xc6\xceIT\xd0:\x9f\xc2\xc3\x06\xb0\xaa\xe5p;#\xa1\xc6U?\xe6o\x83\xe6\xf2K\xc8\\~\x1c\x83hU\xe9\x0f\x84O\xd2;R\t\xb0>\x9c6\xaf\xaeA\xc1\x83@\xd5\xf8P(<\n\x88ۢ\x14Ȱ')\xe09Ⱦ\r\xd1.\xbb\x84\xe8\xf9~\nQ\x9fBo@\xa4\xe7\x86\x16\x1c\xc0d\xc6\xde̠Y\xb9\xb7\x05\xa7\xf5\xe3\x02\x9a\xe7>\xfc\xe7\x80\"\f\x92\x82lH\x95\xb79\xa0\xe5\x9c\x18\xcf\x12$\x81Ѩ\x187\xfa;zU\t\rs\xd9r1\vl\xba\x88\x86S\x11m\xd60\xa3\x95N\x97\xe2\xfe\x83e\xb4\x1b\xc3.P\xfe\xb9\x1b\xd6\f\xe3\xf9\xf0vr\xe7o\xc1;\x16\xcc5\xf7\x95\x9b1\xf7$X\x8d\x9c\x98eB\xed\x1e\x14\xbb\xb1\xc1\xe6A-\x9aa\xf0\xeb\r\x8f\r\xbd\x06\xba\xeb\x7f.\x7f\xa2\xa9l\x9bp\xe8k+\x1b\xc3P\xb9|hfBC\x06\x06'\xa7\x9c\xd1g\xe3\xb82\xb2\xd4\x11\x16\x13=5#g\x9b\xb24\xeb\x12\x97 \xdc\xcc\t\xbdr\x8bzxWc\xeb\x81m\xa8\f\xe8N\x01\xdd\x02\xa6\xdb\x14\xde%\xbcO8\xd1\xef\xbb\xf4Wݲ\xa6\xbaέ\xb9\x8e\xfd*\xc5\x17\xcd{\xfay\x9d\xf9G\xfaٛ\xd7C'\xe6\x81,\xac\x0f\x82\xfeR\xa8\xc9\xca?\xfe>#\xd3\xfa\xaa\x93\xfc\x16yR&H\\\xe3\xecӡ\xee\x9d\xd5CWq\x85\xb6\xa9\xe6\xa8H\xe0S\xbcZ\xb3\x12_\xa8\x9cK\xce\x04\x1e\x0fUI\xf6\xe5\xd7\xc24]\x87\xa3j{^\x87\xe3\x86\"\x9fؘ)\xc7Wݵ\xf6\xaca6\xe7<T\xe8\xbd\xd9N\x84\xe7$0_[)\x8d\x8d\x89G\xc0\xac]\xd3\xfe\xb8\x98,\xdd7\xe7?a\x86\xe6q\v$\xe0\xcd\xc1þ\xe8zq\f\x13d\xfa{\xb2\xebĿ2\x17\xe9\x9f\x03\x17ЬT\xe6\xbc\x15\x95\xb4\xc1쐕ت\x88\xa97\t-]\\\x97\x84\t|\xc3\xfd\xc4\xea\x8a]\x86އ\xff\x88N\x9f\x04\xbe\x18*1\x7f\x13\xe4\xa8MK\xbb\x17S\u0557\xf0\x8f\x0e(\x19\xfb\x02\xed<\xe4\x91F\bcV\x1dU\xa5\x8b\xb9\xda1\xdf&\xaeJ\xdaI\xc8\u008d\xcd\xf4\xb7\xe9\x05\xa4OQN\x01\xaa\x11\xa0\xf3\xf1\xbe0\x1e*\xc7\xc30 \x85\xb13\xf3\xbd\xb3\x18\x87u-\x9d\xdd81\x86\xde\xe6\xe7ڹ\x12\x8d\xbd<S\x8d\x16=\xf2\x0e\xf3#Z\xbc86\xdbc\xa2|\xb3\xee\xff\xa9X\xc2\xff\x7f[\xfc>\x00\xf4\xeb\x84u\t\x16\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xb4VQo\xdbF\f~ׯ Ї\xbcTr\xbb\xbd\fz\x19\x8at\x0f\xc5\xda5h\xb2\xbc\x9fu\x94u\xf3\xe9N#yJ\xbd_?\xf0$Ŏc'.\xb0E\x06\x02\xdd\x1d?\x92\xdfG\xf2T\x96ea\x06w\x8f\xc4.\x86\x1a\xcc\xe0\xf0\xbb`\xd07\xae\xb6\xbfp\xe5\xe2j|_l]\xb05\\'\x96\xd8\x7fC\x8e\x89\x1a\xfc\x88\xad\vN\\\fE\x8fb\xac\x11S\x17\x00&\x84(F\x97Y_\x01\x9a\x18\x84\xa2\xf7H\xe5\x06C\xb5Mk\\'\xe7-R\x06_\\\x8f\xef\xaa\xf7?U\xef\n\x80`z\xaca\x8c>\xf5\xc8\xc1\f\xdcE\xf1\xb1\x990\xab\x11=R\xac\\,x\xc0F]l(\xa6\xa1\x86\xfd\xc6\x041\xbb\x9fB\xbf\xcfh\xb73\xda\xe7\x19-\x1f\xf0\x8e\xe5\xf7\x17\x0e}v,\xf9\xe0\xe0\x13\x19\x7f6\xb2|\x86\xbbH\xf2\xc7\xde{\t#\xfbiǅM\xf2\x86\xce\xd9\x17\x00\xdc\xc4\x01k\xc8\xe6\x83i\xd0\x16\x003?9\x99r\xa1\xe6\xfd\x84\xd8t\xd8g\xce\xf5-\x0e\x18>\xdc|\xba\xff\xf9\xf6\xc92\x80En\xc8\r\xea\xe3\\\x8a\xe0\x18\f,\x91\xc0C\x87\x84p\x9f\xf9\x04\x96H\xc8sЏ\xa0\x00K\xfc\\=.\x0e\x14\a$qK\xf2\xd3sP_\a\xabGq]i\xe8\xd3)\xb0ZX\xc8 \x1d.飝\xb3\x85\u0602t\x8e\x81p d\f\xb2\x17r\xff\xc4\x16L\x80\xb8\xfe\v\x1b\xa9\xe0\x16Ia\x80\xbb\x98\xbc\xd5z\x1c\x91\x04\b\x9b\xb8\t\xee\x9fGl\x06\x89٩7\x82\xb3\xe6\xfb\xc7\x05A\n\xc6\xc3h|·`\x82\x85\xde\xec\x80P\xbd@\n\ax\xf9\bW\xf0%\x12\x82\vm\xac\xa1\x13\x19\xb8^\xad6N\x96\xbejbߧ\xe0d\xb7\xca-\xe2\xd6I\"\xf1\xca\xe2\x88~\xc5nS\x1aj:'\xd8H\"\\\x99\xc1\x959\xf4\xa0\ts\xd5\xdb74w\"_=\x89UvZE,\xe4\xc2\xe6`#7\xc2\v\nh\x0fL\x850\x99N\x89\xee\x89va\x93\xd9\xf9\xf6\xdb\xed\x1d,\xae\xb3\x18O@a\xe6}o\xc8{\t\x940\x17Z\xa4l\a-\xc5>cb\xb0CtA\xf2K\xe3\x1d\x86c\xfa9\xad{'\xaa\xfb\xdf\tYT\xab\n\xae\xf3\xb0\x815B\x1a\xac\x11\xb4\x15|\npmz\xf4׆\xf1\x7f\x17@\x99\xe6R\x89\xbdL\x82\xc39\xb9\xffS\x94zf\xed`c\x19og\xf4:\xddɷ\x036O\x1aHQ\\\xeb\xe6\xcen#=A\x040K\x9f\x9f\xc6\xdb7\xf7\xf9\x06\x9f\x87|\xeb6ǫ\x00\xc6\xda|E\x18\x7fs\xd6\xf6\x05\xc2N\xe4}\x1dC\xeb6Z\xa8m$\x18(\x8e\xce\"\x95K\x9es$\x89\xe6\x84\x1dz\xcb\xd53\xc83\x9c\xeb\xaf!\xb4\xaa\xb1\xf1\xf5+\x91<\x1eT\xa7b\\\x98f\xd6\x1e \x97\x1e\xf5\xf3\x8c\r\x82\xc1\xe6\xa1~\xfcH\xcc5\xcch\xe1\xc1I75\xc7\xc1\xc5\x00p\x99\n\xfalqwj\xf9(\xf6\xbb\x0ea\x8b\xbbi\x9c\"06\x84\xa2\xf3\x8f\xd1k\xf3jgV\x00_\x12\x8b\x86fN\"\x82\x8e\bg\x17\xeb-\xee\x9e\x13\xfd\xaa\xb8\xf3}\xffz\xc8Wz/.\x01\x13\xb6H\x18\xe4d\x8b\xeb'\x06\x05\x14̟/66\xac\x13\xb6\xc1Ax\x15G\xa4\xd1\xe1\xc3\xea!\xd2օM\xa9\x84\x97S!\xf0JC\xe1՛\xfc\xefdD\x00w_?~\xadჵ\x10\xa5C\x82\xc4\xd8&\xbf\x14\xda\xc1m\xf7\x16t0\xbc\x85\xe4\xec\xafW\xc5\t\xa4\xd7x\x89Y+\xe3/\xe0F\xdb\u07b5;\xbd\xb9sPJ\xd1\xed\xa4J$й\xa9b\xf7\xb3\x9a\xd3|\xb0/h\xb5\x8eѣy^z:}\x1d\xe1\xd1=\xa2\xbfR\xcb\xe9G\xda\f\xe0{\xb9\x17\xaa\xec\xcdPN\xbe\x8d\xc4\xde5G\xa7\x97>\xaf\x8b\x17y\xb8\x99\x8f\xe9xP\x0e\x16\xb3\xa5l\xa6\xaf\x98\xfcMc6X\x15\x17+r:\xf1\xf2\xd1AqA\xd6,F\xd2Q\xcf^2ҳٜ\xe7z\x1e\xebM\"-\xff\x19\xf3\t$h\xb2\xff\xd1X\x1f:\xc3\xf8\n\xe7\xa7=ܨ\xe5\"\x83w-6\xbb\xc6\xe3\x04\b\xb1}\x06\xf9\x837\x91\xfe0\xa4\xfeyl%|\x18\x8d\xf3f\xed\xf1\xc4ޟ\xc1\x9c\xdd=+\xfeI=\x9f-2҈\xb6\x06\xa14y\x9e\xab\xac\x06\xa1\x84ſ\x03\x00\xec\xa0\xe0\xa1k\r\x00\x00"),
 }
 
 var CRDs = crds()
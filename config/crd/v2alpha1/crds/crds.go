@@ -29,8 +29,8 @@ import (
 )
 
 var rawCRDs = [][]byte{
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcY_s\xe3\xb8\r\x7fϧ\xc0l\x1f\xf2\xb2Rn\xaf\x9dN\xc7o\xbbN;\x93\xe9m\xea\xb9\xec\xe4\x9d\x12a\x99\x17\x8adI\xc8i\xda\xe9w\uf014d\xfd\xa1\xe3d\xafwz3\t\x82?\xfc\x00\x02 ]\x14ŕp\xea\x11}P\xd6l@8\x85\xff\"4\xfc+\x94O\x7f\t\xa5\xb27\xc7OWO\xca\xc8\rl\xbb@\xb6\xfd\x19\x83\xed|\x8d\xb7\xb8WF\x91\xb2\xe6\xaaE\x12R\x90\xd8\\\x01\bc,\t\x1e\x0e\xfc\x13\xa0\xb6\x86\xbc\xd5\x1a}Ѡ)\x9f\xba\n\xabNi\x89>*\x1f\xb6>\xfeP~\xfa\xb1\xfc\xe1\n\xc0\x88\x167\xc0\xfa\xa4}6\xda\n\x19\xca#j\xf4\xb6T\xf6*8\xacYq\xe3m\xe76p\x9aH\v\xfbM\x13\xe0[A\xe2\xb6\xd7\x11\x87\xb5\n\xf4\xf7\xd5\xd4O*P\x9cv\xba\xf3B/\xf6\x8e3A\x99\xa6\xd3\xc2\xcf\xe7\xae\x00Bm\x1dn\xe0\x9e\xb7v\xa2F\x1e\xebm\x8aP\n\x10RF\x96\x84\xdeye\b\xfd\xd6\xea\xae\x1d\xd8)@b\xa8\xbdr\x14Y\x98\u0082@\x82\xba\x00\xa1\xab\x0f \x02\xdc\xe3\xf3͝\xd9y\xdbx\f\t\x16\xc0/\xc1\x9a\x9d\xa0\xc3\x06\xca$^\xba\x83\b\xd8\xcf&*\x1f\xe2D?D/\x8c7\x90W\xa6\xc9!\xf8\xa6Z\x04\xd9\xf9\xe8B\xb6\xbbF\xa0\x83\nsh\xcf\"0<O(\xcf\x02\x89\xf3\xac.\x90h\xdd\x12\xd1di\x82$\x05a\x0e\xd0ֶN#\xa1\x84\xea\x85p0co}+h\x03\xcaП\xfft\x9e\x8b\x9e\xac2.\xbd\xb5fN\xcc\x17\x1e\x85\xc9pB\xc2^j\xd0gٱ$\xf4\xaf\x01B\xac\xe0\xcbd}B\x92\xf4N\xc7/B\xe1\x90\x03\xbb\a: |\x11\xf5S\xe7\xe0\x81\xac\x17\r\xc2O\xb6N\xee{>\xa0\xc7(Q%\t\x8e^P\xec;볮sX\x97I\xb6W6\xe8Z\xf8o\xbe\xd1\xff=\xb6j\x8f\"\x1b[C\xaa)\xa3\x84\xb2&\x1f`\x9f\x1b|SpMI4Vℱ\x19&\x15\xc0y[c\b\xaf\x04<+\x98\xa1\xb8?\r\xac\xa8I\x12\xc7\x1f\x85v\a\xf1)%\x99\xfa\x80\xad\xd8\xf4+\xacC\xf3yw\xf7\xf8Ǉ\xd90\xbc\x920DM\x813\x05\xc3wޒ\xad\xad\x86\n\xe9\x19\xd1$\u05f7\xf6\x88\x9e\xf3\\\xa3L\x185r֖S\x81S\xce\xe6\xf8\x8e\xfax6Mz\x8c\xd1\xc3\x00\xfd\xd4\xfb\xc0{:\xf4\xa4\x86,\xdc\xeb>\x15\x98\xc9\xe8\u008ek65I\x81\xe4ʂɌ>\x97\xa2\xec\xd9I\xceR\x01<:\x8f\x01\r\xcd!\xf4\xdc\xedA\x18\xb0\xd5/XS\t\x0f\xe8Y\r\x84\x83\xed\xb4d\xe3\x8e\xe8\t<ֶ1\xeaߣ\xee\x00d\xe3\xa6Z\x10\xf6%\xe1\xf4\xc5\xdcm\x84\x86\xa3\xd0\x1d~\x8c\x94\xb5\xe2\x05<\xf2.Й\x89\xbe(\x12J\xf8\xca<)\xb3\xb7\x1b8\x10\xb9\xb0\xb9\xb9i\x14\r\x85\xb5\xb6m\xdb\x19E/7\x91oUud}\xb8\x91xD}\x13TS\b_\x1f\x14aM\x9d\xc7\x1b\xe1T\x11\xa1\x9bX\\\xcbV\xfe\xc1\xf7\xa58\\ϰ\xaeb-}\xb1&\xbe\xe2\x01.\x8c\x1c\xe8\xa2_\x9a\xac8\x11\xcdC\xcc\xce\xcf\x7f}\xf8\x06\xc3\xd6\xd1\x19K\xf6#暈\xe1\xe4\x02&L\x99=\xfa\xe4Ľ\xb7mԉF:\xab\f\xc5\x1f\xb5Vh\x96\xf4\x87\xaej\x15\xb1\xdf\xff\xd9a \xf6U\t\xdb\xd8m@\x85\xd09>Ⲅ;\x03[Ѣފ\x80\xbf\xb9\x03\x98\xe9P0\xb1os\xc1\xb4QZ\n'\xd6&\x13C\xa7s\xc6_ӓ\xff\xe0\xb0f\xd71{\xbcL\xedU_\x01\xf8\xf8\x8a\x99l9S\x99?\xb2\xfce\xab\xc0Rh\x81\xe9Kn\xcd\x00\xccLrm_\x8eB\x92\\)\x05\xd0gK\x98Gg\x83\"\xeb_N\x85\xac\\i8\xe3\x00\xfejaj\xd4\x17,\xd9F!PF2\x938\xc6\x1d\xa7\x88\xa4 b\xb2\xa6\xb1|.\xce\x13\x9c\xbe;\xe2U\x1c\xa8\x01\x89m2\xd9\x1a\xa3\f\x9c:<\x98vrK\xcb*k5\x8ae\xde\xe3\xd8\xfa\xcaIzk\xcd^5k\x1b\xa7\xcd\xe89\xc7_\xa0/\x13\x86\x93-\xd9\n\x8e9FR\xc4zQ\f\x01ɉw\xaf\x9a\xce\xe726\x7f{\x85Z\x86s\xbe\\\x9d\x8f\xc1\xe0\xb8\xcb\x05w\x8e(\x87\xe3ї\x97I\xcd#\x1b\xf3H\x88\x8d&Of\x10\xa6\x10,\xe1n?Ѩ\x02|\xf8\x00\xd6Çt\x19\xf9\xf01\x85k\xa74\x15jZx3\x1a\x9f\x95\xd6þ\xef\x8a\xe2\xb1\xfar\x03d;\xba@\xc0?\x16\xe2\v\x1e\x88;\xb3h;Yx\x16\x8a\xc6r\x97\xc1<n\x1d>B\x85{N\xb1\x1e\xa9\xf3\x86O\x02z\xcf)'D\x95\xb6\xa3w\x19\x15\x8cp\xe1`\xe9\xee\xf6\x829\x0f\xa3\xe0\x90]\xeen\x87\xdc\xf2\x18\xbd0\xa6\x98^\x12\xc8\xe6\x1c\x8aC;#c1z\x1f\xdaX\x01ǫ\xdf%\xc8s\xe9\x01\xb7\xf5\xaaQ\xdcV\x98q\xe6\x94\xf2\x8e|U\xcc\x05\xa2\n\xd1>\x94й\x04\x9cS\fW\xd7\nA\xaa\xfd\x1e=\x1aJ\xf55m\xbc{\xdc^\x87\xd3&9\x9d\xfb\t\x86\xd8a\xb5\xc29\x94ܱ\xb3g{\xa2\xdeE\x11\t\xdf =F3.\xf0\xf3m\":\x90Õ\x9b\xafW\\\bz\xef&\x8d\xb0{\xdcr\a\x961c\xf7\xb8Fx\xbe\xcaAߊ\x9f\xf1\xe0\n\xe5\xca\x7f=\x9e\u05c8\xbd\x90N\x01\xdc\xf1\r;\xef\x1es\x85t\xa4\x03\xe8 \x88%\xfa\xab\x13T/Y\x9d0\x9c\x8fޝ߇\xb7~\x13\xe0\xed\xab\x88\xb7K\xc8g\xf0V/\xbf\x1a2\x17o\xe5Q\xaeQ\x17\xafx\xae\x00w\xcc\x0e\xd6o/Q\xf9\x9d\x8b|w\xb5\x90Y\xa6\xf8\xc5\xf4)Y.'\xe6\x99f1;=\x92ojC\xe3\xe5\xf6\xad\x8dhz\xb2\xea\xdd^w>\xa6\xa1\xfe!\x8boe\xdfՊ\xd6\xe9\thzۿԾ\xadW\xc4\xfb\x9e\x97\x93z'ƫl|r\x18ޙr\xfd\xdbI_Z\x1a\xd3#\xabC\txD\x03\xdcj\v\xa5Q\x0e:C\t߸\x1b\x8f\x17\x9f\xeb\xe5\x15)\xf2\xdd+\x8ae\x97{\xa6\f\xe8\xf5\xbaὉ\xaf;\x05\xabXI\x98NkQi\xdc\x00\xf9\xee\\\xff\x98=(-\x86 \x9aK\x89\xfak\x92JW\xc5~\t\x88\x8a\x9b\x8aeO{\x1dz߿\xabh\x18+/a\xb8\xb72\x020\xdf\xf1~\xf3.,\xb1\a\xbf\x00f\xc72\xb9\x98\x1f\xa1\xbd~=@ӵ\xb9\xcct\x8fϙ\xd1\xcfu\x8d.\x97-\v\xd8yt\xc2g\xa7V\x0f\xc7\xd3\xc9t\xd9\xc9%\xcea.\xabs|\x99\xcd\xcc\xfd-\x1e\x86w1\xdd\xe3\xbbD\xf6p;:X=\x1c\xe6\xf8xj\xba\xb6Bό\xc7\xe7ف\xfa!Kf\x0e\xa00r沓\x86\xb1'\x8c\xaa\xf8$s\x95J\x17\xb8\xa1K\x96*8-rEv\xb0d־\x9c\x0e\xc8\xea\xfd\xec\xbd\xfd\xca\xf8\x98\x9d/¹\x17\xe9\x9c\x17\xa6oˋ\xf9\xf1\x91\xfa\xb7\xd9\xe1\x95\v\xdd\xfcO\x83K-\xf5L\xf8R\x82\xef\xff\xafȥ\xf7i\xa6^\xe7\xe5\xf96\xbfgJ\xce\x12\xb5\x1a\x8c\xc8\xe5Dw\xff\xac2\x1d\xe9\xaa\xf1\xb1p\x03\xff\xf9\xef\xd5\xff\x02\x00\x00\xff\xffG\x0e\xcf\xec\xfa\x1b\x00\x00"),
-	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcYOsۺ\x11\xbf\xfbS줇\\\"\xfa\xe5\xb5\xd3\xe9\xe8\x96\xc8팦/\xae'J}\x87\xc8\x15\x89g\x10`\xf1G\xae\xdb\xe9w\xef,@P \t\x89R\xfaR\x1e<\x16\xfe,v\x17\xbb\xbf\xfd\x01X\xadVw\xac\xe3Ϩ\rWr\r\xac\xe3\xf8O\x8b\x92~\x99\xe2\xe5O\xa6\xe0\xea\xfe\xf8\xf1\xee\x85\xcbj\r\x1bg\xacj\xbf\xa2QN\x97\xf8\x80\a.\xb9\xe5J\u07b5hY\xc5,[\xdf\x010)\x95e\xd4l\xe8'@\xa9\xa4\xd5J\bԫ\x1ae\xf1\xe2\xf6\xb8w\\T\xa8\xbd\xf0\xb8\xf4\xf1\xa7\xe2\xe3\xcf\xc5Ow\x00\x92\xb5\xb8\x06\x92\xe7:\xa1Xe\x8a#\nԪ\xe0\xea\xcetX\x92\xd8Z+\u05ed\xe1\xd4\x11\xa6\xf5K\x06u\x1f\x98e\x7f\xf7\x12|\xa3\xe0\xc6\xfeu\xd2\xf1\v7\xd6wv\xc2i&F\xab\xfav\xc3e\xed\x04\xd3i\xcf\x1d\x80)U\x87kx\xa4%;V\"\xb5\xf5\x96x\x15V\xc0\xaa\xca\xfb\x86\x89'ͥE\xbdQµ\xd1'+\xa8Д\x9aw\xd6\xdb~R\b\x8ce\xd6\x190\xael\x80\x19x\xc4\xd7\xfb\xad|Ҫ\xd6h\x82J\x00\xbf\x1a%\x9f\x98m\xd6P\x84\xe1E\xd70\x83}op\xdf\xcew\xf4M\xf6\x8d\xb45VsY\xe7\xd6\xff\xc6[\x84\xcai\xbfmds\x89`\x1bnR\xc5^\x99!\xe5\xb4\xc5\xea\xac\x1a\xbe\x9f\x84\x19\xcb\xdan\xaaO25(T1\x8b9u6\xaa\xed\x04Z\xac`\xfff1\x1aqP\xbaev\r\\\xda?\xfe\xe1\xbc'zW\x15~ꃒc\xb7|\xa6VH\x9a\x83&\xb4C5\xea\xaco\x94e\xe2\x7fQĒ\x80\xcf\xc9\xfc\xa0I\x90\x9b\xb6/\xaaB\xe1\x06\xea\x00\xb6A\xf8\xcc\xca\x17\xd7\xc1\xce*\xcdj\x84_T\x196\xef\xb5A\xddo\xde>\f1\x8dr\xa2\x82}\xb4\x18\xc0X\xa5\xb3\xbb\xd8aY\x84Y\xbd\xdc(v\xb2\x95\xe35\x7f\xe3 +5\xb2l\x90E\x94)\xfc\b\xaed>\xd2>\xd5xU\x94\xa5ޔ\xaa\xc2\xc1u\x98j\xc4\rtZ\x95h̅\xb8\xa7\xe9#\x1d\x1eO\r3\xb7\x84\x11ǟ\x99\xe8\x1a\xf61\xa0L\xd9`\xcb\xd6\xfd\fա\xfc\xf4\xb4}\xfe\xfdn\xd4\fg1\x83\x95\xd6\x10X\x90\xea\x9dVV\x95J\xc0\x1e\xed+\xa2\xf4\xb8\x05\xad:\xa2&\x90\xab\xb94\xc0d5Ȅt\xc0\t\xaa)Ƚ<\xea\r\x9d}8\xa9\x0eu\xba\xed@Kv\xa8-\x8f\xe8\x1b\xbe\xa4\xac$\xad\x13#ޓ\x9da\x14TTO0X\xd1c)V\xbdk\xc2>q\x03\x1a;\x8d\x06\xa5\x1d\xab\xd0;\xee\x00L\x82\xda\xff\x8a\xa5-`\x87\x9a\xc4\xc4\xf8/\x95<\xa2\xb6\xa0\xb1T\xb5\xe4\xff\x1ad\x1b\xb0\xca/*\x98ž\x1c\x9c>\x8fݒ\t82\xe1\xf0\x03\xf9\x0eZ\xf6\x06\x1ai\x15p2\x91燘\x02\xbe(\x8d\xc0\xe5A\xad\xa1\xb1\xb63\xeb\xfb\xfb\x9a\xdbXNKնNr\xfbv\xef\xdd\xcd\xf7\xce*m\xee+<\xa2\xb87\xbc^1]6\xdcbi\x9d\xc6{\xd6\xf1\x95W]\xfa\x92Z\xb4\xd5\xeft_\x80\xcd\xfb\x91\xae\xb3@\v\x9f\xaf\x85\x17v\x80J\"E9\xeb\xa7\x06+N\x8e\xa6&\xf2\xce\xd7?\xef\xbeA\\\xdao\xc6\xd4\xfb\xde柳\xe6\xb4\x05\xe40.\x0f\xa8\xc3&\x1e\xb4j\xbdL\x94U\xa7\xb8\xb4\xfeG)8ʩ\xfb\x8d۷\xdcҾ\xffá\xb1\xb4W\x05l<ǀ=\x82\xeb(\xbb\xab\x02\xb6\x126\xacE\xb1a\x06\x7f\xf8\x06\x90\xa7͊\x1c{\xdd\x16\xa4\xf4h:8x-\xe9\x88\f\xe7\xcc~\x9d\xd2~\xd7aI\x1bG\xbe\xa3I\xfc\xc0\xfb\x1a@\xb9˒\x91\xc5H\\>]\xe9\xcbB\xfft\xd0D\x9fϹ9Q-\x99@l\xacFa\xe4L(\x80\x98\x96\xb0a\x8e\xc6N\x19n\x95~#\xc1\xa1z\x153\tg\x9cO_\xc9d\x89b\xc1\x92\x8d\x1f\x04\\V\xe4G\x1cb\x8e\xe0!\b\xf0:)Y+ʉs\xee\r\xdf\xd6\xd2\x1c\nQ\x83\x96,\x92\x99\xc2\xc2%\x9c\xb8\x1d\xa4\x1cnj\xd5^)\x81l\x8aw\xa5\xe1;\xc9:\xd3(\xbb`\xdb\xf6\x00q䷷\x0ei\xf1\xcdn\xfb\x81\xfe\xc4v\x8a\x8b#\xafz\x00\xa6\xe4!\x963\aY\b@K\x836\xbb-\x98~\xfa\xdc\t\xd2\t\xc1\xf6\x02\xd7`\xb5\x9b\x1bv>\f\xe9\x8bb7\x82\x99쀉\x81\xbbt|.\xfc\xa2@(\xfd\b۰)\xd4\f\x1e\xa7\xfaCd=\x99\xc4\aZ\x02\xaf\xdc6ٙ\x17\xe2\x0fz\xd2\xc5j\xbcڠdx֞\x9e\x84\x05s\xd4\xe1\x821O\xcf\x1bo\xef\x92e\x04\xcb\xdfcY\x10y>\x12g\xb6=\x8f&䬛hy\xce8E\tF \x81\x15\xb8\xeev\xdd)ù\xc6j\xae\xf3j\xb4_\x99\xee\xb1\xd1g\xd2v\x06\xee\xd0\xf3\xad/Ĩ6J\x1ex=_;=:^ʑ\x8b\xa6͊F\xb2$y\x9cj\x04i\xb2\xf2\xe4n\x15\v\bѤ\x03\xaf\x9d>\x97\xfa\a\x8e\xa227g\xfb\x82?\xbc\x12\v\x186\x18\x11\xab]\x0fU\t\x7f\r\x01\xe1\x8c?9RgƀPS\n\x82ēDn\xe0\xdd;P\x1aޅ\x1b\x85w\x1fB\xfdq\\\xd8\x15OItF\xe2+\x17\"\xae{SY\x1a\xa84\x1dd\x94[\x02\xf1\xbfM\x86O\xfc`\xe9|\xe5m\xb7\n^\x19\xb7\x03w\xcd!x\x94e>\xc0\x1e\x0fė4Z\xa7%\x956Ԛ\x18\x84\xf1\"\x95\xcb`\xfb\x05\xa3LRg\x16\f\x9a\x96$o\x05\xfd?\xc5\xec4\xd13Ƹ\xee6\r=\x85\x1d\xeen\x96\x94\x1c\x8f\x8ez*\xcdkN\xe7\x029\xf4\x9cxK\x00\x87\x8c\xa6\xfd\xa9\xdcÕ\xc7ۂ\x98B$q\x04\x80'q\x94\xa1aq\x02p:wlvی\xccaF\xd5\xe7W&;\x17\xbd\xf1\xf4\xbc\xb9\xca\x0f\xa4J\x06\xaf\xa9\xf9\xb5\xe1e3\u07b7\xd9\x19\xc1\xeb\xc2^\xd0s\xd4\x1b\xd4\xcc\x03\xf5*\xcfX'c\xa6Y6\xe9N\xe3u\xda5\xde\xfal\xef\xd3\xf3\xe6*V\xef/\n\xae\xe3\xf5\xe1\x06\xb0\xf7r\xe9\xb4Fi\xe3\xbd \x1dq\xbf\x83ٗ\xe1F-\xbd3Yb\xc3\xf3\x19\xfe謫\x04mXd\xe8\xfe\xde&\xde\xda\xe5\xf8\xf0I\\\x98\xe9\x8f\xf2$\r+\xc0#J\xa0c\vゐۋ4\xc5tN>\x9d\x06)=\x8a\x85+\xdaxh\x8dw\x16\xfd\x95\xc07\nN\x7f&}o.\xc8\xf4 J\xe9\x97q\xc2<\xa2\xe3u \x9dDWY\xa1W\xd5\xc6lr\x0e\\\xe1+\x1a'2\x05\xe2\ar\x85\xb0d8n\x99,W\xb8|H`\x06\x18\xe8 \xa4\x87\x89Kg\xa6\xef'\x10-\x1a\xc3\xea%\x1c\xff\x12F\x85\xab\x8e~\n\xb0=\xd5ѱj\xefM\x9fl7\xc1\xa8TՒ\x06\x8f\xaa\xf2\xcb˛/\x1eoҤc\xb6Y\xd0\xe4\x89\xd9&\x02\xcc\xc1\t\xe1\xe7̪nO\xc2\xf7H\xd9\xf4[\x15_\x7f\xca]R\x8f\xc6\xe4\x00\x10\xaf\t$\x94\xae\xcd\x11\xfaG|ʹ~*K\xeclƲ\x15<i\xec\x98\xcev͞d\xd2\xcep\x91\x90K\xb4ؗ\x959\xbczd\xfa\xfe\xe2\xa1\xf1&?\xf7\xfa-\xb9:\xde>4JDd\xf7\x0f\x13ҵ{\xd4\xe4o\xff\xf41>\x98\xe5\xb2]V\xa3\xedJ\xe6\x0f\x84\xc7K\"\x14\xa6Cg\xb8\x1d\x89|\xb5\xe2\xa6\x13\xec-#8\x1a\x92\xa2M\x92\xb7\xd3[\xe9y<\\\xbeh\x18\x9e\x89\xf2\xe7\xd6\xdc[On\x0f\xd2W\x9bI\xff\xf0\xfc\xf3cV\xb8\x00\x8c1\x93\xb7\x0fW\x12\xf1\xedC\xcc:^\xa1\xb4t\xb68\xbd\x04\x9cX\x9d\xbcx\xb4J\xae\xebn#\xa2\xa3\xc7\xc3%\x8dG\x83\x17\x98I\xffl\x99\xe3%;Jq\x02\x16\x7f'\xbd\x99>,}\x18ީ\x98\xed/\xc6ˆɚ\x12BRq\xf3\xc51'xF5F\xc4b\xac\xfe\xff\x93Sd\xc3e\xd6\xe85\xaf\x12\xd9\xfdmH\xda\xe2\xf6\xc3C\xc4\x1a\xfe\xfd\x9f\xbb\xff\x06\x00\x00\xff\xff?\xb7\xcf\xe0L \x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcY\xcdr\xe3\xb8\x11\xbe\xeb)\xba&\a_,zg\x93J\xa5t\x9b\x91\x93*Uv&\xaa\xb5\xcbw\x90hR\xd8\x01\x01\x04\x00\xe58\xa9\xbc{\xaa\xf1\xc3\x7fY\xf6lvI]\x84\x06\x1a\x1f\xbent7\xc0\xedv\xbbaF<\xa1uB\xab\x1d0#\xf0_\x1e\x15\xfdsŷ\xbf\xb8B\xe8\xbb\xf3\xc7\xcd7\xa1\xf8\x0e\xf6\x9d\xf3\xba\xfd\x19\x9d\xeel\x85\xf7X\v%\xbc\xd0jӢg\x9cy\xb6\xdb\x000\xa5\xb4g\xd4\xec\xe8/@\xa5\x95\xb7ZJ\xb4\xdb\x06U\xf1\xad+\xb1\xec\x84\xe4h\x83\xf2<\xf5\xf9\x87\xe2\xe3\x8f\xc5\x0f\x1b\x00\xc5Z\xdc\x01\xe9\xe3\xfaYI\u0378+\xce(\xd1\xeaB\xe8\x8d3X\x91\xe2\xc6\xea\xce\xec`\x10āi\xd2\b\xf8\x9eyv\x9ft\x84f)\x9c\xff\xfbB\xf4\x93p>\x88\x8d\xec,\x93\xb3\xb9\x83\xc4\t\xd5t\x92٩l\x03\xe0*mp\a_Y\x8bΰ\n\xf9\x06 \xad)@\xd9\x02\xe3<\xb0\xc4\xe4\xd1\n\xe5\xd1\xee\xb5\xec\xda\xcc\xce\x168\xba\xca\nC]\xa6\xb0\xc0y\xe6;\a\xae\xabN\xc0\x1c|\xc5练:Z\xddXt\x11\x16\xc0/N\xab#\xf3\xa7\x1d\x14\xb1{aN\xcca\x92\x12#;x\b\x82\xd4\xe4_\b\xaf\xf3V\xa8f\r\xc1\xa3h\x11xg\x83\t\xc1\tU!\xf8\x93pSh\xcf\xcc\x11<\xeb\x91_\x04\x12\xe4\xa4\xcey֚9\xa2\xd1\xd0\b\x893\x8fk\x80\xf6\xba5\x12=r(_<\xe6u\xd7ڶ\xcc\xef@(\xff\xe7?]\x84`\x12YE\x18z\xafՔ\x98\xcf\xd4\n\xa3戄\xacԠ]eG{&\x7f\r\x10O\n>\x8f\xc6G$\x8f\xd4\f\xe3\xf6\xabP\xc8\xe5@\xd7\xe0O\b\x9fY\xf5\xad3\xf0\xe0\xb5e\r\xc2O\xba\x8a\xe6{>\xa1%\xf3!\x94\xb1\ay/\b\xb2\x9d\xb6\xab\xa63X\x15\xb1oR\x96u\xcd\xec7\x9d\xe8\xff\xee[\x95E\xb6\xea[9\xd4\x14\xa1\x87\xd0j\xdd\xc1>5\xf8&\xe7\x1a\x93\xa84\xc7\x11c\x13L\u0081\xb1\xbaB\xe7VY\v\x1b\xac \x05I\x18Q|\x1d\x1a\x16\xd4\xc4\x1e\xe7\x1f\x994'\xf614\xb9\xea\x84m\b\xa2\xf4O\x1bT\x9f\x8e\x87\xa7?>L\x9aa\xba\x80\tJVyG\x91\x82Vc\xac\xf6\xba\xd2\x12J\xf4ψ*\x04.h\xf5\x19-\x18\xd95BeO\xa3\x97)>\xee0\xc4l\xf2\xef@\aI\xa3\xd0b\xf0\x1e\xd0\x06\xed\xd8\xfa@\x14\x19\xb4^\xe4(\x9ct\x0f\tf\xd4:[\xc7\r-5\xc6M\xe0\x94Y0.#\xc5R䉝h,\xe1\xc0\xa2\xb1\xe8P\xf9)\x84\xc4]\rL\x81.\x7f\xc1\xca\x17\xf0\x80\x96Ԁ;\xe9NrJHg\xb4\x1e,V\xbaQ\xe2߽n\a^\x87I%\xf3\x98R\xc2\xf0\xd2V\xb4\x8aI83\xd9\xe1m\xa0\xace/`\x91f\x81N\x8d\xf4\x85.\xae\x80/ēP\xb5\xde\xc1\xc9{\xe3vww\x8d\xf09\xb1V\xbam;%\xfc\xcb]\xe0[\x94\x9d\xd7\xd6\xddq<\xa3\xbcs\xa2\xd92[\x9d\x84\xc7\xcaw\x16\xef\x98\x11\xdb\x00]т]\xd1\xf2?ؔ\x8a\xdd\xcd\x04\xeb\xc2\xd7\xe2/\xe4\xc4W,@\x89\x91b\x03KC\xe3B\a\xa2\xa9\x89\xd8\xf9\xf9\xaf\x0f\x8f\x90\xa7\x0e\xfbw\xa2\x14\x12\xef\xc3@7\x98\x80\b\x13\xaaF\x1b\xc6Amu\x1b\x18Gō\x16ʇ?\x95\x14\xa8\xe6\xf4\xbb\xael\x85'\xbb\xff\xb3C\xe7\xc9V\x05\xecC\xb5\x01%Bgh\x8b\xf3\x02\x0e\n\xf6\xacE\xb9g\x0e\x7fs\x03\x10\xd3nKľ\xcd\x04\xe3BixH\xcb.\xb16\x12\xe4J炽\xc6;\xff\xc1`E\xa6#\xf6h\x98\xa8E\xca\x00\xb4}\xd9$J\x14\x13\x95\xeb[\x96\xde\xd5,0\xef4\xc3\xf4ymL\x06\xa6F\xb16\xa5#\n$\xac\x0f\xd5\xe3W\xe6\xc1\x8b\x14f\xd1h'\xbc\xb6/C\"\x9b\xae\xe9\x15\x03Яb\xaaBye%\xfb\xd0\t\x84\xe2\xc4$\xf6~G!\"*\b\xae\xaaU\xa3i_\\&8\xbe\a\x0f\x15S\xe4\xa8\x0e=%\x19\xb5\x9ac\x84\x82\xa1\u0083q%7<qe\xa5\xd6\x12\xd9<\xee\x91o}\xa1 \xbdת\x16\xcdr\x8d\xe3b\xf4\x92\xe1\xaf\xd07#\xea~:%ل|\x8e\x90lC\xbe\xd8f\x87\xa4\xc0[\x8b&\xa5\xff\x95Ik\x81\x92\xbbK\xb6\\쏼\xe00\xcb\xee\x8d(\xf3\xf6H\xe9e\x94\xf3\xbc&\xf3t.\x14\x9a$\\h\xcc{\xa2\x80C=\xd2(\x1c|\xf8\x00\xda\u0087x\x18\xf9pK\xa3\x81\x0e9~+ƉwE㳐2\xcf[l\xdea\x06\xa1*\xd9q\xe4T\x17\xb9+\xab?\x8c\xfb\x92\x85X8\t\xd1f4\xd4t\v\x16%\xf3\xe2\x8c9\x03Z\xad\xe7T\xa7\xbcJ\xd23\x9d`\xf0\x96:\x97\x98\xeb\x01^\xc0#\xed\b\x7f\xa2\xaaAHt\xb9\xbbS̸\x93\xf6\xc0\xec\x1a\xa9\nE\x18c\x91qP\xba/0(\x98׀\xad\xf1/\x91\xd1瓖yrZE\xdfo\xa1Txl\xdf\xefת\x93\x92\x95\x12w\xe0m\xb7D\x1a\x8d\xc1\xace/3Y_\tQ1\xaa;\x7f\xc5\x1c\xff\x98u\x9f\xf9\xa4\xa7*9\xf8\xa1\xd7\xf0̄\xefK\x8f\x85\xda\xd1\xd4\xee\x16J\xac\xa9ް\xe8;\xab(*\xa1\xb5\x14\xfe]P\xa9;\xff.\a\xcbV;\xdc_Y\xceC\xdf1G\xfa\xc3}6\xfdS\xd8\x119t\xf7\x8e\xe0\xf5B%\x8c])\x14\x06\xefC\x1b\n\xa1\xfe\x18~\r\xf2\xb4wƭ\xadh\x04\x95x\xaa\x97\f\xe9'\xfa\xddB/\xd0`Z\x1fr\xe8L\x04\x0e\a\x1f*\x9d\x12\x81\x8b\xbaF\x8b\xca\aI\x9a\xf8\xf8\xb4\xbfq\xc3$k:\xeb\x11\x86\xe0\xea-3\x069\x9d\xccɲ\x89\xa8wQ\xe4\x99m\xd0?\x85e\\\xe1\xe7q\xd45\x93Ce,\x9d\xb9))'\xebF\x8dp|\xdaS5\xbcP\tp|Z\"\xbc\\q\xe4c\xd1\x05\v.P.\xec\x97\xf0\xf4:VU\xbc\xc2\x10\xfd\xcc\xf9\r3\x1f\x9f֊\x9a\x9e\x0e\xf0'\xe6A\xf4\xc7X(\xe7\x11#?i\x7f$s~\x1f\xdeY\x91x\x01\xf0\xfeU\xc4\xfb9\xe4U\x95@\x99\xf1\xd7B\xa6BJX\x9c\x1dE\xe8\xb7\x1d\xac\xbf\"3\xe7\xd5\xc6\xea\xed\xe5\xc2\xfa\xcc[(ת\xd6Y\x9fy\x88\x9f\x89\x87`9\x17L#\xcdL:ޒ\x9b7\xac!\xde&\xed6\x17\xed<.(\xe3\xb5_6{\xd5\xd9\x10\x86ҥ\xa2\xae\xbf\xf3XP\xc5\xeb\xb8\xc4D\xb8y\xd9m^\xf5\xbd\xfdrD8{[>\xcaw,;T\xbc\xfe\xc9w~\xcb\xf0\x01#}!\xaf\xd1\x02\xa3:\xe4\x80gT@\xc7\x1e&$\xf2\xac\xd3\xc5\xd2ą{\x80\x1b\xb7Y\xa8\xec\x15\x85\xb4K\xf5\xeb\n\xe8\xe5\xb8|\xf7GG\xcf-\xa9\xf8\xbe\x92bu\xa3\xb4\xe8\x1ck\xf0\n\xb7_b/\xe2\x80\xe5!\xc0J**\xe6\xe7\x8b\x1b\x97ܧx\x0f\f\xbaӺ\x82\x81n\xb9\b\x80\xfa\x8e\xbb\xb4wa\t\xe7\xa1+`\x8e\xd4g\xcd\xe7{hc,\xcb\xe9Qu\xedr\x8a-]\xbe\xaf\xb4~\xaa*4k\xd1r\vG\x8b\x86\rw\xacó\x1d\x1d\xf1V\x84\xf1\xe0\xb9\\\xfc [ՙ\xfcuU\xf67&\xd6\x06\xbd\xc6t\xc2w\x8d\xec\xd4\rNZ\xe6\xcd\x1c.\xb2Uזh\x89\xf1pU\x9e\xa9\xcfQr\xa15^@\x8eM6hH{8]\xff\xd3N\xa6,\x15\x0fӹJ\xe6\xc2\x19\xb9(\xcb\xc7+\x99\x94/\xc3\x06Y\xdce\xbe\xb7^\xe9?,\xac\t\u05ff\x0eL\x9f\xe5=\xff\xf4\x19>\x18\xfc63\\̖\x00\xd3\x0f8W|\xe1a\xd2\xf9Z\x80Oߎ\x96l\xc3$R/\xe3\xf2t\x9a\xdf3$\xaf\x12\xb5h\f9\x86\x8ft\xa7+\xaeqKW\xf6\x17\xb7;\xf8\xcf\x7f7\xff\x1b\x00\x05\x8ca\x95\x86\x1d\x00\x00"),
+	[]byte("\x1f\x8b\b\x00\x00\x00\x00\x00\x00\xff\xbcYKs\xe3\xb8\x11\xbe\xebWtM\x0es\x19\xd1;\x9bT*\xa5ی\x9cT\xa9\xb23q\x8d\x1c\xdfA\xb2Ea\r\x02\f\x1er\x9cT\xfe{\xaa\xf1\x10A\x12\x96\xecه\xa4\x8b\x00t\xa3\xfbk\xf4\x03\x8d\xf5z\xbdb\x03\x7f@m\xb8\x92\x1b`\x03\xc7\x7f[\x94\xf4\xcfT\x8f\x7f1\x15W7\xa7\x8f\xabG.\xdb\rl\x9d\xb1\xaa\xff\x86F9\xdd\xe0-\x1e\xb8\xe4\x96+\xb9\xeaѲ\x96Y\xb6Y\x010)\x95e4l\xe8/@\xa3\xa4\xd5J\b\xd4\xeb\x0ee\xf5\xe8j\xac\x1d\x17-j\xcf<m}\xfa\xa1\xfa\xf8c\xf5\xc3\n@\xb2\x1e7@\xfc\xdc \x14kMuB\x81ZU\\\xad̀\r\xb1\xed\xb4r\xc3\x06Ɖ@\x16\xb7\f\xe2\xde2\xcb\xfe\xe99\xf8A\xc1\x8d\xfd\xfbl\xe2'n\xac\x9f\x1c\x84\xd3LLv\xf5\xe3\x86\xcb\xce\t\xa6\xf3\x99\x15\x80iԀ\x1b\xf8\xcaz4\x03k\xb0]\x01DM\xbc\bk`m\xeb\xb1a\xe2NsiQo\x95p}\xc2d\r-\x9aF\xf3\x81\x96\xe4\x02\x81\xb1\xcc:\x03\xc65G`\x06\xbe\xe2\xd3\xcdN\xdei\xd5i4A$\x80\x9f\x8d\x92w\xcc\x1e7P\x85\xe5\xd5pd\x06\xe3,ᰁ\xbd\x9f\x88C\xf6\x99\xa45Vsٕ\xf6\xbf\xe7=B\xeb\xb47\x1b\x18.\x1b\x04{\xe4&\x17\xec\x89\x19\x12N[l_\x14\xc3\xcf\x133cY?\xcc\xe5\xc9H\x83@-\xb3X\x12g\xab\xfaA\xa0\xc5\x16\xeag\x8bI\xeb\x83\xd2=\xb3\x1b\xe0\xd2\xfe\xf9O/\x8a0D\xa8*Oz\xab\xe4\x14\x96\xcf4\n\xd9p\x90\x84,ԡ.b\xa3,\x13\xbfD\x10K\f>g\xf4A\x92{\x1a\x86|\xfc\xaa(t\xdc@\x1d\xc0\x1e\x11>\xb3\xe6\xd1\r\xb0\xb7J\xb3\x0e\xe1'\xd5\x04\xe3=\x1dQG\xe3\xd5a\x899*'Z\xa8\x93\xc6\x00\xc6*]\xb4\xe2\x80M\x15\xa8\"\xdf\xc4vf\xca鞿\xf2!k4\xb2\xe2!KQ\xa6\xf2+\xb8\x92\xe5\x93\xf6\xa9\xc3W\x9d\xb2\x1cM\xa9Z<C\x87\xb9D\xdc\xc0\xa0U\x83\xc6\x14\x11\xf3^V\x11y\x9c\f2|\x1d\a\x16\xb0\x84\x15\xa7\x1f\x99\x18\x8e\xec\xa3\x1f2\xcd\x11{\x1f=\xe9\x9f\x1aP~\xba\xdb=\xfcq?\x19\x86\xa9\xf8\x99\x8c\xac\xb1\x86\x82\x05i2heU\xa3\x04\xd4h\x9f\x10\xa5\x8f[Ы\x13j\x18\x84\xeb\xb84\xc0dR\x85\xbeق1T\xd3!\xf7P\xd0l\xa0\x8e\xc7I\r\xa8s\xb3\x03\xe13\xa0\xb6<E\xdf\xf0\xcd\xd2J6:S\xe2=\xe9\x19VAK\xf9\x04\x83\x161\x96b\x1b\xa1\tv\xe2\x064\x0e\x1a\rJ;\x15!\x02w\x00&A\xd5?cc+أ&6\xe9\xfc7J\x9eP[\xd0بN\xf2\xff\x9cy\x1b\xb0\xcao*\x98Ř\x0e\xc6/\xb9\xa3\x96L\xc0\x89\t\x87\x1f\b;\xe8\xd93h\xa4]\xc0Ɍ\x9f_b*\xf8\xa24\x02\x97\a\xb5\x81\xa3\xb5\x83\xd9\xdc\xdctܦtڨ\xbew\x92\xdb\xe7\x1b\x0f7\xaf\x9dU\xdaܴxBqcx\xb7f\xba9r\x8b\x8du\x1ao\xd8\xc0\xd7^tI\n\x9b\xaao\xff\xa0c\x026\xef'\xb2.\x0eZ\xf8\xf9\\x\xc1\x02\x94\x12\x81\x1b`\x914(:\x02MC\x84η\xbf\xee\xef!m\xed\x1dw\xc2\x14\"\xee#\xa1\x19M@\x80qy@\xed\xe9\xe0\xa0U\xef\x11G\xd9\x0e\x8aK\xeb\xff4\x82\xa3\x9c\xc3o\\\xddsKv\xff\x97Cc\xc9V\x15l}\x8d\x015\x82\x1bȻ\xdb\nv\x12\xb6\xacG\xb1e\x06\x7fs\x03\x10\xd2fM\xc0\xbe\xce\x04yy4~\x88\xcb&\xa2\x96M\xa4\n\xe7\x05{\x8dn\xbf\x1f\xb0!\xc3\x11vD\xc4\x0f<\xe6\x00\xf2]\x96\x05\x88j®\xec\xae\xf4-\x86\xfe\xf9\xa2\x99<\x9fK4I,\x99\x85ؔ\x8dBbY0\x05\x10\x89x\x8cÑF\xe3\xa0\f\xb7J?\x13㐽\xa6:]\x00\x9f~\r\x93\r\x8a+\x9al\xfd\"\xe0\xb2%\x1c\xf1|\xe6(<\x04\x06\xfe\x98*\xd9)\xf2\x89\x97\xe0\rߝ\x85\x86I:\xa2\x06-e\x16YH,\\\xc2X\xdbA^Í\x9f\xa0U\xad\x94@6\x8fw\x8d\xe1{\xc9\x06sT\xf6\x8an\xbb\x03\xa4\x95\xf7\xcf\x03\x12\x8c\xdb\xfd\xee\x03l\xf7\xbb4Na\xfc\xc4\xdb\x18\x80)z\xe9\xbe\x14dc\xa0%m\xb6\xfb\x1d\x98H\xbe\x04A:!X-p\x03V\xbb\xa5b/\x1fC\xfa&\xb6[\xc1Lq\xc1L\xc1\xa4\x85__:~\x89!4~\x85=\xb2y\xa8I\x1fZ}\xa2b=#\xe2\xe7\xb2\x04\x9e\xb8=\x16)/\x9c\xbfTt\xb1\x0e_\xadP\xb6\xbc\xa8O,\xfc\x82:\xeaP\xe4\x18\x94\xb9{\xd8z}\xafiFa\xf9{4\v`%\v\xbcB\xb7\x87\tAI\xbb\x99\x94E\x96@\x8eY\x87 \x81-\xb8aUXrYv\xf2p\xaeq\x96\x1f鷞ث0=Uz\xb1\xe0\x85\xe0\x9e\xea\xad/TQm\x95<\xf0n\xb9w~u\xbc\xe4#\x17U\x9b\x00~;ݒ\x10\xa7\x1cA\x92\xac}q\xb7N\t\x84n\xeb\a\xde\xc5*\xbd\xb0遣h͛\xbd\xfd\n\x1e^\x88\xcd+\x95H\xd9.\x86\xaa\xac~\r\a\xc2\x19\x7fs\xa4\xc9\x05ǔ\xe4*\xd8\x1d2\x8e\xdc\xc0\xbbw\xa04\xbc\v\x1d\x85w\x1f\x88\x1a\xa8Oa\xd7</\xa2\v\x1c\x9f\xb8\x10i\xdfj\xf5\x06+\x9dKi\xba\xc8(g\xaf\x00\xf0\x8f\xd9\xf2\x19\x0e\x96\xeeW^w\xab\xe0\x89q{\xae]\x17l\xb3\xad\xcd\a\xa8\xf1@\x05\xabF봤ԆZS\x05a<K\xe5월J>{O\x16\xbf\xac\xd0<%\x11\xe4\xc4\xf9\x1c\xe3\xe2\xfc\xc4\xd1\x17,\x01\xdc\xf06\t}\xf5|\xee\xdd\\\x13r\xba:ɩ4\xef8\xdd\v\xe4yf\xac[BpX\xf0\x05\x88\xb7r\x1f\xae|\x19\\\xc1\xce&\x96\x86\xaa\xa5\x91\x1dyh\u061c\x028\xdd;\xb6\xfb]\x81癢\x8d\xfee\xbe\x03\x8d\xbb\x87\xed\xabp Q\n\U0005a19f\x8e\xbc9N\xed\xb6\xb8#\xd0ϲG\x94t\xbf|\x83\x98\xe5@\xbd\x86\xbaT}\xce\xd6̽l6\x9d\x9f\xd7\xf9\xd4\xd4\xf4\xc5ٻ\x87\xed\xea\x15\x81.4\x856\xab\x17\xe1\x1d+\xc3йK(7Nk\x946\xf5\x05\xd5\xe1\xbb*\xfb&t\xd4\"\b\xbegr\xc5\xdc\xdb%\x85\xbf:\xeb6\x8b6,\x1a \xf4mR\xd7niW\xc8\xd8\xf9\xa0B\xda\x05n\xd8\x02\x9eP\x02][\x18\x17\x14\xb9=KS\xcdi\n\\s.1\x8a9\x8fK\xba\xb4F\xf1RK\xe0\x9e\x0e\xa7o\v\xbc7\x17x\xfa J\xeeW\x00ay\xa2S;\x90n\xa2\xeb\"\xd3W\xe5Ƣs\x9ek\x85oh\x9c\xb0\xbfk\xad\x10\xb6\xf4u\x10\x9ab\xadp\xf9\x92\xc0\xa8\xa7\xa0\x03\x93\x18&^:\xb8\xbf\xac\x80\xe8\xd1\x18\xd6]K6_\xc2*\xb2/K$\xc0jʣS\xd1ޛ\xe8l\xd5\xea\r(R\v\xf0\x8a\x04\xd4\x14\xa4\xed\xe5\x9b\x1b\x8fo\x92d\xa0\xd6\xe4eI\xa8\xa1\x9a\x02\xcc\xc1\t\xe1i\x92H\xe7\xe8\x1d\x8b\xf0\x1aɛ~\xad\xe4\xebo\xb9\xd7ģ5\xa5\x00x\x86m\xc4i\xb99J\xd7/7X\xd3SJa\xf4S\xd3\xe00\xb6\x9b\xc7\xcf\x1a\xee4\x0ell\x94\x8f\x9fuvm/L\x86F\xc2R\xf5q\xae\xc83\x06\x9b\xe2\xdc\xdf\x18/\x11]\xc29\xcaw\r\xea\xb8\f\x8eJ\xa4\xc8\xee\x1f&\xa4\xebkԄ\xb7\x7f\xfaH\xc0\xbfX\xd9P}\x92\x9b+\xa3?\x17<\x9e\x13Ea\xbaN\x87\xeeH\xaaW[n\x06\xc1\x9e\v\x8c\x93\"y\xb4\xc9\xfc6E\xf8\x94\xe4\xab76\x1a\xce\xcfD\xa5\xc9\xf2[\xcf\xf4\xb3|\xb5\x99~\xc6\xe7\x9f\xdff\x87\v\x811y\xf2\xee\xf6\xca)H\x85\xf8\xee6y\x1do\xa9\xdfy\xe0\xd9K\xc09.py\xf1j\x95\xb5몷\x9c\xd8\xe9\xe3\xe15\x89'\x8b\xafT&\xf1\xd9r)\r\xc0\x9e\\\x9c\x02\v\x15㰝?,}8\xbfS1\x1b\x1b\xe3͑\xc9\x0e\r\x15,\x1aCr,1^\x94\x1a\x93\xc2b*\xfe\xefYS\x14\x8f\xcbb\xd0K\xdef\xbcc7$\x1fq\xf5\xf9!b\x03\xff\xfd\xdf\xea\xff\x03\x00?\xb7\xcf\xe0L \x00\x00"),
 }
 
 var CRDs = crds()
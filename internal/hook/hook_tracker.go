@@ -19,11 +19,22 @@ package hook
 import (
 	"fmt"
 	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const (
 	HookSourceAnnotation = "annotation"
 	HookSourceSpec       = "spec"
+
+	HookTypeExec = "exec"
+	HookTypeHTTP = "http"
+
+	// maxHookOutputLength is the maximum length of the stdout, stderr and response body kept in a
+	// hook result. Longer output is truncated from the beginning, since the end of the output
+	// usually explains why a hook failed.
+	maxHookOutputLength = 4096
+	truncatedPrefix     = "...(truncated) "
 )
 
 // hookTrackerKey identifies a backup/restore hook
@@ -57,10 +68,58 @@ type hookTrackerVal struct {
 	hookExecuted bool
 }
 
+// HookResult records the outcome of a single hook execution. The results of a backup's or a
+// restore's hooks are uploaded to the backup storage location.
+type HookResult struct {
+	// PodNamespace is the namespace of the pod the hook ran in.
+	PodNamespace string `json:"podNamespace"`
+	// PodName is the name of the pod the hook ran in.
+	PodName string `json:"podName"`
+	// Container is the container the hook ran in. It's empty for backup HTTP hooks.
+	Container string `json:"container,omitempty"`
+	// HookName is the name of the hook in the backup/restore spec, or "<from-annotation>".
+	HookName string `json:"hookName,omitempty"`
+	// HookSource is where the hook comes from, either "annotation" or "spec".
+	HookSource string `json:"hookSource"`
+	// HookPhase is "pre" or "post" for backup hooks, and empty for restore hooks.
+	HookPhase string `json:"hookPhase,omitempty"`
+	// HookType is either "exec" or "http".
+	HookType string `json:"hookType"`
+	// Failed indicates if the hook failed.
+	Failed bool `json:"failed"`
+	// Executed indicates if the hook was run. Restore hooks may never run, e.g. if their
+	// container never becomes ready.
+	Executed bool `json:"executed"`
+	// ExitCode is the exit code of the command of an exec hook, if the command completed.
+	ExitCode *int `json:"exitCode,omitempty"`
+	// StatusCode is the status code of the response to an HTTP hook, if one was received.
+	StatusCode int `json:"statusCode,omitempty"`
+	// StartTimestamp is when the hook started.
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+	// Duration is how long the hook ran.
+	Duration metav1.Duration `json:"duration"`
+	// Stdout is the end of the standard output of an exec hook, or of the response body of an
+	// HTTP hook.
+	Stdout string `json:"stdout,omitempty"`
+	// Stderr is the end of the standard error of an exec hook.
+	Stderr string `json:"stderr,omitempty"`
+	// Error is the error the hook failed with.
+	Error string `json:"error,omitempty"`
+}
+
+// truncateHookOutput keeps the last maxHookOutputLength bytes of the output of a hook.
+func truncateHookOutput(output string) string {
+	if len(output) <= maxHookOutputLength {
+		return output
+	}
+	return truncatedPrefix + output[len(output)-maxHookOutputLength:]
+}
+
 // HookTracker tracks all hooks' execution status
 type HookTracker struct {
 	lock    *sync.RWMutex
 	tracker map[hookTrackerKey]hookTrackerVal
+	results []HookResult
 }
 
 // NewHookTracker creates a hookTracker.
@@ -71,6 +130,27 @@ func NewHookTracker() *HookTracker {
 	}
 }
 
+// AddResult adds the result of a hook execution to the tracker. The output of the hook is
+// truncated if it's too long.
+func (ht *HookTracker) AddResult(result HookResult) {
+	ht.lock.Lock()
+	defer ht.lock.Unlock()
+
+	result.Stdout = truncateHookOutput(result.Stdout)
+	result.Stderr = truncateHookOutput(result.Stderr)
+	ht.results = append(ht.results, result)
+}
+
+// Results returns the results of the hook executions in the order they were added.
+func (ht *HookTracker) Results() []HookResult {
+	ht.lock.RLock()
+	defer ht.lock.RUnlock()
+
+	results := make([]HookResult, len(ht.results))
+	copy(results, ht.results)
+	return results
+}
+
 // Add adds a hook to the tracker
 // Add must precede the Record for each individual hook.
 // In other words, a hook must be added to the tracker before its execution result is recorded.
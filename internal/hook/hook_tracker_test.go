@@ -17,9 +17,11 @@ limitations under the License.
 package hook
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewHookTracker(t *testing.T) {
@@ -91,3 +93,20 @@ func TestHookTracker_Get(t *testing.T) {
 
 	t.Logf("tracker :%+v", tr)
 }
+
+func TestHookTracker_AddResult(t *testing.T) {
+	tracker := NewHookTracker()
+	assert.Empty(t, tracker.Results())
+
+	longOutput := strings.Repeat("a", maxHookOutputLength) + "end"
+	tracker.AddResult(HookResult{PodNamespace: "ns1", PodName: "pod1", HookName: "h1", Stdout: "out", Stderr: longOutput})
+	tracker.AddResult(HookResult{PodNamespace: "ns1", PodName: "pod2", HookName: "h2", Failed: true})
+
+	results := tracker.Results()
+	require.Len(t, results, 2)
+	assert.Equal(t, "pod1", results[0].PodName)
+	assert.Equal(t, "out", results[0].Stdout)
+	assert.Equal(t, truncatedPrefix+strings.Repeat("a", maxHookOutputLength-3)+"end", results[0].Stderr)
+	assert.Equal(t, "pod2", results[1].PodName)
+	assert.True(t, results[1].Failed)
+}
@@ -57,6 +57,14 @@ const (
 	podBackupHookOnErrorAnnotationKey   = "hook.backup.velero.io/on-error"
 	podBackupHookTimeoutAnnotationKey   = "hook.backup.velero.io/timeout"
 
+	// Backup HTTP hook annotations
+	podBackupHookHTTPSchemeAnnotationKey              = "hook.backup.velero.io/http-scheme"
+	podBackupHookHTTPPortAnnotationKey                = "hook.backup.velero.io/http-port"
+	podBackupHookHTTPPathAnnotationKey                = "hook.backup.velero.io/http-path"
+	podBackupHookHTTPMethodAnnotationKey              = "hook.backup.velero.io/http-method"
+	podBackupHookHTTPHeadersSecretAnnotationKey       = "hook.backup.velero.io/http-headers-secret"
+	podBackupHookHTTPExpectedStatusCodesAnnotationKey = "hook.backup.velero.io/http-expected-status-codes"
+
 	// Restore hook annotations
 	podRestoreHookContainerAnnotationKey            = "post.hook.restore.velero.io/container"
 	podRestoreHookCommandAnnotationKey              = "post.hook.restore.velero.io/command"
@@ -68,6 +76,53 @@ const (
 	podRestoreHookInitContainerNameAnnotationKey    = "init.hook.restore.velero.io/container-name"
 	podRestoreHookInitContainerCommandAnnotationKey = "init.hook.restore.velero.io/command"
 	podRestoreHookInitContainerTimeoutAnnotationKey = "init.hook.restore.velero.io/timeout"
+
+	// Restore HTTP hook annotations
+	podRestoreHookHTTPSchemeAnnotationKey              = "post.hook.restore.velero.io/http-scheme"
+	podRestoreHookHTTPPortAnnotationKey                = "post.hook.restore.velero.io/http-port"
+	podRestoreHookHTTPPathAnnotationKey                = "post.hook.restore.velero.io/http-path"
+	podRestoreHookHTTPMethodAnnotationKey              = "post.hook.restore.velero.io/http-method"
+	podRestoreHookHTTPHeadersSecretAnnotationKey       = "post.hook.restore.velero.io/http-headers-secret"
+	podRestoreHookHTTPExpectedStatusCodesAnnotationKey = "post.hook.restore.velero.io/http-expected-status-codes"
+	podRestoreHookHTTPTimeoutAnnotationKey             = "post.hook.restore.velero.io/http-timeout"
+)
+
+// httpHookAnnotationKeys are the keys of the annotations specifying an HTTP hook. Unlike the hooks of
+// the Backup and Restore specs, hooks specified as annotations can't set a URL: anyone allowed to
+// annotate a pod could otherwise have Velero send requests, with the headers secret, anywhere.
+type httpHookAnnotationKeys struct {
+	scheme              string
+	port                string
+	path                string
+	method              string
+	headersSecret       string
+	expectedStatusCodes string
+	onError             string
+	timeout             string
+}
+
+var (
+	podBackupHookHTTPAnnotationKeys = httpHookAnnotationKeys{
+		scheme:              podBackupHookHTTPSchemeAnnotationKey,
+		port:                podBackupHookHTTPPortAnnotationKey,
+		path:                podBackupHookHTTPPathAnnotationKey,
+		method:              podBackupHookHTTPMethodAnnotationKey,
+		headersSecret:       podBackupHookHTTPHeadersSecretAnnotationKey,
+		expectedStatusCodes: podBackupHookHTTPExpectedStatusCodesAnnotationKey,
+		onError:             podBackupHookOnErrorAnnotationKey,
+		timeout:             podBackupHookTimeoutAnnotationKey,
+	}
+
+	podRestoreHookHTTPAnnotationKeys = httpHookAnnotationKeys{
+		scheme:              podRestoreHookHTTPSchemeAnnotationKey,
+		port:                podRestoreHookHTTPPortAnnotationKey,
+		path:                podRestoreHookHTTPPathAnnotationKey,
+		method:              podRestoreHookHTTPMethodAnnotationKey,
+		headersSecret:       podRestoreHookHTTPHeadersSecretAnnotationKey,
+		expectedStatusCodes: podRestoreHookHTTPExpectedStatusCodesAnnotationKey,
+		onError:             podRestoreHookOnErrorAnnotationKey,
+		timeout:             podRestoreHookHTTPTimeoutAnnotationKey,
+	}
 )
 
 // ItemHookHandler invokes hooks for an item.
@@ -193,6 +248,7 @@ func (i *InitContainerRestoreHookHandler) HandleRestoreHooks(
 // DefaultItemHookHandler is the default itemHookHandler.
 type DefaultItemHookHandler struct {
 	PodCommandExecutor podexec.PodCommandExecutor
+	PodHTTPExecutor    podexec.PodHTTPExecutor
 }
 
 func (h *DefaultItemHookHandler) HandleHooks(
@@ -235,7 +291,7 @@ func (h *DefaultItemHookHandler) HandleHooks(
 
 		hookFailed := false
 		var errExec error
-		if errExec = h.PodCommandExecutor.ExecutePodCommand(hookLog, obj.UnstructuredContent(), namespace, name, "<from-annotation>", hookFromAnnotations); errExec != nil {
+		if errExec = h.handleExecHook(hookLog, obj, namespace, name, HookSourceAnnotation, "<from-annotation>", phase, hookFromAnnotations, hookTracker); errExec != nil {
 			hookLog.WithError(errExec).Error("Error executing hook")
 			hookFailed = true
 		}
@@ -251,6 +307,19 @@ func (h *DefaultItemHookHandler) HandleHooks(
 		return nil
 	}
 
+	httpHookFromAnnotations := getPodHTTPHookFromAnnotations(metadata.GetAnnotations(), phase, log)
+	if phase == PhasePre && httpHookFromAnnotations == nil {
+		httpHookFromAnnotations = getPodHTTPHookFromAnnotations(metadata.GetAnnotations(), "", log)
+	}
+	if httpHookFromAnnotations != nil {
+		errHTTP := h.handleHTTPHook(log, obj, namespace, name, HookSourceAnnotation, "<from-annotation>", phase, httpHookFromAnnotations, hookTracker)
+		if errHTTP != nil && httpHookFromAnnotations.OnError == velerov1api.HookErrorModeFail {
+			return errHTTP
+		}
+
+		return nil
+	}
+
 	labels := labels.Set(metadata.GetLabels())
 	// Otherwise, check for hooks defined in the backup spec.
 	// modeFailError records the error from the hook with "Fail" error mode
@@ -283,7 +352,7 @@ func (h *DefaultItemHookHandler) HandleHooks(
 						)
 
 						hookFailed := false
-						err := h.PodCommandExecutor.ExecutePodCommand(hookLog, obj.UnstructuredContent(), namespace, name, resourceHook.Name, hook.Exec)
+						err := h.handleExecHook(hookLog, obj, namespace, name, HookSourceSpec, resourceHook.Name, phase, hook.Exec, hookTracker)
 						if err != nil {
 							hookLog.WithError(err).Error("Error executing hook")
 							hookFailed = true
@@ -297,6 +366,16 @@ func (h *DefaultItemHookHandler) HandleHooks(
 						}
 					}
 				}
+				if hook.HTTP != nil {
+					if modeFailError == nil {
+						err := h.handleHTTPHook(log, obj, namespace, name, HookSourceSpec, resourceHook.Name, phase, hook.HTTP, hookTracker)
+						if err != nil && hook.HTTP.OnError == velerov1api.HookErrorModeFail {
+							modeFailError = err
+						}
+					} else {
+						hookTracker.Add(namespace, name, "", HookSourceSpec, resourceHook.Name, phase)
+					}
+				}
 			}
 		}
 	}
@@ -304,6 +383,120 @@ func (h *DefaultItemHookHandler) HandleHooks(
 	return modeFailError
 }
 
+// handleHTTPHook sends the request of an HTTP hook and records its result in the hook tracker.
+// HTTP hooks don't run in a container, so they're tracked without one.
+func (h *DefaultItemHookHandler) handleHTTPHook(
+	log logrus.FieldLogger,
+	obj runtime.Unstructured,
+	namespace, name, source, hookName string,
+	phase hookPhase,
+	hook *velerov1api.HTTPHook,
+	hookTracker *HookTracker,
+) error {
+	hookTracker.Add(namespace, name, "", source, hookName, phase)
+
+	hookLog := log.WithFields(
+		logrus.Fields{
+			"hookSource": source,
+			"hookType":   HookTypeHTTP,
+			"hookPhase":  phase,
+		},
+	)
+
+	hookFailed := false
+	var (
+		result *podexec.HTTPResult
+		err    error
+	)
+	start := time.Now()
+	if h.PodHTTPExecutor == nil {
+		err = errors.New("no executor is configured for http hooks")
+	} else {
+		result, err = h.PodHTTPExecutor.ExecutePodHTTPHook(hookLog, obj.UnstructuredContent(), namespace, name, hookName, hook)
+	}
+	hookTracker.AddResult(newHTTPHookResult(namespace, name, "", source, hookName, phase, start, result, err))
+	if err != nil {
+		hookLog.WithError(err).Error("Error executing hook")
+		hookFailed = true
+	}
+
+	errTracker := hookTracker.Record(namespace, name, "", source, hookName, phase, hookFailed)
+	if errTracker != nil {
+		hookLog.WithError(errTracker).Warn("Error recording the hook in hook tracker")
+	}
+
+	return err
+}
+
+// handleExecHook executes the command of an exec hook and adds its result to the hook tracker.
+func (h *DefaultItemHookHandler) handleExecHook(
+	log logrus.FieldLogger,
+	obj runtime.Unstructured,
+	namespace, name, source, hookName string,
+	phase hookPhase,
+	hook *velerov1api.ExecHook,
+	hookTracker *HookTracker,
+) error {
+	start := time.Now()
+	result, err := executePodCommand(h.PodCommandExecutor, log, obj.UnstructuredContent(), namespace, name, hookName, hook)
+	hookTracker.AddResult(newExecHookResult(namespace, name, hook.Container, source, hookName, phase, start, result, err))
+	return err
+}
+
+// executePodCommand executes the command of an exec hook. The result of the command is only
+// returned if the executor is able to capture it.
+func executePodCommand(
+	executor podexec.PodCommandExecutor,
+	log logrus.FieldLogger,
+	item map[string]interface{},
+	namespace, name, hookName string,
+	hook *velerov1api.ExecHook,
+) (*podexec.ExecResult, error) {
+	if resultExecutor, ok := executor.(podexec.PodCommandResultExecutor); ok {
+		return resultExecutor.ExecutePodCommandWithResult(log, item, namespace, name, hookName, hook)
+	}
+	return nil, executor.ExecutePodCommand(log, item, namespace, name, hookName, hook)
+}
+
+func newHookResult(namespace, name, container, source, hookName string, phase hookPhase, hookType string, start time.Time, err error) HookResult {
+	result := HookResult{
+		PodNamespace:   namespace,
+		PodName:        name,
+		Container:      container,
+		HookName:       hookName,
+		HookSource:     source,
+		HookPhase:      string(phase),
+		HookType:       hookType,
+		Failed:         err != nil,
+		Executed:       true,
+		StartTimestamp: &metav1.Time{Time: start},
+		Duration:       metav1.Duration{Duration: time.Since(start)},
+	}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	return result
+}
+
+func newExecHookResult(namespace, name, container, source, hookName string, phase hookPhase, start time.Time, execResult *podexec.ExecResult, err error) HookResult {
+	result := newHookResult(namespace, name, container, source, hookName, phase, HookTypeExec, start, err)
+	if execResult != nil {
+		result.ExitCode = execResult.ExitCode
+		result.Stdout = execResult.Stdout
+		result.Stderr = execResult.Stderr
+	}
+	return result
+}
+
+func newHTTPHookResult(namespace, name, container, source, hookName string, phase hookPhase, start time.Time, httpResult *podexec.HTTPResult, err error) HookResult {
+	result := newHookResult(namespace, name, container, source, hookName, phase, HookTypeHTTP, start, err)
+	if httpResult != nil {
+		result.StatusCode = httpResult.StatusCode
+		result.Stdout = httpResult.Body
+	}
+	return result
+}
+
 // NoOpItemHookHandler is the an itemHookHandler for the Finalize controller where hooks don't run
 type NoOpItemHookHandler struct{}
 
@@ -363,6 +556,68 @@ func getPodExecHookFromAnnotations(annotations map[string]string, phase hookPhas
 	}
 }
 
+// getPodHTTPHookFromAnnotations returns an HTTPHook based on the annotations, as long as the
+// 'http-port' annotation is present. If it's absent, this returns nil.
+func getPodHTTPHookFromAnnotations(annotations map[string]string, phase hookPhase, log logrus.FieldLogger) *velerov1api.HTTPHook {
+	return getHTTPHookFromAnnotations(func(key string) string {
+		return getHookAnnotation(annotations, key, phase)
+	}, podBackupHookHTTPAnnotationKeys, log)
+}
+
+// getHTTPHookFromAnnotations returns an HTTPHook sending its request to the pod, based on the
+// annotations returned by get for the given keys, as long as the port annotation is present. If
+// it's absent, or the port can't be parsed, this returns nil.
+func getHTTPHookFromAnnotations(get func(key string) string, keys httpHookAnnotationKeys, log logrus.FieldLogger) *velerov1api.HTTPHook {
+	portString := get(keys.port)
+	if portString == "" {
+		return nil
+	}
+
+	port, err := strconv.ParseInt(portString, 10, 32)
+	if err != nil {
+		log.Warn(errors.Wrapf(err, "Unable to parse provided port %s", portString))
+		return nil
+	}
+
+	var expectedStatusCodes []int32
+	if codes := get(keys.expectedStatusCodes); codes != "" {
+		for _, code := range strings.Split(codes, ",") {
+			temp, err := strconv.ParseInt(strings.TrimSpace(code), 10, 32)
+			if err != nil {
+				log.Warn(errors.Wrapf(err, "Unable to parse provided status code %s, ignoring", code))
+				continue
+			}
+			expectedStatusCodes = append(expectedStatusCodes, int32(temp))
+		}
+	}
+
+	onError := velerov1api.HookErrorMode(get(keys.onError))
+	if onError != velerov1api.HookErrorModeContinue && onError != velerov1api.HookErrorModeFail {
+		onError = ""
+	}
+
+	var timeout time.Duration
+	timeoutString := get(keys.timeout)
+	if timeoutString != "" {
+		if temp, err := time.ParseDuration(timeoutString); err == nil {
+			timeout = temp
+		} else {
+			log.Warn(errors.Wrapf(err, "Unable to parse provided timeout %s, using default", timeoutString))
+		}
+	}
+
+	return &velerov1api.HTTPHook{
+		Scheme:              get(keys.scheme),
+		Port:                int32(port),
+		Path:                get(keys.path),
+		Method:              get(keys.method),
+		HeadersSecret:       get(keys.headersSecret),
+		ExpectedStatusCodes: expectedStatusCodes,
+		OnError:             onError,
+		Timeout:             metav1.Duration{Duration: timeout},
+	}
+}
+
 func parseStringToCommand(commandValue string) []string {
 	var command []string
 	// check for json array
@@ -499,6 +754,41 @@ func getPodExecRestoreHookFromAnnotations(annotations map[string]string, log log
 		}
 	}
 
+	waitTimeout, waitForReady := getRestoreHookWaitFromAnnotations(annotations, log)
+
+	return &velerov1api.ExecRestoreHook{
+		Container:    container,
+		Command:      parseStringToCommand(commandValue),
+		OnError:      onError,
+		ExecTimeout:  metav1.Duration{Duration: execTimeout},
+		WaitTimeout:  metav1.Duration{Duration: waitTimeout},
+		WaitForReady: waitForReady,
+	}
+}
+
+// getPodHTTPRestoreHookFromAnnotations returns an HTTPRestoreHook based on restore annotations, as
+// long as the 'http-port' annotation is present. If it's absent, this returns nil.
+func getPodHTTPRestoreHookFromAnnotations(annotations map[string]string, log logrus.FieldLogger) *velerov1api.HTTPRestoreHook {
+	httpHook := getHTTPHookFromAnnotations(func(key string) string {
+		return annotations[key]
+	}, podRestoreHookHTTPAnnotationKeys, log)
+	if httpHook == nil {
+		return nil
+	}
+
+	waitTimeout, waitForReady := getRestoreHookWaitFromAnnotations(annotations, log)
+
+	return &velerov1api.HTTPRestoreHook{
+		HTTPHook:     *httpHook,
+		Container:    annotations[podRestoreHookContainerAnnotationKey],
+		WaitTimeout:  metav1.Duration{Duration: waitTimeout},
+		WaitForReady: waitForReady,
+	}
+}
+
+// getRestoreHookWaitFromAnnotations returns the wait timeout and whether to wait for the container
+// to be ready of a restore hook specified in annotations.
+func getRestoreHookWaitFromAnnotations(annotations map[string]string, log logrus.FieldLogger) (time.Duration, *bool) {
 	var waitTimeout time.Duration
 	waitTimeoutString := annotations[podRestoreHookWaitTimeoutAnnotationKey]
 	if waitTimeoutString != "" {
@@ -519,21 +809,40 @@ func getPodExecRestoreHookFromAnnotations(annotations map[string]string, log log
 		}
 	}
 
-	return &velerov1api.ExecRestoreHook{
-		Container:    container,
-		Command:      parseStringToCommand(commandValue),
-		OnError:      onError,
-		ExecTimeout:  metav1.Duration{Duration: execTimeout},
-		WaitTimeout:  metav1.Duration{Duration: waitTimeout},
-		WaitForReady: waitForReady,
-	}
+	return waitTimeout, waitForReady
 }
 
 type PodExecRestoreHook struct {
 	HookName   string
 	HookSource string
 	Hook       velerov1api.ExecRestoreHook
-	executed   bool
+	// HTTP is set for HTTP hooks, which use the container, wait and error settings of Hook but
+	// send an HTTP request instead of executing a command.
+	HTTP     *velerov1api.HTTPHook
+	executed bool
+}
+
+func (h PodExecRestoreHook) hookType() string {
+	if h.HTTP != nil {
+		return HookTypeHTTP
+	}
+	return HookTypeExec
+}
+
+// newPodHTTPRestoreHook returns a PodExecRestoreHook sending the request of an HTTPRestoreHook.
+func newPodHTTPRestoreHook(hookName, hookSource string, hook *velerov1api.HTTPRestoreHook) PodExecRestoreHook {
+	httpHook := hook.HTTPHook
+	return PodExecRestoreHook{
+		HookName:   hookName,
+		HookSource: hookSource,
+		Hook: velerov1api.ExecRestoreHook{
+			Container:    hook.Container,
+			OnError:      hook.OnError,
+			WaitTimeout:  hook.WaitTimeout,
+			WaitForReady: hook.WaitForReady,
+		},
+		HTTP: &httpHook,
+	}
 }
 
 // GroupRestoreExecHooks returns a list of hooks to be executed in a pod grouped by
@@ -570,6 +879,16 @@ func GroupRestoreExecHooks(
 		}
 		return byContainer, nil
 	}
+	httpHookFromAnnotation := getPodHTTPRestoreHookFromAnnotations(metadata.GetAnnotations(), log)
+	if httpHookFromAnnotation != nil {
+		named := newPodHTTPRestoreHook("<from-annotation>", HookSourceAnnotation, httpHookFromAnnotation)
+		if named.Hook.Container == "" {
+			named.Hook.Container = pod.Spec.Containers[0].Name
+		}
+		hookTrack.Add(metadata.GetNamespace(), metadata.GetName(), named.Hook.Container, HookSourceAnnotation, "<from-annotation>", hookPhase(""))
+		byContainer[named.Hook.Container] = []PodExecRestoreHook{named}
+		return byContainer, nil
+	}
 
 	// No hook found on pod's annotations so check for applicable hooks from the restore spec
 	labels := metadata.GetLabels()
@@ -579,14 +898,19 @@ func GroupRestoreExecHooks(
 			continue
 		}
 		for _, rh := range rrh.RestoreHooks {
-			if rh.Exec == nil {
+			var named PodExecRestoreHook
+			switch {
+			case rh.Exec != nil:
+				named = PodExecRestoreHook{
+					HookName:   rrh.Name,
+					Hook:       *rh.Exec,
+					HookSource: HookSourceSpec,
+				}
+			case rh.HTTP != nil:
+				named = newPodHTTPRestoreHook(rrh.Name, HookSourceSpec, rh.HTTP)
+			default:
 				continue
 			}
-			named := PodExecRestoreHook{
-				HookName:   rrh.Name,
-				Hook:       *rh.Exec,
-				HookSource: HookSourceSpec,
-			}
 			// default to false if attr WaitForReady not set
 			if named.Hook.WaitForReady == nil {
 				named.Hook.WaitForReady = boolptr.False()
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -35,6 +36,7 @@ import (
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/podexec"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
@@ -2478,3 +2480,470 @@ func TestRestoreHookTrackerAdd(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleHTTPHooks(t *testing.T) {
+	tests := []struct {
+		name                 string
+		phase                hookPhase
+		annotations          map[string]string
+		hooks                []ResourceHook
+		expectedHooks        map[string]*velerov1api.HTTPHook
+		hookErrorsByName     map[string]error
+		expectedError        string
+		expectedHookAttempts int
+		expectedHookFailures int
+	}{
+		{
+			name:  "pod, annotation = run annotation",
+			phase: PhasePre,
+			annotations: map[string]string{
+				podBackupHookHTTPPortAnnotationKey: "8080",
+				podBackupHookHTTPPathAnnotationKey: "/quiesce",
+			},
+			expectedHooks: map[string]*velerov1api.HTTPHook{
+				"<from-annotation>": {Port: 8080, Path: "/quiesce"},
+			},
+			expectedHookAttempts: 1,
+		},
+		{
+			name:  "pod, annotation with fail error mode, request fails = return error",
+			phase: PhasePost,
+			annotations: map[string]string{
+				phasedKey(PhasePost, podBackupHookHTTPPortAnnotationKey):          "8080",
+				phasedKey(PhasePost, podBackupHookHTTPPathAnnotationKey):          "/unquiesce",
+				phasedKey(PhasePost, podBackupHookOnErrorAnnotationKey):           string(velerov1api.HookErrorModeFail),
+				phasedKey(PhasePost, podBackupHookHTTPMethodAnnotationKey):        "PUT",
+				phasedKey(PhasePost, podBackupHookHTTPHeadersSecretAnnotationKey): "headers",
+			},
+			expectedHooks: map[string]*velerov1api.HTTPHook{
+				"<from-annotation>": {
+					Port:          8080,
+					Path:          "/unquiesce",
+					Method:        "PUT",
+					HeadersSecret: "headers",
+					OnError:       velerov1api.HookErrorModeFail,
+				},
+			},
+			hookErrorsByName: map[string]error{
+				"<from-annotation>": errors.New("unexpected status code 500"),
+			},
+			expectedError:        "unexpected status code 500",
+			expectedHookAttempts: 1,
+			expectedHookFailures: 1,
+		},
+		{
+			name:  "pod, no annotation, spec with exec and http hooks = run both",
+			phase: PhasePre,
+			hooks: []ResourceHook{
+				{
+					Name: "hook1",
+					Pre: []velerov1api.BackupResourceHook{
+						{
+							Exec: &velerov1api.ExecHook{
+								Container: "1a",
+								Command:   []string{"pre-1a"},
+							},
+						},
+					},
+				},
+				{
+					Name: "hook2",
+					Pre: []velerov1api.BackupResourceHook{
+						{
+							HTTP: &velerov1api.HTTPHook{
+								Port: 8080,
+							},
+						},
+					},
+				},
+			},
+			expectedHooks: map[string]*velerov1api.HTTPHook{
+				"hook2": {Port: 8080},
+			},
+			expectedHookAttempts: 2,
+		},
+		{
+			name:  "pod, no annotation, spec with failed http hook in fail error mode = stop executing hooks",
+			phase: PhasePre,
+			hooks: []ResourceHook{
+				{
+					Name: "hook1",
+					Pre: []velerov1api.BackupResourceHook{
+						{
+							HTTP: &velerov1api.HTTPHook{
+								Port:    8080,
+								OnError: velerov1api.HookErrorModeFail,
+							},
+						},
+					},
+				},
+				{
+					Name: "hook2",
+					Pre: []velerov1api.BackupResourceHook{
+						{
+							HTTP: &velerov1api.HTTPHook{
+								Port: 8081,
+							},
+						},
+					},
+				},
+			},
+			expectedHooks: map[string]*velerov1api.HTTPHook{
+				"hook1": {Port: 8080, OnError: velerov1api.HookErrorModeFail},
+			},
+			hookErrorsByName: map[string]error{
+				"hook1": errors.New("connection refused"),
+			},
+			expectedError:        "connection refused",
+			expectedHookAttempts: 1,
+			expectedHookFailures: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			podCommandExecutor := &velerotest.MockPodCommandExecutor{}
+			defer podCommandExecutor.AssertExpectations(t)
+			podHTTPExecutor := &mockPodHTTPExecutor{}
+			defer podHTTPExecutor.AssertExpectations(t)
+
+			h := &DefaultItemHookHandler{
+				PodCommandExecutor: podCommandExecutor,
+				PodHTTPExecutor:    podHTTPExecutor,
+			}
+
+			pod := builder.ForPod("ns", "name").ObjectMeta(builder.WithAnnotationsMap(test.annotations)).Result()
+			obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+			require.NoError(t, err)
+			item := &unstructured.Unstructured{Object: obj}
+
+			for _, resourceHook := range test.hooks {
+				for _, hook := range resourceHook.Pre {
+					if hook.Exec != nil {
+						podCommandExecutor.On("ExecutePodCommand", mock.Anything, item.UnstructuredContent(), "ns", "name", resourceHook.Name, hook.Exec).Return(nil)
+					}
+				}
+			}
+			for hookName, hook := range test.expectedHooks {
+				podHTTPExecutor.On("ExecutePodHTTPHook", mock.Anything, item.UnstructuredContent(), "ns", "name", hookName, hook).Return(nil, test.hookErrorsByName[hookName])
+			}
+
+			hookTracker := NewHookTracker()
+			err = h.HandleHooks(velerotest.NewLogger(), kuberesource.Pods, item, test.hooks, test.phase, hookTracker)
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			attempted, failed := hookTracker.Stat()
+			assert.Equal(t, test.expectedHookAttempts, attempted)
+			assert.Equal(t, test.expectedHookFailures, failed)
+		})
+	}
+}
+
+func TestHandleHooksResults(t *testing.T) {
+	exitCode := func(code int) *int { return &code }
+
+	pod := builder.ForPod("ns", "name").ObjectMeta(builder.WithAnnotations(
+		podBackupHookContainerAnnotationKey, "container1",
+		podBackupHookCommandAnnotationKey, "/usr/bin/foo",
+	)).Result()
+	obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	require.NoError(t, err)
+	annotatedItem := &unstructured.Unstructured{Object: obj}
+
+	obj, err = runtime.DefaultUnstructuredConverter.ToUnstructured(builder.ForPod("ns", "name").Result())
+	require.NoError(t, err)
+	item := &unstructured.Unstructured{Object: obj}
+
+	execHook := &velerov1api.ExecHook{Container: "container2", Command: []string{"/usr/bin/bar"}}
+	httpHook := &velerov1api.HTTPHook{Port: 8080}
+	hooks := []ResourceHook{
+		{
+			Name: "exec-hook",
+			Pre:  []velerov1api.BackupResourceHook{{Exec: execHook}},
+		},
+		{
+			Name: "http-hook",
+			Pre:  []velerov1api.BackupResourceHook{{HTTP: httpHook}},
+		},
+	}
+
+	t.Run("exec hook from annotation", func(t *testing.T) {
+		podCommandExecutor := &mockPodCommandResultExecutor{}
+		defer podCommandExecutor.AssertExpectations(t)
+		podCommandExecutor.On("ExecutePodCommandWithResult", mock.Anything, annotatedItem.UnstructuredContent(), "ns", "name", "<from-annotation>", mock.Anything).
+			Return(&podexec.ExecResult{ExitCode: exitCode(1), Stdout: "out", Stderr: "err"}, errors.New("command terminated with exit code 1"))
+
+		h := &DefaultItemHookHandler{PodCommandExecutor: podCommandExecutor}
+		hookTracker := NewHookTracker()
+		require.NoError(t, h.HandleHooks(velerotest.NewLogger(), kuberesource.Pods, annotatedItem, nil, PhasePre, hookTracker))
+
+		results := hookTracker.Results()
+		require.Len(t, results, 1)
+		assert.NotNil(t, results[0].StartTimestamp)
+		results[0].StartTimestamp = nil
+		results[0].Duration = metav1.Duration{}
+		assert.Equal(t, HookResult{
+			PodNamespace: "ns",
+			PodName:      "name",
+			Container:    "container1",
+			HookName:     "<from-annotation>",
+			HookSource:   HookSourceAnnotation,
+			HookPhase:    "pre",
+			HookType:     HookTypeExec,
+			Failed:       true,
+			Executed:     true,
+			ExitCode:     exitCode(1),
+			Stdout:       "out",
+			Stderr:       "err",
+			Error:        "command terminated with exit code 1",
+		}, results[0])
+	})
+
+	t.Run("exec and http hooks from spec", func(t *testing.T) {
+		podCommandExecutor := &mockPodCommandResultExecutor{}
+		defer podCommandExecutor.AssertExpectations(t)
+		podCommandExecutor.On("ExecutePodCommandWithResult", mock.Anything, item.UnstructuredContent(), "ns", "name", "exec-hook", execHook).
+			Return(&podexec.ExecResult{ExitCode: exitCode(0), Stdout: "done"}, nil)
+		podHTTPExecutor := &mockPodHTTPExecutor{}
+		defer podHTTPExecutor.AssertExpectations(t)
+		podHTTPExecutor.On("ExecutePodHTTPHook", mock.Anything, item.UnstructuredContent(), "ns", "name", "http-hook", httpHook).
+			Return(&podexec.HTTPResult{StatusCode: 200, Body: "quiesced"}, nil)
+
+		h := &DefaultItemHookHandler{PodCommandExecutor: podCommandExecutor, PodHTTPExecutor: podHTTPExecutor}
+		hookTracker := NewHookTracker()
+		require.NoError(t, h.HandleHooks(velerotest.NewLogger(), kuberesource.Pods, item, hooks, PhasePre, hookTracker))
+
+		results := hookTracker.Results()
+		require.Len(t, results, 2)
+		assert.Equal(t, "exec-hook", results[0].HookName)
+		assert.Equal(t, "container2", results[0].Container)
+		assert.Equal(t, HookTypeExec, results[0].HookType)
+		assert.Equal(t, exitCode(0), results[0].ExitCode)
+		assert.Equal(t, "done", results[0].Stdout)
+		assert.False(t, results[0].Failed)
+		assert.Equal(t, "http-hook", results[1].HookName)
+		assert.Equal(t, HookTypeHTTP, results[1].HookType)
+		assert.Equal(t, 200, results[1].StatusCode)
+		assert.Equal(t, "quiesced", results[1].Stdout)
+		assert.False(t, results[1].Failed)
+	})
+
+	t.Run("executor not capturing results", func(t *testing.T) {
+		podCommandExecutor := &velerotest.MockPodCommandExecutor{}
+		defer podCommandExecutor.AssertExpectations(t)
+		podCommandExecutor.On("ExecutePodCommand", mock.Anything, item.UnstructuredContent(), "ns", "name", "exec-hook", execHook).Return(errors.New("failed"))
+
+		h := &DefaultItemHookHandler{PodCommandExecutor: podCommandExecutor}
+		hookTracker := NewHookTracker()
+		require.NoError(t, h.HandleHooks(velerotest.NewLogger(), kuberesource.Pods, item, hooks[:1], PhasePre, hookTracker))
+
+		results := hookTracker.Results()
+		require.Len(t, results, 1)
+		assert.True(t, results[0].Failed)
+		assert.Equal(t, "failed", results[0].Error)
+		assert.Nil(t, results[0].ExitCode)
+	})
+}
+
+func TestGetPodHTTPHookFromAnnotations(t *testing.T) {
+	phases := []hookPhase{"", PhasePre, PhasePost}
+	for _, phase := range phases {
+		tests := []struct {
+			name         string
+			annotations  map[string]string
+			expectedHook *velerov1api.HTTPHook
+		}{
+			{
+				name:         "missing port annotation",
+				expectedHook: nil,
+			},
+			{
+				name: "command annotation only",
+				annotations: map[string]string{
+					phasedKey(phase, podBackupHookCommandAnnotationKey): "/usr/bin/foo",
+				},
+				expectedHook: nil,
+			},
+			{
+				name: "url isn't supported in annotations",
+				annotations: map[string]string{
+					phasedKey(phase, "hook.backup.velero.io/http-url"): "https://example.com/quiesce",
+				},
+				expectedHook: nil,
+			},
+			{
+				name: "invalid port",
+				annotations: map[string]string{
+					phasedKey(phase, podBackupHookHTTPPortAnnotationKey): "http",
+				},
+				expectedHook: nil,
+			},
+			{
+				name: "all annotations",
+				annotations: map[string]string{
+					phasedKey(phase, podBackupHookHTTPSchemeAnnotationKey):              "https",
+					phasedKey(phase, podBackupHookHTTPPortAnnotationKey):                "8443",
+					phasedKey(phase, podBackupHookHTTPPathAnnotationKey):                "/quiesce",
+					phasedKey(phase, podBackupHookHTTPMethodAnnotationKey):              "PUT",
+					phasedKey(phase, podBackupHookHTTPHeadersSecretAnnotationKey):       "headers",
+					phasedKey(phase, podBackupHookHTTPExpectedStatusCodesAnnotationKey): "200, 204,abc",
+					phasedKey(phase, podBackupHookOnErrorAnnotationKey):                 string(velerov1api.HookErrorModeContinue),
+					phasedKey(phase, podBackupHookTimeoutAnnotationKey):                 "5s",
+				},
+				expectedHook: &velerov1api.HTTPHook{
+					Scheme:              "https",
+					Port:                8443,
+					Path:                "/quiesce",
+					Method:              "PUT",
+					HeadersSecret:       "headers",
+					ExpectedStatusCodes: []int32{200, 204},
+					OnError:             velerov1api.HookErrorModeContinue,
+					Timeout:             metav1.Duration{Duration: 5 * time.Second},
+				},
+			},
+		}
+
+		for _, test := range tests {
+			t.Run(fmt.Sprintf("%s (phase=%q)", test.name, phase), func(t *testing.T) {
+				l := velerotest.NewLogger()
+				hook := getPodHTTPHookFromAnnotations(test.annotations, phase, l)
+				assert.Equal(t, test.expectedHook, hook)
+			})
+		}
+	}
+}
+
+func TestGroupRestoreHTTPHooks(t *testing.T) {
+	hookTracker := NewHookTracker()
+
+	testCases := []struct {
+		name                 string
+		resourceRestoreHooks []ResourceRestoreHook
+		pod                  *corev1api.Pod
+		expected             map[string][]PodExecRestoreHook
+	}{
+		{
+			name: "should return hook from annotation when no spec hooks are set",
+			pod: builder.ForPod("default", "my-pod").
+				ObjectMeta(builder.WithAnnotations(
+					podRestoreHookHTTPPortAnnotationKey, "8080",
+					podRestoreHookHTTPPathAnnotationKey, "/unquiesce",
+					podRestoreHookOnErrorAnnotationKey, string(velerov1api.HookErrorModeContinue),
+					podRestoreHookHTTPTimeoutAnnotationKey, "1s",
+					podRestoreHookWaitTimeoutAnnotationKey, "1m",
+					podRestoreHookWaitForReadyAnnotationKey, "true",
+				)).
+				Containers(&corev1api.Container{
+					Name: "container1",
+				}).
+				Result(),
+			expected: map[string][]PodExecRestoreHook{
+				"container1": {
+					{
+						HookName:   "<from-annotation>",
+						HookSource: HookSourceAnnotation,
+						Hook: velerov1api.ExecRestoreHook{
+							Container:    "container1",
+							OnError:      velerov1api.HookErrorModeContinue,
+							WaitTimeout:  metav1.Duration{Duration: time.Minute},
+							WaitForReady: boolptr.True(),
+						},
+						HTTP: &velerov1api.HTTPHook{
+							Port:    8080,
+							Path:    "/unquiesce",
+							OnError: velerov1api.HookErrorModeContinue,
+							Timeout: metav1.Duration{Duration: time.Second},
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "should return exec and http hooks from spec, defaulting the container to the first one",
+			resourceRestoreHooks: []ResourceRestoreHook{
+				{
+					Name:     "hook1",
+					Selector: ResourceHookSelector{},
+					RestoreHooks: []velerov1api.RestoreResourceHook{
+						{
+							Exec: &velerov1api.ExecRestoreHook{
+								Container: "container1",
+								Command:   []string{"/usr/bin/foo"},
+							},
+						},
+						{
+							HTTP: &velerov1api.HTTPRestoreHook{
+								HTTPHook: velerov1api.HTTPHook{
+									URL: "http://example.com/unquiesce",
+								},
+								WaitTimeout: metav1.Duration{Duration: time.Minute},
+							},
+						},
+					},
+				},
+			},
+			pod: builder.ForPod("default", "my-pod").
+				Containers(&corev1api.Container{
+					Name: "container1",
+				}).
+				Result(),
+			expected: map[string][]PodExecRestoreHook{
+				"container1": {
+					{
+						HookName:   "hook1",
+						HookSource: HookSourceSpec,
+						Hook: velerov1api.ExecRestoreHook{
+							Container:    "container1",
+							Command:      []string{"/usr/bin/foo"},
+							WaitForReady: boolptr.False(),
+						},
+					},
+					{
+						HookName:   "hook1",
+						HookSource: HookSourceSpec,
+						Hook: velerov1api.ExecRestoreHook{
+							Container:    "container1",
+							WaitTimeout:  metav1.Duration{Duration: time.Minute},
+							WaitForReady: boolptr.False(),
+						},
+						HTTP: &velerov1api.HTTPHook{
+							URL: "http://example.com/unquiesce",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual, err := GroupRestoreExecHooks(tc.resourceRestoreHooks, tc.pod, velerotest.NewLogger(), hookTracker)
+			assert.Nil(t, err)
+			assert.Equal(t, tc.expected, actual)
+		})
+	}
+}
+
+type mockPodHTTPExecutor struct {
+	mock.Mock
+}
+
+func (e *mockPodHTTPExecutor) ExecutePodHTTPHook(log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *velerov1api.HTTPHook) (*podexec.HTTPResult, error) {
+	args := e.Called(log, item, namespace, name, hookName, hook)
+	result, _ := args.Get(0).(*podexec.HTTPResult)
+	return result, args.Error(1)
+}
+
+type mockPodCommandResultExecutor struct {
+	velerotest.MockPodCommandExecutor
+}
+
+func (e *mockPodCommandResultExecutor) ExecutePodCommandWithResult(log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *velerov1api.ExecHook) (*podexec.ExecResult, error) {
+	args := e.Called(log, item, namespace, name, hookName, hook)
+	result, _ := args.Get(0).(*podexec.ExecResult)
+	return result, args.Error(1)
+}
@@ -65,6 +65,7 @@ var _ ListWatchFactory = &DefaultListWatchFactory{}
 type DefaultWaitExecHookHandler struct {
 	ListWatchFactory   ListWatchFactory
 	PodCommandExecutor podexec.PodCommandExecutor
+	PodHTTPExecutor    podexec.PodHTTPExecutor
 }
 
 var _ WaitExecHookHandler = &DefaultWaitExecHookHandler{}
@@ -157,7 +158,7 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 				hookLog := podLog.WithFields(
 					logrus.Fields{
 						"hookSource": hook.HookSource,
-						"hookType":   "exec",
+						"hookType":   hook.hookType(),
 						"hookPhase":  "post",
 					},
 				)
@@ -173,20 +174,14 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 					}
 
 					if hook.Hook.OnError == velerov1api.HookErrorModeFail {
+						hookTracker.AddResult(newUnexecutedHookResult(newPod, hook, err))
 						cancel()
 						return
 					}
 				}
-				eh := &velerov1api.ExecHook{
-					Container: hook.Hook.Container,
-					Command:   hook.Hook.Command,
-					OnError:   hook.Hook.OnError,
-					Timeout:   hook.Hook.ExecTimeout,
-				}
-
 				hookFailed := false
 				var hookErr error
-				if hookErr = e.PodCommandExecutor.ExecutePodCommand(hookLog, podMap, pod.Namespace, pod.Name, hook.HookName, eh); hookErr != nil {
+				if hookErr = e.executeHook(hookLog, podMap, pod, hook, hookTracker); hookErr != nil {
 					hookLog.WithError(hookErr).Error("Error executing hook")
 					hookErr = fmt.Errorf("hook %s in container %s failed to execute, err: %v", hook.HookName, hook.Hook.Container, hookErr)
 					errors = append(errors, hookErr)
@@ -240,7 +235,7 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 			hookLog := log.WithFields(
 				logrus.Fields{
 					"hookSource": hook.HookSource,
-					"hookType":   "exec",
+					"hookType":   hook.hookType(),
 					"hookPhase":  "post",
 				},
 			)
@@ -249,6 +244,7 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 			if errTracker != nil {
 				hookLog.WithError(errTracker).Warn("Error recording the hook in hook tracker")
 			}
+			hookTracker.AddResult(newUnexecutedHookResult(pod, hook, err))
 
 			hookLog.Error(err)
 			errors = append(errors, err)
@@ -258,6 +254,50 @@ func (e *DefaultWaitExecHookHandler) HandleHooks(
 	return errors
 }
 
+// executeHook sends the request of an HTTP hook, or executes the command of an exec hook, and
+// adds the hook's result to the hook tracker.
+func (e *DefaultWaitExecHookHandler) executeHook(log logrus.FieldLogger, podMap map[string]interface{}, pod *v1.Pod, hook PodExecRestoreHook, hookTracker *HookTracker) error {
+	start := time.Now()
+
+	if hook.HTTP != nil {
+		var (
+			result *podexec.HTTPResult
+			err    error
+		)
+		if e.PodHTTPExecutor == nil {
+			err = fmt.Errorf("no executor is configured for http hooks")
+		} else {
+			result, err = e.PodHTTPExecutor.ExecutePodHTTPHook(log, podMap, pod.Namespace, pod.Name, hook.HookName, hook.HTTP)
+		}
+		hookTracker.AddResult(newHTTPHookResult(pod.Namespace, pod.Name, hook.Hook.Container, hook.HookSource, hook.HookName, "", start, result, err))
+		return err
+	}
+
+	eh := &velerov1api.ExecHook{
+		Container: hook.Hook.Container,
+		Command:   hook.Hook.Command,
+		OnError:   hook.Hook.OnError,
+		Timeout:   hook.Hook.ExecTimeout,
+	}
+	result, err := executePodCommand(e.PodCommandExecutor, log, podMap, pod.Namespace, pod.Name, hook.HookName, eh)
+	hookTracker.AddResult(newExecHookResult(pod.Namespace, pod.Name, eh.Container, hook.HookSource, hook.HookName, "", start, result, err))
+	return err
+}
+
+// newUnexecutedHookResult returns the result of a hook which didn't run.
+func newUnexecutedHookResult(pod *v1.Pod, hook PodExecRestoreHook, err error) HookResult {
+	return HookResult{
+		PodNamespace: pod.Namespace,
+		PodName:      pod.Name,
+		Container:    hook.Hook.Container,
+		HookName:     hook.HookName,
+		HookSource:   hook.HookSource,
+		HookType:     hook.hookType(),
+		Failed:       true,
+		Error:        err.Error(),
+	}
+}
+
 func podHasContainer(pod *v1.Pod, containerName string) bool {
 	if pod == nil {
 		return false
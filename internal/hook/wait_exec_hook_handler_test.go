@@ -1279,3 +1279,99 @@ func TestRestoreHookTrackerUpdate(t *testing.T) {
 		})
 	}
 }
+
+func TestWaitExecHandleHTTPHooks(t *testing.T) {
+	httpHook := &velerov1api.HTTPHook{
+		Port:    8080,
+		Path:    "/unquiesce",
+		OnError: velerov1api.HookErrorModeFail,
+	}
+
+	tests := []struct {
+		name           string
+		httpError      error
+		expectedErrors []error
+		expectedFailed int
+	}{
+		{
+			name: "should send the request of the hook once the container is running",
+		},
+		{
+			name:           "should return the error of the failed request",
+			httpError:      errors.New("unexpected status code 500"),
+			expectedErrors: []error{errors.New("hook my-hook-1 in container container1 failed to execute, err: unexpected status code 500")},
+			expectedFailed: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			pod := builder.ForPod("default", "my-pod").
+				Containers(&v1.Container{
+					Name: "container1",
+				}).
+				ContainerStatuses(&v1.ContainerStatus{
+					Name: "container1",
+					State: v1.ContainerState{
+						Running: &v1.ContainerStateRunning{},
+					},
+				}).
+				Result()
+
+			// the source sets the resource version of the pods added to it, so it gets a copy
+			// to keep the pod passed to the handler unchanged
+			source := fcache.NewFakeControllerSource()
+			added := pod.DeepCopy()
+			go func() {
+				source.Add(added)
+			}()
+
+			podCommandExecutor := &velerotest.MockPodCommandExecutor{}
+			defer podCommandExecutor.AssertExpectations(t)
+			podHTTPExecutor := &mockPodHTTPExecutor{}
+			defer podHTTPExecutor.AssertExpectations(t)
+
+			h := &DefaultWaitExecHookHandler{
+				PodCommandExecutor: podCommandExecutor,
+				PodHTTPExecutor:    podHTTPExecutor,
+				ListWatchFactory:   &fakeListWatchFactory{source},
+			}
+
+			expectedPod := pod.DeepCopy()
+			expectedPod.ResourceVersion = "1"
+			obj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(expectedPod)
+			require.NoError(t, err)
+			podHTTPExecutor.On("ExecutePodHTTPHook", mock.Anything, obj, "default", "my-pod", "my-hook-1", httpHook).Return(nil, test.httpError)
+
+			byContainer := map[string][]PodExecRestoreHook{
+				"container1": {
+					newPodHTTPRestoreHook("my-hook-1", HookSourceSpec, &velerov1api.HTTPRestoreHook{
+						HTTPHook:  *httpHook,
+						Container: "container1",
+					}),
+				},
+			}
+
+			hookTracker := NewHookTracker()
+			hookTracker.Add("default", "my-pod", "container1", HookSourceSpec, "my-hook-1", hookPhase(""))
+			errs := h.HandleHooks(context.Background(), velerotest.NewLogger(), pod, byContainer, hookTracker)
+
+			require.Len(t, errs, len(test.expectedErrors))
+			for i, ee := range test.expectedErrors {
+				assert.EqualError(t, errs[i], ee.Error())
+			}
+
+			attempted, failed := hookTracker.Stat()
+			assert.Equal(t, 1, attempted)
+			assert.Equal(t, test.expectedFailed, failed)
+
+			results := hookTracker.Results()
+			require.Len(t, results, 1)
+			assert.Equal(t, "my-hook-1", results[0].HookName)
+			assert.Equal(t, "container1", results[0].Container)
+			assert.Equal(t, HookTypeHTTP, results[0].HookType)
+			assert.True(t, results[0].Executed)
+			assert.Equal(t, test.expectedFailed == 1, results[0].Failed)
+		})
+	}
+}
@@ -0,0 +1,325 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integrity
+
+import (
+	"archive/tar"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+)
+
+// Manifest records the SHA-256 digests of the files of a backup in object
+// storage and of the resource entries in its tarball, so that a backup can be
+// checked for truncation or tampering before it's relied on. The backup's
+// metadata file, velero-backup.json, isn't covered: it's the Backup object,
+// which the server rewrites whenever the backup's status changes.
+type Manifest struct {
+	// Files maps the download target kind of each backup file to its digest.
+	// The digests are computed over the files as Velero wrote them, before
+	// any encryption by the backup store.
+	Files map[velerov1api.DownloadTargetKind]string `json:"files"`
+
+	// Resources maps the path of each entry in the backup tarball to its digest.
+	// +optional
+	Resources map[string]string `json:"resources,omitempty"`
+
+	// Signature is the hex-encoded HMAC-SHA256 of the manifest with an empty
+	// signature, computed with the signing key of the backup storage location.
+	// +optional
+	Signature string `json:"signature,omitempty"`
+}
+
+// NewManifest returns an empty Manifest.
+func NewManifest() *Manifest {
+	return &Manifest{
+		Files: make(map[velerov1api.DownloadTargetKind]string),
+	}
+}
+
+// Sign sets the manifest's signature using key.
+func (m *Manifest) Sign(key []byte) error {
+	signature, err := m.signature(key)
+	if err != nil {
+		return err
+	}
+
+	m.Signature = signature
+	return nil
+}
+
+func (m *Manifest) signature(key []byte) (string, error) {
+	unsigned := *m
+	unsigned.Signature = ""
+
+	// encoding/json sorts map keys, so the encoding is stable.
+	data, err := json.Marshal(unsigned)
+	if err != nil {
+		return "", errors.Wrap(err, "error encoding manifest")
+	}
+
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// Digest returns the hex-encoded SHA-256 digest of the data read from r.
+func Digest(r io.Reader) (string, error) {
+	h := sha256.New()
+	if _, err := io.Copy(h, r); err != nil {
+		return "", errors.WithStack(err)
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// ResourceDigests returns the digests of the regular files in the backup
+// tarball read from r, keyed by their path in the tarball. r is always read
+// to its end.
+func ResourceDigests(r io.Reader) (map[string]string, error) {
+	// drain whatever the tarball reader leaves behind, so writers feeding r
+	// through a pipe never block
+	defer io.Copy(io.Discard, r) //nolint:errcheck // Best effort.
+
+	dr, err := archive.NewDecompressingReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer dr.Close()
+
+	digests := make(map[string]string)
+	tr := tar.NewReader(dr)
+	for {
+		header, err := tr.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "error reading backup tarball")
+		}
+		if header.Typeflag != tar.TypeReg {
+			continue
+		}
+
+		digest, err := Digest(tr)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading %s from backup tarball", header.Name)
+		}
+		digests[header.Name] = digest
+	}
+
+	return digests, nil
+}
+
+// TarballDigester computes the digest of a backup tarball, and of the
+// resource entries in it, while the tarball is written to it.
+type TarballDigester struct {
+	hash   hash.Hash
+	writer *io.PipeWriter
+	result chan tarballDigests
+}
+
+type tarballDigests struct {
+	resources map[string]string
+	err       error
+}
+
+// NewTarballDigester returns a TarballDigester. Finish must be called once the
+// whole tarball has been written.
+func NewTarballDigester() *TarballDigester {
+	pr, pw := io.Pipe()
+	d := &TarballDigester{
+		hash:   sha256.New(),
+		writer: pw,
+		result: make(chan tarballDigests, 1),
+	}
+
+	go func() {
+		resources, err := ResourceDigests(pr)
+		d.result <- tarballDigests{resources: resources, err: err}
+	}()
+
+	return d
+}
+
+func (d *TarballDigester) Write(p []byte) (int, error) {
+	d.hash.Write(p)
+	return d.writer.Write(p)
+}
+
+// Finish returns the digest of the tarball and the digests of its resource
+// entries. The digest of the tarball is returned even if the tarball couldn't
+// be read.
+func (d *TarballDigester) Finish() (string, map[string]string, error) {
+	d.writer.Close()
+	res := <-d.result
+
+	return hex.EncodeToString(d.hash.Sum(nil)), res.resources, res.err
+}
+
+// Fetcher returns the backup file of the given download target kind, or nil
+// if the file doesn't exist.
+type Fetcher func(kind velerov1api.DownloadTargetKind) (io.ReadCloser, error)
+
+// Result is the outcome of verifying a backup against its manifest.
+type Result struct {
+	// FilesVerified is the number of backup files whose digest matched.
+	FilesVerified int
+
+	// ResourcesVerified is the number of tarball entries whose digest matched.
+	ResourcesVerified int
+
+	// Mismatches describes each item which failed verification.
+	Mismatches []string
+}
+
+// Verified returns true if no item failed verification.
+func (r *Result) Verified() bool {
+	return len(r.Mismatches) == 0
+}
+
+func (r *Result) addMismatch(format string, args ...interface{}) {
+	r.Mismatches = append(r.Mismatches, fmt.Sprintf(format, args...))
+}
+
+// MissingManifest returns the result of verifying a backup whose manifest is
+// missing although one is required, e.g. since manifests are signed.
+func MissingManifest() *Result {
+	res := new(Result)
+	res.addMismatch("manifest: missing")
+	return res
+}
+
+// Verify recomputes the digests of the backup files returned by fetch and of
+// the entries in the backup tarball and compares them to the manifest. If
+// signingKey is set, the manifest's signature is checked too. Errors
+// fetching the files are returned, while everything that doesn't match the
+// manifest is reported in the result.
+func Verify(m *Manifest, signingKey []byte, fetch Fetcher) (*Result, error) {
+	res := new(Result)
+
+	switch {
+	case len(signingKey) > 0 && m.Signature == "":
+		res.addMismatch("manifest: not signed")
+	case len(signingKey) > 0:
+		signature, err := m.signature(signingKey)
+		if err != nil {
+			return nil, err
+		}
+		if !hmac.Equal([]byte(signature), []byte(m.Signature)) {
+			res.addMismatch("manifest: signature mismatch")
+		}
+	case m.Signature != "":
+		res.addMismatch("manifest: signed but no signing key is configured to verify it")
+	}
+
+	kinds := make([]string, 0, len(m.Files))
+	for kind := range m.Files {
+		kinds = append(kinds, string(kind))
+	}
+	sort.Strings(kinds)
+
+	for _, k := range kinds {
+		kind := velerov1api.DownloadTargetKind(k)
+		if err := verifyFile(m, kind, fetch, res); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+func verifyFile(m *Manifest, kind velerov1api.DownloadTargetKind, fetch Fetcher, res *Result) error {
+	rc, err := fetch(kind)
+	if err != nil {
+		return errors.Wrapf(err, "error fetching %s", kind)
+	}
+	if rc == nil {
+		res.addMismatch("file %s: missing", kind)
+		return nil
+	}
+	defer rc.Close()
+
+	if kind != velerov1api.DownloadTargetKindBackupContents {
+		digest, err := Digest(rc)
+		if err != nil {
+			res.addMismatch("file %s: %v", kind, err)
+			return nil
+		}
+		compareFile(m, kind, digest, res)
+		return nil
+	}
+
+	h := sha256.New()
+	resources, resourcesErr := ResourceDigests(io.TeeReader(rc, h))
+	compareFile(m, kind, hex.EncodeToString(h.Sum(nil)), res)
+	if resourcesErr != nil {
+		res.addMismatch("file %s: %v", kind, resourcesErr)
+		return nil
+	}
+	compareResources(m.Resources, resources, res)
+
+	return nil
+}
+
+func compareFile(m *Manifest, kind velerov1api.DownloadTargetKind, digest string, res *Result) {
+	if digest != m.Files[kind] {
+		res.addMismatch("file %s: digest mismatch", kind)
+		return
+	}
+	res.FilesVerified++
+}
+
+func compareResources(want, got map[string]string, res *Result) {
+	names := make([]string, 0, len(want))
+	for name := range want {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		digest, found := got[name]
+		switch {
+		case !found:
+			res.addMismatch("resource %s: missing from backup tarball", name)
+		case digest != want[name]:
+			res.addMismatch("resource %s: digest mismatch", name)
+		default:
+			res.ResourcesVerified++
+		}
+	}
+
+	var unexpected []string
+	for name := range got {
+		if _, found := want[name]; !found {
+			unexpected = append(unexpected, name)
+		}
+	}
+	sort.Strings(unexpected)
+	for _, name := range unexpected {
+		res.addMismatch("resource %s: not in manifest", name)
+	}
+}
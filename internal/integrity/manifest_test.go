@@ -0,0 +1,202 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package integrity
+
+import (
+	"archive/tar"
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+)
+
+func newTarball(t *testing.T, compression velerov1api.BackupCompression, files map[string]string) []byte {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	cw, err := archive.NewCompressingWriter(buf, compression)
+	require.NoError(t, err)
+	tw := tar.NewWriter(cw)
+	for name, data := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Size: int64(len(data)), Typeflag: tar.TypeReg, Mode: 0755}))
+		_, err := tw.Write([]byte(data))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, cw.Close())
+
+	return buf.Bytes()
+}
+
+func TestTarballDigester(t *testing.T) {
+	files := map[string]string{
+		"metadata/version":                          "1.1.0",
+		"resources/pods/namespaces/ns-1/pod-1.json": `{"kind":"Pod"}`,
+	}
+
+	for _, compression := range []velerov1api.BackupCompression{
+		velerov1api.BackupCompressionGzip,
+		velerov1api.BackupCompressionZstd,
+		velerov1api.BackupCompressionNone,
+	} {
+		t.Run(string(compression), func(t *testing.T) {
+			tarball := newTarball(t, compression, files)
+
+			d := NewTarballDigester()
+			_, err := io.Copy(d, bytes.NewReader(tarball))
+			require.NoError(t, err)
+			digest, resources, err := d.Finish()
+			require.NoError(t, err)
+
+			expected, err := Digest(bytes.NewReader(tarball))
+			require.NoError(t, err)
+			assert.Equal(t, expected, digest)
+
+			require.Len(t, resources, len(files))
+			for name, data := range files {
+				expected, err := Digest(strings.NewReader(data))
+				require.NoError(t, err)
+				assert.Equal(t, expected, resources[name])
+			}
+		})
+	}
+
+	t.Run("invalid tarball", func(t *testing.T) {
+		d := NewTarballDigester()
+		_, err := io.Copy(d, strings.NewReader(strings.Repeat("not a tarball", 1000)))
+		require.NoError(t, err)
+		digest, _, err := d.Finish()
+		assert.Error(t, err)
+		assert.NotEmpty(t, digest)
+	})
+}
+
+func TestVerify(t *testing.T) {
+	tarball := newTarball(t, velerov1api.BackupCompressionGzip, map[string]string{
+		"resources/pods/namespaces/ns-1/pod-1.json": `{"kind":"Pod"}`,
+	})
+	log := []byte("log")
+
+	newManifest := func(t *testing.T) *Manifest {
+		m := NewManifest()
+		d := NewTarballDigester()
+		_, err := io.Copy(d, bytes.NewReader(tarball))
+		require.NoError(t, err)
+		m.Files[velerov1api.DownloadTargetKindBackupContents], m.Resources, err = d.Finish()
+		require.NoError(t, err)
+		m.Files[velerov1api.DownloadTargetKindBackupLog], err = Digest(bytes.NewReader(log))
+		require.NoError(t, err)
+		return m
+	}
+
+	fetcher := func(files map[velerov1api.DownloadTargetKind][]byte) Fetcher {
+		return func(kind velerov1api.DownloadTargetKind) (io.ReadCloser, error) {
+			data, found := files[kind]
+			if !found {
+				return nil, nil
+			}
+			return io.NopCloser(bytes.NewReader(data)), nil
+		}
+	}
+
+	tests := []struct {
+		name               string
+		signWith           []byte
+		verifyWith         []byte
+		files              map[velerov1api.DownloadTargetKind][]byte
+		expectedMismatches []string
+	}{
+		{
+			name: "unsigned manifest verified without a key",
+			files: map[velerov1api.DownloadTargetKind][]byte{
+				velerov1api.DownloadTargetKindBackupContents: tarball,
+				velerov1api.DownloadTargetKindBackupLog:      log,
+			},
+		},
+		{
+			name:       "signed manifest verified with the key",
+			signWith:   []byte("key"),
+			verifyWith: []byte("key"),
+			files: map[velerov1api.DownloadTargetKind][]byte{
+				velerov1api.DownloadTargetKindBackupContents: tarball,
+				velerov1api.DownloadTargetKindBackupLog:      log,
+			},
+		},
+		{
+			name:       "signed with another key",
+			signWith:   []byte("key"),
+			verifyWith: []byte("other-key"),
+			files: map[velerov1api.DownloadTargetKind][]byte{
+				velerov1api.DownloadTargetKindBackupContents: tarball,
+				velerov1api.DownloadTargetKindBackupLog:      log,
+			},
+			expectedMismatches: []string{"manifest: signature mismatch"},
+		},
+		{
+			name:       "unsigned manifest verified with a key",
+			verifyWith: []byte("key"),
+			files: map[velerov1api.DownloadTargetKind][]byte{
+				velerov1api.DownloadTargetKindBackupContents: tarball,
+				velerov1api.DownloadTargetKindBackupLog:      log,
+			},
+			expectedMismatches: []string{"manifest: not signed"},
+		},
+		{
+			name:     "signed manifest verified without a key",
+			signWith: []byte("key"),
+			files: map[velerov1api.DownloadTargetKind][]byte{
+				velerov1api.DownloadTargetKindBackupContents: tarball,
+				velerov1api.DownloadTargetKindBackupLog:      log,
+			},
+			expectedMismatches: []string{"manifest: signed but no signing key is configured to verify it"},
+		},
+		{
+			name: "missing and modified files",
+			files: map[velerov1api.DownloadTargetKind][]byte{
+				velerov1api.DownloadTargetKindBackupContents: newTarball(t, velerov1api.BackupCompressionGzip, map[string]string{
+					"resources/pods/namespaces/ns-1/pod-2.json": `{"kind":"Pod"}`,
+				}),
+			},
+			expectedMismatches: []string{
+				"file BackupContents: digest mismatch",
+				"resource resources/pods/namespaces/ns-1/pod-1.json: missing from backup tarball",
+				"resource resources/pods/namespaces/ns-1/pod-2.json: not in manifest",
+				"file BackupLog: missing",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			m := newManifest(t)
+			if test.signWith != nil {
+				require.NoError(t, m.Sign(test.signWith))
+			}
+
+			res, err := Verify(m, test.verifyWith, fetcher(test.files))
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedMismatches, res.Mismatches)
+			assert.Equal(t, len(test.expectedMismatches) == 0, res.Verified())
+		})
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package resourcemodifiers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// MatchOperator is the comparison a MatchExpression makes between the values
+// its JSONPath selects and its value.
+type MatchOperator string
+
+const (
+	MatchOperatorExists             MatchOperator = "Exists"
+	MatchOperatorDoesNotExist       MatchOperator = "DoesNotExist"
+	MatchOperatorEquals             MatchOperator = "Equals"
+	MatchOperatorNotEquals          MatchOperator = "NotEquals"
+	MatchOperatorIn                 MatchOperator = "In"
+	MatchOperatorNotIn              MatchOperator = "NotIn"
+	MatchOperatorGreaterThan        MatchOperator = "GreaterThan"
+	MatchOperatorGreaterThanOrEqual MatchOperator = "GreaterThanOrEqual"
+	MatchOperatorLessThan           MatchOperator = "LessThan"
+	MatchOperatorLessThanOrEqual    MatchOperator = "LessThanOrEqual"
+)
+
+// MatchExpression matches an object by comparing the values selected by a
+// JSONPath expression, such as "{.spec.type}" or
+// "{.metadata.ownerReferences[*].kind}", with a value. When the JSONPath
+// selects several values, the expression matches if any of them compares
+// successfully, except for NotEquals and NotIn which require none of them to
+// be equal.
+type MatchExpression struct {
+	JSONPath string        `json:"jsonPath"`
+	Operator MatchOperator `json:"operator"`
+	Value    string        `json:"value,omitempty"`
+	Values   []string      `json:"values,omitempty"`
+}
+
+// Match returns true if the object meets the expression.
+func (e *MatchExpression) Match(u *unstructured.Unstructured) (bool, error) {
+	values, err := e.find(u)
+	if err != nil {
+		return false, err
+	}
+
+	switch e.Operator {
+	case MatchOperatorExists:
+		return len(values) > 0, nil
+	case MatchOperatorDoesNotExist:
+		return len(values) == 0, nil
+	case MatchOperatorEquals:
+		return containsAny(values, []string{e.Value}), nil
+	case MatchOperatorNotEquals:
+		return !containsAny(values, []string{e.Value}), nil
+	case MatchOperatorIn:
+		return containsAny(values, e.Values), nil
+	case MatchOperatorNotIn:
+		return !containsAny(values, e.Values), nil
+	case MatchOperatorGreaterThan, MatchOperatorGreaterThanOrEqual, MatchOperatorLessThan, MatchOperatorLessThanOrEqual:
+		value, err := strconv.ParseFloat(e.Value, 64)
+		if err != nil {
+			return false, fmt.Errorf("value %q of operator %s is not a number", e.Value, e.Operator)
+		}
+		for _, v := range values {
+			number, ok := toNumber(v)
+			if ok && compareNumbers(e.Operator, number, value) {
+				return true, nil
+			}
+		}
+		return false, nil
+	default:
+		return false, fmt.Errorf("unsupported operator %s", e.Operator)
+	}
+}
+
+// find returns the values selected by the expression's JSONPath, ignoring
+// missing fields.
+func (e *MatchExpression) find(u *unstructured.Unstructured) ([]interface{}, error) {
+	jp, err := parseJSONPath(e.JSONPath)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := jp.FindResults(u.Object)
+	if err != nil {
+		return nil, fmt.Errorf("error in evaluating jsonPath %s: %s", e.JSONPath, err)
+	}
+
+	var values []interface{}
+	for _, result := range results {
+		for _, v := range result {
+			if !v.IsValid() || !v.CanInterface() || v.Interface() == nil {
+				continue
+			}
+			values = append(values, v.Interface())
+		}
+	}
+	return values, nil
+}
+
+// parseJSONPath parses a JSONPath expression, which may be given without the
+// surrounding braces.
+func parseJSONPath(path string) (*jsonpath.JSONPath, error) {
+	if !strings.HasPrefix(path, "{") {
+		path = "{" + path + "}"
+	}
+	jp := jsonpath.New("match").AllowMissingKeys(true)
+	if err := jp.Parse(path); err != nil {
+		return nil, fmt.Errorf("invalid jsonPath %s: %s", path, err)
+	}
+	return jp, nil
+}
+
+func containsAny(values []interface{}, expected []string) bool {
+	for _, v := range values {
+		s := fmt.Sprint(v)
+		for _, e := range expected {
+			if s == e {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func toNumber(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case float32:
+		return float64(n), true
+	case float64:
+		return n, true
+	case string:
+		f, err := strconv.ParseFloat(n, 64)
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func compareNumbers(operator MatchOperator, a, b float64) bool {
+	switch operator {
+	case MatchOperatorGreaterThan:
+		return a > b
+	case MatchOperatorGreaterThanOrEqual:
+		return a >= b
+	case MatchOperatorLessThan:
+		return a < b
+	case MatchOperatorLessThanOrEqual:
+		return a <= b
+	}
+	return false
+}
@@ -0,0 +1,137 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package resourcemodifiers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestMatchExpression_Match(t *testing.T) {
+	pod := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Pod",
+			"metadata": map[string]interface{}{
+				"name":      "pod-1",
+				"namespace": "foo",
+				"ownerReferences": []interface{}{
+					map[string]interface{}{"kind": "ReplicaSet", "name": "rs-1"},
+					map[string]interface{}{"kind": "Job", "name": "job-1"},
+				},
+			},
+			"spec": map[string]interface{}{
+				"priority": int64(5),
+				"containers": []interface{}{
+					map[string]interface{}{"name": "app", "image": "nginx"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		expression MatchExpression
+		want       bool
+		wantErr    bool
+	}{
+		{
+			name:       "exists",
+			expression: MatchExpression{JSONPath: "{.spec.priority}", Operator: MatchOperatorExists},
+			want:       true,
+		},
+		{
+			name:       "exists without braces",
+			expression: MatchExpression{JSONPath: ".metadata.name", Operator: MatchOperatorExists},
+			want:       true,
+		},
+		{
+			name:       "missing field does not exist",
+			expression: MatchExpression{JSONPath: "{.spec.nodeName}", Operator: MatchOperatorDoesNotExist},
+			want:       true,
+		},
+		{
+			name:       "equals one of several values",
+			expression: MatchExpression{JSONPath: "{.metadata.ownerReferences[*].kind}", Operator: MatchOperatorEquals, Value: "Job"},
+			want:       true,
+		},
+		{
+			name:       "not equals fails when any value is equal",
+			expression: MatchExpression{JSONPath: "{.metadata.ownerReferences[*].kind}", Operator: MatchOperatorNotEquals, Value: "Job"},
+			want:       false,
+		},
+		{
+			name:       "not equals on a missing field",
+			expression: MatchExpression{JSONPath: "{.spec.nodeName}", Operator: MatchOperatorNotEquals, Value: "node-1"},
+			want:       true,
+		},
+		{
+			name:       "in",
+			expression: MatchExpression{JSONPath: "{.spec.containers[*].image}", Operator: MatchOperatorIn, Values: []string{"busybox", "nginx"}},
+			want:       true,
+		},
+		{
+			name:       "not in",
+			expression: MatchExpression{JSONPath: "{.spec.containers[*].image}", Operator: MatchOperatorNotIn, Values: []string{"busybox"}},
+			want:       true,
+		},
+		{
+			name:       "greater than",
+			expression: MatchExpression{JSONPath: "{.spec.priority}", Operator: MatchOperatorGreaterThan, Value: "3"},
+			want:       true,
+		},
+		{
+			name:       "less than or equal",
+			expression: MatchExpression{JSONPath: "{.spec.priority}", Operator: MatchOperatorLessThanOrEqual, Value: "4.5"},
+			want:       false,
+		},
+		{
+			name:       "numeric comparison on a non-numeric value",
+			expression: MatchExpression{JSONPath: "{.metadata.name}", Operator: MatchOperatorGreaterThan, Value: "0"},
+			want:       false,
+		},
+		{
+			name:       "numeric comparison on a missing field",
+			expression: MatchExpression{JSONPath: "{.spec.replicas}", Operator: MatchOperatorLessThan, Value: "10"},
+			want:       false,
+		},
+		{
+			name:       "invalid value of numeric comparison",
+			expression: MatchExpression{JSONPath: "{.spec.priority}", Operator: MatchOperatorGreaterThan, Value: "high"},
+			wantErr:    true,
+		},
+		{
+			name:       "invalid jsonPath",
+			expression: MatchExpression{JSONPath: "{.spec.containers[}", Operator: MatchOperatorExists},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.expression.Match(pod)
+			if tt.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -30,14 +30,27 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/yaml"
 
+	"github.com/vmware-tanzu/velero/internal/resourceref"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 )
 
 const (
-	ConfigmapRefType                   = "configmap"
+	ConfigmapRefType                   = resourceref.ConfigmapRefType
 	ResourceModifierSupportedVersionV1 = "v1"
 )
 
+// RuleAction is what a resource modifier rule does to the objects it matches.
+type RuleAction string
+
+const (
+	// RuleActionPatch patches the matched objects with the rule's patches. It's
+	// the default action.
+	RuleActionPatch RuleAction = "patch"
+
+	// RuleActionDrop excludes the matched objects entirely.
+	RuleActionDrop RuleAction = "drop"
+)
+
 type MatchRule struct {
 	Path  string `json:"path,omitempty"`
 	Value string `json:"value,omitempty"`
@@ -49,10 +62,12 @@ type Conditions struct {
 	ResourceNameRegex string                `json:"resourceNameRegex,omitempty"`
 	LabelSelector     *metav1.LabelSelector `json:"labelSelector,omitempty"`
 	Matches           []MatchRule           `json:"matches,omitempty"`
+	MatchExpressions  []MatchExpression     `json:"matchExpressions,omitempty"`
 }
 
 type ResourceModifierRule struct {
 	Conditions       Conditions            `json:"conditions"`
+	Action           RuleAction            `json:"action,omitempty"`
 	Patches          []JSONPatch           `json:"patches,omitempty"`
 	MergePatches     []JSONMergePatch      `json:"mergePatches,omitempty"`
 	StrategicPatches []StrategicMergePatch `json:"strategicPatches,omitempty"`
@@ -84,71 +99,106 @@ func GetResourceModifiersFromConfig(cm *v1.ConfigMap) (*ResourceModifiers, error
 	return resModifiers, nil
 }
 
-func (p *ResourceModifiers) ApplyResourceModifierRules(obj *unstructured.Unstructured, groupResource string, scheme *runtime.Scheme, log logrus.FieldLogger) []error {
+// ApplyResourceModifierRules applies the rules to the object in order. It
+// returns true if the object matches a drop rule, in which case the rules
+// after it aren't applied and the object should be excluded.
+func (p *ResourceModifiers) ApplyResourceModifierRules(obj *unstructured.Unstructured, groupResource string, scheme *runtime.Scheme, log logrus.FieldLogger) (bool, []error) {
 	var errs []error
 	for _, rule := range p.ResourceModifierRules {
-		err := rule.apply(obj, groupResource, scheme, log)
+		drop, err := rule.apply(obj, groupResource, scheme, log)
 		if err != nil {
 			errs = append(errs, err)
 		}
+		if drop {
+			return true, errs
+		}
 	}
 
-	return errs
+	return false, errs
 }
 
-func (r *ResourceModifierRule) apply(obj *unstructured.Unstructured, groupResource string, scheme *runtime.Scheme, log logrus.FieldLogger) error {
+func (r *ResourceModifierRule) apply(obj *unstructured.Unstructured, groupResource string, scheme *runtime.Scheme, log logrus.FieldLogger) (bool, error) {
+	match, err := r.Conditions.Match(obj, groupResource, log)
+	if err != nil {
+		return false, err
+	}
+	if !match {
+		return false, nil
+	}
+
+	if r.Action == RuleActionDrop {
+		log.Infof("Dropping %s/%s by resource modifier rule", obj.GetNamespace(), obj.GetName())
+		return true, nil
+	}
+
+	log.Infof("Applying resource modifier patch on %s/%s", obj.GetNamespace(), obj.GetName())
+	err = r.applyPatch(obj, scheme, log)
+	if err != nil {
+		return false, err
+	}
+	return false, nil
+}
+
+// Match returns true if the object of the group resource meets all the conditions.
+func (c *Conditions) Match(obj *unstructured.Unstructured, groupResource string, log logrus.FieldLogger) (bool, error) {
 	ns := obj.GetNamespace()
 	if ns != "" {
-		namespaceInclusion := collections.NewIncludesExcludes().Includes(r.Conditions.Namespaces...)
+		namespaceInclusion := collections.NewIncludesExcludes().Includes(c.Namespaces...)
 		if !namespaceInclusion.ShouldInclude(ns) {
-			return nil
+			return false, nil
 		}
 	}
 
-	g, err := glob.Compile(r.Conditions.GroupResource, '.')
+	g, err := glob.Compile(c.GroupResource, '.')
 	if err != nil {
-		log.Errorf("Bad glob pattern of groupResource in condition, groupResource: %s, err: %s", r.Conditions.GroupResource, err)
-		return err
+		log.Errorf("Bad glob pattern of groupResource in condition, groupResource: %s, err: %s", c.GroupResource, err)
+		return false, err
 	}
 
 	if !g.Match(groupResource) {
-		return nil
+		return false, nil
 	}
 
-	if r.Conditions.ResourceNameRegex != "" {
-		match, err := regexp.MatchString(r.Conditions.ResourceNameRegex, obj.GetName())
+	if c.ResourceNameRegex != "" {
+		match, err := regexp.MatchString(c.ResourceNameRegex, obj.GetName())
 		if err != nil {
-			return errors.Errorf("error in matching regex %s", err.Error())
+			return false, errors.Errorf("error in matching regex %s", err.Error())
 		}
 		if !match {
-			return nil
+			return false, nil
 		}
 	}
 
-	if r.Conditions.LabelSelector != nil {
-		selector, err := metav1.LabelSelectorAsSelector(r.Conditions.LabelSelector)
+	if c.LabelSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(c.LabelSelector)
 		if err != nil {
-			return errors.Errorf("error in creating label selector %s", err.Error())
+			return false, errors.Errorf("error in creating label selector %s", err.Error())
 		}
 		if !selector.Matches(labels.Set(obj.GetLabels())) {
-			return nil
+			return false, nil
 		}
 	}
 
-	match, err := matchConditions(obj, r.Conditions.Matches, log)
+	match, err := matchConditions(obj, c.Matches, log)
 	if err != nil {
-		return err
+		return false, err
 	} else if !match {
 		log.Info("Conditions do not match, skip it")
-		return nil
+		return false, nil
 	}
 
-	log.Infof("Applying resource modifier patch on %s/%s", obj.GetNamespace(), obj.GetName())
-	err = r.applyPatch(obj, scheme, log)
-	if err != nil {
-		return err
+	for i := range c.MatchExpressions {
+		match, err := c.MatchExpressions[i].Match(obj)
+		if err != nil {
+			return false, err
+		}
+		if !match {
+			log.Info("Match expressions do not match, skip it")
+			return false, nil
+		}
 	}
-	return nil
+
+	return true, nil
 }
 
 func matchConditions(u *unstructured.Unstructured, rules []MatchRule, _ logrus.FieldLogger) (bool, error) {
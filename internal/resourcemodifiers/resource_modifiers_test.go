@@ -21,6 +21,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -1027,7 +1028,7 @@ func TestResourceModifiers_ApplyResourceModifierRules(t *testing.T) {
 				Version:               tt.fields.Version,
 				ResourceModifierRules: tt.fields.ResourceModifierRules,
 			}
-			got := p.ApplyResourceModifierRules(tt.args.obj, tt.args.groupResource, nil, logrus.New())
+			_, got := p.ApplyResourceModifierRules(tt.args.obj, tt.args.groupResource, nil, logrus.New())
 
 			assert.Equal(t, tt.wantErr, len(got) > 0)
 			assert.Equal(t, *tt.wantObj, *tt.args.obj)
@@ -1339,7 +1340,7 @@ func TestResourceModifiers_ApplyResourceModifierRules_StrategicMergePatch(t *tes
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := tt.rm.ApplyResourceModifierRules(tt.obj, tt.groupResource, scheme, logrus.New())
+			_, got := tt.rm.ApplyResourceModifierRules(tt.obj, tt.groupResource, scheme, logrus.New())
 
 			assert.Equal(t, tt.wantErr, len(got) > 0)
 			assert.Equal(t, *tt.wantObj, *tt.obj)
@@ -1490,7 +1491,7 @@ func TestResourceModifiers_ApplyResourceModifierRules_JSONMergePatch(t *testing.
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := tt.rm.ApplyResourceModifierRules(tt.obj, tt.groupResource, nil, logrus.New())
+			_, got := tt.rm.ApplyResourceModifierRules(tt.obj, tt.groupResource, nil, logrus.New())
 
 			assert.Equal(t, tt.wantErr, len(got) > 0)
 			assert.Equal(t, *tt.wantObj, *tt.obj)
@@ -1566,7 +1567,7 @@ func TestResourceModifiers_wildcard_in_GroupResource(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := tt.rm.ApplyResourceModifierRules(tt.obj, tt.groupResource, nil, logrus.New())
+			_, got := tt.rm.ApplyResourceModifierRules(tt.obj, tt.groupResource, nil, logrus.New())
 
 			assert.Equal(t, tt.wantErr, len(got) > 0)
 			assert.Equal(t, *tt.wantObj, *tt.obj)
@@ -1654,7 +1655,7 @@ func TestResourceModifiers_conditional_patches(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := tt.rm.ApplyResourceModifierRules(tt.obj, tt.groupResource, nil, logrus.New())
+			_, got := tt.rm.ApplyResourceModifierRules(tt.obj, tt.groupResource, nil, logrus.New())
 
 			assert.Equal(t, tt.wantErr, len(got) > 0)
 			assert.Equal(t, *tt.wantObj, *tt.obj)
@@ -1760,3 +1761,141 @@ func TestJSONPatch_ToString(t *testing.T) {
 		})
 	}
 }
+
+func TestResourceModifiers_ApplyResourceModifierRules_Drop(t *testing.T) {
+	cm := &v1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-configmap",
+			Namespace: "test-namespace",
+		},
+		Data: map[string]string{
+			"sub.yml": `version: v1
+resourceModifierRules:
+- conditions:
+    groupResource: services
+    matchExpressions:
+    - jsonPath: "{.spec.type}"
+      operator: Equals
+      value: LoadBalancer
+  action: drop
+- conditions:
+    groupResource: deployments.apps
+    matchExpressions:
+    - jsonPath: "{.spec.replicas}"
+      operator: GreaterThan
+      value: "3"
+  patches:
+  - operation: replace
+    path: "/spec/replicas"
+    value: "3"
+- conditions:
+    groupResource: deployments.apps
+    matchExpressions:
+    - jsonPath: "{.metadata.labels.tier}"
+      operator: In
+      values: ["test", "dev"]
+  action: drop
+`,
+		},
+	}
+	rm, err := GetResourceModifiersFromConfig(cm)
+	require.NoError(t, err)
+	require.NoError(t, rm.Validate())
+
+	tests := []struct {
+		name          string
+		obj           *unstructured.Unstructured
+		groupResource string
+		wantDrop      bool
+		wantObj       *unstructured.Unstructured
+	}{
+		{
+			name: "load balancer service is dropped",
+			obj: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Service",
+				"metadata":   map[string]interface{}{"name": "svc", "namespace": "foo"},
+				"spec":       map[string]interface{}{"type": "LoadBalancer"},
+			}},
+			groupResource: "services",
+			wantDrop:      true,
+		},
+		{
+			name: "cluster IP service is kept",
+			obj: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Service",
+				"metadata":   map[string]interface{}{"name": "svc", "namespace": "foo"},
+				"spec":       map[string]interface{}{"type": "ClusterIP"},
+			}},
+			groupResource: "services",
+			wantDrop:      false,
+			wantObj: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Service",
+				"metadata":   map[string]interface{}{"name": "svc", "namespace": "foo"},
+				"spec":       map[string]interface{}{"type": "ClusterIP"},
+			}},
+		},
+		{
+			name: "deployment with more than 3 replicas is patched",
+			obj: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]interface{}{"name": "deploy", "namespace": "foo"},
+				"spec":       map[string]interface{}{"replicas": int64(5)},
+			}},
+			groupResource: "deployments.apps",
+			wantDrop:      false,
+			wantObj: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]interface{}{"name": "deploy", "namespace": "foo"},
+				"spec":       map[string]interface{}{"replicas": int64(3)},
+			}},
+		},
+		{
+			name: "deployment with 2 replicas is not patched",
+			obj: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]interface{}{"name": "deploy", "namespace": "foo"},
+				"spec":       map[string]interface{}{"replicas": int64(2)},
+			}},
+			groupResource: "deployments.apps",
+			wantDrop:      false,
+			wantObj: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata":   map[string]interface{}{"name": "deploy", "namespace": "foo"},
+				"spec":       map[string]interface{}{"replicas": int64(2)},
+			}},
+		},
+		{
+			name: "patched deployment is dropped by a later rule",
+			obj: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata": map[string]interface{}{
+					"name":      "deploy",
+					"namespace": "foo",
+					"labels":    map[string]interface{}{"tier": "dev"},
+				},
+				"spec": map[string]interface{}{"replicas": int64(5)},
+			}},
+			groupResource: "deployments.apps",
+			wantDrop:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			drop, errs := rm.ApplyResourceModifierRules(tt.obj, tt.groupResource, nil, logrus.New())
+			assert.Empty(t, errs)
+			assert.Equal(t, tt.wantDrop, drop)
+			if tt.wantObj != nil {
+				assert.Equal(t, *tt.wantObj, *tt.obj)
+			}
+		})
+	}
+}
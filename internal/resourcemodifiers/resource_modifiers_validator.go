@@ -17,6 +17,7 @@ package resourcemodifiers
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -25,6 +26,16 @@ func (r *ResourceModifierRule) Validate() error {
 		return err
 	}
 
+	switch r.Action {
+	case "", RuleActionPatch:
+	case RuleActionDrop:
+		if len(r.Patches) > 0 || len(r.MergePatches) > 0 || len(r.StrategicPatches) > 0 {
+			return fmt.Errorf("patches cannot be specified with the %s action", RuleActionDrop)
+		}
+	default:
+		return fmt.Errorf("unsupported action %s", r.Action)
+	}
+
 	count := 0
 	for _, size := range []int{
 		len(r.Patches),
@@ -80,5 +91,49 @@ func (c *Conditions) Validate() error {
 	if c.GroupResource == "" {
 		return fmt.Errorf("groupkResource cannot be empty")
 	}
+	for i := range c.MatchExpressions {
+		if err := c.MatchExpressions[i].Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *MatchExpression) Validate() error {
+	if e.JSONPath == "" {
+		return fmt.Errorf("jsonPath cannot be empty")
+	}
+	if _, err := parseJSONPath(e.JSONPath); err != nil {
+		return err
+	}
+
+	switch e.Operator {
+	case MatchOperatorExists, MatchOperatorDoesNotExist:
+		if e.Value != "" || len(e.Values) > 0 {
+			return fmt.Errorf("value and values cannot be specified with operator %s", e.Operator)
+		}
+	case MatchOperatorEquals, MatchOperatorNotEquals:
+		if len(e.Values) > 0 {
+			return fmt.Errorf("values cannot be specified with operator %s", e.Operator)
+		}
+	case MatchOperatorIn, MatchOperatorNotIn:
+		if e.Value != "" {
+			return fmt.Errorf("value cannot be specified with operator %s", e.Operator)
+		}
+		if len(e.Values) == 0 {
+			return fmt.Errorf("values cannot be empty with operator %s", e.Operator)
+		}
+	case MatchOperatorGreaterThan, MatchOperatorGreaterThanOrEqual, MatchOperatorLessThan, MatchOperatorLessThanOrEqual:
+		if len(e.Values) > 0 {
+			return fmt.Errorf("values cannot be specified with operator %s", e.Operator)
+		}
+		if _, err := strconv.ParseFloat(e.Value, 64); err != nil {
+			return fmt.Errorf("value %q of operator %s is not a number", e.Value, e.Operator)
+		}
+	case "":
+		return fmt.Errorf("operator cannot be empty")
+	default:
+		return fmt.Errorf("unsupported operator %s", e.Operator)
+	}
 	return nil
 }
@@ -155,6 +155,86 @@ func TestResourceModifiers_Validate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "drop action with match expressions",
+			fields: fields{
+				Version: "v1",
+				ResourceModifierRules: []ResourceModifierRule{
+					{
+						Conditions: Conditions{
+							GroupResource: "services",
+							MatchExpressions: []MatchExpression{
+								{
+									JSONPath: "{.spec.type}",
+									Operator: MatchOperatorEquals,
+									Value:    "LoadBalancer",
+								},
+							},
+						},
+						Action: RuleActionDrop,
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "drop action with patches",
+			fields: fields{
+				Version: "v1",
+				ResourceModifierRules: []ResourceModifierRule{
+					{
+						Conditions: Conditions{
+							GroupResource: "services",
+						},
+						Action: RuleActionDrop,
+						Patches: []JSONPatch{
+							{
+								Operation: "remove",
+								Path:      "/spec/loadBalancerIP",
+							},
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "unsupported action",
+			fields: fields{
+				Version: "v1",
+				ResourceModifierRules: []ResourceModifierRule{
+					{
+						Conditions: Conditions{
+							GroupResource: "services",
+						},
+						Action: "delete",
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid match expression",
+			fields: fields{
+				Version: "v1",
+				ResourceModifierRules: []ResourceModifierRule{
+					{
+						Conditions: Conditions{
+							GroupResource: "deployments.apps",
+							MatchExpressions: []MatchExpression{
+								{
+									JSONPath: "{.spec.replicas}",
+									Operator: MatchOperatorGreaterThan,
+									Value:    "three",
+								},
+							},
+						},
+						Action: RuleActionDrop,
+					},
+				},
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -231,3 +311,64 @@ func TestJsonPatch_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestMatchExpression_Validate(t *testing.T) {
+	tests := []struct {
+		name       string
+		expression MatchExpression
+		wantErr    bool
+	}{
+		{
+			name:       "exists",
+			expression: MatchExpression{JSONPath: ".spec.nodeName", Operator: MatchOperatorExists},
+			wantErr:    false,
+		},
+		{
+			name:       "exists with a value",
+			expression: MatchExpression{JSONPath: ".spec.nodeName", Operator: MatchOperatorExists, Value: "node-1"},
+			wantErr:    true,
+		},
+		{
+			name:       "in",
+			expression: MatchExpression{JSONPath: "{.metadata.ownerReferences[*].kind}", Operator: MatchOperatorIn, Values: []string{"Job", "CronJob"}},
+			wantErr:    false,
+		},
+		{
+			name:       "in without values",
+			expression: MatchExpression{JSONPath: "{.metadata.ownerReferences[*].kind}", Operator: MatchOperatorIn},
+			wantErr:    true,
+		},
+		{
+			name:       "less than a number",
+			expression: MatchExpression{JSONPath: "{.spec.replicas}", Operator: MatchOperatorLessThan, Value: "3"},
+			wantErr:    false,
+		},
+		{
+			name:       "empty jsonPath",
+			expression: MatchExpression{Operator: MatchOperatorEquals, Value: "LoadBalancer"},
+			wantErr:    true,
+		},
+		{
+			name:       "invalid jsonPath",
+			expression: MatchExpression{JSONPath: "{.spec.ports[}", Operator: MatchOperatorEquals, Value: "80"},
+			wantErr:    true,
+		},
+		{
+			name:       "empty operator",
+			expression: MatchExpression{JSONPath: "{.spec.type}", Value: "LoadBalancer"},
+			wantErr:    true,
+		},
+		{
+			name:       "unsupported operator",
+			expression: MatchExpression{JSONPath: "{.spec.type}", Operator: "Like", Value: "LoadBalancer"},
+			wantErr:    true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.expression.Validate(); (err != nil) != tt.wantErr {
+				t.Errorf("MatchExpression.Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package resourcepolicies
+
+import (
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
+)
+
+type resFilterPolicy struct {
+	action     ResourceFilterAction
+	conditions resourcemodifiers.Conditions
+}
+
+// unmarshalResourceConditions parse map[string]interface{} into the conditions of resource modifiers
+// and validate key fields of the map.
+func unmarshalResourceConditions(con map[string]interface{}) (*resourcemodifiers.Conditions, error) {
+	data, err := yaml.Marshal(con)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode resource conditions")
+	}
+
+	conditions := &resourcemodifiers.Conditions{}
+	if err := yaml.UnmarshalStrict(data, conditions); err != nil {
+		return nil, errors.Wrap(err, "failed to decode resource conditions")
+	}
+	return conditions, nil
+}
@@ -0,0 +1,244 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package resourcepolicies
+
+import (
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newUnstructured(namespace, name string, labels map[string]string, secretType string) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	obj.SetNamespace(namespace)
+	obj.SetName(name)
+	obj.SetLabels(labels)
+	if secretType != "" {
+		obj.Object["type"] = secretType
+	}
+	return obj
+}
+
+func TestShouldIncludeResource(t *testing.T) {
+	testCases := []struct {
+		name          string
+		yamlData      string
+		obj           *unstructured.Unstructured
+		groupResource string
+		include       bool
+		exclude       bool
+	}{
+		{
+			name: "no resource filter policies",
+			yamlData: `version: v1
+volumePolicies: []`,
+			obj:           newUnstructured("ns-1", "pod-1", nil, ""),
+			groupResource: "pods",
+			include:       true,
+		},
+		{
+			name: "excluded by group resource glob and field match",
+			yamlData: `version: v1
+resourceFilterPolicies:
+- conditions:
+    groupResource: "secrets"
+    matches:
+    - path: /type
+      value: kubernetes.io/tls
+  action:
+    type: exclude`,
+			obj:           newUnstructured("ns-1", "cert", nil, "kubernetes.io/tls"),
+			groupResource: "secrets",
+			include:       false,
+			exclude:       true,
+		},
+		{
+			name: "field value mismatch is not excluded",
+			yamlData: `version: v1
+resourceFilterPolicies:
+- conditions:
+    groupResource: "secrets"
+    matches:
+    - path: /type
+      value: kubernetes.io/tls
+  action:
+    type: exclude`,
+			obj:           newUnstructured("ns-1", "password", nil, "Opaque"),
+			groupResource: "secrets",
+			include:       true,
+		},
+		{
+			name: "excluded by name regex in namespaces",
+			yamlData: `version: v1
+resourceFilterPolicies:
+- conditions:
+    groupResource: "*.apps"
+    namespaces:
+    - ns-1
+    resourceNameRegex: "^tmp-"
+  action:
+    type: exclude`,
+			obj:           newUnstructured("ns-1", "tmp-deploy", nil, ""),
+			groupResource: "deployments.apps",
+			include:       false,
+			exclude:       true,
+		},
+		{
+			name: "first matched policy is respected",
+			yamlData: `version: v1
+resourceFilterPolicies:
+- conditions:
+    groupResource: "configmaps"
+    labelSelector:
+      matchLabels:
+        keep: "true"
+  action:
+    type: include
+- conditions:
+    groupResource: "*"
+  action:
+    type: exclude`,
+			obj:           newUnstructured("ns-1", "cm-1", map[string]string{"keep": "true"}, ""),
+			groupResource: "configmaps",
+			include:       true,
+		},
+		{
+			name: "resources matching no policy are excluded when there are include policies",
+			yamlData: `version: v1
+resourceFilterPolicies:
+- conditions:
+    groupResource: "configmaps"
+    labelSelector:
+      matchLabels:
+        keep: "true"
+  action:
+    type: include`,
+			obj:           newUnstructured("ns-1", "cm-1", map[string]string{"keep": "false"}, ""),
+			groupResource: "configmaps",
+			include:       false,
+			exclude:       false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policies, err := GetResourcePoliciesFromConfig(&v1.ConfigMap{Data: map[string]string{"policies": tc.yamlData}})
+			require.NoError(t, err)
+			require.NoError(t, policies.Validate())
+
+			include, err := policies.ShouldIncludeResource(tc.obj, tc.groupResource, logrus.StandardLogger())
+			require.NoError(t, err)
+			assert.Equal(t, tc.include, include)
+
+			exclude, err := policies.ShouldExcludeResource(tc.obj, tc.groupResource, logrus.StandardLogger())
+			require.NoError(t, err)
+			assert.Equal(t, tc.exclude, exclude)
+		})
+	}
+}
+
+func TestValidateResourceFilterPolicies(t *testing.T) {
+	testCases := []struct {
+		name     string
+		yamlData string
+		wantErr  bool
+	}{
+		{
+			name: "valid policy",
+			yamlData: `version: v1
+resourceFilterPolicies:
+- conditions:
+    groupResource: "secrets"
+    resourceNameRegex: "^tls-"
+  action:
+    type: exclude`,
+		},
+		{
+			name: "unknown action",
+			yamlData: `version: v1
+resourceFilterPolicies:
+- conditions:
+    groupResource: "secrets"
+  action:
+    type: skip`,
+			wantErr: true,
+		},
+		{
+			name: "empty group resource",
+			yamlData: `version: v1
+resourceFilterPolicies:
+- conditions:
+    resourceNameRegex: "^tls-"
+  action:
+    type: exclude`,
+			wantErr: true,
+		},
+		{
+			name: "invalid name regex",
+			yamlData: `version: v1
+resourceFilterPolicies:
+- conditions:
+    groupResource: "secrets"
+    resourceNameRegex: "["
+  action:
+    type: exclude`,
+			wantErr: true,
+		},
+		{
+			name: "invalid label selector",
+			yamlData: `version: v1
+resourceFilterPolicies:
+- conditions:
+    groupResource: "secrets"
+    labelSelector:
+      matchExpressions:
+      - key: app
+        operator: Unknown
+  action:
+    type: exclude`,
+			wantErr: true,
+		},
+		{
+			name: "unknown condition",
+			yamlData: `version: v1
+resourceFilterPolicies:
+- conditions:
+    groupResource: "secrets"
+    unknown: value
+  action:
+    type: exclude`,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policies, err := GetResourcePoliciesFromConfig(&v1.ConfigMap{Data: map[string]string{"policies": tc.yamlData}})
+			if err == nil {
+				err = policies.Validate()
+			}
+			if tc.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,56 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+	http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+package resourcepolicies
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/gobwas/glob"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// validate check the action and the conditions of the resource filter policy
+func (p *resFilterPolicy) validate() error {
+	if p.action.Type != Include && p.action.Type != Exclude {
+		return fmt.Errorf("invalid resource filter action type %s", p.action.Type)
+	}
+
+	if err := p.conditions.Validate(); err != nil {
+		return err
+	}
+	if _, err := glob.Compile(p.conditions.GroupResource, '.'); err != nil {
+		return errors.Wrapf(err, "invalid groupResource %s", p.conditions.GroupResource)
+	}
+	if p.conditions.ResourceNameRegex != "" {
+		if _, err := regexp.Compile(p.conditions.ResourceNameRegex); err != nil {
+			return errors.Wrapf(err, "invalid resourceNameRegex %s", p.conditions.ResourceNameRegex)
+		}
+	}
+	if p.conditions.LabelSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(p.conditions.LabelSelector); err != nil {
+			return errors.Wrap(err, "invalid labelSelector")
+		}
+	}
+	for _, match := range p.conditions.Matches {
+		if match.Path == "" {
+			return fmt.Errorf("path is required for match rule")
+		}
+	}
+
+	return nil
+}
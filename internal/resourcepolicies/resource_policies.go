@@ -20,20 +20,42 @@ import (
 	"strings"
 
 	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	"github.com/vmware-tanzu/velero/internal/resourceref"
 )
 
 type VolumeActionType string
 
+type ResourceFilterActionType string
+
 const (
 	// currently only support configmap type of resource config
-	ConfigmapRefType string           = "configmap"
-	Skip             VolumeActionType = "skip"
+	ConfigmapRefType string = resourceref.ConfigmapRefType
+	// Skip skips the backup of the volume's data
+	Skip VolumeActionType = "skip"
+	// Snapshot backs up the volume with a native or CSI snapshot
+	Snapshot VolumeActionType = "snapshot"
+	// FSBackup backs up the volume with pod volume backup
+	FSBackup VolumeActionType = "fs-backup"
+	// DataMover backs up the volume with a CSI snapshot whose data is moved to the backup storage
+	DataMover VolumeActionType = "datamover"
+
+	// DataMoverParameter is the parameter of the datamover action naming the data mover to use,
+	// the backup's data mover is used if it's not set
+	DataMoverParameter = "dataMover"
+
+	// Include includes the matched resources in the backup
+	Include ResourceFilterActionType = "include"
+	// Exclude excludes the matched resources from the backup
+	Exclude ResourceFilterActionType = "exclude"
 )
 
 // Action defined as one action for a specific way of backup
 type Action struct {
-	// Type defined specific type of action, currently only support 'skip'
+	// Type defined specific type of action, one of 'skip', 'snapshot', 'fs-backup' and 'datamover'
 	Type VolumeActionType `yaml:"type"`
 	// Parameters defined map of parameters when executing a specific action
 	Parameters map[string]interface{} `yaml:"parameters,omitempty"`
@@ -46,18 +68,30 @@ type volumePolicy struct {
 	Action     Action                 `yaml:"action"`
 }
 
-// resourcePolicies currently defined slice of volume policies to handle backup
+// ResourceFilterAction defined whether the resources matching a resource filter policy are included or excluded
+type ResourceFilterAction struct {
+	// Type defined specific type of action, either 'include' or 'exclude'
+	Type ResourceFilterActionType `yaml:"type"`
+}
+
+// resourceFilterPolicy defined policy to conditions to match resources and related action to filter matched resources
+type resourceFilterPolicy struct {
+	// Conditions defined the conditions to match resources, in the same format as the conditions of resource modifiers
+	Conditions map[string]interface{} `yaml:"conditions"`
+	Action     ResourceFilterAction   `yaml:"action"`
+}
+
+// resourcePolicies currently defined slice of volume policies and resource filter policies to handle backup
 type resourcePolicies struct {
-	Version        string         `yaml:"version"`
-	VolumePolicies []volumePolicy `yaml:"volumePolicies"`
-	// we may support other resource policies in the future, and they could be added separately
-	// OtherResourcePolicies []OtherResourcePolicy
+	Version                string                 `yaml:"version"`
+	VolumePolicies         []volumePolicy         `yaml:"volumePolicies"`
+	ResourceFilterPolicies []resourceFilterPolicy `yaml:"resourceFilterPolicies,omitempty"`
 }
 
 type Policies struct {
-	version        string
-	volumePolicies []volPolicy
-	// OtherPolicies
+	version                string
+	volumePolicies         []volPolicy
+	resourceFilterPolicies []resFilterPolicy
 }
 
 func unmarshalResourcePolicies(yamlData *string) (*resourcePolicies, error) {
@@ -69,6 +103,12 @@ func unmarshalResourcePolicies(yamlData *string) (*resourcePolicies, error) {
 	return resPolicies, nil
 }
 
+// DataMover returns the data mover set in the parameters of a datamover action.
+func (a *Action) DataMover() string {
+	dataMover, _ := a.Parameters[DataMoverParameter].(string)
+	return dataMover
+}
+
 func (p *Policies) buildPolicy(resPolicies *resourcePolicies) error {
 	for _, vp := range resPolicies.VolumePolicies {
 		con, err := unmarshalVolConditions(vp.Conditions)
@@ -86,10 +126,20 @@ func (p *Policies) buildPolicy(resPolicies *resourcePolicies) error {
 		volP.conditions = append(volP.conditions, &nfsCondition{nfs: con.NFS})
 		volP.conditions = append(volP.conditions, &csiCondition{csi: con.CSI})
 		volP.conditions = append(volP.conditions, &volumeTypeCondition{volumeTypes: con.VolumeTypes})
+		volP.conditions = append(volP.conditions, &namespaceCondition{namespaces: con.Namespaces})
+		volP.conditions = append(volP.conditions, &pvcLabelsCondition{labels: con.PVCLabels})
+		volP.conditions = append(volP.conditions, &pvcAnnotationsCondition{annotations: con.PVCAnnotations})
+		volP.conditions = append(volP.conditions, &podLabelsCondition{labels: con.PodLabels})
 		p.volumePolicies = append(p.volumePolicies, volP)
 	}
 
-	// Other resource policies
+	for _, rp := range resPolicies.ResourceFilterPolicies {
+		con, err := unmarshalResourceConditions(rp.Conditions)
+		if err != nil {
+			return errors.WithStack(err)
+		}
+		p.resourceFilterPolicies = append(p.resourceFilterPolicies, resFilterPolicy{action: rp.Action, conditions: *con})
+	}
 
 	p.version = resPolicies.Version
 	return nil
@@ -112,6 +162,21 @@ func (p *Policies) match(res *structuredVolume) *Action {
 	return nil
 }
 
+// VolumeFilterData is a volume together with the objects related to it, which the conditions
+// of the volume policies match on besides the volume itself.
+type VolumeFilterData struct {
+	// PersistentVolume is the volume, for persistent volumes
+	PersistentVolume *v1.PersistentVolume
+	// PodVolume is the volume, for pod volumes which aren't persistent volumes
+	PodVolume *v1.Volume
+	// PVC is the claim of the persistent volume
+	PVC *v1.PersistentVolumeClaim
+	// Pods are the pods mounting the volume, podLabels conditions are matched if one of them matches
+	Pods []*v1.Pod
+}
+
+// GetMatchAction returns the action of the first volume policy matched by res, which is either
+// a *v1.PersistentVolume, a *v1.Volume or a VolumeFilterData, or nil if no policy matches.
 func (p *Policies) GetMatchAction(res interface{}) (*Action, error) {
 	volume := &structuredVolume{}
 	switch obj := res.(type) {
@@ -119,12 +184,62 @@ func (p *Policies) GetMatchAction(res interface{}) (*Action, error) {
 		volume.parsePV(obj)
 	case *v1.Volume:
 		volume.parsePodVolume(obj)
+	case VolumeFilterData:
+		if obj.PersistentVolume == nil && obj.PodVolume == nil {
+			return nil, errors.New("failed to convert object, no volume is set")
+		}
+		volume.parse(obj)
 	default:
 		return nil, errors.New("failed to convert object")
 	}
 	return p.match(volume), nil
 }
 
+// ShouldIncludeResource returns whether the object of the group resource is backed up according
+// to the resource filter policies. The action of the first policy matched by the object is
+// respected. Objects matching no policy are backed up, unless there are policies including
+// resources, in which case only the objects matching those are backed up.
+func (p *Policies) ShouldIncludeResource(obj *unstructured.Unstructured, groupResource string, log logrus.FieldLogger) (bool, error) {
+	action, err := p.matchResourceFilter(obj, groupResource, log)
+	if err != nil {
+		return false, err
+	}
+	if action != nil {
+		return action.Type == Include, nil
+	}
+
+	for _, policy := range p.resourceFilterPolicies {
+		if policy.action.Type == Include {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+// ShouldExcludeResource returns true only if the first resource filter policy matched by the
+// object of the group resource excludes it.
+func (p *Policies) ShouldExcludeResource(obj *unstructured.Unstructured, groupResource string, log logrus.FieldLogger) (bool, error) {
+	action, err := p.matchResourceFilter(obj, groupResource, log)
+	if err != nil {
+		return false, err
+	}
+	return action != nil && action.Type == Exclude, nil
+}
+
+func (p *Policies) matchResourceFilter(obj *unstructured.Unstructured, groupResource string, log logrus.FieldLogger) (*ResourceFilterAction, error) {
+	for i := range p.resourceFilterPolicies {
+		policy := &p.resourceFilterPolicies[i]
+		match, err := policy.conditions.Match(obj, groupResource, log)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error matching resource filter policy of group resource %s", policy.conditions.GroupResource)
+		}
+		if match {
+			return &policy.action, nil
+		}
+	}
+	return nil, nil
+}
+
 func (p *Policies) Validate() error {
 	if p.version != currentSupportDataVersion {
 		return fmt.Errorf("incompatible version number %s with supported version %s", p.version, currentSupportDataVersion)
@@ -140,6 +255,12 @@ func (p *Policies) Validate() error {
 			}
 		}
 	}
+
+	for _, policy := range p.resourceFilterPolicies {
+		if err := policy.validate(); err != nil {
+			return errors.WithStack(err)
+		}
+	}
 	return nil
 }
 
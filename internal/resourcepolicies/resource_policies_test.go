@@ -439,3 +439,88 @@ volumePolicies:
 		})
 	}
 }
+
+func TestGetMatchActionWithFilterData(t *testing.T) {
+	yamlData := `version: v1
+volumePolicies:
+- conditions:
+    namespaces:
+      - ns-1
+    pvcLabels:
+      app: db
+  action:
+    type: snapshot
+- conditions:
+    podLabels:
+      backup: fs
+  action:
+    type: fs-backup
+- conditions:
+    pvcAnnotations:
+      backup.example.com/skip: "true"
+  action:
+    type: skip`
+
+	resPolicies, err := unmarshalResourcePolicies(&yamlData)
+	assert.NoError(t, err)
+	policies := &Policies{}
+	assert.NoError(t, policies.buildPolicy(resPolicies))
+
+	pv := &v1.PersistentVolume{
+		Spec: v1.PersistentVolumeSpec{
+			ClaimRef: &v1.ObjectReference{Namespace: "ns-1", Name: "pvc-1"},
+		},
+	}
+	pvc := func(ns string, labels, annotations map[string]string) *v1.PersistentVolumeClaim {
+		return &v1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: "pvc-1", Labels: labels, Annotations: annotations}}
+	}
+	pod := &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "ns-2", Name: "pod-1", Labels: map[string]string{"backup": "fs"}}}
+
+	testCases := []struct {
+		name     string
+		data     VolumeFilterData
+		expected *Action
+	}{
+		{
+			name:     "pv matching namespace and pvc labels",
+			data:     VolumeFilterData{PersistentVolume: pv, PVC: pvc("ns-1", map[string]string{"app": "db"}, nil)},
+			expected: &Action{Type: Snapshot},
+		},
+		{
+			name:     "pvc labels in another namespace",
+			data:     VolumeFilterData{PersistentVolume: pv, PVC: pvc("ns-2", map[string]string{"app": "db"}, nil)},
+			expected: nil,
+		},
+		{
+			name:     "pv without pvc doesn't match pvc labels",
+			data:     VolumeFilterData{PersistentVolume: pv},
+			expected: nil,
+		},
+		{
+			name:     "pod volume matching pod labels",
+			data:     VolumeFilterData{PodVolume: &v1.Volume{Name: "vol-1"}, Pods: []*v1.Pod{pod}},
+			expected: &Action{Type: FSBackup},
+		},
+		{
+			name:     "pv mounted by pods of which one matches pod labels",
+			data:     VolumeFilterData{PersistentVolume: pv, PVC: pvc("ns-1", nil, nil), Pods: []*v1.Pod{{}, pod}},
+			expected: &Action{Type: FSBackup},
+		},
+		{
+			name:     "pv matching pvc annotations",
+			data:     VolumeFilterData{PersistentVolume: pv, PVC: pvc("ns-1", nil, map[string]string{"backup.example.com/skip": "true"})},
+			expected: &Action{Type: Skip},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			action, err := policies.GetMatchAction(tc.data)
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, action)
+		})
+	}
+
+	_, err = policies.GetMatchAction(VolumeFilterData{Pods: []*v1.Pod{pod}})
+	assert.Error(t, err)
+}
@@ -43,16 +43,37 @@ type capacity struct {
 }
 
 type structuredVolume struct {
-	capacity     resource.Quantity
-	storageClass string
-	nfs          *nFSVolumeSource
-	csi          *csiVolumeSource
-	volumeType   SupportedVolume
+	capacity       resource.Quantity
+	storageClass   string
+	nfs            *nFSVolumeSource
+	csi            *csiVolumeSource
+	volumeType     SupportedVolume
+	namespace      string
+	pvcLabels      map[string]string
+	pvcAnnotations map[string]string
+	podLabels      []map[string]string
+}
+
+func (s *structuredVolume) parse(data VolumeFilterData) {
+	if data.PersistentVolume != nil {
+		s.parsePV(data.PersistentVolume)
+	} else if data.PodVolume != nil {
+		s.parsePodVolume(data.PodVolume)
+	}
+	if data.PVC != nil {
+		s.parsePVC(data.PVC)
+	}
+	for _, pod := range data.Pods {
+		s.parsePod(pod)
+	}
 }
 
 func (s *structuredVolume) parsePV(pv *corev1api.PersistentVolume) {
 	s.capacity = *pv.Spec.Capacity.Storage()
 	s.storageClass = pv.Spec.StorageClassName
+	if pv.Spec.ClaimRef != nil {
+		s.namespace = pv.Spec.ClaimRef.Namespace
+	}
 	nfs := pv.Spec.NFS
 	if nfs != nil {
 		s.nfs = &nFSVolumeSource{Server: nfs.Server, Path: nfs.Path}
@@ -80,6 +101,19 @@ func (s *structuredVolume) parsePodVolume(vol *corev1api.Volume) {
 	s.volumeType = getVolumeTypeFromVolume(vol)
 }
 
+func (s *structuredVolume) parsePVC(pvc *corev1api.PersistentVolumeClaim) {
+	s.namespace = pvc.Namespace
+	s.pvcLabels = pvc.Labels
+	s.pvcAnnotations = pvc.Annotations
+}
+
+func (s *structuredVolume) parsePod(pod *corev1api.Pod) {
+	if s.namespace == "" {
+		s.namespace = pod.Namespace
+	}
+	s.podLabels = append(s.podLabels, pod.Labels)
+}
+
 type capacityCondition struct {
 	capacity capacity
 }
@@ -163,6 +197,66 @@ func (c *csiCondition) match(v *structuredVolume) bool {
 	return c.csi.Driver == v.csi.Driver
 }
 
+type namespaceCondition struct {
+	namespaces []string
+}
+
+func (c *namespaceCondition) match(v *structuredVolume) bool {
+	if len(c.namespaces) == 0 {
+		return true
+	}
+
+	for _, ns := range c.namespaces {
+		if v.namespace == ns {
+			return true
+		}
+	}
+
+	return false
+}
+
+type pvcLabelsCondition struct {
+	labels map[string]string
+}
+
+func (c *pvcLabelsCondition) match(v *structuredVolume) bool {
+	return matchAll(c.labels, v.pvcLabels)
+}
+
+type pvcAnnotationsCondition struct {
+	annotations map[string]string
+}
+
+func (c *pvcAnnotationsCondition) match(v *structuredVolume) bool {
+	return matchAll(c.annotations, v.pvcAnnotations)
+}
+
+type podLabelsCondition struct {
+	labels map[string]string
+}
+
+func (c *podLabelsCondition) match(v *structuredVolume) bool {
+	if len(c.labels) == 0 {
+		return true
+	}
+	for _, labels := range v.podLabels {
+		if matchAll(c.labels, labels) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchAll returns true if all the key/value pairs in want are in got
+func matchAll(want, got map[string]string) bool {
+	for k, v := range want {
+		if value, found := got[k]; !found || value != v {
+			return false
+		}
+	}
+	return true
+}
+
 // parseCapacity parse string into capacity format
 func parseCapacity(cap string) (*capacity, error) {
 	if cap == "" {
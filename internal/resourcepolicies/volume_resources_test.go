@@ -232,6 +232,140 @@ func TestCSIConditionMatch(t *testing.T) {
 	}
 }
 
+func TestNamespaceConditionMatch(t *testing.T) {
+	tests := []struct {
+		name          string
+		condition     *namespaceCondition
+		volume        *structuredVolume
+		expectedMatch bool
+	}{
+		{
+			name:          "empty namespaces",
+			condition:     &namespaceCondition{},
+			volume:        &structuredVolume{namespace: "ns-1"},
+			expectedMatch: true,
+		},
+		{
+			name:          "match one of the namespaces",
+			condition:     &namespaceCondition{[]string{"ns-1", "ns-2"}},
+			volume:        &structuredVolume{namespace: "ns-2"},
+			expectedMatch: true,
+		},
+		{
+			name:          "mismatch namespace",
+			condition:     &namespaceCondition{[]string{"ns-1"}},
+			volume:        &structuredVolume{namespace: "ns-2"},
+			expectedMatch: false,
+		},
+		{
+			name:          "volume without namespace",
+			condition:     &namespaceCondition{[]string{"ns-1"}},
+			volume:        &structuredVolume{},
+			expectedMatch: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedMatch, tt.condition.match(tt.volume))
+		})
+	}
+}
+
+func TestLabelsAndAnnotationsConditionMatch(t *testing.T) {
+	volume := &structuredVolume{
+		pvcLabels:      map[string]string{"app": "db", "tier": "backend"},
+		pvcAnnotations: map[string]string{"backup": "true"},
+		podLabels:      []map[string]string{{"team": "a"}},
+	}
+
+	tests := []struct {
+		name          string
+		condition     volumeCondition
+		volume        *structuredVolume
+		expectedMatch bool
+	}{
+		{
+			name:          "empty pvc labels",
+			condition:     &pvcLabelsCondition{},
+			volume:        &structuredVolume{},
+			expectedMatch: true,
+		},
+		{
+			name:          "match all pvc labels",
+			condition:     &pvcLabelsCondition{map[string]string{"app": "db", "tier": "backend"}},
+			volume:        volume,
+			expectedMatch: true,
+		},
+		{
+			name:          "mismatch pvc label value",
+			condition:     &pvcLabelsCondition{map[string]string{"app": "web"}},
+			volume:        volume,
+			expectedMatch: false,
+		},
+		{
+			name:          "missing pvc label",
+			condition:     &pvcLabelsCondition{map[string]string{"app": "db", "zone": "a"}},
+			volume:        volume,
+			expectedMatch: false,
+		},
+		{
+			name:          "volume without pvc",
+			condition:     &pvcLabelsCondition{map[string]string{"app": "db"}},
+			volume:        &structuredVolume{},
+			expectedMatch: false,
+		},
+		{
+			name:          "match pvc annotations",
+			condition:     &pvcAnnotationsCondition{map[string]string{"backup": "true"}},
+			volume:        volume,
+			expectedMatch: true,
+		},
+		{
+			name:          "mismatch pvc annotations",
+			condition:     &pvcAnnotationsCondition{map[string]string{"backup": "false"}},
+			volume:        volume,
+			expectedMatch: false,
+		},
+		{
+			name:          "empty pod labels",
+			condition:     &podLabelsCondition{},
+			volume:        &structuredVolume{},
+			expectedMatch: true,
+		},
+		{
+			name:          "match pod labels",
+			condition:     &podLabelsCondition{map[string]string{"team": "a"}},
+			volume:        volume,
+			expectedMatch: true,
+		},
+		{
+			name:          "mismatch pod labels",
+			condition:     &podLabelsCondition{map[string]string{"team": "b"}},
+			volume:        volume,
+			expectedMatch: false,
+		},
+		{
+			name:          "match labels of one of the pods",
+			condition:     &podLabelsCondition{map[string]string{"team": "b"}},
+			volume:        &structuredVolume{podLabels: []map[string]string{{"team": "a"}, {"team": "b"}}},
+			expectedMatch: true,
+		},
+		{
+			name:          "volume without pod",
+			condition:     &podLabelsCondition{map[string]string{"team": "a"}},
+			volume:        &structuredVolume{},
+			expectedMatch: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedMatch, tt.condition.match(tt.volume))
+		})
+	}
+}
+
 func TestUnmarshalVolumeConditions(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -18,9 +18,11 @@ package resourcepolicies
 import (
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 const currentSupportDataVersion = "v1"
@@ -43,6 +45,14 @@ type volumeConditions struct {
 	NFS          *nFSVolumeSource  `yaml:"nfs,omitempty"`
 	CSI          *csiVolumeSource  `yaml:"csi,omitempty"`
 	VolumeTypes  []SupportedVolume `yaml:"volumeTypes,omitempty"`
+	// Namespaces matches the namespace of the volume's PVC, or of the pod of a pod volume
+	Namespaces []string `yaml:"namespaces,omitempty"`
+	// PVCLabels matches the labels of the volume's PVC
+	PVCLabels map[string]string `yaml:"pvcLabels,omitempty"`
+	// PVCAnnotations matches the annotations of the volume's PVC
+	PVCAnnotations map[string]string `yaml:"pvcAnnotations,omitempty"`
+	// PodLabels matches the labels of the pod mounting the volume
+	PodLabels map[string]string `yaml:"podLabels,omitempty"`
 }
 
 func (c *capacityCondition) validate() error {
@@ -72,6 +82,44 @@ func (c *csiCondition) validate() error {
 	return nil
 }
 
+func (c *namespaceCondition) validate() error {
+	for _, ns := range c.namespaces {
+		if errs := validation.IsDNS1123Label(ns); len(errs) > 0 {
+			return errors.Errorf("invalid namespace %q in namespaces: %s", ns, strings.Join(errs, ", "))
+		}
+	}
+	return nil
+}
+
+func (c *pvcLabelsCondition) validate() error {
+	return validateLabels("pvcLabels", c.labels)
+}
+
+func (c *pvcAnnotationsCondition) validate() error {
+	for k := range c.annotations {
+		if errs := validation.IsQualifiedName(k); len(errs) > 0 {
+			return errors.Errorf("invalid key %q in pvcAnnotations: %s", k, strings.Join(errs, ", "))
+		}
+	}
+	return nil
+}
+
+func (c *podLabelsCondition) validate() error {
+	return validateLabels("podLabels", c.labels)
+}
+
+func validateLabels(condition string, labels map[string]string) error {
+	for k, v := range labels {
+		if errs := validation.IsQualifiedName(k); len(errs) > 0 {
+			return errors.Errorf("invalid key %q in %s: %s", k, condition, strings.Join(errs, ", "))
+		}
+		if errs := validation.IsValidLabelValue(v); len(errs) > 0 {
+			return errors.Errorf("invalid value %q of key %q in %s: %s", v, k, condition, strings.Join(errs, ", "))
+		}
+	}
+	return nil
+}
+
 // decodeStruct restric validate the keys in decoded mappings to exist as fields in the struct being decoded into
 func decodeStruct(r io.Reader, s interface{}) error {
 	dec := yaml.NewDecoder(r)
@@ -82,10 +130,20 @@ func decodeStruct(r io.Reader, s interface{}) error {
 // validate check action format
 func (a *Action) validate() error {
 	// validate Type
-	if a.Type != Skip {
+	switch a.Type {
+	case Skip, Snapshot, FSBackup:
+	case DataMover:
+		for k, v := range a.Parameters {
+			if k != DataMoverParameter {
+				return fmt.Errorf("invalid parameter %s of action type %s", k, a.Type)
+			}
+			if _, ok := v.(string); !ok {
+				return fmt.Errorf("parameter %s of action type %s must be a string", k, a.Type)
+			}
+		}
+	default:
 		return fmt.Errorf("invalid action type %s", a.Type)
 	}
 
-	// TODO validate parameters
 	return nil
 }
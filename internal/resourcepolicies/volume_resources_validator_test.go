@@ -220,6 +220,123 @@ func TestValidate(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "supported action types",
+			res: &resourcePolicies{
+				Version: "v1",
+				VolumePolicies: []volumePolicy{
+					{
+						Action:     Action{Type: "fs-backup"},
+						Conditions: map[string]interface{}{"nfs": map[string]interface{}{}},
+					},
+					{
+						Action:     Action{Type: "snapshot"},
+						Conditions: map[string]interface{}{"storageClass": []string{"gp2"}},
+					},
+					{
+						Action:     Action{Type: "datamover", Parameters: map[string]interface{}{"dataMover": "velero"}},
+						Conditions: map[string]interface{}{"capacity": "100Gi,"},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "unknown parameter of datamover action",
+			res: &resourcePolicies{
+				Version: "v1",
+				VolumePolicies: []volumePolicy{
+					{
+						Action:     Action{Type: "datamover", Parameters: map[string]interface{}{"unknown": "velero"}},
+						Conditions: map[string]interface{}{"capacity": "100Gi,"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid data mover of datamover action",
+			res: &resourcePolicies{
+				Version: "v1",
+				VolumePolicies: []volumePolicy{
+					{
+						Action:     Action{Type: "datamover", Parameters: map[string]interface{}{"dataMover": 1}},
+						Conditions: map[string]interface{}{"capacity": "100Gi,"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "supported pvc and pod conditions",
+			res: &resourcePolicies{
+				Version: "v1",
+				VolumePolicies: []volumePolicy{
+					{
+						Action: Action{Type: "skip"},
+						Conditions: map[string]interface{}{
+							"namespaces":     []string{"ns-1"},
+							"pvcLabels":      map[string]string{"app": "db"},
+							"pvcAnnotations": map[string]string{"example.com/backup": "no"},
+							"podLabels":      map[string]string{"team": "a"},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "invalid namespace",
+			res: &resourcePolicies{
+				Version: "v1",
+				VolumePolicies: []volumePolicy{
+					{
+						Action:     Action{Type: "skip"},
+						Conditions: map[string]interface{}{"namespaces": []string{"NS_1"}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid pvc label value",
+			res: &resourcePolicies{
+				Version: "v1",
+				VolumePolicies: []volumePolicy{
+					{
+						Action:     Action{Type: "skip"},
+						Conditions: map[string]interface{}{"pvcLabels": map[string]string{"app": "not a value"}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid pod label key",
+			res: &resourcePolicies{
+				Version: "v1",
+				VolumePolicies: []volumePolicy{
+					{
+						Action:     Action{Type: "skip"},
+						Conditions: map[string]interface{}{"podLabels": map[string]string{"-team": "a"}},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "error format of pvc annotations",
+			res: &resourcePolicies{
+				Version: "v1",
+				VolumePolicies: []volumePolicy{
+					{
+						Action:     Action{Type: "skip"},
+						Conditions: map[string]interface{}{"pvcAnnotations": []string{"backup"}},
+					},
+				},
+			},
+			wantErr: true,
+		},
 		{
 			name: "supported formart volume policies",
 			res: &resourcePolicies{
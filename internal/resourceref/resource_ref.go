@@ -0,0 +1,25 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package resourceref defines the kinds of the objects the resource policies
+// and the resource modifiers are referenced by. It has no dependencies, so that
+// packages used by the tests of the policies' dependencies, such as the
+// builders, can refer to them.
+package resourceref
+
+// ConfigmapRefType is the kind of a reference to resource policies or
+// resource modifiers stored in a ConfigMap.
+const ConfigmapRefType = "configmap"
@@ -0,0 +1,101 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restorepreview
+
+import (
+	"encoding/json"
+	"sort"
+	"sync"
+)
+
+// Action is what a restore would do to an item.
+type Action string
+
+const (
+	// ActionCreate means the item doesn't exist in the cluster and would be created.
+	ActionCreate Action = "create"
+
+	// ActionSkipExisting means the item already exists in the cluster, the same
+	// as in the backup, and would be left as it is.
+	ActionSkipExisting Action = "skip-existing"
+
+	// ActionUpdate means the item already exists in the cluster and would be
+	// updated to match the backup.
+	ActionUpdate Action = "update"
+
+	// ActionConflict means the item already exists in the cluster, different
+	// from the backup, and would be left as it is with a warning.
+	ActionConflict Action = "conflict"
+)
+
+// Item is what a restore would do to one item.
+type Item struct {
+	GroupResource string `json:"groupResource"`
+	Namespace     string `json:"namespace,omitempty"`
+	Name          string `json:"name"`
+	Action        Action `json:"action"`
+
+	// Diff is the JSON merge patch from the item in the cluster to the item
+	// as it would be restored, for the items which already exist.
+	Diff json.RawMessage `json:"diff,omitempty"`
+
+	// Message explains why the restore would do less than restoring the item
+	// as it is in the backup, e.g. when the server would reject the update.
+	Message string `json:"message,omitempty"`
+}
+
+// Preview collects what a restore would do to each item. It's safe for
+// concurrent use.
+type Preview struct {
+	lock  sync.Mutex
+	items map[string]Item
+}
+
+// New returns an empty Preview.
+func New() *Preview {
+	return &Preview{
+		items: make(map[string]Item),
+	}
+}
+
+// Add records what the restore would do to an item, replacing anything
+// recorded for the same item before.
+func (p *Preview) Add(item Item) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.items[key(item)] = item
+}
+
+// Items returns the recorded items sorted by group resource, namespace and name.
+func (p *Preview) Items() []Item {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	items := make([]Item, 0, len(p.items))
+	for _, item := range p.items {
+		items = append(items, item)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return key(items[i]) < key(items[j])
+	})
+	return items
+}
+
+func key(item Item) string {
+	return item.GroupResource + "/" + item.Namespace + "/" + item.Name
+}
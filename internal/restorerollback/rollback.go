@@ -0,0 +1,316 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restorerollback
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/dynamic"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+)
+
+// terminatingTimeout is how long a rollback waits for an item it deletes to
+// terminate, before creating its original version again.
+var terminatingTimeout = 10 * time.Minute
+
+// Action is what a restore did to an item.
+type Action string
+
+const (
+	// ActionCreated means the item didn't exist in the cluster and was created
+	// by the restore.
+	ActionCreated Action = "created"
+
+	// ActionUpdated means the item already existed in the cluster and was
+	// updated, or deleted and created again, by the restore.
+	ActionUpdated Action = "updated"
+)
+
+// Item is what a restore did to one item, with what's needed to revert it.
+type Item struct {
+	Group     string `json:"group,omitempty"`
+	Version   string `json:"version"`
+	Resource  string `json:"resource"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name"`
+	Action    Action `json:"action"`
+
+	// UID is the UID of the created item, if it's known, so that an item
+	// created again by someone else after the restore isn't deleted.
+	UID types.UID `json:"uid,omitempty"`
+
+	// Original is the item as it was in the cluster before the restore, for
+	// the updated items.
+	Original json.RawMessage `json:"original,omitempty"`
+}
+
+// GroupVersionResource returns the group, version and resource of the item.
+func (i *Item) GroupVersionResource() schema.GroupVersionResource {
+	return schema.GroupVersionResource{Group: i.Group, Version: i.Version, Resource: i.Resource}
+}
+
+// Recorder records what a restore does to the items, in the order the items
+// are restored. Only the first record of each item is kept, so that the
+// original of an updated item is the version before the restore. It's safe
+// for concurrent use.
+type Recorder struct {
+	lock     sync.Mutex
+	items    []Item
+	recorded map[string]bool
+}
+
+// NewRecorder returns an empty Recorder.
+func NewRecorder() *Recorder {
+	return &Recorder{
+		recorded: make(map[string]bool),
+	}
+}
+
+// Created records that the restore created an item.
+func (r *Recorder) Created(gvr schema.GroupVersionResource, namespace, name string, uid types.UID) {
+	r.add(Item{
+		Group:     gvr.Group,
+		Version:   gvr.Version,
+		Resource:  gvr.Resource,
+		Namespace: namespace,
+		Name:      name,
+		Action:    ActionCreated,
+		UID:       uid,
+	})
+}
+
+// Updated records that the restore updated an item, which was original
+// before the restore.
+func (r *Recorder) Updated(gvr schema.GroupVersionResource, original *unstructured.Unstructured) error {
+	data, err := original.MarshalJSON()
+	if err != nil {
+		return errors.Wrapf(err, "error marshalling original %s %s/%s", gvr.Resource, original.GetNamespace(), original.GetName())
+	}
+
+	r.add(Item{
+		Group:     gvr.Group,
+		Version:   gvr.Version,
+		Resource:  gvr.Resource,
+		Namespace: original.GetNamespace(),
+		Name:      original.GetName(),
+		Action:    ActionUpdated,
+		Original:  data,
+	})
+	return nil
+}
+
+func (r *Recorder) add(item Item) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	key := item.Group + "/" + item.Resource + "/" + item.Namespace + "/" + item.Name
+	if r.recorded[key] {
+		return
+	}
+	r.recorded[key] = true
+	r.items = append(r.items, item)
+}
+
+// Load records the items recorded by an interrupted run of the restore, so
+// that the originals of the items it updated are kept when it's resumed.
+func (r *Recorder) Load(items []Item) {
+	for _, item := range items {
+		r.add(item)
+	}
+}
+
+// Items returns the recorded items in the order they were recorded.
+func (r *Recorder) Items() []Item {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return append([]Item(nil), r.items...)
+}
+
+// Rollback reverts what a restore did to the items, in the reverse order they
+// were restored. The pod volume restores and data downloads of the restore are
+// deleted first, then the created items are deleted, and the updated items
+// are reverted to their original version. It returns the errors of the items
+// which couldn't be reverted, after trying all of them.
+func Rollback(ctx context.Context, dynamicClient dynamic.Interface, client kbclient.Client, restore *velerov1api.Restore, items []Item, log logrus.FieldLogger) []error {
+	var errs []error
+
+	artifacts := []kbclient.Object{
+		&velerov1api.PodVolumeRestore{},
+		&velerov2alpha1api.DataDownload{},
+	}
+	for _, artifact := range artifacts {
+		if err := client.DeleteAllOf(ctx, artifact,
+			kbclient.InNamespace(restore.Namespace),
+			kbclient.MatchingLabels{velerov1api.RestoreUIDLabel: string(restore.UID)},
+		); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, errors.Wrapf(err, "error deleting %T of restore", artifact))
+		}
+	}
+
+	for i := len(items) - 1; i >= 0; i-- {
+		item := items[i]
+		itemLog := log.WithFields(logrus.Fields{
+			"resource":  item.GroupVersionResource().GroupResource().String(),
+			"namespace": item.Namespace,
+			"name":      item.Name,
+		})
+
+		var resourceClient dynamic.ResourceInterface = dynamicClient.Resource(item.GroupVersionResource())
+		if item.Namespace != "" {
+			resourceClient = dynamicClient.Resource(item.GroupVersionResource()).Namespace(item.Namespace)
+		}
+
+		var err error
+		switch item.Action {
+		case ActionCreated:
+			err = deleteCreated(ctx, resourceClient, item, itemLog)
+		case ActionUpdated:
+			err = revertUpdated(ctx, resourceClient, item, itemLog)
+		default:
+			err = errors.Errorf("unknown action %q", item.Action)
+		}
+		if err != nil {
+			itemLog.WithError(err).Error("Error rolling back item")
+			errs = append(errs, errors.Wrapf(err, "error rolling back %s %s", item.GroupVersionResource().GroupResource(), namespaceAndName(item)))
+		}
+	}
+
+	return errs
+}
+
+func deleteCreated(ctx context.Context, resourceClient dynamic.ResourceInterface, item Item, log logrus.FieldLogger) error {
+	opts := metav1.DeleteOptions{}
+	if item.UID != "" {
+		opts.Preconditions = &metav1.Preconditions{UID: &item.UID}
+	}
+
+	err := resourceClient.Delete(ctx, item.Name, opts)
+	switch {
+	case apierrors.IsNotFound(err):
+		log.Info("Item created by the restore is already gone")
+		return nil
+	case apierrors.IsConflict(err):
+		log.Info("Item created by the restore has been replaced, leaving it as it is")
+		return nil
+	case err != nil:
+		return err
+	}
+
+	log.Info("Deleted item created by the restore")
+	return nil
+}
+
+func revertUpdated(ctx context.Context, resourceClient dynamic.ResourceInterface, item Item, log logrus.FieldLogger) error {
+	original := new(unstructured.Unstructured)
+	if err := original.UnmarshalJSON(item.Original); err != nil {
+		return errors.Wrap(err, "error unmarshalling original item")
+	}
+	original.SetManagedFields(nil)
+
+	current, err := resourceClient.Get(ctx, item.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		if err := createOriginal(ctx, resourceClient, original); err != nil {
+			return err
+		}
+		log.Info("Created original item again")
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	// an item deleted and created again by the restore is recreated the same
+	// way, since it may differ from the original in fields which can't be updated
+	if original.GetUID() != "" && current.GetUID() != original.GetUID() {
+		if err := deleteCurrent(ctx, resourceClient, current); err != nil {
+			return err
+		}
+		if err := createOriginal(ctx, resourceClient, original); err != nil {
+			return err
+		}
+		log.Info("Recreated original item replaced by the restore")
+		return nil
+	}
+
+	original.SetResourceVersion(current.GetResourceVersion())
+	if _, err := resourceClient.Update(ctx, original, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+
+	log.Info("Reverted item updated by the restore")
+	return nil
+}
+
+// deleteCurrent deletes the current version of an item, and waits until it's
+// gone.
+func deleteCurrent(ctx context.Context, resourceClient dynamic.ResourceInterface, current *unstructured.Unstructured) error {
+	uid := current.GetUID()
+	if err := resourceClient.Delete(ctx, current.GetName(), metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{UID: &uid},
+	}); err != nil && !apierrors.IsNotFound(err) {
+		return errors.Wrap(err, "error deleting item to recreate the original")
+	}
+
+	err := wait.PollImmediate(time.Second, terminatingTimeout, func() (bool, error) {
+		res, err := resourceClient.Get(ctx, current.GetName(), metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if res.GetUID() != uid {
+			return false, errors.New("item was created again by another client")
+		}
+		return false, nil
+	})
+	return errors.Wrap(err, "error waiting for item to terminate")
+}
+
+// createOriginal creates the original version of an item, without the
+// metadata set by the API server.
+func createOriginal(ctx context.Context, resourceClient dynamic.ResourceInterface, original *unstructured.Unstructured) error {
+	original.SetUID("")
+	original.SetResourceVersion("")
+	original.SetCreationTimestamp(metav1.Time{})
+	original.SetDeletionTimestamp(nil)
+	original.SetGeneration(0)
+	_, err := resourceClient.Create(ctx, original, metav1.CreateOptions{})
+	return err
+}
+
+func namespaceAndName(item Item) string {
+	if item.Namespace == "" {
+		return item.Name
+	}
+	return item.Namespace + "/" + item.Name
+}
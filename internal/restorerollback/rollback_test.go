@@ -0,0 +1,170 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restorerollback
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	kubetesting "k8s.io/client-go/testing"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+var (
+	configMaps = schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	namespaces = schema.GroupVersionResource{Version: "v1", Resource: "namespaces"}
+)
+
+func newConfigMap(namespace, name string, data map[string]interface{}) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"namespace": namespace,
+			"name":      name,
+		},
+		"data": data,
+	}}
+	return obj
+}
+
+func TestRecorder(t *testing.T) {
+	recorder := NewRecorder()
+
+	recorder.Created(namespaces, "", "ns-1", "uid-1")
+	recorder.Created(configMaps, "ns-1", "cm-1", "uid-2")
+	require.NoError(t, recorder.Updated(configMaps, newConfigMap("ns-1", "cm-2", map[string]interface{}{"a": "1"})))
+	// the later records of an item are ignored
+	recorder.Created(configMaps, "ns-1", "cm-2", "uid-3")
+	require.NoError(t, recorder.Updated(configMaps, newConfigMap("ns-1", "cm-2", map[string]interface{}{"a": "2"})))
+
+	items := recorder.Items()
+	require.Len(t, items, 3)
+
+	assert.Equal(t, Item{Version: "v1", Resource: "namespaces", Name: "ns-1", Action: ActionCreated, UID: "uid-1"}, items[0])
+	assert.Equal(t, Item{Version: "v1", Resource: "configmaps", Namespace: "ns-1", Name: "cm-1", Action: ActionCreated, UID: "uid-2"}, items[1])
+
+	assert.Equal(t, ActionUpdated, items[2].Action)
+	original := new(unstructured.Unstructured)
+	require.NoError(t, original.UnmarshalJSON(items[2].Original))
+	assert.Equal(t, map[string]interface{}{"a": "1"}, original.Object["data"])
+}
+
+func TestRecorderLoad(t *testing.T) {
+	recorder := NewRecorder()
+	recorder.Load([]Item{
+		{Version: "v1", Resource: "namespaces", Name: "ns-1", Action: ActionCreated, UID: "uid-1"},
+		{Version: "v1", Resource: "configmaps", Namespace: "ns-1", Name: "cm-1", Action: ActionUpdated, Original: []byte(`{}`)},
+	})
+
+	// the items of the interrupted run are kept over the records of the resumed one
+	recorder.Created(configMaps, "ns-1", "cm-1", "uid-2")
+	recorder.Created(configMaps, "ns-1", "cm-2", "uid-3")
+
+	items := recorder.Items()
+	require.Len(t, items, 3)
+	assert.Equal(t, ActionCreated, items[0].Action)
+	assert.Equal(t, ActionUpdated, items[1].Action)
+	assert.Equal(t, "cm-2", items[2].Name)
+}
+
+func TestRollback(t *testing.T) {
+	restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").ObjectMeta(builder.WithUID("restore-uid")).Result()
+
+	created := newConfigMap("ns-1", "created", nil)
+	updated := newConfigMap("ns-1", "updated", map[string]interface{}{"a": "restored"})
+	updated.SetUID("uid-updated")
+	recreated := newConfigMap("ns-1", "recreated", map[string]interface{}{"a": "restored"})
+	recreated.SetUID("uid-recreated")
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), created, updated, recreated)
+
+	pvr := builder.ForPodVolumeRestore(velerov1api.DefaultNamespace, "pvr-1").ObjectMeta(builder.WithLabels(velerov1api.RestoreUIDLabel, "restore-uid")).Result()
+	otherPVR := builder.ForPodVolumeRestore(velerov1api.DefaultNamespace, "pvr-2").ObjectMeta(builder.WithLabels(velerov1api.RestoreUIDLabel, "other-uid")).Result()
+	client := velerotest.NewFakeControllerRuntimeClient(t, pvr, otherPVR)
+
+	recorder := NewRecorder()
+	recorder.Created(configMaps, "ns-1", "created", "")
+	recorder.Created(configMaps, "ns-1", "gone", "")
+	require.NoError(t, recorder.Updated(configMaps, newConfigMap("ns-1", "updated", map[string]interface{}{"a": "original"})))
+	require.NoError(t, recorder.Updated(configMaps, newConfigMap("ns-1", "deleted", map[string]interface{}{"a": "original"})))
+	original := newConfigMap("ns-1", "recreated", map[string]interface{}{"a": "original"})
+	original.SetUID("uid-original")
+	require.NoError(t, recorder.Updated(configMaps, original))
+
+	errs := Rollback(context.Background(), dynamicClient, client, restore, recorder.Items(), velerotest.NewLogger())
+	require.Empty(t, errs)
+
+	// the created items are deleted, even if they're already gone
+	_, err := dynamicClient.Resource(configMaps).Namespace("ns-1").Get(context.Background(), "created", metav1.GetOptions{})
+	assert.True(t, apierrors.IsNotFound(err))
+
+	// the updated items are reverted, or created again if they were deleted
+	for _, name := range []string{"updated", "deleted", "recreated"} {
+		res, err := dynamicClient.Resource(configMaps).Namespace("ns-1").Get(context.Background(), name, metav1.GetOptions{})
+		require.NoError(t, err)
+		assert.Equal(t, map[string]interface{}{"a": "original"}, res.Object["data"])
+	}
+
+	// the items recreated by the restore are deleted and created again, rather than updated
+	var recreateVerbs []string
+	for _, action := range dynamicClient.Actions() {
+		switch a := action.(type) {
+		case kubetesting.DeleteActionImpl:
+			if a.GetName() == "recreated" {
+				recreateVerbs = append(recreateVerbs, a.GetVerb())
+			}
+		case kubetesting.CreateActionImpl:
+			if a.GetObject().(*unstructured.Unstructured).GetName() == "recreated" {
+				recreateVerbs = append(recreateVerbs, a.GetVerb())
+			}
+		case kubetesting.UpdateActionImpl:
+			if a.GetObject().(*unstructured.Unstructured).GetName() == "recreated" {
+				recreateVerbs = append(recreateVerbs, a.GetVerb())
+			}
+		}
+	}
+	assert.Equal(t, []string{"delete", "create"}, recreateVerbs)
+
+	// only the pod volume restores of the restore are deleted
+	pvrs := new(velerov1api.PodVolumeRestoreList)
+	require.NoError(t, client.List(context.Background(), pvrs, kbclient.InNamespace(velerov1api.DefaultNamespace)))
+	require.Len(t, pvrs.Items, 1)
+	assert.Equal(t, "pvr-2", pvrs.Items[0].Name)
+}
+
+func TestRollbackUnknownAction(t *testing.T) {
+	restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result()
+	dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+	client := velerotest.NewFakeControllerRuntimeClient(t)
+
+	items := []Item{{Version: "v1", Resource: "configmaps", Namespace: "ns-1", Name: "cm-1", Action: "unknown"}}
+	errs := Rollback(context.Background(), dynamicClient, client, restore, items, velerotest.NewLogger())
+	require.Len(t, errs, 1)
+	assert.Contains(t, errs[0].Error(), `unknown action "unknown"`)
+}
@@ -191,6 +191,16 @@ type VolumesInformation struct {
 	PodVolumeBackups       []*velerov1api.PodVolumeBackup
 	BackupOperations       []*itemoperation.BackupOperation
 	BackupName             string
+
+	// plannedCSISnapshots contains the PVCs that a dry-run backup would have
+	// snapshotted through the CSI plugin.
+	plannedCSISnapshots []plannedCSISnapshot
+}
+
+type plannedCSISnapshot struct {
+	PVCName      string
+	PVCNamespace string
+	DataMoved    bool
 }
 
 type pvcPvInfo struct {
@@ -216,6 +226,16 @@ func (v *VolumesInformation) InsertPVMap(pv corev1api.PersistentVolume, pvcName,
 	}
 }
 
+// InsertPlannedCSISnapshot records that the PVC would be backed up by a CSI snapshot.
+// It's used by dry-run backups, which don't create VolumeSnapshots.
+func (v *VolumesInformation) InsertPlannedCSISnapshot(pvcName, pvcNamespace string, dataMoved bool) {
+	v.plannedCSISnapshots = append(v.plannedCSISnapshots, plannedCSISnapshot{
+		PVCName:      pvcName,
+		PVCNamespace: pvcNamespace,
+		DataMoved:    dataMoved,
+	})
+}
+
 func (v *VolumesInformation) Result(
 	csiVolumeSnapshots []snapshotv1api.VolumeSnapshot,
 	csiVolumeSnapshotContents []snapshotv1api.VolumeSnapshotContent,
@@ -234,6 +254,7 @@ func (v *VolumesInformation) Result(
 	v.generateVolumeInfoForCSIVolumeSnapshot()
 	v.generateVolumeInfoFromPVB()
 	v.generateVolumeInfoFromDataUpload()
+	v.generateVolumeInfoForPlannedCSISnapshot()
 
 	return v.volumeInfos
 }
@@ -403,6 +424,36 @@ func (v *VolumesInformation) generateVolumeInfoForCSIVolumeSnapshot() {
 	v.volumeInfos = append(v.volumeInfos, tmpVolumeInfos...)
 }
 
+// generateVolumeInfoForPlannedCSISnapshot generate VolumeInfos for the CSI snapshots
+// a dry-run backup would have taken.
+func (v *VolumesInformation) generateVolumeInfoForPlannedCSISnapshot() {
+	tmpVolumeInfos := make([]*VolumeInfo, 0)
+
+	for _, planned := range v.plannedCSISnapshots {
+		if pvcPVInfo := v.retrievePvcPvInfo("", planned.PVCName, planned.PVCNamespace); pvcPVInfo != nil {
+			volumeInfo := &VolumeInfo{
+				BackupMethod:      CSISnapshot,
+				PVCName:           pvcPVInfo.PVCName,
+				PVCNamespace:      pvcPVInfo.PVCNamespace,
+				PVName:            pvcPVInfo.PV.Name,
+				SnapshotDataMoved: planned.DataMoved,
+				Skipped:           false,
+				PVInfo: &PVInfo{
+					ReclaimPolicy: string(pvcPVInfo.PV.Spec.PersistentVolumeReclaimPolicy),
+					Labels:        pvcPVInfo.PV.Labels,
+				},
+			}
+
+			tmpVolumeInfos = append(tmpVolumeInfos, volumeInfo)
+		} else {
+			v.logger.Warnf("cannot find info for PVC %s/%s", planned.PVCNamespace, planned.PVCName)
+			continue
+		}
+	}
+
+	v.volumeInfos = append(v.volumeInfos, tmpVolumeInfos...)
+}
+
 // generateVolumeInfoFromPVB generate VolumeInfo for PVB.
 func (v *VolumesInformation) generateVolumeInfoFromPVB() {
 	tmpVolumeInfos := make([]*VolumeInfo, 0)
@@ -457,6 +457,69 @@ func TestGenerateVolumeInfoForCSIVolumeSnapshot(t *testing.T) {
 	}
 }
 
+func TestGenerateVolumeInfoForPlannedCSISnapshot(t *testing.T) {
+	tests := []struct {
+		name                string
+		pvMap               map[string]pvcPvInfo
+		dataMoved           bool
+		expectedVolumeInfos []*VolumeInfo
+	}{
+		{
+			name:                "Cannot find info for PVC",
+			expectedVolumeInfos: []*VolumeInfo{},
+		},
+		{
+			name: "Planned CSI snapshot with data movement",
+			pvMap: map[string]pvcPvInfo{
+				"testPV": {
+					PVCName:      "testPVC",
+					PVCNamespace: "velero",
+					PV: corev1api.PersistentVolume{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:   "testPV",
+							Labels: map[string]string{"a": "b"},
+						},
+						Spec: corev1api.PersistentVolumeSpec{
+							PersistentVolumeReclaimPolicy: corev1api.PersistentVolumeReclaimDelete,
+						},
+					},
+				},
+			},
+			dataMoved: true,
+			expectedVolumeInfos: []*VolumeInfo{
+				{
+					PVCName:           "testPVC",
+					PVCNamespace:      "velero",
+					PVName:            "testPV",
+					BackupMethod:      CSISnapshot,
+					SnapshotDataMoved: true,
+					PVInfo: &PVInfo{
+						ReclaimPolicy: "Delete",
+						Labels: map[string]string{
+							"a": "b",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			volumesInfo := VolumesInformation{}
+			volumesInfo.Init()
+			for k, v := range tc.pvMap {
+				volumesInfo.pvMap[k] = v
+			}
+			volumesInfo.InsertPlannedCSISnapshot("testPVC", "velero", tc.dataMoved)
+			volumesInfo.logger = logging.DefaultLogger(logrus.DebugLevel, logging.FormatJSON)
+
+			volumesInfo.generateVolumeInfoForPlannedCSISnapshot()
+			require.Equal(t, tc.expectedVolumeInfos, volumesInfo.volumeInfos)
+		})
+	}
+}
+
 func TestGenerateVolumeInfoFromPVB(t *testing.T) {
 	tests := []struct {
 		name                string
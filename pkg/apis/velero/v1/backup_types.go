@@ -166,6 +166,12 @@ type BackupSpec struct {
 	// +optional
 	ResourcePolicy *v1.TypedLocalObjectReference `json:"resourcePolicy,omitempty"`
 
+	// ResourceModifier specifies the reference to JSON resource patches that should be applied to resources
+	// before they are written into the backup.
+	// +optional
+	// +nullable
+	ResourceModifier *v1.TypedLocalObjectReference `json:"resourceModifier,omitempty"`
+
 	// SnapshotMoveData specifies whether snapshot data should be moved
 	// +optional
 	// +nullable
@@ -180,6 +186,27 @@ type BackupSpec struct {
 	// +optional
 	// +nullable
 	UploaderConfig *UploaderConfigForBackup `json:"uploaderConfig,omitempty"`
+
+	// DryRun specifies whether the backup only previews what would be captured.
+	// A dry-run backup collects items and evaluates resource policies and volume
+	// backup methods, but takes no snapshots, runs no hooks and uploads no tarball.
+	// The resource list and volume information are persisted for download.
+	// +optional
+	// +nullable
+	DryRun *bool `json:"dryRun,omitempty"`
+
+	// Compression specifies the compression algorithm used for the backup tarball.
+	// If not set, the server's default compression is used.
+	// +optional
+	Compression BackupCompression `json:"compression,omitempty"`
+
+	// Cancel requests the cancellation of the backup. The items which
+	// haven't been backed up yet are skipped, the pod volume backups, data
+	// uploads and plugin operations in progress are canceled, and the
+	// backup ends in the Cancelled phase.
+	// +optional
+	// +nullable
+	Cancel *bool `json:"cancel,omitempty"`
 }
 
 // UploaderConfigForBackup defines the configuration for the uploader when doing backup.
@@ -244,7 +271,12 @@ type BackupResourceHookSpec struct {
 // BackupResourceHook defines a hook for a resource.
 type BackupResourceHook struct {
 	// Exec defines an exec hook.
-	Exec *ExecHook `json:"exec"`
+	// +optional
+	Exec *ExecHook `json:"exec,omitempty"`
+
+	// HTTP defines an HTTP hook.
+	// +optional
+	HTTP *HTTPHook `json:"http,omitempty"`
 }
 
 // ExecHook is a hook that uses the pod exec API to execute a command in a container in a pod.
@@ -268,6 +300,55 @@ type ExecHook struct {
 	Timeout metav1.Duration `json:"timeout,omitempty"`
 }
 
+// HTTPHook is a hook that sends an HTTP request to an endpoint served by a pod, or to a URL, for
+// workloads which can't run commands in their containers.
+type HTTPHook struct {
+	// URL is the URL the request is sent to. If not specified, the request is sent to the pod's IP
+	// on Port and Path.
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// Scheme is the scheme used to reach the pod when URL is not specified. Defaults to http.
+	// +kubebuilder:validation:Enum=http;https
+	// +optional
+	Scheme string `json:"scheme,omitempty"`
+
+	// Port is the port of the pod the request is sent to when URL is not specified.
+	// +optional
+	Port int32 `json:"port,omitempty"`
+
+	// Path is the path of the request sent to the pod when URL is not specified. Defaults to "/".
+	// +optional
+	Path string `json:"path,omitempty"`
+
+	// Method is the HTTP method of the request. Defaults to POST.
+	// +optional
+	Method string `json:"method,omitempty"`
+
+	// HeadersSecret is the name of a secret in the pod's namespace whose keys and values are sent
+	// as the request's headers.
+	// +optional
+	HeadersSecret string `json:"headersSecret,omitempty"`
+
+	// ExpectedStatusCodes are the response status codes which mean the hook succeeded. If not
+	// specified, any 2xx status code does.
+	// +optional
+	ExpectedStatusCodes []int32 `json:"expectedStatusCodes,omitempty"`
+
+	// InsecureSkipTLSVerify disables the verification of the server's certificate for HTTPS requests.
+	// +optional
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+
+	// OnError specifies how Velero should behave if it encounters an error executing this hook.
+	// +optional
+	OnError HookErrorMode `json:"onError,omitempty"`
+
+	// Timeout defines the maximum amount of time Velero should wait for the response before
+	// considering the execution a failure.
+	// +optional
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+}
+
 // HookErrorMode defines how Velero should treat an error from a hook.
 // +kubebuilder:validation:Enum=Continue;Fail
 type HookErrorMode string
@@ -282,9 +363,24 @@ const (
 	HookErrorModeFail HookErrorMode = "Fail"
 )
 
+// BackupCompression is the compression algorithm used for a backup tarball.
+// +kubebuilder:validation:Enum=gzip;zstd;none
+type BackupCompression string
+
+const (
+	// BackupCompressionGzip compresses the backup tarball with gzip.
+	BackupCompressionGzip BackupCompression = "gzip"
+
+	// BackupCompressionZstd compresses the backup tarball with zstd.
+	BackupCompressionZstd BackupCompression = "zstd"
+
+	// BackupCompressionNone leaves the backup tarball uncompressed.
+	BackupCompressionNone BackupCompression = "none"
+)
+
 // BackupPhase is a string representation of the lifecycle phase
 // of a Velero backup.
-// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;WaitingForPluginOperations;WaitingForPluginOperationsPartiallyFailed;Finalizing;FinalizingPartiallyFailed;Completed;PartiallyFailed;Failed;Deleting
+// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;WaitingForPluginOperations;WaitingForPluginOperationsPartiallyFailed;Finalizing;FinalizingPartiallyFailed;Completed;PartiallyFailed;Failed;Cancelled;Deleting
 type BackupPhase string
 
 const (
@@ -343,6 +439,11 @@ const (
 	// prevented it from completing successfully.
 	BackupPhaseFailed BackupPhase = "Failed"
 
+	// BackupPhaseCancelled means the backup was cancelled before it
+	// completed. A cancelled backup expires when it's cancelled, so
+	// its partial data is deleted by the garbage collection.
+	BackupPhaseCancelled BackupPhase = "Cancelled"
+
 	// BackupPhaseDeleting means the backup and all its associated data are being deleted.
 	BackupPhaseDeleting BackupPhase = "Deleting"
 )
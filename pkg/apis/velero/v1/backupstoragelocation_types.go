@@ -148,6 +148,35 @@ type ObjectStorageLocation struct {
 	// CACert defines a CA bundle to use when verifying TLS connections to the provider.
 	// +optional
 	CACert []byte `json:"caCert,omitempty"`
+
+	// Encryption specifies the settings for encrypting objects on the client side
+	// before they are uploaded to the object storage.
+	// +optional
+	// +nullable
+	Encryption *ObjectStorageEncryption `json:"encryption,omitempty"`
+
+	// Integrity specifies the settings for the integrity manifest written
+	// with each backup.
+	// +optional
+	// +nullable
+	Integrity *ObjectStorageIntegrity `json:"integrity,omitempty"`
+}
+
+// ObjectStorageEncryption specifies the settings for client-side envelope encryption
+// of the objects Velero writes to object storage.
+type ObjectStorageEncryption struct {
+	// Key is the Secret key holding the 256-bit key used to encrypt the per-object
+	// data keys. The value must be 32 bytes, either raw or base64-encoded.
+	Key *corev1api.SecretKeySelector `json:"key"`
+}
+
+// ObjectStorageIntegrity specifies the settings for the integrity manifest
+// recording the digests of each backup's files.
+type ObjectStorageIntegrity struct {
+	// SigningKey is the Secret key holding the key used to sign the integrity
+	// manifest of each backup with HMAC-SHA256.
+	// +optional
+	SigningKey *corev1api.SecretKeySelector `json:"signingKey,omitempty"`
 }
 
 // BackupStorageLocationPhase is the lifecycle phase of a Velero BackupStorageLocation.
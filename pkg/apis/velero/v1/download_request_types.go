@@ -25,7 +25,7 @@ type DownloadRequestSpec struct {
 }
 
 // DownloadTargetKind represents what type of file to download.
-// +kubebuilder:validation:Enum=BackupLog;BackupContents;BackupVolumeSnapshots;BackupItemOperations;BackupResourceList;BackupResults;RestoreLog;RestoreResults;RestoreResourceList;RestoreItemOperations;CSIBackupVolumeSnapshots;CSIBackupVolumeSnapshotContents;BackupVolumeInfos
+// +kubebuilder:validation:Enum=BackupLog;BackupContents;BackupVolumeSnapshots;BackupItemOperations;BackupResourceList;BackupResults;RestoreLog;RestoreResults;RestoreResourceList;RestoreItemOperations;CSIBackupVolumeSnapshots;CSIBackupVolumeSnapshotContents;BackupVolumeInfos;BackupPodVolumeBackups;CSIBackupVolumeSnapshotClasses;BackupManifest;BackupHookResults;RestoreHookResults;RestorePreview
 type DownloadTargetKind string
 
 const (
@@ -42,6 +42,12 @@ const (
 	DownloadTargetKindCSIBackupVolumeSnapshots        DownloadTargetKind = "CSIBackupVolumeSnapshots"
 	DownloadTargetKindCSIBackupVolumeSnapshotContents DownloadTargetKind = "CSIBackupVolumeSnapshotContents"
 	DownloadTargetKindBackupVolumeInfos               DownloadTargetKind = "BackupVolumeInfos"
+	DownloadTargetKindBackupPodVolumeBackups          DownloadTargetKind = "BackupPodVolumeBackups"
+	DownloadTargetKindCSIBackupVolumeSnapshotClasses  DownloadTargetKind = "CSIBackupVolumeSnapshotClasses"
+	DownloadTargetKindBackupManifest                  DownloadTargetKind = "BackupManifest"
+	DownloadTargetKindBackupHookResults               DownloadTargetKind = "BackupHookResults"
+	DownloadTargetKindRestoreHookResults              DownloadTargetKind = "RestoreHookResults"
+	DownloadTargetKindRestorePreview                  DownloadTargetKind = "RestorePreview"
 )
 
 // DownloadTarget is the specification for what kind of file to download, and the name of the
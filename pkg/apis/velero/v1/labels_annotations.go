@@ -90,6 +90,10 @@ const (
 	// ResourceUsageLabel is the label key to explain the Velero resource usage.
 	ResourceUsageLabel = "velero.io/resource-usage"
 
+	// RollbackRequestedAnnotation is the annotation key used to request the
+	// rollback of a restore which has completed.
+	RollbackRequestedAnnotation = "velero.io/rollback-requested"
+
 	// VolumesToBackupAnnotation is the annotation on a pod whose mounted volumes
 	// need to be backed up using pod volume backup.
 	VolumesToBackupAnnotation = "backup.velero.io/backup-volumes"
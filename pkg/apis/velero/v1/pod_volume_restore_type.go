@@ -54,6 +54,13 @@ type PodVolumeRestoreSpec struct {
 	// +optional
 	// +nullable
 	UploaderSettings map[string]string `json:"uploaderSettings,omitempty"`
+
+	// IncludedPaths is a list of paths, relative to the root of the volume,
+	// to be restored. The other files of the snapshot are neither read nor
+	// restored. If empty, the whole volume is restored.
+	// +optional
+	// +nullable
+	IncludedPaths []string `json:"includedPaths,omitempty"`
 }
 
 // PodVolumeRestorePhase represents the lifecycle phase of a PodVolumeRestore.
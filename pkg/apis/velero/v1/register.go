@@ -57,6 +57,7 @@ func CustomResources() map[string]typeInfo {
 		"BackupStorageLocation":  newTypeInfo("backupstoragelocations", &BackupStorageLocation{}, &BackupStorageLocationList{}),
 		"VolumeSnapshotLocation": newTypeInfo("volumesnapshotlocations", &VolumeSnapshotLocation{}, &VolumeSnapshotLocationList{}),
 		"ServerStatusRequest":    newTypeInfo("serverstatusrequests", &ServerStatusRequest{}, &ServerStatusRequestList{}),
+		"SnapshotBrowseRequest":  newTypeInfo("snapshotbrowserequests", &SnapshotBrowseRequest{}, &SnapshotBrowseRequestList{}),
 	}
 }
 
@@ -65,6 +65,14 @@ type RestoreSpec struct {
 	// +optional
 	NamespaceMapping map[string]string `json:"namespaceMapping,omitempty"`
 
+	// NamespaceMappingPatterns is a list of patterns mapping source
+	// namespace names to target namespace names to restore into. The
+	// patterns are tried in order and the first matching one is applied.
+	// Namespaces in NamespaceMapping aren't mapped by the patterns.
+	// +optional
+	// +nullable
+	NamespaceMappingPatterns []NamespaceMappingPattern `json:"namespaceMappingPatterns,omitempty"`
+
 	// LabelSelector is a metav1.LabelSelector to filter with
 	// when restoring individual objects from the backup. If empty
 	// or nil, all objects are included. Optional.
@@ -114,6 +122,13 @@ type RestoreSpec struct {
 	// +nullable
 	ExistingResourcePolicy PolicyType `json:"existingResourcePolicy,omitempty"`
 
+	// ExistingResourcePolicyOverrides overrides ExistingResourcePolicy for specific
+	// resources. The keys are resource names, in the same form as IncludedResources,
+	// e.g. "deployments.apps" or "secrets".
+	// +optional
+	// +nullable
+	ExistingResourcePolicyOverrides map[string]PolicyType `json:"existingResourcePolicyOverrides,omitempty"`
+
 	// ItemOperationTimeout specifies the time used to wait for RestoreItemAction operations
 	// The default value is 4 hour.
 	// +optional
@@ -128,6 +143,29 @@ type RestoreSpec struct {
 	// +optional
 	// +nullable
 	UploaderConfig *UploaderConfigForRestore `json:"uploaderConfig,omitempty"`
+
+	// Preview specifies whether the restore only reports what it would
+	// do to each item, without creating or updating anything in the
+	// cluster.
+	// +optional
+	// +nullable
+	Preview *bool `json:"preview,omitempty"`
+
+	// RollbackOnFailure specifies whether the restore is rolled back
+	// when it ends PartiallyFailed or Failed: the items created by the
+	// restore are deleted, and the items updated by it are reverted to
+	// their version before the restore.
+	// +optional
+	// +nullable
+	RollbackOnFailure *bool `json:"rollbackOnFailure,omitempty"`
+
+	// Cancel requests the cancellation of the restore. The items which
+	// haven't been restored yet are skipped, the data downloads and plugin
+	// operations in progress are canceled, and the restore ends in the
+	// Cancelled phase.
+	// +optional
+	// +nullable
+	Cancel *bool `json:"cancel,omitempty"`
 }
 
 // UploaderConfigForRestore defines the configuration for the restore.
@@ -136,6 +174,36 @@ type UploaderConfigForRestore struct {
 	// +optional
 	// +nullable
 	WriteSparseFiles *bool `json:"writeSparseFiles,omitempty"`
+
+	// IncludedPaths is a list of paths, relative to the root of the
+	// volumes, to be restored from the pod volume and data mover
+	// snapshots. If empty, the whole volumes are restored.
+	// +optional
+	// +nullable
+	IncludedPaths []string `json:"includedPaths,omitempty"`
+}
+
+// NamespaceMappingPattern maps the source namespaces matching a pattern to
+// target namespace names.
+type NamespaceMappingPattern struct {
+	// Regex is a regular expression which must match the whole source
+	// namespace name. If empty, every namespace matches.
+	// +optional
+	Regex string `json:"regex,omitempty"`
+
+	// Replacement is the target namespace name for a source namespace
+	// matching Regex, and may refer to Regex's capture groups, e.g. "$1".
+	// If empty, the source namespace name is used.
+	// +optional
+	Replacement string `json:"replacement,omitempty"`
+
+	// Prefix is prepended to the target namespace name.
+	// +optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// Suffix is appended to the target namespace name.
+	// +optional
+	Suffix string `json:"suffix,omitempty"`
 }
 
 // RestoreHooks contains custom behaviors that should be executed during or post restore.
@@ -201,6 +269,9 @@ type RestoreResourceHook struct {
 
 	// Init defines an init restore hook.
 	Init *InitRestoreHook `json:"init,omitempty"`
+
+	// HTTP defines an HTTP restore hook.
+	HTTP *HTTPRestoreHook `json:"http,omitempty"`
 }
 
 // ExecRestoreHook is a hook that uses pod exec API to execute a command inside a container in a pod
@@ -234,6 +305,27 @@ type ExecRestoreHook struct {
 	WaitForReady *bool `json:"waitForReady,omitempty"`
 }
 
+// HTTPRestoreHook is a hook that sends an HTTP request to an endpoint served by a pod, or to a URL,
+// once a container of the restored pod is up.
+type HTTPRestoreHook struct {
+	HTTPHook `json:",inline"`
+
+	// Container is the container in the pod which must be running, or ready if WaitForReady is set,
+	// before the request is sent. If not specified, the pod's first container is used.
+	// +optional
+	Container string `json:"container,omitempty"`
+
+	// WaitTimeout defines the maximum amount of time Velero should wait for the container to be Ready
+	// before sending the request.
+	// +optional
+	WaitTimeout metav1.Duration `json:"waitTimeout,omitempty"`
+
+	// WaitForReady ensures the request will be sent when the container is Ready instead of Running.
+	// +optional
+	// +nullable
+	WaitForReady *bool `json:"waitForReady,omitempty"`
+}
+
 // InitRestoreHook is a hook that adds an init container to a PodSpec to run commands before the
 // workload pod is able to start.
 type InitRestoreHook struct {
@@ -249,7 +341,7 @@ type InitRestoreHook struct {
 
 // RestorePhase is a string representation of the lifecycle phase
 // of a Velero restore
-// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;WaitingForPluginOperations;WaitingForPluginOperationsPartiallyFailed;Completed;PartiallyFailed;Failed
+// +kubebuilder:validation:Enum=New;FailedValidation;InProgress;WaitingForPluginOperations;WaitingForPluginOperationsPartiallyFailed;Completed;PartiallyFailed;Failed;Cancelled
 type RestorePhase string
 
 const (
@@ -289,6 +381,11 @@ const (
 	// The failing error is recorded in status.FailureReason.
 	RestorePhaseFailed RestorePhase = "Failed"
 
+	// RestorePhaseCancelled means the restore was cancelled before it
+	// completed. A cancelled restore is rolled back, so the items it
+	// has already created or updated are deleted or reverted.
+	RestorePhaseCancelled RestorePhase = "Cancelled"
+
 	// PolicyTypeNone means velero will not overwrite the resource
 	// in cluster with the one in backup whether changed/unchanged.
 	PolicyTypeNone PolicyType = "none"
@@ -296,6 +393,17 @@ const (
 	// PolicyTypeUpdate means velero will try to attempt a patch on
 	// the changed resources.
 	PolicyTypeUpdate PolicyType = "update"
+
+	// PolicyTypeRecreate means velero will delete the changed resources
+	// and wait for them to terminate before creating them again. Persistent
+	// volumes, persistent volume claims and namespaces are updated instead,
+	// unless the policy is set for them by an override.
+	PolicyTypeRecreate PolicyType = "recreate"
+
+	// PolicyTypeMerge means velero will attempt a three-way strategic merge
+	// of the backed-up version onto the changed resources, keeping the fields
+	// which are only set in the cluster.
+	PolicyTypeMerge PolicyType = "merge"
 )
 
 // RestoreStatus captures the current status of a Velero restore
@@ -363,6 +471,57 @@ type RestoreStatus struct {
 	// +optional
 	// +nullable
 	HookStatus *HookStatus `json:"hookStatus,omitempty"`
+
+	// Rollback contains information about the rollback of the restore, if
+	// it's been rolled back.
+	// +optional
+	// +nullable
+	Rollback *RestoreRollbackStatus `json:"rollback,omitempty"`
+}
+
+// RestoreRollbackPhase is a string representation of the lifecycle phase
+// of the rollback of a Velero restore
+// +kubebuilder:validation:Enum=InProgress;Completed;PartiallyFailed
+type RestoreRollbackPhase string
+
+const (
+	// RestoreRollbackPhaseInProgress means the rollback is currently executing.
+	RestoreRollbackPhaseInProgress RestoreRollbackPhase = "InProgress"
+
+	// RestoreRollbackPhaseCompleted means all the items of the restore
+	// have been rolled back.
+	RestoreRollbackPhaseCompleted RestoreRollbackPhase = "Completed"
+
+	// RestoreRollbackPhasePartiallyFailed means the rollback has run to
+	// completion but encountered 1+ errors rolling back individual items.
+	RestoreRollbackPhasePartiallyFailed RestoreRollbackPhase = "PartiallyFailed"
+)
+
+// RestoreRollbackStatus captures the status of the rollback of a Velero restore
+type RestoreRollbackStatus struct {
+	// Phase is the current state of the rollback
+	// +optional
+	Phase RestoreRollbackPhase `json:"phase,omitempty"`
+
+	// Errors is a count of all the items which couldn't be rolled back.
+	// The actual errors are in the server's log.
+	// +optional
+	Errors int `json:"errors,omitempty"`
+
+	// FailureReason is an error that prevented the rollback of all the items,
+	// e.g. because the items restored weren't recorded.
+	// +optional
+	FailureReason string `json:"failureReason,omitempty"`
+
+	// StartTimestamp records the time the rollback was started.
+	// +optional
+	// +nullable
+	StartTimestamp *metav1.Time `json:"startTimestamp,omitempty"`
+
+	// CompletionTimestamp records the time the rollback was completed.
+	// +optional
+	// +nullable
+	CompletionTimestamp *metav1.Time `json:"completionTimestamp,omitempty"`
 }
 
 // RestoreProgress stores information about the restore's execution progress
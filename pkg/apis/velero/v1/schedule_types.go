@@ -33,6 +33,32 @@ type ScheduleSpec struct {
 	// the Backup.
 	Schedule string `json:"schedule"`
 
+	// TimeZone is the IANA name of the time zone the Schedule and its
+	// blackout windows are evaluated in, for example "Europe/Paris".
+	// If empty, UTC is used.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// BlackoutWindows are recurring time ranges, such as change freezes
+	// or peak business hours, in which the Backups due aren't run.
+	// +optional
+	// +nullable
+	BlackoutWindows []ScheduleBlackoutWindow `json:"blackoutWindows,omitempty"`
+
+	// OverlapPolicy defines what happens when the Schedule is due while
+	// a Backup it created is still new or in progress. If empty, the run
+	// is queued.
+	// +optional
+	OverlapPolicy ScheduleOverlapPolicy `json:"overlapPolicy,omitempty"`
+
+	// StartingDeadline is how late after its scheduled time a Backup can
+	// still be started, for example after the Velero server was down or
+	// while the run was queued. The runs which can't start in time are
+	// missed. If empty, late runs are always started.
+	// +optional
+	// +nullable
+	StartingDeadline *metav1.Duration `json:"startingDeadline,omitempty"`
+
 	// UseOwnerReferencesBackup specifies whether to use
 	// OwnerReferences on backups created by this Schedule.
 	// +optional
@@ -49,6 +75,102 @@ type ScheduleSpec struct {
 	// If empty, will follow server configuration (default: false).
 	// +optional
 	SkipImmediately *bool `json:"skipImmediately,omitempty"`
+
+	// Retention is the GFS (grandfather-father-son) retention policy of the
+	// backups created by this Schedule. If set, the completed backups of the
+	// Schedule are deleted once no rule of the policy retains them, instead
+	// of when their TTL expires.
+	// +optional
+	// +nullable
+	Retention *ScheduleRetention `json:"retention,omitempty"`
+}
+
+// ScheduleOverlapPolicy defines what happens when a Schedule is due while
+// a Backup it created is still new or in progress.
+// +kubebuilder:validation:Enum=Skip;Queue;Allow
+type ScheduleOverlapPolicy string
+
+const (
+	// ScheduleOverlapPolicySkip skips the run, which is recorded as missed.
+	ScheduleOverlapPolicySkip ScheduleOverlapPolicy = "Skip"
+
+	// ScheduleOverlapPolicyQueue runs the Backup once the running Backups
+	// end. At most one run is queued.
+	ScheduleOverlapPolicyQueue ScheduleOverlapPolicy = "Queue"
+
+	// ScheduleOverlapPolicyAllow runs the Backup concurrently with the
+	// running Backups.
+	ScheduleOverlapPolicyAllow ScheduleOverlapPolicy = "Allow"
+)
+
+// BlackoutWindowPolicy defines what happens to a Backup due in a blackout
+// window.
+// +kubebuilder:validation:Enum=Skip;Defer
+type BlackoutWindowPolicy string
+
+const (
+	// BlackoutWindowPolicySkip skips the Backup due in the window, the
+	// Schedule runs again at its next time.
+	BlackoutWindowPolicySkip BlackoutWindowPolicy = "Skip"
+
+	// BlackoutWindowPolicyDefer defers the Backup due in the window to
+	// the end of the window.
+	BlackoutWindowPolicyDefer BlackoutWindowPolicy = "Defer"
+)
+
+// ScheduleBlackoutWindow is a recurring time range in which the Backups
+// due aren't run.
+type ScheduleBlackoutWindow struct {
+	// Name is the name of the window, shown in the reason of the
+	// skipped Backups.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Start is a Cron expression defining when the window starts.
+	Start string `json:"start"`
+
+	// Duration is how long the window lasts.
+	Duration metav1.Duration `json:"duration"`
+
+	// Policy defines what happens to the Backups due in the window.
+	// If empty, they are skipped.
+	// +optional
+	Policy BlackoutWindowPolicy `json:"policy,omitempty"`
+}
+
+// ScheduleRetention defines how many completed backups of a Schedule are
+// kept. Each rule keeps the latest backup of each of the given number of
+// latest periods which have a backup.
+type ScheduleRetention struct {
+	// KeepLast is the number of latest backups to keep.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepLast int `json:"keepLast,omitempty"`
+
+	// KeepHourly is the number of latest hours to keep a backup for.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepHourly int `json:"keepHourly,omitempty"`
+
+	// KeepDaily is the number of latest days to keep a backup for.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepDaily int `json:"keepDaily,omitempty"`
+
+	// KeepWeekly is the number of latest ISO 8601 weeks to keep a backup for.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepWeekly int `json:"keepWeekly,omitempty"`
+
+	// KeepMonthly is the number of latest months to keep a backup for.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepMonthly int `json:"keepMonthly,omitempty"`
+
+	// KeepYearly is the number of latest years to keep a backup for.
+	// +optional
+	// +kubebuilder:validation:Minimum=0
+	KeepYearly int `json:"keepYearly,omitempty"`
 }
 
 // SchedulePhase is a string representation of the lifecycle phase
@@ -87,6 +209,24 @@ type ScheduleStatus struct {
 	// +nullable
 	LastSkipped *metav1.Time `json:"lastSkipped,omitempty"`
 
+	// LastSkippedReason is why the Backup was skipped the last time
+	// +optional
+	LastSkippedReason string `json:"lastSkippedReason,omitempty"`
+
+	// MissedRuns is the number of runs of the Schedule which were missed
+	// +optional
+	MissedRuns int64 `json:"missedRuns,omitempty"`
+
+	// LastMissedRun is the last time a run of the Schedule was missed
+	// +optional
+	// +nullable
+	LastMissedRun *metav1.Time `json:"lastMissedRun,omitempty"`
+
+	// LastMissedRunReason is why a run of the Schedule was missed the
+	// last time
+	// +optional
+	LastMissedRunReason string `json:"lastMissedRunReason,omitempty"`
+
 	// ValidationErrors is a slice of all validation errors (if
 	// applicable)
 	// +optional
@@ -0,0 +1,159 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:shortName=sbr
+// +kubebuilder:object:generate=true
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:name="Backup",type="string",JSONPath=".spec.backupName",description="Name of the backup"
+// +kubebuilder:printcolumn:name="Path",type="string",JSONPath=".spec.path",description="Path of the listed directory"
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Phase of the request"
+
+// SnapshotBrowseRequest is a request to list the entries of a directory
+// inside a pod volume or data mover snapshot of a backup.
+type SnapshotBrowseRequest struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// +optional
+	Spec SnapshotBrowseRequestSpec `json:"spec,omitempty"`
+
+	// +optional
+	Status SnapshotBrowseRequestStatus `json:"status,omitempty"`
+}
+
+// SnapshotBrowseRequestSpec is the specification for a SnapshotBrowseRequest.
+type SnapshotBrowseRequestSpec struct {
+	// BackupName is the name of the backup which contains the snapshot.
+	BackupName string `json:"backupName"`
+
+	// Namespace is the namespace of the pod or of the PVC whose volume
+	// was backed up.
+	Namespace string `json:"namespace"`
+
+	// Pod is the name of the pod whose volume was backed up by a pod
+	// volume backup. It must be set together with Volume.
+	// +optional
+	Pod string `json:"pod,omitempty"`
+
+	// Volume is the name of the volume within the Pod.
+	// +optional
+	Volume string `json:"volume,omitempty"`
+
+	// PVC is the name of the PVC whose volume was backed up by the data
+	// mover. It can't be set together with Pod.
+	// +optional
+	PVC string `json:"pvc,omitempty"`
+
+	// Path is the path of the directory to list, relative to the root of
+	// the volume. If empty, the root of the volume is listed.
+	// +optional
+	Path string `json:"path,omitempty"`
+}
+
+// SnapshotBrowseRequestPhase represents the lifecycle phase of a SnapshotBrowseRequest.
+// +kubebuilder:validation:Enum=New;Processed;Failed
+type SnapshotBrowseRequestPhase string
+
+const (
+	// SnapshotBrowseRequestPhaseNew means the SnapshotBrowseRequest has not been processed yet.
+	SnapshotBrowseRequestPhaseNew SnapshotBrowseRequestPhase = "New"
+	// SnapshotBrowseRequestPhaseProcessed means the directory has been listed.
+	SnapshotBrowseRequestPhaseProcessed SnapshotBrowseRequestPhase = "Processed"
+	// SnapshotBrowseRequestPhaseFailed means the directory couldn't be listed.
+	SnapshotBrowseRequestPhaseFailed SnapshotBrowseRequestPhase = "Failed"
+)
+
+// SnapshotEntryType is the type of an entry of a snapshot directory.
+// +kubebuilder:validation:Enum=Directory;File;Symlink
+type SnapshotEntryType string
+
+const (
+	SnapshotEntryTypeDirectory SnapshotEntryType = "Directory"
+	SnapshotEntryTypeFile      SnapshotEntryType = "File"
+	SnapshotEntryTypeSymlink   SnapshotEntryType = "Symlink"
+)
+
+// SnapshotEntry is an entry of a snapshot directory.
+type SnapshotEntry struct {
+	// Name is the name of the entry.
+	Name string `json:"name"`
+
+	// Type is the type of the entry.
+	Type SnapshotEntryType `json:"type"`
+
+	// Mode is the file mode of the entry, e.g. "-rw-r--r--".
+	// +optional
+	Mode string `json:"mode,omitempty"`
+
+	// Size is the size of the entry in bytes.
+	// +optional
+	Size int64 `json:"size,omitempty"`
+
+	// ModTime is the modification time of the entry.
+	// +optional
+	// +nullable
+	ModTime *metav1.Time `json:"modTime,omitempty"`
+}
+
+// SnapshotBrowseRequestStatus is the current status of a SnapshotBrowseRequest.
+type SnapshotBrowseRequestStatus struct {
+	// Phase is the current lifecycle phase of the SnapshotBrowseRequest.
+	// +optional
+	Phase SnapshotBrowseRequestPhase `json:"phase,omitempty"`
+
+	// Message is a message about the SnapshotBrowseRequest's status, it
+	// says why the directory couldn't be listed.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// ProcessedTimestamp is when the SnapshotBrowseRequest was processed.
+	// +optional
+	// +nullable
+	ProcessedTimestamp *metav1.Time `json:"processedTimestamp,omitempty"`
+
+	// Entries are the entries of the directory, sorted by name.
+	// +optional
+	// +nullable
+	Entries []SnapshotEntry `json:"entries,omitempty"`
+
+	// Truncated is true if the directory has more entries than the ones
+	// listed in Entries.
+	// +optional
+	Truncated bool `json:"truncated,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:rbac:groups=velero.io,resources=snapshotbrowserequests,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=snapshotbrowserequests/status,verbs=get;update;patch
+
+// SnapshotBrowseRequestList is a list of SnapshotBrowseRequests.
+type SnapshotBrowseRequestList struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// +optional
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []SnapshotBrowseRequest `json:"items"`
+}
@@ -209,6 +209,11 @@ func (in *BackupResourceHook) DeepCopyInto(out *BackupResourceHook) {
 		*out = new(ExecHook)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPHook)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupResourceHook.
@@ -376,6 +381,11 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 		*out = new(corev1.TypedLocalObjectReference)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ResourceModifier != nil {
+		in, out := &in.ResourceModifier, &out.ResourceModifier
+		*out = new(corev1.TypedLocalObjectReference)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SnapshotMoveData != nil {
 		in, out := &in.SnapshotMoveData, &out.SnapshotMoveData
 		*out = new(bool)
@@ -386,6 +396,16 @@ func (in *BackupSpec) DeepCopyInto(out *BackupSpec) {
 		*out = new(UploaderConfigForBackup)
 		**out = **in
 	}
+	if in.DryRun != nil {
+		in, out := &in.DryRun, &out.DryRun
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Cancel != nil {
+		in, out := &in.Cancel, &out.Cancel
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackupSpec.
@@ -811,6 +831,49 @@ func (in *ExecRestoreHook) DeepCopy() *ExecRestoreHook {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPHook) DeepCopyInto(out *HTTPHook) {
+	*out = *in
+	if in.ExpectedStatusCodes != nil {
+		in, out := &in.ExpectedStatusCodes, &out.ExpectedStatusCodes
+		*out = make([]int32, len(*in))
+		copy(*out, *in)
+	}
+	out.Timeout = in.Timeout
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPHook.
+func (in *HTTPHook) DeepCopy() *HTTPHook {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPRestoreHook) DeepCopyInto(out *HTTPRestoreHook) {
+	*out = *in
+	in.HTTPHook.DeepCopyInto(&out.HTTPHook)
+	out.WaitTimeout = in.WaitTimeout
+	if in.WaitForReady != nil {
+		in, out := &in.WaitForReady, &out.WaitForReady
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRestoreHook.
+func (in *HTTPRestoreHook) DeepCopy() *HTTPRestoreHook {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPRestoreHook)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HookStatus) DeepCopyInto(out *HookStatus) {
 	*out = *in
@@ -871,6 +934,61 @@ func (in *Metadata) DeepCopy() *Metadata {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NamespaceMappingPattern) DeepCopyInto(out *NamespaceMappingPattern) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NamespaceMappingPattern.
+func (in *NamespaceMappingPattern) DeepCopy() *NamespaceMappingPattern {
+	if in == nil {
+		return nil
+	}
+	out := new(NamespaceMappingPattern)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStorageEncryption) DeepCopyInto(out *ObjectStorageEncryption) {
+	*out = *in
+	if in.Key != nil {
+		in, out := &in.Key, &out.Key
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageEncryption.
+func (in *ObjectStorageEncryption) DeepCopy() *ObjectStorageEncryption {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStorageEncryption)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStorageIntegrity) DeepCopyInto(out *ObjectStorageIntegrity) {
+	*out = *in
+	if in.SigningKey != nil {
+		in, out := &in.SigningKey, &out.SigningKey
+		*out = new(corev1.SecretKeySelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageIntegrity.
+func (in *ObjectStorageIntegrity) DeepCopy() *ObjectStorageIntegrity {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStorageIntegrity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ObjectStorageLocation) DeepCopyInto(out *ObjectStorageLocation) {
 	*out = *in
@@ -879,6 +997,16 @@ func (in *ObjectStorageLocation) DeepCopyInto(out *ObjectStorageLocation) {
 		*out = make([]byte, len(*in))
 		copy(*out, *in)
 	}
+	if in.Encryption != nil {
+		in, out := &in.Encryption, &out.Encryption
+		*out = new(ObjectStorageEncryption)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Integrity != nil {
+		in, out := &in.Integrity, &out.Integrity
+		*out = new(ObjectStorageIntegrity)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageLocation.
@@ -1089,6 +1217,11 @@ func (in *PodVolumeRestoreSpec) DeepCopyInto(out *PodVolumeRestoreSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.IncludedPaths != nil {
+		in, out := &in.IncludedPaths, &out.IncludedPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PodVolumeRestoreSpec.
@@ -1234,6 +1367,11 @@ func (in *RestoreResourceHook) DeepCopyInto(out *RestoreResourceHook) {
 		*out = new(InitRestoreHook)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(HTTPRestoreHook)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreResourceHook.
@@ -1293,6 +1431,29 @@ func (in *RestoreResourceHookSpec) DeepCopy() *RestoreResourceHookSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RestoreRollbackStatus) DeepCopyInto(out *RestoreRollbackStatus) {
+	*out = *in
+	if in.StartTimestamp != nil {
+		in, out := &in.StartTimestamp, &out.StartTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTimestamp != nil {
+		in, out := &in.CompletionTimestamp, &out.CompletionTimestamp
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreRollbackStatus.
+func (in *RestoreRollbackStatus) DeepCopy() *RestoreRollbackStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(RestoreRollbackStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 	*out = *in
@@ -1323,6 +1484,11 @@ func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.NamespaceMappingPatterns != nil {
+		in, out := &in.NamespaceMappingPatterns, &out.NamespaceMappingPatterns
+		*out = make([]NamespaceMappingPattern, len(*in))
+		copy(*out, *in)
+	}
 	if in.LabelSelector != nil {
 		in, out := &in.LabelSelector, &out.LabelSelector
 		*out = new(metav1.LabelSelector)
@@ -1360,6 +1526,13 @@ func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 		**out = **in
 	}
 	in.Hooks.DeepCopyInto(&out.Hooks)
+	if in.ExistingResourcePolicyOverrides != nil {
+		in, out := &in.ExistingResourcePolicyOverrides, &out.ExistingResourcePolicyOverrides
+		*out = make(map[string]PolicyType, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	out.ItemOperationTimeout = in.ItemOperationTimeout
 	if in.ResourceModifier != nil {
 		in, out := &in.ResourceModifier, &out.ResourceModifier
@@ -1371,6 +1544,21 @@ func (in *RestoreSpec) DeepCopyInto(out *RestoreSpec) {
 		*out = new(UploaderConfigForRestore)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Preview != nil {
+		in, out := &in.Preview, &out.Preview
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RollbackOnFailure != nil {
+		in, out := &in.RollbackOnFailure, &out.RollbackOnFailure
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Cancel != nil {
+		in, out := &in.Cancel, &out.Cancel
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreSpec.
@@ -1409,6 +1597,11 @@ func (in *RestoreStatus) DeepCopyInto(out *RestoreStatus) {
 		*out = new(HookStatus)
 		**out = **in
 	}
+	if in.Rollback != nil {
+		in, out := &in.Rollback, &out.Rollback
+		*out = new(RestoreRollbackStatus)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RestoreStatus.
@@ -1473,6 +1666,22 @@ func (in *Schedule) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleBlackoutWindow) DeepCopyInto(out *ScheduleBlackoutWindow) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleBlackoutWindow.
+func (in *ScheduleBlackoutWindow) DeepCopy() *ScheduleBlackoutWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleBlackoutWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScheduleList) DeepCopyInto(out *ScheduleList) {
 	*out = *in
@@ -1505,10 +1714,35 @@ func (in *ScheduleList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScheduleRetention) DeepCopyInto(out *ScheduleRetention) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleRetention.
+func (in *ScheduleRetention) DeepCopy() *ScheduleRetention {
+	if in == nil {
+		return nil
+	}
+	out := new(ScheduleRetention)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ScheduleSpec) DeepCopyInto(out *ScheduleSpec) {
 	*out = *in
 	in.Template.DeepCopyInto(&out.Template)
+	if in.BlackoutWindows != nil {
+		in, out := &in.BlackoutWindows, &out.BlackoutWindows
+		*out = make([]ScheduleBlackoutWindow, len(*in))
+		copy(*out, *in)
+	}
+	if in.StartingDeadline != nil {
+		in, out := &in.StartingDeadline, &out.StartingDeadline
+		*out = new(metav1.Duration)
+		**out = **in
+	}
 	if in.UseOwnerReferencesInBackup != nil {
 		in, out := &in.UseOwnerReferencesInBackup, &out.UseOwnerReferencesInBackup
 		*out = new(bool)
@@ -1519,6 +1753,11 @@ func (in *ScheduleSpec) DeepCopyInto(out *ScheduleSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.Retention != nil {
+		in, out := &in.Retention, &out.Retention
+		*out = new(ScheduleRetention)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScheduleSpec.
@@ -1542,6 +1781,10 @@ func (in *ScheduleStatus) DeepCopyInto(out *ScheduleStatus) {
 		in, out := &in.LastSkipped, &out.LastSkipped
 		*out = (*in).DeepCopy()
 	}
+	if in.LastMissedRun != nil {
+		in, out := &in.LastMissedRun, &out.LastMissedRun
+		*out = (*in).DeepCopy()
+	}
 	if in.ValidationErrors != nil {
 		in, out := &in.ValidationErrors, &out.ValidationErrors
 		*out = make([]string, len(*in))
@@ -1657,6 +1900,125 @@ func (in *ServerStatusRequestStatus) DeepCopy() *ServerStatusRequestStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotBrowseRequest) DeepCopyInto(out *SnapshotBrowseRequest) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotBrowseRequest.
+func (in *SnapshotBrowseRequest) DeepCopy() *SnapshotBrowseRequest {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotBrowseRequest)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SnapshotBrowseRequest) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotBrowseRequestList) DeepCopyInto(out *SnapshotBrowseRequestList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SnapshotBrowseRequest, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotBrowseRequestList.
+func (in *SnapshotBrowseRequestList) DeepCopy() *SnapshotBrowseRequestList {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotBrowseRequestList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SnapshotBrowseRequestList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotBrowseRequestSpec) DeepCopyInto(out *SnapshotBrowseRequestSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotBrowseRequestSpec.
+func (in *SnapshotBrowseRequestSpec) DeepCopy() *SnapshotBrowseRequestSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotBrowseRequestSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotBrowseRequestStatus) DeepCopyInto(out *SnapshotBrowseRequestStatus) {
+	*out = *in
+	if in.ProcessedTimestamp != nil {
+		in, out := &in.ProcessedTimestamp, &out.ProcessedTimestamp
+		*out = (*in).DeepCopy()
+	}
+	if in.Entries != nil {
+		in, out := &in.Entries, &out.Entries
+		*out = make([]SnapshotEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotBrowseRequestStatus.
+func (in *SnapshotBrowseRequestStatus) DeepCopy() *SnapshotBrowseRequestStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotBrowseRequestStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SnapshotEntry) DeepCopyInto(out *SnapshotEntry) {
+	*out = *in
+	if in.ModTime != nil {
+		in, out := &in.ModTime, &out.ModTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SnapshotEntry.
+func (in *SnapshotEntry) DeepCopy() *SnapshotEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(SnapshotEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StorageType) DeepCopyInto(out *StorageType) {
 	*out = *in
@@ -1700,6 +2062,11 @@ func (in *UploaderConfigForRestore) DeepCopyInto(out *UploaderConfigForRestore)
 		*out = new(bool)
 		**out = **in
 	}
+	if in.IncludedPaths != nil {
+		in, out := &in.IncludedPaths, &out.IncludedPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UploaderConfigForRestore.
@@ -47,6 +47,13 @@ type DataDownloadSpec struct {
 	// +optional
 	DataMoverConfig map[string]string `json:"dataMoverConfig,omitempty"`
 
+	// IncludedPaths is a list of paths, relative to the root of the volume,
+	// to be restored. The other files of the snapshot are neither read nor
+	// restored. If empty, the whole volume is restored.
+	// +optional
+	// +nullable
+	IncludedPaths []string `json:"includedPaths,omitempty"`
+
 	// Cancel indicates request to cancel the ongoing DataDownload. It can be set
 	// when the DataDownload is in InProgress phase
 	Cancel bool `json:"cancel,omitempty"`
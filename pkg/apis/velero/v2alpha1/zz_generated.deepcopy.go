@@ -94,6 +94,11 @@ func (in *DataDownloadSpec) DeepCopyInto(out *DataDownloadSpec) {
 			(*out)[key] = val
 		}
 	}
+	if in.IncludedPaths != nil {
+		in, out := &in.IncludedPaths, &out.IncludedPaths
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	out.OperationTimeout = in.OperationTimeout
 }
 
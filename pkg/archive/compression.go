@@ -0,0 +1,105 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"io"
+
+	"github.com/klauspost/compress/zstd"
+	"github.com/pkg/errors"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+// ValidateCompression returns an error if the compression is not supported.
+// An empty compression is valid and means the default, gzip.
+func ValidateCompression(compression velerov1api.BackupCompression) error {
+	switch compression {
+	case "", velerov1api.BackupCompressionGzip, velerov1api.BackupCompressionZstd, velerov1api.BackupCompressionNone:
+		return nil
+	default:
+		return errors.Errorf("invalid compression %q, valid values are %s, %s and %s", compression,
+			velerov1api.BackupCompressionGzip, velerov1api.BackupCompressionZstd, velerov1api.BackupCompressionNone)
+	}
+}
+
+// NewCompressingWriter returns a writer which compresses the data written to it into w
+// with the given algorithm. The returned writer must be closed to flush the data.
+func NewCompressingWriter(w io.Writer, compression velerov1api.BackupCompression) (io.WriteCloser, error) {
+	switch compression {
+	case "", velerov1api.BackupCompressionGzip:
+		return gzip.NewWriter(w), nil
+	case velerov1api.BackupCompressionZstd:
+		zw, err := zstd.NewWriter(w)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return zw, nil
+	case velerov1api.BackupCompressionNone:
+		return nopWriteCloser{w}, nil
+	default:
+		return nil, ValidateCompression(compression)
+	}
+}
+
+// NewDecompressingReader returns a reader which decompresses the data read from r.
+// The compression algorithm is detected from the leading bytes of the data, so
+// tarballs of any supported compression, including uncompressed ones, can be read.
+func NewDecompressingReader(r io.Reader) (io.ReadCloser, error) {
+	br := bufio.NewReader(r)
+
+	header, err := br.Peek(len(zstdMagic))
+	if err != nil && err != io.EOF {
+		return nil, errors.WithStack(err)
+	}
+	if len(header) == 0 {
+		return nil, errors.New("archive is empty")
+	}
+
+	switch {
+	case bytes.HasPrefix(header, gzipMagic):
+		gzr, err := gzip.NewReader(br)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return gzr, nil
+	case bytes.HasPrefix(header, zstdMagic):
+		zr, err := zstd.NewReader(br)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+		return zr.IOReadCloser(), nil
+	default:
+		return io.NopCloser(br), nil
+	}
+}
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error {
+	return nil
+}
@@ -0,0 +1,67 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package archive
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestCompressionRoundTrip(t *testing.T) {
+	data := bytes.Repeat([]byte("velero backup data "), 1000)
+
+	for _, compression := range []velerov1api.BackupCompression{
+		"",
+		velerov1api.BackupCompressionGzip,
+		velerov1api.BackupCompressionZstd,
+		velerov1api.BackupCompressionNone,
+	} {
+		t.Run(string(compression), func(t *testing.T) {
+			buf := new(bytes.Buffer)
+			w, err := NewCompressingWriter(buf, compression)
+			require.NoError(t, err)
+			_, err = w.Write(data)
+			require.NoError(t, err)
+			require.NoError(t, w.Close())
+
+			if compression != velerov1api.BackupCompressionNone {
+				assert.Less(t, buf.Len(), len(data))
+			}
+
+			r, err := NewDecompressingReader(buf)
+			require.NoError(t, err)
+			defer r.Close()
+
+			res, err := io.ReadAll(r)
+			require.NoError(t, err)
+			assert.Equal(t, data, res)
+		})
+	}
+}
+
+func TestInvalidCompression(t *testing.T) {
+	assert.Error(t, ValidateCompression("lz4"))
+
+	_, err := NewCompressingWriter(new(bytes.Buffer), "lz4")
+	assert.Error(t, err)
+}
@@ -18,13 +18,13 @@ package backup
 
 import (
 	"archive/tar"
-	"compress/gzip"
 	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/pkg/errors"
@@ -37,6 +37,7 @@ import (
 
 	"github.com/vmware-tanzu/velero/internal/hook"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/client"
 
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -84,6 +85,7 @@ type kubernetesBackupper struct {
 	defaultVolumesToFsBackup  bool
 	clientPageSize            int
 	uploaderType              string
+	itemBackupWorkers         int
 }
 
 func (i *itemKey) String() string {
@@ -111,6 +113,7 @@ func NewKubernetesBackupper(
 	defaultVolumesToFsBackup bool,
 	clientPageSize int,
 	uploaderType string,
+	itemBackupWorkers int,
 ) (Backupper, error) {
 	return &kubernetesBackupper{
 		kbClient:                  kbClient,
@@ -122,6 +125,7 @@ func NewKubernetesBackupper(
 		defaultVolumesToFsBackup:  defaultVolumesToFsBackup,
 		clientPageSize:            clientPageSize,
 		uploaderType:              uploaderType,
+		itemBackupWorkers:         itemBackupWorkers,
 	}, nil
 }
 
@@ -172,7 +176,7 @@ type VolumeSnapshotterGetter interface {
 	GetVolumeSnapshotter(name string) (vsv1.VolumeSnapshotter, error)
 }
 
-// Backup backs up the items specified in the Backup, placing them in a gzip-compressed tar file
+// Backup backs up the items specified in the Backup, placing them in a compressed tar file
 // written to backupFile. The finalized velerov1api.Backup is written to metadata. Any error that represents
 // a complete backup failure is returned. Errors that constitute partial failures (i.e. failures to
 // back up individual resources that don't prevent the backup from continuing to be processed) are logged
@@ -188,10 +192,13 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 	backupFile io.Writer,
 	backupItemActionResolver framework.BackupItemActionResolverV2,
 	volumeSnapshotterGetter VolumeSnapshotterGetter) error {
-	gzippedData := gzip.NewWriter(backupFile)
-	defer gzippedData.Close()
+	compressedData, err := archive.NewCompressingWriter(backupFile, backupRequest.Spec.Compression)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer compressedData.Close()
 
-	tw := tar.NewWriter(gzippedData)
+	tw := tar.NewWriter(compressedData)
 	defer tw.Close()
 
 	log.Info("Writing backup version file")
@@ -221,7 +228,6 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 
 	log.Infof("Backing up all volumes using pod volume backup: %t", boolptr.IsSetToTrue(backupRequest.Backup.Spec.DefaultVolumesToFsBackup))
 
-	var err error
 	backupRequest.ResourceHooks, err = getResourceHooks(backupRequest.Spec.Hooks.Resources, kb.discoveryHelper)
 	if err != nil {
 		log.WithError(errors.WithStack(err)).Debugf("Error from getResourceHooks")
@@ -246,8 +252,19 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 		}
 	}
 
-	ctx, cancelFunc := context.WithTimeout(context.Background(), podVolumeTimeout)
-	defer cancelFunc()
+	timeoutCtx, timeoutCancelFunc := context.WithTimeout(context.Background(), podVolumeTimeout)
+	defer timeoutCancelFunc()
+	ctx, cancelFunc := context.WithCancelCause(timeoutCtx)
+	defer cancelFunc(nil)
+
+	// stop waiting for the pod volume backups when the backup is cancelled
+	go func() {
+		select {
+		case <-backupRequest.Cancelled():
+			cancelFunc(podvolume.ErrCancelled)
+		case <-ctx.Done():
+		}
+	}()
 
 	var podVolumeBackupper podvolume.Backupper
 	if kb.podVolumeBackupperFactory != nil {
@@ -290,6 +307,16 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 	}
 	backupRequest.Status.Progress = &velerov1api.BackupProgress{TotalItems: len(items)}
 
+	var itemHookHandler hook.ItemHookHandler = &hook.DefaultItemHookHandler{
+		PodCommandExecutor: kb.podCommandExecutor,
+		PodHTTPExecutor:    podexec.NewPodHTTPExecutor(kb.kbClient),
+	}
+	if boolptr.IsSetToTrue(backupRequest.Spec.DryRun) {
+		// hooks run commands inside the workloads, so they must not be executed for a preview
+		log.Info("Backup is a dry run, hooks will not be executed and no volume data will be backed up")
+		itemHookHandler = &hook.NoOpItemHookHandler{}
+	}
+
 	itemBackupper := &itemBackupper{
 		backupRequest:            backupRequest,
 		tarWriter:                tw,
@@ -299,10 +326,10 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 		podVolumeBackupper:       podVolumeBackupper,
 		podVolumeSnapshotTracker: newPVCSnapshotTracker(),
 		volumeSnapshotterGetter:  volumeSnapshotterGetter,
-		itemHookHandler: &hook.DefaultItemHookHandler{
-			PodCommandExecutor: kb.podCommandExecutor,
-		},
-		hookTracker: hook.NewHookTracker(),
+		itemHookHandler:          itemHookHandler,
+		hookTracker:              backupRequest.GetHookTracker(),
+
+		snapshotLocationVolumeSnapshotters: make(map[string]vsv1.VolumeSnapshotter),
 	}
 
 	// helper struct to send current progress between the main
@@ -354,9 +381,15 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 		}
 	}()
 
-	backedUpGroupResources := map[schema.GroupResource]bool{}
+	var (
+		// progressLock guards the progress and the backed up group resources,
+		// which are updated by the workers backing up items concurrently
+		progressLock           sync.Mutex
+		itemsProcessed         int
+		backedUpGroupResources = map[schema.GroupResource]bool{}
+	)
 
-	for i, item := range items {
+	backupItemFromFile := func(worker int, item *kubernetesResource) {
 		log.WithFields(map[string]interface{}{
 			"progress":  "",
 			"resource":  item.groupResource.String(),
@@ -366,35 +399,42 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 
 		// use an anonymous func so we can defer-close/remove the file
 		// as soon as we're done with it
-		func() {
+		backedUp := func() bool {
 			var unstructured unstructured.Unstructured
 
 			f, err := os.Open(item.path)
 			if err != nil {
 				log.WithError(errors.WithStack(err)).Error("Error opening file containing item")
-				return
+				return false
 			}
 			defer f.Close()
 			defer os.Remove(f.Name())
 
 			if err := json.NewDecoder(f).Decode(&unstructured); err != nil {
 				log.WithError(errors.WithStack(err)).Error("Error decoding JSON from file")
-				return
+				return false
 			}
 
-			if backedUp := kb.backupItem(log, item.groupResource, itemBackupper, &unstructured, item.preferredGVR); backedUp {
-				backedUpGroupResources[item.groupResource] = true
-			}
+			return kb.backupItem(log, item.groupResource, itemBackupper.forWorker(worker), &unstructured, item.preferredGVR)
 		}()
 
+		progressLock.Lock()
+		defer progressLock.Unlock()
+
+		if backedUp {
+			backedUpGroupResources[item.groupResource] = true
+		}
+		itemsProcessed++
+
 		// updated total is computed as "how many items we've backed up so far, plus
 		// how many items we know of that are remaining"
-		totalItems := len(backupRequest.BackedUpItems) + (len(items) - (i + 1))
+		backedUpItems := backupRequest.backedUpItemCount()
+		totalItems := backedUpItems + (len(items) - itemsProcessed)
 
 		// send a progress update
 		update <- progressUpdate{
 			totalItems:    totalItems,
-			itemsBackedUp: len(backupRequest.BackedUpItems),
+			itemsBackedUp: backedUpItems,
 		}
 
 		log.WithFields(map[string]interface{}{
@@ -402,12 +442,66 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 			"resource":  item.groupResource.String(),
 			"namespace": item.namespace,
 			"name":      item.name,
-		}).Infof("Backed up %d items out of an estimated total of %d (estimate will change throughout the backup)", len(backupRequest.BackedUpItems), totalItems)
+		}).Infof("Backed up %d items out of an estimated total of %d (estimate will change throughout the backup)", backedUpItems, totalItems)
+	}
+
+	// Items are backed up one resource at a time, in the order they were collected, so
+	// e.g. pods are backed up, running their hooks and snapshotting their volumes, before
+	// the remaining PVCs and PVs are. The items of a resource are backed up concurrently
+	// by the item backup workers, unless the backup specifies an order for them.
+	for _, resourceItems := range groupItemsByResource(items) {
+		if backupRequest.IsCancelled() {
+			break
+		}
+
+		gr := resourceItems[0].groupResource
+		workers := kb.itemBackupWorkers
+		if len(getOrderedResourcesForType(backupRequest.Spec.OrderedResources, gr.Resource)) > 0 {
+			workers = 1
+		}
+		if workers > len(resourceItems) {
+			workers = len(resourceItems)
+		}
+
+		if workers <= 1 {
+			for _, item := range resourceItems {
+				if backupRequest.IsCancelled() {
+					break
+				}
+				backupItemFromFile(0, item)
+			}
+			continue
+		}
+
+		log.Infof("Backing up %d items of resource %s with %d workers", len(resourceItems), gr, workers)
+		itemChan := make(chan *kubernetesResource)
+		wg := new(sync.WaitGroup)
+		for worker := 1; worker <= workers; worker++ {
+			wg.Add(1)
+			go func(worker int) {
+				defer wg.Done()
+				for item := range itemChan {
+					backupItemFromFile(worker, item)
+				}
+			}(worker)
+		}
+		for _, item := range resourceItems {
+			if backupRequest.IsCancelled() {
+				break
+			}
+			itemChan <- item
+		}
+		close(itemChan)
+		wg.Wait()
 	}
 
 	// no more progress updates will be sent on the 'update' channel
 	quit <- struct{}{}
 
+	if backupRequest.IsCancelled() {
+		log.Warn("Backup was cancelled, the remaining items were skipped")
+	}
+
 	// back up CRD(this is a CRD definition of the resource, it's a CRD instance) for resource if found.
 	// We should only need to do this if we've backed up at least one item for the resource
 	// and the CRD type(this is the CRD type itself) is neither included or excluded.
@@ -451,6 +545,18 @@ func (kb *kubernetesBackupper) BackupWithResolvers(log logrus.FieldLogger,
 	return nil
 }
 
+// groupItemsByResource splits the items into runs of consecutive items of the same resource.
+func groupItemsByResource(items []*kubernetesResource) [][]*kubernetesResource {
+	var groups [][]*kubernetesResource
+	for i, item := range items {
+		if i == 0 || item.groupResource != items[i-1].groupResource {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], item)
+	}
+	return groups
+}
+
 func (kb *kubernetesBackupper) backupItem(log logrus.FieldLogger, gr schema.GroupResource, itemBackupper *itemBackupper, unstructured *unstructured.Unstructured, preferredGVR schema.GroupVersionResource) bool {
 	backedUpItem, _, err := itemBackupper.backupItem(log, unstructured, gr, preferredGVR, false, false)
 	if aggregate, ok := err.(kubeerrs.Aggregate); ok {
@@ -554,18 +660,21 @@ func (kb *kubernetesBackupper) FinalizeBackup(log logrus.FieldLogger,
 	outBackupFile io.Writer,
 	backupItemActionResolver framework.BackupItemActionResolverV2,
 	asyncBIAOperations []*itemoperation.BackupOperation) error {
-	gzw := gzip.NewWriter(outBackupFile)
-	defer gzw.Close()
-	tw := tar.NewWriter(gzw)
+	cw, err := archive.NewCompressingWriter(outBackupFile, backupRequest.Spec.Compression)
+	if err != nil {
+		return err
+	}
+	defer cw.Close()
+	tw := tar.NewWriter(cw)
 	defer tw.Close()
 
-	gzr, err := gzip.NewReader(inBackupFile)
+	dr, err := archive.NewDecompressingReader(inBackupFile)
 	if err != nil {
-		log.Infof("error creating gzip reader: %v", err)
+		log.Infof("error creating decompressing reader: %v", err)
 		return err
 	}
-	defer gzr.Close()
-	tr := tar.NewReader(gzr)
+	defer dr.Close()
+	tr := tar.NewReader(dr)
 
 	backupRequest.ResolvedActions, err = backupItemActionResolver.ResolveActions(kb.discoveryHelper, log)
 	if err != nil {
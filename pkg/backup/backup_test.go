@@ -40,9 +40,12 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
@@ -55,6 +58,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/podvolume"
 	"github.com/vmware-tanzu/velero/pkg/test"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
+	"github.com/vmware-tanzu/velero/pkg/util/logging"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
@@ -158,6 +162,158 @@ func TestBackupProgressIsUpdated(t *testing.T) {
 	assert.Equal(t, len(req.BackedUpItems), req.Status.Progress.ItemsBackedUp)
 }
 
+// tarballEntries returns the paths of the resource entries in the backup tarball, in order.
+func tarballEntries(t *testing.T, backupFile io.Reader) []string {
+	t.Helper()
+
+	gzr, err := gzip.NewReader(backupFile)
+	require.NoError(t, err)
+	defer gzr.Close()
+
+	var entries []string
+	r := tar.NewReader(gzr)
+	for {
+		hdr, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+
+		if strings.HasPrefix(hdr.Name, "resources/") && !strings.Contains(hdr.Name, velerov1.PreferredVersionDir) {
+			entries = append(entries, hdr.Name)
+		}
+	}
+	return entries
+}
+
+// TestBackupWithItemBackupWorkers verifies that items backed up concurrently
+// are all backed up once, with additional items complete before the items
+// returning them, and that the progress is accurate.
+func TestBackupWithItemBackupWorkers(t *testing.T) {
+	h := newHarness(t)
+	h.backupper.itemBackupWorkers = 4
+
+	req := &Request{
+		Backup:           defaultBackup().Result(),
+		SkippedPVTracker: NewSkipPVTracker(),
+	}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	var pods []metav1.Object
+	for i := 0; i < 20; i++ {
+		pods = append(pods, builder.ForPod(fmt.Sprintf("ns-%d", i%3), fmt.Sprintf("pod-%d", i)).Result())
+	}
+	h.addItems(t, test.Pods(pods...))
+	h.addItems(t, test.Secrets(builder.ForSecret("ns-0", "shared").Result()))
+	h.addItems(t, test.PVs(
+		builder.ForPersistentVolume("pv-1").Result(),
+		builder.ForPersistentVolume("pv-2").Result(),
+	))
+
+	// every pod returns the same secret as additional item, which is slow to back up
+	actions := []biav2.BackupItemAction{
+		&pluggableAction{
+			selector: velero.ResourceSelector{IncludedResources: []string{"pods"}},
+			executeFunc: func(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, string, []velero.ResourceIdentifier, error) {
+				return item, []velero.ResourceIdentifier{{GroupResource: kuberesource.Secrets, Namespace: "ns-0", Name: "shared"}}, "", nil, nil
+			},
+		},
+		&pluggableAction{
+			selector: velero.ResourceSelector{IncludedResources: []string{"secrets"}},
+			executeFunc: func(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, string, []velero.ResourceIdentifier, error) {
+				time.Sleep(100 * time.Millisecond)
+				return item, nil, "", nil, nil
+			},
+		},
+	}
+
+	require.NoError(t, h.backupper.Backup(h.log, req, backupFile, actions, nil))
+
+	entries := tarballEntries(t, backupFile)
+	assert.Len(t, entries, 23)
+	require.Equal(t, "resources/secrets/namespaces/ns-0/shared.json", entries[0])
+
+	assert.Len(t, req.BackedUpItems, 23)
+	require.NotNil(t, req.Status.Progress)
+	assert.Equal(t, 23, req.Status.Progress.TotalItems)
+	assert.Equal(t, 23, req.Status.Progress.ItemsBackedUp)
+}
+
+// TestBackupWithItemBackupWorkersKeepsOrderedResources verifies that the items of
+// resources with an order specified in the backup are backed up one at a time in
+// that order, even when items are backed up concurrently.
+func TestBackupWithItemBackupWorkersKeepsOrderedResources(t *testing.T) {
+	h := newHarness(t)
+	h.backupper.itemBackupWorkers = 4
+
+	req := &Request{
+		Backup: defaultBackup().OrderedResources(map[string]string{
+			"pods": "ns-1/pod-4,ns-1/pod-2,ns-1/pod-3,ns-1/pod-1",
+		}).Result(),
+		SkippedPVTracker: NewSkipPVTracker(),
+	}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	h.addItems(t, test.Pods(
+		builder.ForPod("ns-1", "pod-1").Result(),
+		builder.ForPod("ns-1", "pod-2").Result(),
+		builder.ForPod("ns-1", "pod-3").Result(),
+		builder.ForPod("ns-1", "pod-4").Result(),
+	))
+
+	require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil))
+
+	assert.Equal(t, []string{
+		"resources/pods/namespaces/ns-1/pod-4.json",
+		"resources/pods/namespaces/ns-1/pod-2.json",
+		"resources/pods/namespaces/ns-1/pod-3.json",
+		"resources/pods/namespaces/ns-1/pod-1.json",
+	}, tarballEntries(t, backupFile))
+}
+
+// TestBackupCompression verifies that the backup tarball is written with
+// the compression specified on the backup.
+func TestBackupCompression(t *testing.T) {
+	tests := []struct {
+		name        string
+		compression velerov1.BackupCompression
+		wantMagic   []byte
+	}{
+		{
+			name:      "no compression specified defaults to gzip",
+			wantMagic: []byte{0x1f, 0x8b},
+		},
+		{
+			name:        "zstd",
+			compression: velerov1.BackupCompressionZstd,
+			wantMagic:   []byte{0x28, 0xb5, 0x2f, 0xfd},
+		},
+		{
+			name:        "none",
+			compression: velerov1.BackupCompressionNone,
+			wantMagic:   []byte("metadata/version"),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			req := &Request{
+				Backup:           defaultBackup().Compression(tc.compression).Result(),
+				SkippedPVTracker: NewSkipPVTracker(),
+			}
+			backupFile := bytes.NewBuffer([]byte{})
+
+			h.addItems(t, test.Pods(builder.ForPod("foo", "bar").Result()))
+
+			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil))
+
+			assert.True(t, bytes.HasPrefix(backupFile.Bytes(), tc.wantMagic))
+			assertTarballContents(t, backupFile, "metadata/version", "resources/pods/namespaces/foo/bar.json", "resources/pods/v1-preferredversion/namespaces/foo/bar.json")
+		})
+	}
+}
+
 // TestBackupResourceFiltering runs backups with different combinations
 // of resource filters (included/excluded resources, included/excluded
 // namespaces, label selectors, "include cluster resources" flag), and
@@ -1186,6 +1342,60 @@ func TestBackupUsesNewCohabitatingResourcesForEachBackup(t *testing.T) {
 	assertTarballContents(t, backup2File, "metadata/version", "resources/deployments.apps/namespaces/ns-1/deploy-1.json", "resources/deployments.apps/v1-preferredversion/namespaces/ns-1/deploy-1.json")
 }
 
+// TestBackupWithResourceModifiers verifies that the backup's resource modifiers
+// are applied to the items written into the backup, and that the items dropped
+// by them are left out of it without executing the actions for them.
+func TestBackupWithResourceModifiers(t *testing.T) {
+	h := newHarness(t)
+	req := &Request{
+		Backup:           defaultBackup().ResourceModifier("modifiers").Result(),
+		SkippedPVTracker: NewSkipPVTracker(),
+		ResourceModifiers: &resourcemodifiers.ResourceModifiers{
+			Version: resourcemodifiers.ResourceModifierSupportedVersionV1,
+			ResourceModifierRules: []resourcemodifiers.ResourceModifierRule{
+				{
+					Conditions:   resourcemodifiers.Conditions{GroupResource: "secrets", ResourceNameRegex: "^creds$"},
+					MergePatches: []resourcemodifiers.JSONMergePatch{{PatchData: `{"data":null}`}},
+				},
+				{
+					Conditions: resourcemodifiers.Conditions{
+						GroupResource: "pods",
+						LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"drop": "true"}},
+					},
+					Action: resourcemodifiers.RuleActionDrop,
+				},
+			},
+		},
+	}
+	backupFile := bytes.NewBuffer([]byte{})
+
+	h.addItems(t, test.Pods(
+		builder.ForPod("foo", "pod-1").Result(),
+		builder.ForPod("foo", "pod-2").ObjectMeta(builder.WithLabels("drop", "true")).Result(),
+	))
+	h.addItems(t, test.Secrets(
+		builder.ForSecret("foo", "creds").Data(map[string][]byte{"password": []byte("secret")}).Result(),
+	))
+
+	action := new(recordResourcesAction).ForResource("pods")
+
+	require.NoError(t, h.backupper.Backup(h.log, req, backupFile, []biav2.BackupItemAction{action}, nil))
+
+	// the actions aren't executed for the dropped items
+	assert.Equal(t, []string{"foo/pod-1"}, action.ids)
+	assertTarballContents(t, bytes.NewReader(backupFile.Bytes()),
+		"metadata/version",
+		"resources/pods/namespaces/foo/pod-1.json",
+		"resources/pods/v1-preferredversion/namespaces/foo/pod-1.json",
+		"resources/secrets/namespaces/foo/creds.json",
+		"resources/secrets/v1-preferredversion/namespaces/foo/creds.json",
+	)
+	assertTarballFileContents(t, bytes.NewReader(backupFile.Bytes()), map[string]unstructuredObject{
+		"resources/secrets/namespaces/foo/creds.json": toUnstructuredOrFail(t, builder.ForSecret("foo", "creds").Result()),
+	})
+	assert.Len(t, req.BackedUpItems, 2)
+}
+
 // TestBackupResourceOrdering runs backups of the core API group and ensures that items are backed
 // up in the expected order (pods, PVCs, PVs, everything else). Verification is done by looking
 // at the order of files written to the backup tarball.
@@ -2537,6 +2747,38 @@ func TestBackupWithSnapshots(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "dry-run backup records the snapshot without creating it",
+			req: &Request{
+				Backup: defaultBackup().DryRun(true).Result(),
+				SnapshotLocations: []*velerov1.VolumeSnapshotLocation{
+					newSnapshotLocation("velero", "default", "default"),
+				},
+				SkippedPVTracker: NewSkipPVTracker(),
+			},
+			apiResources: []*test.APIResource{
+				test.PVs(
+					builder.ForPersistentVolume("pv-1").ObjectMeta(builder.WithLabels("topology.kubernetes.io/zone", "zone-1")).Result(),
+				),
+			},
+			snapshotterGetter: map[string]vsv1.VolumeSnapshotter{
+				"default": new(fakeVolumeSnapshotter).WithVolume("pv-1", "vol-1", "zone-1", "type-1", 100, false),
+			},
+			want: []*volume.Snapshot{
+				{
+					Spec: volume.SnapshotSpec{
+						BackupName:           "backup-1",
+						Location:             "default",
+						PersistentVolumeName: "pv-1",
+						ProviderVolumeID:     "vol-1",
+						VolumeAZ:             "zone-1",
+					},
+					Status: volume.SnapshotStatus{
+						Phase: volume.SnapshotPhaseNew,
+					},
+				},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -2619,6 +2861,7 @@ func TestBackupWithAsyncOperations(t *testing.T) {
 		apiResources []*test.APIResource
 		actions      []biav2.BackupItemAction
 		want         []*itemoperation.BackupOperation
+		cancelled    []string
 	}{
 		{
 			name: "action that starts a short-running process records operation",
@@ -2696,6 +2939,23 @@ func TestBackupWithAsyncOperations(t *testing.T) {
 			},
 			want: []*itemoperation.BackupOperation{},
 		},
+		{
+			name: "dry-run backup cancels the operation started by an action",
+			req: &Request{
+				Backup:           defaultBackup().DryRun(true).Result(),
+				SkippedPVTracker: NewSkipPVTracker(),
+			},
+			apiResources: []*test.APIResource{
+				test.Pods(
+					builder.ForPod("ns-1", "pod-4").Result(),
+				),
+			},
+			actions: []biav2.BackupItemAction{
+				&pluggableAction{executeFunc: completedOperationAction.executeFunc},
+			},
+			want:      []*itemoperation.BackupOperation{},
+			cancelled: []string{"pod-4-1"},
+		},
 	}
 
 	for _, tc := range tests {
@@ -2712,6 +2972,12 @@ func TestBackupWithAsyncOperations(t *testing.T) {
 			err := h.backupper.Backup(h.log, tc.req, backupFile, tc.actions, nil)
 			assert.NoError(t, err)
 
+			var cancelled []string
+			for _, action := range tc.actions {
+				cancelled = append(cancelled, action.(*pluggableAction).cancelled...)
+			}
+			assert.Equal(t, tc.cancelled, cancelled)
+
 			resultOper := *tc.req.GetItemOperationsList()
 			// set want Created times so it won't fail the assert.Equal test
 			for i, wantOper := range tc.want {
@@ -2722,6 +2988,86 @@ func TestBackupWithAsyncOperations(t *testing.T) {
 	}
 }
 
+// TestBackupResumedFromCheckpoint runs a backup, then resumes it from its
+// checkpoint and verifies that the asynchronous operations and native
+// snapshots of the first run are adopted instead of being started again.
+func TestBackupResumedFromCheckpoint(t *testing.T) {
+	executions := 0
+	action := &pluggableAction{
+		selector: velero.ResourceSelector{IncludedResources: []string{"pods"}},
+		executeFunc: func(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, string, []velero.ResourceIdentifier, error) {
+			executions++
+			obj := item.(*unstructured.Unstructured)
+			return obj, nil, obj.GetName() + "-1", nil, nil
+		},
+	}
+	newRequest := func() *Request {
+		return &Request{
+			Backup: defaultBackup().Result(),
+			SnapshotLocations: []*velerov1.VolumeSnapshotLocation{
+				newSnapshotLocation("velero", "default", "default"),
+			},
+			SkippedPVTracker: NewSkipPVTracker(),
+		}
+	}
+	snapshotterGetter := volumeSnapshotterGetter{
+		"default": new(fakeVolumeSnapshotter).WithVolume("pv-1", "vol-1", "", "type-1", 100, false),
+	}
+
+	h := newHarness(t)
+	h.addItems(t, test.Pods(
+		builder.ForPod("ns-1", "pod-1").Result(),
+		builder.ForPod("ns-1", "pod-2").Result(),
+	))
+	h.addItems(t, test.PVs(
+		builder.ForPersistentVolume("pv-1").Result(),
+	))
+
+	req := newRequest()
+	require.NoError(t, h.backupper.Backup(h.log, req, bytes.NewBuffer([]byte{}), []biav2.BackupItemAction{action}, snapshotterGetter))
+	require.Equal(t, 2, executions)
+
+	checkpoint, operations := req.Checkpoint()
+	assert.Len(t, checkpoint.Items, 3)
+	require.Len(t, checkpoint.ActionResults, 2)
+	require.Len(t, checkpoint.VolumeSnapshots, 1)
+	require.Len(t, operations, 2)
+
+	// the operation of pod-1 failed and the checkpoint of pod-2 was lost, so
+	// only the snapshot is adopted
+	checkpoint.VolumeSnapshots[0].Status.ProviderSnapshotID = "adopted-snapshot"
+	checkpoint.ActionResults = checkpoint.ActionResults[:1]
+	for _, operation := range operations {
+		if operation.Spec.ResourceIdentifier.Name == checkpoint.ActionResults[0].ResourceIdentifier.Name {
+			operation.Status.Phase = itemoperation.OperationPhaseFailed
+		}
+	}
+
+	req = newRequest()
+	req.Resume(checkpoint, operations)
+	require.NoError(t, h.backupper.Backup(h.log, req, bytes.NewBuffer([]byte{}), []biav2.BackupItemAction{action}, snapshotterGetter))
+	assert.Equal(t, 4, executions)
+	require.Len(t, req.VolumeSnapshots, 1)
+	assert.Equal(t, "adopted-snapshot", req.VolumeSnapshots[0].Status.ProviderSnapshotID)
+	unadopted := req.UnadoptedOperations()
+	require.Len(t, unadopted, 1)
+	assert.NotEqual(t, checkpoint.ActionResults[0].OperationID, unadopted[0].Spec.OperationID)
+
+	// the operations of the second run are all adopted
+	checkpoint, operations = req.Checkpoint()
+	operations[0].Status.Phase = itemoperation.OperationPhaseInProgress
+
+	req = newRequest()
+	req.Resume(checkpoint, operations)
+	require.NoError(t, h.backupper.Backup(h.log, req, bytes.NewBuffer([]byte{}), []biav2.BackupItemAction{action}, snapshotterGetter))
+	assert.Equal(t, 4, executions)
+	assert.Empty(t, req.UnadoptedOperations())
+	resumedOperations := *req.GetItemOperationsList()
+	require.Len(t, resumedOperations, 2)
+	assert.ElementsMatch(t, []itemoperation.OperationPhase{itemoperation.OperationPhaseInProgress, itemoperation.OperationPhaseNew},
+		[]itemoperation.OperationPhase{resumedOperations[0].Status.Phase, resumedOperations[1].Status.Phase})
+}
+
 // TestBackupWithInvalidHooks runs backups with invalid hook specifications and verifies
 // that an error is returned.
 func TestBackupWithInvalidHooks(t *testing.T) {
@@ -3058,7 +3404,7 @@ type fakePodVolumeBackupper struct{}
 
 // BackupPodVolumes returns one pod volume backup per entry in volumes, with namespace "velero"
 // and name "pvb-<pod-namespace>-<pod-name>-<volume-name>".
-func (b *fakePodVolumeBackupper) BackupPodVolumes(backup *velerov1.Backup, pod *corev1.Pod, volumes []string, _ *resourcepolicies.Policies, _ logrus.FieldLogger) ([]*velerov1.PodVolumeBackup, *podvolume.PVCBackupSummary, []error) {
+func (b *fakePodVolumeBackupper) BackupPodVolumes(backup *velerov1.Backup, pod *corev1.Pod, volumes []string, _ *resourcepolicies.Policies, _ podvolume.PodsUsingPVC, _ logrus.FieldLogger) ([]*velerov1.PodVolumeBackup, *podvolume.PVCBackupSummary, []error) {
 	var res []*velerov1.PodVolumeBackup
 	pvcSummary := podvolume.NewPVCBackupSummary()
 
@@ -3208,12 +3554,340 @@ func TestBackupWithPodVolume(t *testing.T) {
 	}
 }
 
+// TestBackupWithVolumePolicyActions runs backups with resource policies choosing the backup
+// method of the volumes, and ensures that the matched actions take precedence over the
+// pods' annotations.
+func TestBackupWithVolumePolicyActions(t *testing.T) {
+	policies := `version: v1
+volumePolicies:
+- conditions:
+    storageClass:
+    - nfs
+  action:
+    type: fs-backup
+- conditions:
+    storageClass:
+    - gp3
+  action:
+    type: snapshot
+`
+
+	tests := []struct {
+		name          string
+		policies      string
+		pod           *corev1.Pod
+		wantPVBs      []*velerov1.PodVolumeBackup
+		wantSnapshots []string
+	}{
+		{
+			name: "volumes matching policies without annotations",
+			pod: builder.ForPod("ns-1", "pod-1").
+				Volumes(
+					builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result(),
+					builder.ForVolume("vol-2").PersistentVolumeClaimSource("pvc-2").Result(),
+				).
+				Result(),
+			wantPVBs: []*velerov1.PodVolumeBackup{
+				builder.ForPodVolumeBackup("velero", "pvb-ns-1-pod-1-vol-1").Volume("vol-1").Result(),
+			},
+			wantSnapshots: []string{"pv-2"},
+		},
+		{
+			name: "policies take precedence over annotations",
+			pod: builder.ForPod("ns-1", "pod-1").
+				ObjectMeta(builder.WithAnnotations("backup.velero.io/backup-volumes", "vol-2")).
+				Volumes(
+					builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result(),
+					builder.ForVolume("vol-2").PersistentVolumeClaimSource("pvc-2").Result(),
+				).
+				Result(),
+			wantPVBs: []*velerov1.PodVolumeBackup{
+				builder.ForPodVolumeBackup("velero", "pvb-ns-1-pod-1-vol-1").Volume("vol-1").Result(),
+			},
+			wantSnapshots: []string{"pv-2"},
+		},
+		{
+			name: "policies matching pvc and pod labels",
+			policies: `version: v1
+volumePolicies:
+- conditions:
+    pvcLabels:
+      backup: skip
+  action:
+    type: skip
+- conditions:
+    namespaces:
+    - ns-1
+    podLabels:
+      app: db
+  action:
+    type: fs-backup
+`,
+			pod: builder.ForPod("ns-1", "pod-1").
+				ObjectMeta(builder.WithLabels("app", "db")).
+				Volumes(
+					builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result(),
+					builder.ForVolume("vol-2").PersistentVolumeClaimSource("pvc-2").Result(),
+				).
+				Result(),
+			wantPVBs: []*velerov1.PodVolumeBackup{
+				builder.ForPodVolumeBackup("velero", "pvb-ns-1-pod-1-vol-1").Volume("vol-1").Result(),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				h          = newHarness(t)
+				backupFile = bytes.NewBuffer([]byte{})
+				pvcs       = []metav1.Object{
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result(),
+					builder.ForPersistentVolumeClaim("ns-1", "pvc-2").VolumeName("pv-2").ObjectMeta(builder.WithLabels("backup", "skip")).Result(),
+				}
+				pvs = []metav1.Object{
+					builder.ForPersistentVolume("pv-1").ClaimRef("ns-1", "pvc-1").StorageClass("nfs").Result(),
+					builder.ForPersistentVolume("pv-2").ClaimRef("ns-1", "pvc-2").StorageClass("gp3").Result(),
+				}
+			)
+
+			if tc.policies == "" {
+				tc.policies = policies
+			}
+			resPolicies, err := resourcepolicies.GetResourcePoliciesFromConfig(
+				builder.ForConfigMap("velero", "policies").Data("policies", tc.policies).Result())
+			require.NoError(t, err)
+			require.NoError(t, resPolicies.Validate())
+
+			req := &Request{
+				Backup:            defaultBackup().Result(),
+				SnapshotLocations: []*velerov1.VolumeSnapshotLocation{newSnapshotLocation("velero", "default", "default")},
+				SkippedPVTracker:  NewSkipPVTracker(),
+				ResPolicies:       resPolicies,
+			}
+
+			h.backupper.podVolumeBackupperFactory = new(fakePodVolumeBackupperFactory)
+			for _, obj := range append(append(pvcs, pvs...), tc.pod) {
+				require.NoError(t, h.backupper.kbClient.Create(context.Background(), obj.(kbclient.Object)))
+			}
+			h.addItems(t, test.Pods(tc.pod))
+			h.addItems(t, test.PVCs(pvcs...))
+			h.addItems(t, test.PVs(pvs...))
+
+			snapshotterGetter := volumeSnapshotterGetter{
+				"default": new(fakeVolumeSnapshotter).
+					WithVolume("pv-1", "vol-1", "", "type-1", 100, false).
+					WithVolume("pv-2", "vol-2", "", "type-1", 100, false),
+			}
+			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, snapshotterGetter))
+
+			assert.Equal(t, tc.wantPVBs, req.PodVolumeBackups)
+
+			var snapshots []string
+			for _, snapshot := range req.VolumeSnapshots {
+				snapshots = append(snapshots, snapshot.Spec.PersistentVolumeName)
+			}
+			assert.Equal(t, tc.wantSnapshots, snapshots)
+		})
+	}
+}
+
+// TestBackupWithResourceFilterPolicies runs backups with the resource filter policies of the
+// resource policies, and verifies that only the items they include are backed up.
+func TestBackupWithResourceFilterPolicies(t *testing.T) {
+	apiResources := []*test.APIResource{
+		test.Pods(
+			builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels("app", "a")).Result(),
+			builder.ForPod("ns-1", "pod-2").ObjectMeta(builder.WithLabels("app", "b")).Result(),
+		),
+		test.Secrets(
+			builder.ForSecret("ns-1", "tmp-1").Result(),
+			builder.ForSecret("ns-1", "keep-1").Result(),
+		),
+		test.PVs(
+			builder.ForPersistentVolume("pv-1").Result(),
+		),
+	}
+
+	tests := []struct {
+		name         string
+		policies     string
+		wantBackedUp []string
+	}{
+		{
+			name: "exclude policies",
+			policies: `version: v1
+resourceFilterPolicies:
+- conditions:
+    groupResource: secrets
+    resourceNameRegex: "^tmp-"
+  action:
+    type: exclude
+- conditions:
+    groupResource: pods
+    labelSelector:
+      matchLabels:
+        app: b
+  action:
+    type: exclude
+`,
+			wantBackedUp: []string{
+				"resources/pods/namespaces/ns-1/pod-1.json",
+				"resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json",
+				"resources/secrets/namespaces/ns-1/keep-1.json",
+				"resources/secrets/v1-preferredversion/namespaces/ns-1/keep-1.json",
+				"resources/persistentvolumes/cluster/pv-1.json",
+				"resources/persistentvolumes/v1-preferredversion/cluster/pv-1.json",
+			},
+		},
+		{
+			name: "include policies",
+			policies: `version: v1
+resourceFilterPolicies:
+- conditions:
+    groupResource: pods
+    labelSelector:
+      matchLabels:
+        app: a
+  action:
+    type: include
+`,
+			wantBackedUp: []string{
+				"resources/pods/namespaces/ns-1/pod-1.json",
+				"resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json",
+				"resources/persistentvolumes/cluster/pv-1.json",
+				"resources/persistentvolumes/v1-preferredversion/cluster/pv-1.json",
+			},
+		},
+		{
+			name: "include policies with cluster-scoped items excluded explicitly",
+			policies: `version: v1
+resourceFilterPolicies:
+- conditions:
+    groupResource: pods
+    labelSelector:
+      matchLabels:
+        app: a
+  action:
+    type: include
+- conditions:
+    groupResource: persistentvolumes
+  action:
+    type: exclude
+`,
+			wantBackedUp: []string{
+				"resources/pods/namespaces/ns-1/pod-1.json",
+				"resources/pods/v1-preferredversion/namespaces/ns-1/pod-1.json",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				h          = newHarness(t)
+				backupFile = bytes.NewBuffer([]byte{})
+			)
+
+			resPolicies, err := resourcepolicies.GetResourcePoliciesFromConfig(
+				builder.ForConfigMap("velero", "policies").Data("policies", tc.policies).Result())
+			require.NoError(t, err)
+			require.NoError(t, resPolicies.Validate())
+
+			req := &Request{
+				Backup:           defaultBackup().Result(),
+				SkippedPVTracker: NewSkipPVTracker(),
+				ResPolicies:      resPolicies,
+			}
+
+			for _, resource := range apiResources {
+				h.addItems(t, resource)
+			}
+
+			require.NoError(t, h.backupper.Backup(h.log, req, backupFile, nil, nil))
+
+			assertTarballContents(t, backupFile, append(tc.wantBackedUp, "metadata/version")...)
+		})
+	}
+}
+
+// TestBackupWithInvalidResourceFilterPolicies verifies that the items the resource filter policies
+// can't be applied to are reported as errors backing them up.
+func TestBackupWithInvalidResourceFilterPolicies(t *testing.T) {
+	var (
+		h          = newHarness(t)
+		backupFile = bytes.NewBuffer([]byte{})
+		logCounter = logging.NewLogHook()
+		log        = logrus.New()
+	)
+	log.Out = io.Discard
+	log.Hooks.Add(logCounter)
+
+	// the policies aren't validated, so the invalid regex is only found when they're applied
+	resPolicies, err := resourcepolicies.GetResourcePoliciesFromConfig(
+		builder.ForConfigMap("velero", "policies").Data("policies", `version: v1
+resourceFilterPolicies:
+- conditions:
+    groupResource: pods
+    resourceNameRegex: "(pod"
+  action:
+    type: exclude
+`).Result())
+	require.NoError(t, err)
+
+	req := &Request{
+		Backup:           defaultBackup().Result(),
+		SkippedPVTracker: NewSkipPVTracker(),
+		ResPolicies:      resPolicies,
+	}
+	h.addItems(t, test.Pods(builder.ForPod("ns-1", "pod-1").Result()))
+	h.addItems(t, test.Secrets(builder.ForSecret("ns-1", "secret-1").Result()))
+
+	require.NoError(t, h.backupper.Backup(log, req, backupFile, nil, nil))
+
+	assertTarballContents(t, backupFile,
+		"metadata/version",
+		"resources/secrets/namespaces/ns-1/secret-1.json",
+		"resources/secrets/v1-preferredversion/namespaces/ns-1/secret-1.json",
+	)
+	errs := logCounter.GetEntries(logrus.ErrorLevel)
+	require.NotEmpty(t, errs.Velero)
+	assert.Contains(t, errs.Velero[0], "name: /pod-1 message: /Error backing up item error: /error matching resource filter policy")
+}
+
+// TestBackupForVolumeAction verifies the backup passed to the snapshot plugins for the
+// actions of resource policies.
+func TestBackupForVolumeAction(t *testing.T) {
+	backup := defaultBackup().SnapshotMoveData(true).Result()
+
+	assert.Same(t, backup, backupForVolumeAction(backup, nil))
+	assert.Same(t, backup, backupForVolumeAction(backup, &resourcepolicies.Action{Type: resourcepolicies.FSBackup}))
+
+	res := backupForVolumeAction(backup, &resourcepolicies.Action{Type: resourcepolicies.Snapshot})
+	assert.False(t, *res.Spec.SnapshotMoveData)
+	assert.True(t, *backup.Spec.SnapshotMoveData)
+
+	backup = defaultBackup().Result()
+	res = backupForVolumeAction(backup, &resourcepolicies.Action{Type: resourcepolicies.DataMover})
+	assert.True(t, *res.Spec.SnapshotMoveData)
+	assert.Empty(t, res.Spec.DataMover)
+
+	res = backupForVolumeAction(backup, &resourcepolicies.Action{
+		Type:       resourcepolicies.DataMover,
+		Parameters: map[string]interface{}{resourcepolicies.DataMoverParameter: "mover"},
+	})
+	assert.True(t, *res.Spec.SnapshotMoveData)
+	assert.Equal(t, "mover", res.Spec.DataMover)
+	assert.Nil(t, backup.Spec.SnapshotMoveData)
+}
+
 // pluggableAction is a backup item action that can be plugged with Execute
 // and Progress function bodies at runtime.
 type pluggableAction struct {
 	selector     velero.ResourceSelector
 	executeFunc  func(runtime.Unstructured, *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, string, []velero.ResourceIdentifier, error)
 	progressFunc func(string, *velerov1.Backup) (velero.OperationProgress, error)
+	cancelled    []string
 }
 
 func (a *pluggableAction) Execute(item runtime.Unstructured, backup *velerov1.Backup) (runtime.Unstructured, []velero.ResourceIdentifier, string, []velero.ResourceIdentifier, error) {
@@ -3237,6 +3911,7 @@ func (a *pluggableAction) Progress(operationID string, backup *velerov1.Backup)
 }
 
 func (a *pluggableAction) Cancel(operationID string, backup *velerov1.Backup) error {
+	a.cancelled = append(a.cancelled, operationID)
 	return nil
 }
 
@@ -3326,7 +4001,7 @@ func toUnstructuredOrFail(t *testing.T, obj interface{}) map[string]interface{}
 func assertTarballContents(t *testing.T, backupFile io.Reader, items ...string) {
 	t.Helper()
 
-	gzr, err := gzip.NewReader(backupFile)
+	gzr, err := archive.NewDecompressingReader(backupFile)
 	require.NoError(t, err)
 
 	r := tar.NewReader(gzr)
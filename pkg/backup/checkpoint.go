@@ -0,0 +1,197 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+// Checkpoint records the progress of a backup, so that a backup interrupted by
+// a restart of the server can be resumed. The items are collected and backed
+// up again when the backup is resumed, but the asynchronous operations started
+// by the backup item actions and the native volume snapshots recorded here are
+// adopted instead of being started again.
+type Checkpoint struct {
+	// Items are the items written into the backup tarball.
+	Items []velero.ResourceIdentifier `json:"items"`
+	// ActionResults are the results of the backup item actions which started
+	// an asynchronous operation.
+	ActionResults []ActionResult `json:"actionResults,omitempty"`
+	// VolumeSnapshots are the native volume snapshots taken.
+	VolumeSnapshots []*volume.Snapshot `json:"volumeSnapshots,omitempty"`
+}
+
+// ActionResult is the outcome of the execution of a backup item action
+// which started an asynchronous operation.
+type ActionResult struct {
+	BackupItemAction   string                      `json:"backupItemAction"`
+	ResourceIdentifier velero.ResourceIdentifier   `json:"resourceIdentifier"`
+	OperationID        string                      `json:"operationID"`
+	UpdatedItem        map[string]interface{}      `json:"updatedItem"`
+	AdditionalItems    []velero.ResourceIdentifier `json:"additionalItems,omitempty"`
+	PostOperationItems []velero.ResourceIdentifier `json:"postOperationItems,omitempty"`
+}
+
+type actionResultKey struct {
+	action string
+	item   velero.ResourceIdentifier
+}
+
+type operationKey struct {
+	action      string
+	operationID string
+}
+
+// resumeState holds what the backup adopts from the checkpoint of the
+// interrupted run.
+type resumeState struct {
+	items         map[velero.ResourceIdentifier]struct{}
+	actionResults map[actionResultKey]ActionResult
+	operations    map[operationKey]*itemoperation.BackupOperation
+	adopted       map[operationKey]bool
+	snapshots     []*volume.Snapshot
+}
+
+// Checkpoint returns the progress of the backup so far, along with a copy
+// of its item operations.
+func (r *Request) Checkpoint() (*Checkpoint, []*itemoperation.BackupOperation) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	checkpoint := &Checkpoint{
+		Items:         append([]velero.ResourceIdentifier{}, r.writtenItems...),
+		ActionResults: append([]ActionResult{}, r.actionResults...),
+	}
+	for _, snapshot := range r.VolumeSnapshots {
+		if snapshot.Status.Phase == volume.SnapshotPhaseCompleted {
+			checkpoint.VolumeSnapshots = append(checkpoint.VolumeSnapshots, snapshot)
+		}
+	}
+
+	var operations []*itemoperation.BackupOperation
+	for _, operation := range *r.GetItemOperationsList() {
+		operations = append(operations, operation.DeepCopy())
+	}
+	return checkpoint, operations
+}
+
+// Resume prepares the backup to adopt the progress of an interrupted run
+// recorded in the checkpoint, with the item operations of that run. It must
+// be called before the backup starts.
+func (r *Request) Resume(checkpoint *Checkpoint, operations []*itemoperation.BackupOperation) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.resumed = &resumeState{
+		items:         make(map[velero.ResourceIdentifier]struct{}),
+		actionResults: make(map[actionResultKey]ActionResult),
+		operations:    make(map[operationKey]*itemoperation.BackupOperation),
+		adopted:       make(map[operationKey]bool),
+	}
+	if checkpoint != nil {
+		for _, item := range checkpoint.Items {
+			r.resumed.items[item] = struct{}{}
+		}
+		for _, result := range checkpoint.ActionResults {
+			r.resumed.actionResults[actionResultKey{action: result.BackupItemAction, item: result.ResourceIdentifier}] = result
+		}
+		r.resumed.snapshots = checkpoint.VolumeSnapshots
+	}
+	for _, operation := range operations {
+		r.resumed.operations[operationKey{action: operation.Spec.BackupItemAction, operationID: operation.Spec.OperationID}] = operation
+	}
+}
+
+// UnadoptedOperations returns the unfinished item operations of the
+// interrupted run which the resumed backup didn't adopt. They should be
+// cancelled since nothing refers to them anymore.
+func (r *Request) UnadoptedOperations() []*itemoperation.BackupOperation {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.resumed == nil {
+		return nil
+	}
+
+	var unadopted []*itemoperation.BackupOperation
+	for key, operation := range r.resumed.operations {
+		if r.resumed.adopted[key] {
+			continue
+		}
+		if operation.Status.Phase == itemoperation.OperationPhaseNew || operation.Status.Phase == itemoperation.OperationPhaseInProgress {
+			unadopted = append(unadopted, operation)
+		}
+	}
+	return unadopted
+}
+
+// adoptActionResult returns the result of the action for the item recorded by
+// the interrupted run along with the operation it started, if the item was
+// written into the backup and the operation didn't fail. The operation is
+// then adopted by the backup.
+func (r *Request) adoptActionResult(action string, item velero.ResourceIdentifier) (*ActionResult, *itemoperation.BackupOperation) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.resumed == nil {
+		return nil, nil
+	}
+	if _, written := r.resumed.items[item]; !written {
+		return nil, nil
+	}
+	result, ok := r.resumed.actionResults[actionResultKey{action: action, item: item}]
+	if !ok {
+		return nil, nil
+	}
+	key := operationKey{action: action, operationID: result.OperationID}
+	operation, ok := r.resumed.operations[key]
+	if !ok || r.resumed.adopted[key] || operation.Status.Phase == itemoperation.OperationPhaseFailed {
+		return nil, nil
+	}
+
+	r.resumed.adopted[key] = true
+	return &result, operation.DeepCopy()
+}
+
+// resumedSnapshot returns the completed native snapshot of the volume taken
+// by the interrupted run, if any.
+func (r *Request) resumedSnapshot(pvName, volumeID string) *volume.Snapshot {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.resumed == nil {
+		return nil
+	}
+	for _, snapshot := range r.resumed.snapshots {
+		if snapshot.Spec.PersistentVolumeName == pvName && snapshot.Spec.ProviderVolumeID == volumeID &&
+			snapshot.Status.Phase == volume.SnapshotPhaseCompleted {
+			return snapshot
+		}
+	}
+	return nil
+}
+
+// recordActionResult adds the result of a backup item action which started
+// an asynchronous operation to the checkpoint.
+func (r *Request) recordActionResult(result ActionResult) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.actionResults = append(r.actionResults, result)
+}
@@ -53,6 +53,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/podvolume"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	csiutil "github.com/vmware-tanzu/velero/pkg/util/csi"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
 	pdvolumeutil "github.com/vmware-tanzu/velero/pkg/util/podvolume"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
@@ -79,6 +80,19 @@ type itemBackupper struct {
 	itemHookHandler                    hook.ItemHookHandler
 	snapshotLocationVolumeSnapshotters map[string]vsv1.VolumeSnapshotter
 	hookTracker                        *hook.HookTracker
+
+	// worker identifies the worker using the itemBackupper when items are
+	// backed up concurrently, see forWorker.
+	worker int
+}
+
+// forWorker returns a copy of the itemBackupper for a worker backing up items
+// concurrently with other workers. The copies share all of their state, so the
+// volume snapshotter cache must have been initialized.
+func (ib *itemBackupper) forWorker(worker int) *itemBackupper {
+	workerBackupper := *ib
+	workerBackupper.worker = worker
+	return &workerBackupper
 }
 
 type FileForArchive struct {
@@ -98,6 +112,15 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 	if !selectedForBackup || err != nil || len(files) == 0 || finalize {
 		return selectedForBackup, files, err
 	}
+	metadata, err := meta.Accessor(obj)
+	if err != nil {
+		return false, []FileForArchive{}, errors.WithStack(err)
+	}
+
+	// the items backed up concurrently share the tar writer
+	ib.backupRequest.lock.Lock()
+	defer ib.backupRequest.lock.Unlock()
+
 	for _, file := range files {
 		if err := ib.tarWriter.WriteHeader(file.Header); err != nil {
 			return false, []FileForArchive{}, errors.WithStack(err)
@@ -107,6 +130,11 @@ func (ib *itemBackupper) backupItem(logger logrus.FieldLogger, obj runtime.Unstr
 			return false, []FileForArchive{}, errors.WithStack(err)
 		}
 	}
+	ib.backupRequest.writtenItems = append(ib.backupRequest.writtenItems, velero.ResourceIdentifier{
+		GroupResource: groupResource,
+		Namespace:     metadata.GetNamespace(),
+		Name:          metadata.GetName(),
+	})
 	return true, []FileForArchive{}, nil
 }
 
@@ -157,6 +185,13 @@ func (ib *itemBackupper) backupItemInternal(logger logrus.FieldLogger, obj runti
 			log.Info("Excluding item because resource is excluded")
 			return false, itemFiles, nil
 		}
+
+		if excluded, err := excludedByResourcePolicies(ib.backupRequest.ResPolicies, obj, groupResource, namespace == "", log); err != nil {
+			return false, itemFiles, err
+		} else if excluded {
+			log.Info("Excluding item because it's excluded by resource policies")
+			return false, itemFiles, nil
+		}
 	}
 
 	if metadata.GetDeletionTimestamp() != nil {
@@ -170,12 +205,26 @@ func (ib *itemBackupper) backupItemInternal(logger logrus.FieldLogger, obj runti
 		name:      name,
 	}
 
-	if _, exists := ib.backupRequest.BackedUpItems[key]; exists {
+	if !ib.backupRequest.claimItem(key, ib.worker) {
 		log.Info("Skipping item because it's already been backed up.")
 		// returning true since this item *is* in the backup, even though we're not backing it up here
 		return true, itemFiles, nil
 	}
-	ib.backupRequest.BackedUpItems[key] = struct{}{}
+	defer ib.backupRequest.releaseItem(key)
+
+	// the drop rules are evaluated before anything is done for the item, so the
+	// hooks, actions and volume backups aren't run for a dropped item
+	if ib.backupRequest.ResourceModifiers != nil {
+		_, drop, err := ib.applyResourceModifiers(obj, groupResource, log)
+		if err != nil {
+			return false, itemFiles, err
+		}
+		if drop {
+			log.Info("Excluding item because it's dropped by resource modifiers")
+			ib.backupRequest.dropItem(key)
+			return false, itemFiles, nil
+		}
+	}
 	log.Info("Backing up item")
 
 	var (
@@ -200,10 +249,14 @@ func (ib *itemBackupper) backupItemInternal(logger logrus.FieldLogger, obj runti
 			// nil it on error since it's not valid
 			pod = nil
 		} else {
-			// Get the list of volumes to back up using pod volume backup from the pod's annotations. Remove from this list
-			// any volumes that use a PVC that we've already backed up (this would be in a read-write-many scenario,
-			// where it's been backed up from another pod), since we don't need >1 backup per PVC.
-			includedVolumes, optedOutVolumes := pdvolumeutil.GetVolumesByPod(pod, boolptr.IsSetToTrue(ib.backupRequest.Spec.DefaultVolumesToFsBackup))
+			// Get the list of volumes to back up using pod volume backup from the pod's annotations, or from the
+			// resource policies matched by the volumes. Remove from this list any volumes that use a PVC that we've
+			// already backed up (this would be in a read-write-many scenario, where it's been backed up from another
+			// pod), since we don't need >1 backup per PVC.
+			includedVolumes, optedOutVolumes, err := ib.getPodVolumes(pod)
+			if err != nil {
+				backupErrs = append(backupErrs, err)
+			}
 			for _, volume := range includedVolumes {
 				// track the volumes that are PVCs using the PVC snapshot tracker, so that when we backup PVCs/PVs
 				// via an item action in the next step, we don't snapshot PVs that will have their data backed up
@@ -265,7 +318,9 @@ func (ib *itemBackupper) backupItemInternal(logger logrus.FieldLogger, obj runti
 		// even if there are errors.
 		podVolumeBackups, podVolumePVCBackupSummary, errs := ib.backupPodVolumes(log, pod, pvbVolumes)
 
+		ib.backupRequest.lock.Lock()
 		ib.backupRequest.PodVolumeBackups = append(ib.backupRequest.PodVolumeBackups, podVolumeBackups...)
+		ib.backupRequest.lock.Unlock()
 		backupErrs = append(backupErrs, errs...)
 
 		// Mark the volumes that has been processed by pod volume backup as Taken in the tracker.
@@ -302,6 +357,21 @@ func (ib *itemBackupper) backupItemInternal(logger logrus.FieldLogger, obj runti
 		return false, itemFiles, kubeerrs.NewAggregate(backupErrs)
 	}
 
+	// the patches are applied to the item as updated by the actions, which may
+	// also make it match a drop rule
+	if ib.backupRequest.ResourceModifiers != nil {
+		modified, drop, err := ib.applyResourceModifiers(obj, groupResource, log)
+		if err != nil {
+			return false, itemFiles, err
+		}
+		if drop {
+			log.Info("Excluding item because it's dropped by resource modifiers")
+			ib.backupRequest.dropItem(key)
+			return false, itemFiles, nil
+		}
+		obj = modified
+	}
+
 	itemBytes, err := json.Marshal(obj.UnstructuredContent())
 	if err != nil {
 		return false, itemFiles, errors.WithStack(err)
@@ -330,6 +400,23 @@ func getFileForArchive(namespace, name, groupResource, versionPath string, itemB
 	return FileForArchive{FilePath: filePath, Header: hdr, FileBytes: itemBytes}
 }
 
+// applyResourceModifiers applies the backup's resource modifiers to a copy of
+// the item, which is what's written into the backup. It returns true if the
+// item is dropped by a rule and shouldn't be backed up.
+func (ib *itemBackupper) applyResourceModifiers(obj runtime.Unstructured, groupResource schema.GroupResource, log logrus.FieldLogger) (runtime.Unstructured, bool, error) {
+	modified := &unstructured.Unstructured{Object: runtime.DeepCopyJSON(obj.UnstructuredContent())}
+
+	var scheme *runtime.Scheme
+	if ib.kbClient != nil {
+		scheme = ib.kbClient.Scheme()
+	}
+	drop, errs := ib.backupRequest.ResourceModifiers.ApplyResourceModifierRules(modified, groupResource.String(), scheme, log)
+	if len(errs) > 0 {
+		return nil, false, errors.Wrap(kubeerrs.NewAggregate(errs), "error applying resource modifiers")
+	}
+	return modified, drop, nil
+}
+
 // backupPodVolumes triggers pod volume backups of the specified pod volumes, and returns a list of PodVolumeBackups
 // for volumes that were successfully backed up, and a slice of any errors that were encountered.
 func (ib *itemBackupper) backupPodVolumes(log logrus.FieldLogger, pod *corev1api.Pod, volumes []string) ([]*velerov1api.PodVolumeBackup, *podvolume.PVCBackupSummary, []error) {
@@ -342,7 +429,7 @@ func (ib *itemBackupper) backupPodVolumes(log logrus.FieldLogger, pod *corev1api
 		return nil, nil, nil
 	}
 
-	return ib.podVolumeBackupper.BackupPodVolumes(ib.backupRequest.Backup, pod, volumes, ib.backupRequest.ResPolicies, log)
+	return ib.podVolumeBackupper.BackupPodVolumes(ib.backupRequest.Backup, pod, volumes, ib.backupRequest.ResPolicies, ib.podsUsingPVC, log)
 }
 
 func (ib *itemBackupper) executeActions(
@@ -360,13 +447,23 @@ func (ib *itemBackupper) executeActions(
 		}
 		log.Info("Executing custom action")
 		actionName := action.Name()
-		if act, err := ib.getMatchAction(obj, groupResource, actionName); err != nil {
+		act, err := ib.getMatchAction(obj, groupResource, actionName)
+		if err != nil {
 			return nil, itemFiles, errors.WithStack(err)
-		} else if act != nil && act.Type == resourcepolicies.Skip {
+		}
+		if act != nil && act.Type == resourcepolicies.Skip {
 			log.Infof("Skip executing Backup Item Action: %s of resource %s: %s/%s for the matched resource policies", actionName, groupResource, namespace, name)
 			ib.trackSkippedPV(obj, groupResource, "", "skipped due to resource policy ", log)
 			continue
 		}
+		if act != nil && act.Type == resourcepolicies.FSBackup {
+			log.Infof("Skip executing Backup Item Action: %s of resource %s: %s/%s because the matched resource policies back up the volume with fs-backup", actionName, groupResource, namespace, name)
+			if !ib.podVolumeSnapshotTracker.Has(namespace, name) {
+				ib.trackSkippedPV(obj, groupResource, csiSnapshotApproach, "matched action is 'fs-backup' in chosen resource policies", log)
+			}
+			continue
+		}
+		backup := backupForVolumeAction(ib.backupRequest.Backup, act)
 
 		// If the EnableCSI feature is not enabled, but the executing action is from CSI plugin, skip the action.
 		if csiutil.ShouldSkipAction(actionName) {
@@ -375,9 +472,58 @@ func (ib *itemBackupper) executeActions(
 			continue
 		}
 
-		updatedItem, additionalItemIdentifiers, operationID, postOperationItems, err := action.Execute(obj, ib.backupRequest.Backup)
-		if err != nil {
-			return nil, itemFiles, errors.Wrapf(err, "error executing custom action (groupResource=%s, namespace=%s, name=%s)", groupResource.String(), namespace, name)
+		// The snapshot plugins create VolumeSnapshots and data movement operations, so a dry-run
+		// backup only records which method would be used for the volume instead of executing them.
+		if boolptr.IsSetToTrue(ib.backupRequest.Spec.DryRun) && (actionName == csiBIAPluginName || actionName == vsphereBIAPluginName) {
+			if err := ib.planVolumeSnapshot(obj, groupResource, actionName, backup, log); err != nil {
+				return nil, itemFiles, err
+			}
+			continue
+		}
+
+		resourceIdentifier := velero.ResourceIdentifier{
+			GroupResource: groupResource,
+			Namespace:     namespace,
+			Name:          name,
+		}
+		var (
+			updatedItem               runtime.Unstructured
+			additionalItemIdentifiers []velero.ResourceIdentifier
+			operationID               string
+			postOperationItems        []velero.ResourceIdentifier
+		)
+		// a resumed backup adopts the operation started for the item before the restart
+		result, adoptedOperation := ib.backupRequest.adoptActionResult(actionName, resourceIdentifier)
+		if adoptedOperation != nil {
+			log.Infof("Adopting operation %s started by the action before the restart", result.OperationID)
+			updatedItem = &unstructured.Unstructured{Object: runtime.DeepCopyJSON(result.UpdatedItem)}
+			additionalItemIdentifiers = result.AdditionalItems
+			operationID = result.OperationID
+			postOperationItems = result.PostOperationItems
+		} else {
+			updatedItem, additionalItemIdentifiers, operationID, postOperationItems, err = action.Execute(obj, backup)
+			if err != nil {
+				return nil, itemFiles, errors.Wrapf(err, "error executing custom action (groupResource=%s, namespace=%s, name=%s)", groupResource.String(), namespace, name)
+			}
+		}
+		// The other actions are executed by a dry-run backup, since it can't tell what they
+		// do beforehand, but the operations they start are cancelled right away.
+		if operationID != "" && boolptr.IsSetToTrue(ib.backupRequest.Spec.DryRun) {
+			log.Warnf("Cancelling operation %s started by action %s since the backup is a dry run", operationID, actionName)
+			if err := action.Cancel(operationID, backup); err != nil {
+				log.WithError(err).Warnf("Error cancelling operation %s started by action %s", operationID, actionName)
+			}
+			operationID, postOperationItems = "", nil
+		}
+		if operationID != "" && !finalize {
+			ib.backupRequest.recordActionResult(ActionResult{
+				BackupItemAction:   actionName,
+				ResourceIdentifier: resourceIdentifier,
+				OperationID:        operationID,
+				UpdatedItem:        runtime.DeepCopyJSON(updatedItem.UnstructuredContent()),
+				AdditionalItems:    additionalItemIdentifiers,
+				PostOperationItems: postOperationItems,
+			})
 		}
 		u := &unstructured.Unstructured{Object: updatedItem.UnstructuredContent()}
 		if actionName == csiBIAPluginName && additionalItemIdentifiers == nil && u.GetAnnotations()[skippedNoCSIPVAnnotation] == "true" {
@@ -400,28 +546,28 @@ func (ib *itemBackupper) executeActions(
 			if finalize {
 				return nil, itemFiles, fmt.Errorf("backup Item Action created operation during finalize (groupResource=%s, namespace=%s, name=%s)", groupResource.String(), namespace, name)
 			}
-			resourceIdentifier := velero.ResourceIdentifier{
-				GroupResource: groupResource,
-				Namespace:     namespace,
-				Name:          name,
-			}
-			now := metav1.Now()
-			newOperation := itemoperation.BackupOperation{
-				Spec: itemoperation.BackupOperationSpec{
-					BackupName:         ib.backupRequest.Backup.Name,
-					BackupUID:          string(ib.backupRequest.Backup.UID),
-					BackupItemAction:   action.Name(),
-					ResourceIdentifier: resourceIdentifier,
-					OperationID:        operationID,
-				},
-				Status: itemoperation.OperationStatus{
-					Phase:   itemoperation.OperationPhaseNew,
-					Created: &now,
-				},
+			newOperation := adoptedOperation
+			if newOperation == nil {
+				now := metav1.Now()
+				newOperation = &itemoperation.BackupOperation{
+					Spec: itemoperation.BackupOperationSpec{
+						BackupName:         ib.backupRequest.Backup.Name,
+						BackupUID:          string(ib.backupRequest.Backup.UID),
+						BackupItemAction:   action.Name(),
+						ResourceIdentifier: resourceIdentifier,
+						OperationID:        operationID,
+					},
+					Status: itemoperation.OperationStatus{
+						Phase:   itemoperation.OperationPhaseNew,
+						Created: &now,
+					},
+				}
+				newOperation.Spec.PostOperationItems = postOperationItems
 			}
-			newOperation.Spec.PostOperationItems = postOperationItems
+			ib.backupRequest.lock.Lock()
 			itemOperList := ib.backupRequest.GetItemOperationsList()
-			*itemOperList = append(*itemOperList, &newOperation)
+			*itemOperList = append(*itemOperList, newOperation)
+			ib.backupRequest.lock.Unlock()
 		}
 
 		for _, additionalItem := range additionalItemIdentifiers {
@@ -462,6 +608,9 @@ func (ib *itemBackupper) executeActions(
 // volumeSnapshotter instantiates and initializes a VolumeSnapshotter given a VolumeSnapshotLocation,
 // or returns an existing one if one's already been initialized for the location.
 func (ib *itemBackupper) volumeSnapshotter(snapshotLocation *velerov1api.VolumeSnapshotLocation) (vsv1.VolumeSnapshotter, error) {
+	ib.backupRequest.lock.Lock()
+	defer ib.backupRequest.lock.Unlock()
+
 	if bs, ok := ib.snapshotLocationVolumeSnapshotters[snapshotLocation.Name]; ok {
 		return bs, nil
 	}
@@ -549,7 +698,7 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 	}
 
 	if ib.backupRequest.ResPolicies != nil {
-		if action, err := ib.backupRequest.ResPolicies.GetMatchAction(pv); err != nil {
+		if action, err := ib.getPVMatchAction(pv, nil); err != nil {
 			log.WithError(err).Errorf("Error getting matched resource policies for pv %s", pv.Name)
 			return nil
 		} else if action != nil && action.Type == resourcepolicies.Skip {
@@ -557,6 +706,12 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 			// at this point we are sure this object is PV therefore we'll call the tracker directly
 			ib.backupRequest.SkippedPVTracker.Track(pv.Name, volumeSnapshotApproach, "matched action is 'skip' in chosen resource policies")
 			return nil
+		} else if action != nil && action.Type == resourcepolicies.FSBackup {
+			log.Infof("skip snapshot of pv %s because the matched resource policies back it up with fs-backup", pv.Name)
+			ib.backupRequest.SkippedPVTracker.Track(pv.Name, volumeSnapshotApproach, "matched action is 'fs-backup' in chosen resource policies")
+			return nil
+		} else if action != nil && action.Type == resourcepolicies.DataMover {
+			log.Warn("VolumeSnapshotter plugin doesn't support data movement, fall back to Velero native snapshot for the matched resource policies.")
 		}
 	}
 
@@ -616,6 +771,25 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 
 	log = log.WithField("volumeID", volumeID)
 
+	if boolptr.IsSetToTrue(ib.backupRequest.Spec.DryRun) {
+		log.Info("Backup is a dry run, persistent volume would be snapshotted by the volume snapshotter")
+		ib.backupRequest.SkippedPVTracker.Untrack(pv.Name)
+		ib.backupRequest.lock.Lock()
+		ib.backupRequest.VolumeSnapshots = append(ib.backupRequest.VolumeSnapshots,
+			volumeSnapshot(ib.backupRequest.Backup, pv.Name, volumeID, "", pvFailureDomainZone, location, nil))
+		ib.backupRequest.lock.Unlock()
+		return nil
+	}
+
+	if snapshot := ib.backupRequest.resumedSnapshot(pv.Name, volumeID); snapshot != nil {
+		log.Infof("Adopting snapshot %s taken before the restart", snapshot.Status.ProviderSnapshotID)
+		ib.backupRequest.SkippedPVTracker.Untrack(pv.Name)
+		ib.backupRequest.lock.Lock()
+		ib.backupRequest.VolumeSnapshots = append(ib.backupRequest.VolumeSnapshots, snapshot)
+		ib.backupRequest.lock.Unlock()
+		return nil
+	}
+
 	// create tags from the backup's labels
 	tags := map[string]string{}
 	for k, v := range ib.backupRequest.GetLabels() {
@@ -643,12 +817,59 @@ func (ib *itemBackupper) takePVSnapshot(obj runtime.Unstructured, log logrus.Fie
 		snapshot.Status.Phase = volume.SnapshotPhaseCompleted
 		snapshot.Status.ProviderSnapshotID = snapshotID
 	}
+	ib.backupRequest.lock.Lock()
 	ib.backupRequest.VolumeSnapshots = append(ib.backupRequest.VolumeSnapshots, snapshot)
+	ib.backupRequest.lock.Unlock()
 
 	// nil errors are automatically removed
 	return kubeerrs.NewAggregate(errs)
 }
 
+// planVolumeSnapshot records the volume backup method the snapshot plugin would choose for
+// the PVC of a dry-run backup, without executing the plugin.
+func (ib *itemBackupper) planVolumeSnapshot(obj runtime.Unstructured, groupResource schema.GroupResource, actionName string, backup *velerov1api.Backup, log logrus.FieldLogger) error {
+	if groupResource != kuberesource.PersistentVolumeClaims {
+		return nil
+	}
+
+	pvc := new(corev1api.PersistentVolumeClaim)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.UnstructuredContent(), pvc); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if boolptr.IsSetToFalse(ib.backupRequest.Spec.SnapshotVolumes) {
+		ib.trackSkippedPV(obj, groupResource, csiSnapshotApproach, "backup has volume snapshots disabled", log)
+		return nil
+	}
+
+	if ib.podVolumeSnapshotTracker.Has(pvc.Namespace, pvc.Name) {
+		log.Info("Skipping snapshot of persistent volume claim because volume is being backed up with pod volume backup.")
+		return nil
+	}
+
+	if pvc.Spec.VolumeName == "" {
+		log.Info("Skipping snapshot of persistent volume claim because it's not bound.")
+		return nil
+	}
+
+	pv := new(corev1api.PersistentVolume)
+	if err := ib.kbClient.Get(context.Background(), kbClient.ObjectKey{Name: pvc.Spec.VolumeName}, pv); err != nil {
+		return errors.WithStack(err)
+	}
+
+	if actionName == csiBIAPluginName && pv.Spec.CSI == nil {
+		ib.trackSkippedPV(obj, groupResource, csiSnapshotApproach, "skipped b/c it's not a CSI volume", log)
+		return nil
+	}
+
+	log.Infof("Backup is a dry run, persistent volume claim would be snapshotted by %s", actionName)
+	ib.unTrackSkippedPV(obj, groupResource, log)
+	ib.backupRequest.lock.Lock()
+	ib.backupRequest.VolumesInformation.InsertPlannedCSISnapshot(pvc.Name, pvc.Namespace, boolptr.IsSetToTrue(backup.Spec.SnapshotMoveData))
+	ib.backupRequest.lock.Unlock()
+	return nil
+}
+
 func (ib *itemBackupper) getMatchAction(obj runtime.Unstructured, groupResource schema.GroupResource, backupItemActionName string) (*resourcepolicies.Action, error) {
 	if ib.backupRequest.ResPolicies != nil && groupResource == kuberesource.PersistentVolumeClaims && (backupItemActionName == csiBIAPluginName || backupItemActionName == vsphereBIAPluginName) {
 		pvc := corev1api.PersistentVolumeClaim{}
@@ -665,12 +886,152 @@ func (ib *itemBackupper) getMatchAction(obj runtime.Unstructured, groupResource
 		if err := ib.kbClient.Get(context.Background(), kbClient.ObjectKey{Name: pvName}, pv); err != nil {
 			return nil, errors.WithStack(err)
 		}
-		return ib.backupRequest.ResPolicies.GetMatchAction(pv)
+		return ib.getPVMatchAction(pv, &pvc)
 	}
 
 	return nil, nil
 }
 
+// getPVMatchAction returns the action of the resource policies matched by the PV. The PV's claim,
+// when it's not provided, and the pods mounting the claim are looked up for the policies' conditions
+// on them.
+func (ib *itemBackupper) getPVMatchAction(pv *corev1api.PersistentVolume, pvc *corev1api.PersistentVolumeClaim) (*resourcepolicies.Action, error) {
+	data := resourcepolicies.VolumeFilterData{PersistentVolume: pv, PVC: pvc}
+
+	if data.PVC == nil && pv.Spec.ClaimRef != nil {
+		claim := new(corev1api.PersistentVolumeClaim)
+		err := ib.kbClient.Get(context.Background(), kbClient.ObjectKey{Namespace: pv.Spec.ClaimRef.Namespace, Name: pv.Spec.ClaimRef.Name}, claim)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, errors.Wrapf(err, "error getting persistent volume claim %s/%s", pv.Spec.ClaimRef.Namespace, pv.Spec.ClaimRef.Name)
+		}
+		if err == nil {
+			data.PVC = claim
+		}
+	}
+
+	if data.PVC != nil {
+		pods, err := ib.podsUsingPVC(data.PVC, nil)
+		if err != nil {
+			return nil, err
+		}
+		data.Pods = pods
+	}
+
+	return ib.backupRequest.ResPolicies.GetMatchAction(data)
+}
+
+// podsUsingPVC returns the pods mounting the PVC, which the podLabels conditions of the resource
+// policies are matched against. The pods are listed once per namespace for the backup, so pod, if
+// not nil, is included even if it was created after they were listed.
+func (ib *itemBackupper) podsUsingPVC(pvc *corev1api.PersistentVolumeClaim, pod *corev1api.Pod) ([]*corev1api.Pod, error) {
+	pods, err := ib.backupRequest.podsInNamespace(pvc.Namespace, ib.listPods)
+	if err != nil {
+		return nil, err
+	}
+
+	var using []*corev1api.Pod
+	if pod != nil {
+		using = append(using, pod)
+	}
+	for i := range pods {
+		if pod != nil && pods[i].UID == pod.UID && pods[i].Name == pod.Name {
+			continue
+		}
+		if kube.IsPodUsingPVC(&pods[i], pvc.Name) {
+			using = append(using, &pods[i])
+		}
+	}
+	return using, nil
+}
+
+// listPods returns the pods of the namespace
+func (ib *itemBackupper) listPods(namespace string) ([]corev1api.Pod, error) {
+	podList := new(corev1api.PodList)
+	if err := ib.kbClient.List(context.Background(), podList, kbClient.InNamespace(namespace)); err != nil {
+		return nil, errors.Wrapf(err, "error listing pods in namespace %s", namespace)
+	}
+	return podList.Items, nil
+}
+
+// excludedByResourcePolicies returns true if the resource filter policies exclude the item. As for
+// the resource filters of the backup, cluster-scoped items are only excluded when a policy explicitly
+// excludes them, and namespaces are never filtered by the policies.
+func excludedByResourcePolicies(policies *resourcepolicies.Policies, obj runtime.Unstructured, groupResource schema.GroupResource, clusterScoped bool, log logrus.FieldLogger) (bool, error) {
+	if policies == nil || groupResource == kuberesource.Namespaces {
+		return false, nil
+	}
+
+	u := &unstructured.Unstructured{Object: obj.UnstructuredContent()}
+	if clusterScoped {
+		return policies.ShouldExcludeResource(u, groupResource.String(), log)
+	}
+
+	include, err := policies.ShouldIncludeResource(u, groupResource.String(), log)
+	return !include, err
+}
+
+// getPodVolumes returns the volumes of the pod to back up with pod volume backup and the volumes
+// opted out of it. When the backup has resource policies, the actions matched by the volumes take
+// precedence over the pod's annotations and the backup's defaultVolumesToFsBackup.
+func (ib *itemBackupper) getPodVolumes(pod *corev1api.Pod) ([]string, []string, error) {
+	defaultVolumesToFsBackup := boolptr.IsSetToTrue(ib.backupRequest.Spec.DefaultVolumesToFsBackup)
+	if ib.backupRequest.ResPolicies == nil {
+		includedVolumes, optedOutVolumes := pdvolumeutil.GetVolumesByPod(pod, defaultVolumesToFsBackup)
+		return includedVolumes, optedOutVolumes, nil
+	}
+
+	return pdvolumeutil.GetVolumesByPodAndPolicy(pod, defaultVolumesToFsBackup, func(volume *corev1api.Volume) (*resourcepolicies.Action, error) {
+		if volume.PersistentVolumeClaim == nil {
+			return ib.backupRequest.ResPolicies.GetMatchAction(resourcepolicies.VolumeFilterData{PodVolume: volume, Pods: []*corev1api.Pod{pod}})
+		}
+
+		pvc := new(corev1api.PersistentVolumeClaim)
+		if err := ib.kbClient.Get(context.Background(), kbClient.ObjectKey{Namespace: pod.Namespace, Name: volume.PersistentVolumeClaim.ClaimName}, pvc); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, errors.Wrapf(err, "error getting persistent volume claim %s/%s", pod.Namespace, volume.PersistentVolumeClaim.ClaimName)
+		}
+		if pvc.Spec.VolumeName == "" {
+			return nil, nil
+		}
+
+		pv := new(corev1api.PersistentVolume)
+		if err := ib.kbClient.Get(context.Background(), kbClient.ObjectKey{Name: pvc.Spec.VolumeName}, pv); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, errors.Wrapf(err, "error getting persistent volume %s", pvc.Spec.VolumeName)
+		}
+		pods, err := ib.podsUsingPVC(pvc, pod)
+		if err != nil {
+			return nil, err
+		}
+		return ib.backupRequest.ResPolicies.GetMatchAction(resourcepolicies.VolumeFilterData{PersistentVolume: pv, PVC: pvc, Pods: pods})
+	})
+}
+
+// backupForVolumeAction returns the backup passed to the snapshot plugins for a volume matching the
+// action of a resource policy. The snapshot and datamover actions decide whether the data of the
+// volume's snapshot is moved, and which data mover moves it.
+func backupForVolumeAction(backup *velerov1api.Backup, action *resourcepolicies.Action) *velerov1api.Backup {
+	if action == nil || (action.Type != resourcepolicies.Snapshot && action.Type != resourcepolicies.DataMover) {
+		return backup
+	}
+
+	res := backup.DeepCopy()
+	if action.Type == resourcepolicies.Snapshot {
+		res.Spec.SnapshotMoveData = boolptr.False()
+		return res
+	}
+
+	res.Spec.SnapshotMoveData = boolptr.True()
+	if dataMover := action.DataMover(); dataMover != "" {
+		res.Spec.DataMover = dataMover
+	}
+	return res
+}
+
 // trackSkippedPV tracks the skipped PV based on the object and the given approach and reason
 // this function will be called throughout the process of backup, it needs to handle any object
 func (ib *itemBackupper) trackSkippedPV(obj runtime.Unstructured, groupResource schema.GroupResource, approach string, reason string, log logrus.FieldLogger) {
@@ -706,7 +1067,9 @@ func (ib *itemBackupper) addVolumeInfo(obj runtime.Unstructured, log logrus.Fiel
 		pvcNamespace = pv.Spec.ClaimRef.Namespace
 	}
 
+	ib.backupRequest.lock.Lock()
 	ib.backupRequest.VolumesInformation.InsertPVMap(*pv, pvcName, pvcNamespace)
+	ib.backupRequest.lock.Unlock()
 
 	return nil
 }
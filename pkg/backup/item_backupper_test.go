@@ -17,6 +17,7 @@ limitations under the License.
 package backup
 
 import (
+	"context"
 	"testing"
 
 	"github.com/sirupsen/logrus"
@@ -31,7 +32,9 @@ import (
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
 
 func Test_resourceKey(t *testing.T) {
@@ -269,3 +272,113 @@ func TestAddVolumeInfo(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPVMatchAction(t *testing.T) {
+	policies, err := resourcepolicies.GetResourcePoliciesFromConfig(
+		builder.ForConfigMap("velero", "policies").Data("policies", `version: v1
+volumePolicies:
+- conditions:
+    podLabels:
+      app: db
+  action:
+    type: fs-backup
+`).Result())
+	require.NoError(t, err)
+
+	pod := func(name, app, claimName string) *corev1api.Pod {
+		return builder.ForPod("ns-1", name).
+			ObjectMeta(builder.WithLabels("app", app)).
+			Volumes(builder.ForVolume("vol-1").PersistentVolumeClaimSource(claimName).Result()).
+			Result()
+	}
+
+	ib := &itemBackupper{
+		backupRequest: &Request{ResPolicies: policies},
+		kbClient: velerotest.NewFakeControllerRuntimeClient(t,
+			pod("pod-1", "web", "pvc-1"),
+			pod("pod-2", "db", "pvc-1"),
+			pod("pod-3", "web", "pvc-2"),
+		),
+	}
+
+	tests := []struct {
+		name     string
+		pvc      string
+		expected *resourcepolicies.Action
+	}{
+		{
+			name:     "one of the pods mounting the pvc matches",
+			pvc:      "pvc-1",
+			expected: &resourcepolicies.Action{Type: resourcepolicies.FSBackup},
+		},
+		{
+			name:     "no pod mounting the pvc matches",
+			pvc:      "pvc-2",
+			expected: nil,
+		},
+		{
+			name:     "no pod mounts the pvc",
+			pvc:      "pvc-3",
+			expected: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pv := builder.ForPersistentVolume("pv-1").ClaimRef("ns-1", tc.pvc).Result()
+			pvc := builder.ForPersistentVolumeClaim("ns-1", tc.pvc).VolumeName("pv-1").Result()
+
+			action, err := ib.getPVMatchAction(pv, pvc)
+			require.NoError(t, err)
+			assert.Equal(t, tc.expected, action)
+		})
+	}
+
+	// the pods are listed once per namespace
+	require.NoError(t, ib.kbClient.Create(context.Background(), pod("pod-4", "db", "pvc-2")))
+	action, err := ib.getPVMatchAction(
+		builder.ForPersistentVolume("pv-2").ClaimRef("ns-1", "pvc-2").Result(),
+		builder.ForPersistentVolumeClaim("ns-1", "pvc-2").VolumeName("pv-2").Result(),
+	)
+	require.NoError(t, err)
+	assert.Nil(t, action)
+}
+
+func TestGetPodVolumesWithSharedPVC(t *testing.T) {
+	policies, err := resourcepolicies.GetResourcePoliciesFromConfig(
+		builder.ForConfigMap("velero", "policies").Data("policies", `version: v1
+volumePolicies:
+- conditions:
+    podLabels:
+      app: db
+  action:
+    type: fs-backup
+`).Result())
+	require.NoError(t, err)
+
+	pod := func(name, app string) *corev1api.Pod {
+		return builder.ForPod("ns-1", name).
+			ObjectMeta(builder.WithLabels("app", app)).
+			Volumes(builder.ForVolume("vol-1").PersistentVolumeClaimSource("pvc-1").Result()).
+			Result()
+	}
+	web, db := pod("pod-1", "web"), pod("pod-2", "db")
+
+	ib := &itemBackupper{
+		backupRequest: &Request{Backup: builder.ForBackup("velero", "backup-1").Result(), ResPolicies: policies},
+		kbClient: velerotest.NewFakeControllerRuntimeClient(t,
+			web,
+			db,
+			builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result(),
+			builder.ForPersistentVolume("pv-1").ClaimRef("ns-1", "pvc-1").Result(),
+		),
+	}
+
+	// the volume is backed up from both pods, since the pvc is mounted by a pod matching the policy
+	for _, p := range []*corev1api.Pod{web, db} {
+		included, optedOut, err := ib.getPodVolumes(p)
+		require.NoError(t, err)
+		assert.Equal(t, []string{"vol-1"}, included, p.Name)
+		assert.Empty(t, optedOut, p.Name)
+	}
+}
@@ -357,6 +357,16 @@ func (r *itemCollector) getResourceItems(log logrus.FieldLogger, gv schema.Group
 		for i := range unstructuredItems {
 			item := &unstructuredItems[i]
 
+			// An item the policies can't be applied to is still collected, so the item backupper
+			// applies them again and reports the error as an error backing up the item.
+			excluded, err := excludedByResourcePolicies(r.backupRequest.ResPolicies, item, gr, clusterScoped, log)
+			if err != nil {
+				log.WithError(err).WithField("name", item.GetName()).Debug("Error applying resource filter policies")
+			} else if excluded {
+				log.WithField("name", item.GetName()).Info("Skipping item because it's excluded by resource policies")
+				continue
+			}
+
 			path, err := r.writeToFile(item)
 			if err != nil {
 				log.WithError(err).Error("Error writing item to file")
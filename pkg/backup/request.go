@@ -19,13 +19,18 @@ package backup
 import (
 	"fmt"
 	"sort"
+	"sync"
+
+	corev1api "k8s.io/api/core/v1"
 
 	"github.com/vmware-tanzu/velero/internal/hook"
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	internalVolume "github.com/vmware-tanzu/velero/internal/volume"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
@@ -51,9 +56,37 @@ type Request struct {
 	PodVolumeBackups          []*velerov1api.PodVolumeBackup
 	BackedUpItems             map[itemKey]struct{}
 	itemOperationsList        *[]*itemoperation.BackupOperation
+	hookTracker               *hook.HookTracker
 	ResPolicies               *resourcepolicies.Policies
+	ResourceModifiers         *resourcemodifiers.ResourceModifiers
 	SkippedPVTracker          *skipPVTracker
 	VolumesInformation        internalVolume.VolumesInformation
+
+	// lock guards the fields updated while the items are backed up, which
+	// may be done by several workers concurrently.
+	lock sync.Mutex
+	// inProgressItems holds the items claimed by a worker and not yet backed up.
+	inProgressItems map[itemKey]*itemClaim
+	// waitingWorkers maps each worker waiting for an item to the worker backing it up.
+	waitingWorkers map[int]int
+	// cancelled is closed when the cancellation of the backup is requested.
+	cancelled chan struct{}
+	// writtenItems and actionResults are recorded for the checkpoint of the backup.
+	writtenItems  []velero.ResourceIdentifier
+	actionResults []ActionResult
+	// resumed is set when the backup resumes an interrupted run, see Resume.
+	resumed *resumeState
+
+	// podsLock guards namespacePods, which caches the pods of the namespaces whose
+	// volumes are matched against the volume policies, see podsInNamespace.
+	podsLock      sync.Mutex
+	namespacePods map[string][]corev1api.Pod
+}
+
+// itemClaim records which worker is backing up an item.
+type itemClaim struct {
+	worker int
+	done   chan struct{}
 }
 
 // VolumesInformation contains the information needs by generating
@@ -68,6 +101,152 @@ func (r *Request) GetItemOperationsList() *[]*itemoperation.BackupOperation {
 	return r.itemOperationsList
 }
 
+// GetHookTracker returns the tracker of the backup's hooks, initializing it if necessary
+func (r *Request) GetHookTracker() *hook.HookTracker {
+	if r.hookTracker == nil {
+		r.hookTracker = hook.NewHookTracker()
+	}
+	return r.hookTracker
+}
+
+// claimItem adds the item to BackedUpItems for the worker and returns true,
+// unless the item has already been claimed. If the item is still being backed
+// up by another worker, claimItem waits until it's done, so the item is
+// complete when a worker needs it as an additional item. It doesn't wait if
+// the other worker is itself waiting for this worker, which would deadlock.
+func (r *Request) claimItem(key itemKey, worker int) bool {
+	r.lock.Lock()
+
+	if _, exists := r.BackedUpItems[key]; !exists {
+		r.BackedUpItems[key] = struct{}{}
+		if r.inProgressItems == nil {
+			r.inProgressItems = make(map[itemKey]*itemClaim)
+			r.waitingWorkers = make(map[int]int)
+		}
+		r.inProgressItems[key] = &itemClaim{worker: worker, done: make(chan struct{})}
+		r.lock.Unlock()
+		return true
+	}
+
+	claim, inProgress := r.inProgressItems[key]
+	if !inProgress || r.waitsFor(claim.worker, worker) {
+		r.lock.Unlock()
+		return false
+	}
+
+	r.waitingWorkers[worker] = claim.worker
+	r.lock.Unlock()
+
+	<-claim.done
+
+	r.lock.Lock()
+	delete(r.waitingWorkers, worker)
+	r.lock.Unlock()
+	return false
+}
+
+// waitsFor returns true if worker is, directly or transitively, waiting for
+// the other worker. A worker always waits for itself.
+func (r *Request) waitsFor(worker, other int) bool {
+	for {
+		if worker == other {
+			return true
+		}
+		next, waiting := r.waitingWorkers[worker]
+		if !waiting {
+			return false
+		}
+		worker = next
+	}
+}
+
+// releaseItem marks the item claimed with claimItem as done.
+func (r *Request) releaseItem(key itemKey) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if claim, ok := r.inProgressItems[key]; ok {
+		close(claim.done)
+		delete(r.inProgressItems, key)
+	}
+}
+
+// dropItem removes the item claimed with claimItem from BackedUpItems, when
+// it turns out not to be backed up after all.
+func (r *Request) dropItem(key itemKey) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	delete(r.BackedUpItems, key)
+}
+
+// podsInNamespace returns the pods of the namespace. They're listed with list the
+// first time, and cached for the rest of the backup.
+func (r *Request) podsInNamespace(namespace string, list func(namespace string) ([]corev1api.Pod, error)) ([]corev1api.Pod, error) {
+	r.podsLock.Lock()
+	defer r.podsLock.Unlock()
+
+	if pods, ok := r.namespacePods[namespace]; ok {
+		return pods, nil
+	}
+
+	pods, err := list(namespace)
+	if err != nil {
+		return nil, err
+	}
+	if r.namespacePods == nil {
+		r.namespacePods = make(map[string][]corev1api.Pod)
+	}
+	r.namespacePods[namespace] = pods
+	return pods, nil
+}
+
+// backedUpItemCount returns the number of items claimed so far.
+func (r *Request) backedUpItemCount() int {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	return len(r.BackedUpItems)
+}
+
+// Cancelled returns a channel which is closed when the cancellation of the
+// backup is requested.
+func (r *Request) Cancelled() <-chan struct{} {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.cancelled == nil {
+		r.cancelled = make(chan struct{})
+	}
+	return r.cancelled
+}
+
+// Cancel requests the cancellation of the backup: the items which haven't
+// been backed up yet are skipped, and the backup stops waiting for its pod
+// volume backups.
+func (r *Request) Cancel() {
+	cancelled := r.Cancelled()
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	select {
+	case <-cancelled:
+	default:
+		close(r.cancelled)
+	}
+}
+
+// IsCancelled returns true if the cancellation of the backup was requested.
+func (r *Request) IsCancelled() bool {
+	select {
+	case <-r.Cancelled():
+		return true
+	default:
+		return false
+	}
+}
+
 // BackupResourceList returns the list of backed up resources grouped by the API
 // Version and Kind
 func (r *Request) BackupResourceList() map[string][]string {
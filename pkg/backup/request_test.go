@@ -18,6 +18,7 @@ package backup
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -80,3 +81,53 @@ func TestRequest_BackupResourceListEntriesSorted(t *testing.T) {
 		"v1/Pod": {"ns1/pod1", "ns2/pod2"},
 	}, req.BackupResourceList())
 }
+
+func TestRequest_ClaimItem(t *testing.T) {
+	req := &Request{BackedUpItems: map[itemKey]struct{}{}}
+	pod := itemKey{resource: "v1/Pod", namespace: "ns1", name: "pod1"}
+	pvc := itemKey{resource: "v1/PersistentVolumeClaim", namespace: "ns1", name: "pvc1"}
+
+	assert.True(t, req.claimItem(pod, 1))
+	assert.Equal(t, 1, req.backedUpItemCount())
+
+	// an item claimed by the same worker isn't waited for
+	assert.False(t, req.claimItem(pod, 1))
+
+	// worker 2 waits for worker 1 to back up the pod
+	assert.True(t, req.claimItem(pvc, 2))
+	claimed := make(chan bool)
+	go func() {
+		claimed <- req.claimItem(pod, 2)
+	}()
+
+	select {
+	case <-claimed:
+		assert.Fail(t, "claimItem returned before the item was released")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// worker 1 doesn't wait for the PVC, since worker 2 is waiting for it
+	assert.False(t, req.claimItem(pvc, 1))
+
+	req.releaseItem(pod)
+	assert.False(t, <-claimed)
+
+	req.releaseItem(pvc)
+	assert.Equal(t, 2, req.backedUpItemCount())
+}
+
+func TestRequest_Cancel(t *testing.T) {
+	req := &Request{}
+	assert.False(t, req.IsCancelled())
+
+	cancelled := req.Cancelled()
+	req.Cancel()
+	req.Cancel()
+	assert.True(t, req.IsCancelled())
+
+	select {
+	case <-cancelled:
+	case <-time.After(time.Second):
+		t.Fatal("the cancelled channel wasn't closed")
+	}
+}
@@ -23,7 +23,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
-	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
+	"github.com/vmware-tanzu/velero/internal/resourceref"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 
 	"github.com/sirupsen/logrus"
@@ -284,7 +284,13 @@ func (b *BackupBuilder) ItemOperationTimeout(timeout time.Duration) *BackupBuild
 
 // ResourcePolicies sets the Backup's resource polices.
 func (b *BackupBuilder) ResourcePolicies(name string) *BackupBuilder {
-	b.object.Spec.ResourcePolicy = &v1.TypedLocalObjectReference{Kind: resourcepolicies.ConfigmapRefType, Name: name}
+	b.object.Spec.ResourcePolicy = &v1.TypedLocalObjectReference{Kind: resourceref.ConfigmapRefType, Name: name}
+	return b
+}
+
+// ResourceModifier sets the Backup's resource modifiers.
+func (b *BackupBuilder) ResourceModifier(name string) *BackupBuilder {
+	b.object.Spec.ResourceModifier = &v1.TypedLocalObjectReference{Kind: resourceref.ConfigmapRefType, Name: name}
 	return b
 }
 
@@ -309,6 +315,24 @@ func (b *BackupBuilder) ParallelFilesUpload(parallel int) *BackupBuilder {
 	return b
 }
 
+// Compression sets the Backup's tarball compression.
+func (b *BackupBuilder) Compression(compression velerov1api.BackupCompression) *BackupBuilder {
+	b.object.Spec.Compression = compression
+	return b
+}
+
+// DryRun sets the Backup's "dry run" flag.
+func (b *BackupBuilder) DryRun(val bool) *BackupBuilder {
+	b.object.Spec.DryRun = &val
+	return b
+}
+
+// Cancel sets the Backup's cancel flag.
+func (b *BackupBuilder) Cancel(val bool) *BackupBuilder {
+	b.object.Spec.Cancel = &val
+	return b
+}
+
 // WithStatus sets the Backup's status.
 func (b *BackupBuilder) WithStatus(status velerov1api.BackupStatus) *BackupBuilder {
 	b.object.Status = status
@@ -136,6 +136,12 @@ func (b *RestoreBuilder) NamespaceMappings(mapping ...string) *RestoreBuilder {
 	return b
 }
 
+// NamespaceMappingPatterns appends to the Restore's namespace mapping patterns.
+func (b *RestoreBuilder) NamespaceMappingPatterns(patterns ...velerov1api.NamespaceMappingPattern) *RestoreBuilder {
+	b.object.Spec.NamespaceMappingPatterns = append(b.object.Spec.NamespaceMappingPatterns, patterns...)
+	return b
+}
+
 // Phase sets the Restore's phase.
 func (b *RestoreBuilder) Phase(phase velerov1api.RestorePhase) *RestoreBuilder {
 	b.object.Status.Phase = phase
@@ -154,6 +160,33 @@ func (b *RestoreBuilder) PreserveNodePorts(val bool) *RestoreBuilder {
 	return b
 }
 
+// Preview sets the Restore's preview flag.
+func (b *RestoreBuilder) Preview(val bool) *RestoreBuilder {
+	b.object.Spec.Preview = &val
+	return b
+}
+
+// RollbackOnFailure sets the Restore's rollback on failure flag.
+func (b *RestoreBuilder) RollbackOnFailure(val bool) *RestoreBuilder {
+	b.object.Spec.RollbackOnFailure = &val
+	return b
+}
+
+// Cancel sets the Restore's cancel flag.
+func (b *RestoreBuilder) Cancel(val bool) *RestoreBuilder {
+	b.object.Spec.Cancel = &val
+	return b
+}
+
+// ExistingResourcePolicyOverride sets the Restore's resource policy for a resource.
+func (b *RestoreBuilder) ExistingResourcePolicyOverride(resource, policy string) *RestoreBuilder {
+	if b.object.Spec.ExistingResourcePolicyOverrides == nil {
+		b.object.Spec.ExistingResourcePolicyOverrides = make(map[string]velerov1api.PolicyType)
+	}
+	b.object.Spec.ExistingResourcePolicyOverrides[resource] = velerov1api.PolicyType(policy)
+	return b
+}
+
 // StartTimestamp sets the Restore's start timestamp.
 func (b *RestoreBuilder) StartTimestamp(val time.Time) *RestoreBuilder {
 	b.object.Status.StartTimestamp = &metav1.Time{Time: val}
@@ -177,3 +210,12 @@ func (b *RestoreBuilder) WriteSparseFiles(val bool) *RestoreBuilder {
 	b.object.Spec.UploaderConfig.WriteSparseFiles = &val
 	return b
 }
+
+// IncludedPaths sets the Restore's uploader included paths
+func (b *RestoreBuilder) IncludedPaths(paths ...string) *RestoreBuilder {
+	if b.object.Spec.UploaderConfig == nil {
+		b.object.Spec.UploaderConfig = &velerov1api.UploaderConfigForRestore{}
+	}
+	b.object.Spec.UploaderConfig.IncludedPaths = append(b.object.Spec.UploaderConfig.IncludedPaths, paths...)
+	return b
+}
@@ -95,3 +95,33 @@ func (b *ScheduleBuilder) SkipImmediately(skip *bool) *ScheduleBuilder {
 	b.object.Spec.SkipImmediately = skip
 	return b
 }
+
+// Retention sets the Schedule's retention policy.
+func (b *ScheduleBuilder) Retention(retention *velerov1api.ScheduleRetention) *ScheduleBuilder {
+	b.object.Spec.Retention = retention
+	return b
+}
+
+// TimeZone sets the Schedule's time zone.
+func (b *ScheduleBuilder) TimeZone(timeZone string) *ScheduleBuilder {
+	b.object.Spec.TimeZone = timeZone
+	return b
+}
+
+// BlackoutWindows appends to the Schedule's blackout windows.
+func (b *ScheduleBuilder) BlackoutWindows(windows ...velerov1api.ScheduleBlackoutWindow) *ScheduleBuilder {
+	b.object.Spec.BlackoutWindows = append(b.object.Spec.BlackoutWindows, windows...)
+	return b
+}
+
+// OverlapPolicy sets the Schedule's overlap policy.
+func (b *ScheduleBuilder) OverlapPolicy(policy velerov1api.ScheduleOverlapPolicy) *ScheduleBuilder {
+	b.object.Spec.OverlapPolicy = policy
+	return b
+}
+
+// StartingDeadline sets the Schedule's starting deadline.
+func (b *ScheduleBuilder) StartingDeadline(deadline time.Duration) *ScheduleBuilder {
+	b.object.Spec.StartingDeadline = &metav1.Duration{Duration: deadline}
+	return b
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2018 the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package builder
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// SnapshotBrowseRequestBuilder builds SnapshotBrowseRequest objects.
+type SnapshotBrowseRequestBuilder struct {
+	object *velerov1api.SnapshotBrowseRequest
+}
+
+// ForSnapshotBrowseRequest is the constructor for a SnapshotBrowseRequestBuilder.
+func ForSnapshotBrowseRequest(ns, name string) *SnapshotBrowseRequestBuilder {
+	return &SnapshotBrowseRequestBuilder{
+		object: &velerov1api.SnapshotBrowseRequest{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: velerov1api.SchemeGroupVersion.String(),
+				Kind:       "SnapshotBrowseRequest",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: ns,
+				Name:      name,
+			},
+		},
+	}
+}
+
+// Result returns the built SnapshotBrowseRequest.
+func (b *SnapshotBrowseRequestBuilder) Result() *velerov1api.SnapshotBrowseRequest {
+	return b.object
+}
+
+// ObjectMeta applies functional options to the SnapshotBrowseRequest's ObjectMeta.
+func (b *SnapshotBrowseRequestBuilder) ObjectMeta(opts ...ObjectMetaOpt) *SnapshotBrowseRequestBuilder {
+	for _, opt := range opts {
+		opt(b.object)
+	}
+
+	return b
+}
+
+// BackupName sets the SnapshotBrowseRequest's backup name.
+func (b *SnapshotBrowseRequestBuilder) BackupName(name string) *SnapshotBrowseRequestBuilder {
+	b.object.Spec.BackupName = name
+	return b
+}
+
+// PodVolume sets the SnapshotBrowseRequest's pod volume.
+func (b *SnapshotBrowseRequestBuilder) PodVolume(ns, pod, volume string) *SnapshotBrowseRequestBuilder {
+	b.object.Spec.Namespace = ns
+	b.object.Spec.Pod = pod
+	b.object.Spec.Volume = volume
+	return b
+}
+
+// PVC sets the SnapshotBrowseRequest's PVC.
+func (b *SnapshotBrowseRequestBuilder) PVC(ns, pvc string) *SnapshotBrowseRequestBuilder {
+	b.object.Spec.Namespace = ns
+	b.object.Spec.PVC = pvc
+	return b
+}
+
+// Path sets the SnapshotBrowseRequest's path.
+func (b *SnapshotBrowseRequestBuilder) Path(path string) *SnapshotBrowseRequestBuilder {
+	b.object.Spec.Path = path
+	return b
+}
+
+// Phase sets the SnapshotBrowseRequest's phase.
+func (b *SnapshotBrowseRequestBuilder) Phase(phase velerov1api.SnapshotBrowseRequestPhase) *SnapshotBrowseRequestBuilder {
+	b.object.Status.Phase = phase
+	return b
+}
+
+// ProcessedTimestamp sets the SnapshotBrowseRequest's processed timestamp.
+func (b *SnapshotBrowseRequestBuilder) ProcessedTimestamp(time time.Time) *SnapshotBrowseRequestBuilder {
+	b.object.Status.ProcessedTimestamp = &metav1.Time{Time: time}
+	return b
+}
+
+// Entries sets the SnapshotBrowseRequest's entries.
+func (b *SnapshotBrowseRequestBuilder) Entries(entries ...velerov1api.SnapshotEntry) *SnapshotBrowseRequestBuilder {
+	b.object.Status.Entries = entries
+	return b
+}
@@ -88,6 +88,10 @@ type Patcher interface {
 	//Patch patches the named object using the provided patch bytes, which are expected to be in JSON merge patch format. The patched object is returned.
 
 	Patch(name string, data []byte) (*unstructured.Unstructured, error)
+
+	// DryRunPatch sends the patch of the named object like Patch, but the server only validates it
+	// without persisting the change. The object as it would be patched is returned.
+	DryRunPatch(name string, data []byte) (*unstructured.Unstructured, error)
 }
 
 // Deletor deletes an object.
@@ -140,6 +144,10 @@ func (d *dynamicResourceClient) Patch(name string, data []byte) (*unstructured.U
 	return d.resourceClient.Patch(context.TODO(), name, types.MergePatchType, data, metav1.PatchOptions{})
 }
 
+func (d *dynamicResourceClient) DryRunPatch(name string, data []byte) (*unstructured.Unstructured, error) {
+	return d.resourceClient.Patch(context.TODO(), name, types.MergePatchType, data, metav1.PatchOptions{DryRun: []string{metav1.DryRunAll}})
+}
+
 func (d *dynamicResourceClient) Delete(name string, opts metav1.DeleteOptions) error {
 	return d.resourceClient.Delete(context.TODO(), name, opts)
 }
@@ -0,0 +1,234 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+type BrowseOptions struct {
+	BackupName string
+	Pod        string
+	Volume     string
+	PVC        string
+	Path       string
+	Output     string
+	Timeout    time.Duration
+	Client     kbclient.Client
+}
+
+func NewBrowseOptions() *BrowseOptions {
+	return &BrowseOptions{
+		Path:    "/",
+		Output:  "text",
+		Timeout: time.Minute,
+	}
+}
+
+func (o *BrowseOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.StringVar(&o.Pod, "pod", o.Pod, "Pod whose volume was backed up by a pod volume backup, formatted as NAMESPACE/NAME. Must be used with --volume.")
+	flags.StringVar(&o.Volume, "volume", o.Volume, "Name of the volume within the pod.")
+	flags.StringVar(&o.PVC, "pvc", o.PVC, "PVC whose volume was backed up by the data mover, formatted as NAMESPACE/NAME.")
+	flags.StringVar(&o.Path, "path", o.Path, "Path of the directory to list, relative to the root of the volume.")
+	flags.StringVarP(&o.Output, "output", "o", o.Output, "Output format. Valid values are 'text' and 'json'.")
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "Maximum time to wait for the directory to be listed.")
+}
+
+func (o *BrowseOptions) Complete(args []string, f client.Factory) error {
+	o.BackupName = args[0]
+
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+	o.Client = kbClient
+	return nil
+}
+
+func (o *BrowseOptions) Validate() error {
+	if o.Output != "text" && o.Output != "json" {
+		return errors.Errorf("invalid output format %q, valid values are 'text' and 'json'", o.Output)
+	}
+
+	switch {
+	case o.Pod != "" && o.PVC != "":
+		return errors.New("--pod can't be used with --pvc")
+	case o.Pod == "" && o.PVC == "":
+		return errors.New("either --pod and --volume, or --pvc must be specified")
+	case o.Pod != "" && o.Volume == "":
+		return errors.New("--volume must be specified with --pod")
+	case o.PVC != "" && o.Volume != "":
+		return errors.New("--volume can only be used with --pod")
+	}
+
+	for _, name := range []string{o.Pod, o.PVC} {
+		if name == "" {
+			continue
+		}
+		if _, _, err := splitNamespacedName(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *BrowseOptions) Run(c *cobra.Command, f client.Factory) error {
+	backup := new(velerov1api.Backup)
+	err := o.Client.Get(context.TODO(), kbclient.ObjectKey{Namespace: f.Namespace(), Name: o.BackupName}, backup)
+	if apierrors.IsNotFound(err) {
+		return fmt.Errorf("backup %q does not exist", o.BackupName)
+	} else if err != nil {
+		return fmt.Errorf("error checking for backup %q: %v", o.BackupName, err)
+	}
+
+	request := builder.ForSnapshotBrowseRequest(f.Namespace(), "").
+		ObjectMeta(builder.WithGenerateName(o.BackupName + "-browse-")).
+		BackupName(o.BackupName).
+		Path(o.Path).
+		Result()
+	if o.Pod != "" {
+		namespace, name, _ := splitNamespacedName(o.Pod)
+		request.Spec.Namespace, request.Spec.Pod, request.Spec.Volume = namespace, name, o.Volume
+	} else {
+		namespace, name, _ := splitNamespacedName(o.PVC)
+		request.Spec.Namespace, request.Spec.PVC = namespace, name
+	}
+
+	processed, err := browseSnapshot(o.Client, request, o.Timeout)
+	if err != nil {
+		return err
+	}
+
+	if o.Output == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(processed.Status.Entries)
+	}
+	printSnapshotEntries(processed, os.Stdout)
+	return nil
+}
+
+// browseSnapshot creates the SnapshotBrowseRequest, waits for the server to
+// process it and deletes it.
+func browseSnapshot(kbClient kbclient.Client, request *velerov1api.SnapshotBrowseRequest, timeout time.Duration) (*velerov1api.SnapshotBrowseRequest, error) {
+	if err := client.CreateRetryGenerateName(kbClient, context.Background(), request); err != nil {
+		return nil, errors.Wrap(err, "error creating snapshot browse request")
+	}
+	defer func() {
+		// the server deletes the requests which aren't deleted here
+		_ = kbClient.Delete(context.Background(), request)
+	}()
+
+	processed := new(velerov1api.SnapshotBrowseRequest)
+	key := kbclient.ObjectKey{Namespace: request.Namespace, Name: request.Name}
+	err := wait.PollImmediate(250*time.Millisecond, timeout, func() (bool, error) {
+		if err := kbClient.Get(context.TODO(), key, processed); err != nil {
+			return false, nil
+		}
+		return processed.Status.Phase == velerov1api.SnapshotBrowseRequestPhaseProcessed ||
+			processed.Status.Phase == velerov1api.SnapshotBrowseRequestPhaseFailed, nil
+	})
+	if err == wait.ErrWaitTimeout {
+		return nil, errors.New("timed out waiting for the snapshot directory to be listed, check that the Velero server is running")
+	} else if err != nil {
+		return nil, err
+	}
+
+	if processed.Status.Phase == velerov1api.SnapshotBrowseRequestPhaseFailed {
+		return nil, errors.Errorf("error listing the snapshot directory: %s", processed.Status.Message)
+	}
+	return processed, nil
+}
+
+// printSnapshotEntries prints the entries of a snapshot directory like "ls -l",
+// with a slash after the names of the directories.
+func printSnapshotEntries(request *velerov1api.SnapshotBrowseRequest, out io.Writer) {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	for _, entry := range request.Status.Entries {
+		modTime := ""
+		if entry.ModTime != nil {
+			modTime = entry.ModTime.Format("2006-01-02 15:04")
+		}
+		name := entry.Name
+		if entry.Type == velerov1api.SnapshotEntryTypeDirectory {
+			name += "/"
+		}
+		fmt.Fprintf(w, "%s\t%d\t%s\t%s\n", entry.Mode, entry.Size, modTime, name)
+	}
+	w.Flush()
+
+	if request.Status.Truncated {
+		fmt.Fprintf(out, "\nOnly the first %d entries of the directory are listed.\n", len(request.Status.Entries))
+	}
+}
+
+// splitNamespacedName splits a name formatted as NAMESPACE/NAME.
+func splitNamespacedName(s string) (string, string, error) {
+	parts := strings.Split(s, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", errors.Errorf("invalid name %q, it must be formatted as NAMESPACE/NAME", s)
+	}
+	return parts[0], parts[1], nil
+}
+
+func NewBrowseCommand(f client.Factory) *cobra.Command {
+	o := NewBrowseOptions()
+
+	c := &cobra.Command{
+		Use:   "browse NAME",
+		Short: "List the files of a volume backed up by a pod volume backup or by the data mover",
+		Long: `List the files of a directory of a volume backed up by a pod volume backup or by the data mover.
+
+The Velero server reads the directory from the snapshot in the backup repository, without restoring the
+volume. Only snapshots taken by the kopia uploader can be browsed. Use 'velero restore create --include-paths'
+to restore the files.`,
+		Example: `  # List the root directory of the volume "data" of the pod "app/app-0" in the backup "backup-1".
+  velero backup browse backup-1 --pod app/app-0 --volume data
+
+  # List the directory "/etc/app" of the PVC "app/config", which was backed up by the data mover.
+  velero backup browse backup-1 --pvc app/config --path /etc/app`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
@@ -0,0 +1,174 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
+	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestNewBrowseCommand(t *testing.T) {
+	t.Run("Flag test", func(t *testing.T) {
+		o := NewBrowseOptions()
+		flags := new(flag.FlagSet)
+		o.BindFlags(flags)
+
+		flags.Parse([]string{"--pod", "app/app-0"})
+		flags.Parse([]string{"--volume", "data"})
+		flags.Parse([]string{"--path", "/etc"})
+		flags.Parse([]string{"-o", "json"})
+
+		assert.Equal(t, "app/app-0", o.Pod)
+		assert.Equal(t, "data", o.Volume)
+		assert.Equal(t, "/etc", o.Path)
+		assert.Equal(t, "json", o.Output)
+		assert.NoError(t, o.Validate())
+
+		o.PVC = "app/config"
+		assert.EqualError(t, o.Validate(), "--pod can't be used with --pvc")
+
+		o.Pod = ""
+		assert.EqualError(t, o.Validate(), "--volume can only be used with --pod")
+
+		o.Volume = ""
+		o.PVC = "config"
+		assert.EqualError(t, o.Validate(), `invalid name "config", it must be formatted as NAMESPACE/NAME`)
+
+		o.PVC = ""
+		assert.EqualError(t, o.Validate(), "either --pod and --volume, or --pvc must be specified")
+
+		o.Pod = "app/app-0"
+		assert.EqualError(t, o.Validate(), "--volume must be specified with --pod")
+	})
+
+	t.Run("Backup not exist test", func(t *testing.T) {
+		f := &factorymocks.Factory{}
+		kbClient := velerotest.NewFakeControllerRuntimeClient(t)
+		f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+		f.On("KubebuilderClient").Return(kbClient, nil)
+
+		c := NewBrowseCommand(f)
+		assert.Equal(t, "List the files of a volume backed up by a pod volume backup or by the data mover", c.Short)
+
+		o := NewBrowseOptions()
+		require.NoError(t, o.Complete([]string{"not-exist"}, f))
+		assert.EqualError(t, o.Run(c, f), `backup "not-exist" does not exist`)
+	})
+}
+
+func TestBrowseSnapshot(t *testing.T) {
+	tests := []struct {
+		name      string
+		phase     velerov1api.SnapshotBrowseRequestPhase
+		message   string
+		wantErr   string
+		wantEntry string
+	}{
+		{
+			name:      "processed",
+			phase:     velerov1api.SnapshotBrowseRequestPhaseProcessed,
+			wantEntry: "app.conf",
+		},
+		{
+			name:    "failed",
+			phase:   velerov1api.SnapshotBrowseRequestPhaseFailed,
+			message: "path not found",
+			wantErr: "error listing the snapshot directory: path not found",
+		},
+		{
+			name:    "not processed",
+			wantErr: "timed out waiting for the snapshot directory to be listed, check that the Velero server is running",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			kbClient := velerotest.NewFakeControllerRuntimeClient(t)
+
+			// process the request like the server does
+			done := make(chan struct{})
+			defer close(done)
+			go func() {
+				for {
+					select {
+					case <-done:
+						return
+					case <-time.After(50 * time.Millisecond):
+					}
+
+					requests := &velerov1api.SnapshotBrowseRequestList{}
+					if err := kbClient.List(context.Background(), requests); err != nil || len(requests.Items) == 0 || test.phase == "" {
+						continue
+					}
+					request := &requests.Items[0]
+					original := request.DeepCopy()
+					request.Status.Phase = test.phase
+					request.Status.Message = test.message
+					request.Status.Entries = []velerov1api.SnapshotEntry{{Name: "app.conf", Type: velerov1api.SnapshotEntryTypeFile}}
+					_ = kbClient.Patch(context.Background(), request, kbclient.MergeFrom(original))
+				}
+			}()
+
+			request := builder.ForSnapshotBrowseRequest(cmdtest.VeleroNameSpace, "").ObjectMeta(builder.WithGenerateName("backup-1-browse-")).
+				BackupName("backup-1").PodVolume("app", "app-0", "data").Result()
+			processed, err := browseSnapshot(kbClient, request, time.Second)
+			if test.wantErr != "" {
+				require.EqualError(t, err, test.wantErr)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, test.wantEntry, processed.Status.Entries[0].Name)
+			}
+
+			requests := &velerov1api.SnapshotBrowseRequestList{}
+			require.NoError(t, kbClient.List(context.Background(), requests))
+			assert.Empty(t, requests.Items)
+		})
+	}
+}
+
+func TestPrintSnapshotEntries(t *testing.T) {
+	modTime := metav1.NewTime(time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC))
+	request := builder.ForSnapshotBrowseRequest(cmdtest.VeleroNameSpace, "request-1").Entries(
+		velerov1api.SnapshotEntry{Name: "app", Type: velerov1api.SnapshotEntryTypeDirectory, Mode: "drwxr-xr-x", Size: 4096, ModTime: &modTime},
+		velerov1api.SnapshotEntry{Name: "app.conf", Type: velerov1api.SnapshotEntryTypeFile, Mode: "-rw-r--r--", Size: 2048, ModTime: &modTime},
+	).Result()
+
+	buf := new(bytes.Buffer)
+	printSnapshotEntries(request, buf)
+	assert.Equal(t, `drwxr-xr-x  4096  2023-06-01 10:00  app/
+-rw-r--r--  2048  2023-06-01 10:00  app.conf
+`, buf.String())
+
+	request.Status.Truncated = true
+	buf.Reset()
+	printSnapshotEntries(request, buf)
+	assert.Contains(t, buf.String(), "Only the first 2 entries of the directory are listed.")
+}
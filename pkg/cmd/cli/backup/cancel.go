@@ -0,0 +1,83 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+func NewCancelCommand(f client.Factory, use string) *cobra.Command {
+	c := &cobra.Command{
+		Use:   use + " NAME",
+		Short: "Cancel a backup",
+		Long: `Cancel a backup which hasn't finished processing. The remaining items aren't backed up,
+and the unfinished pod volume backups, data uploads and plugin operations are cancelled.
+The backup ends in phase Cancelled and expires right away, so its partial data is deleted
+by the next garbage collection.`,
+		Example: `  # Cancel the backup "backup-1".
+  velero backup cancel backup-1`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			kbClient, err := f.KubebuilderClient()
+			cmd.CheckError(err)
+
+			cmd.CheckError(requestCancel(kbClient, f.Namespace(), args[0]))
+			fmt.Printf("Request to cancel backup %q submitted successfully.\nRun `velero backup describe %s` for more details.\n", args[0], args[0])
+		},
+	}
+
+	return c
+}
+
+// requestCancel sets the cancel flag of the backup so that the server stops it.
+func requestCancel(kbClient kbclient.Client, namespace, name string) error {
+	backup := new(velerov1api.Backup)
+	err := kbClient.Get(context.TODO(), kbclient.ObjectKey{Namespace: namespace, Name: name}, backup)
+	if apierrors.IsNotFound(err) {
+		return fmt.Errorf("backup %q does not exist", name)
+	} else if err != nil {
+		return fmt.Errorf("error checking for backup %q: %v", name, err)
+	}
+
+	switch backup.Status.Phase {
+	case "", velerov1api.BackupPhaseNew, velerov1api.BackupPhaseInProgress,
+		velerov1api.BackupPhaseWaitingForPluginOperations, velerov1api.BackupPhaseWaitingForPluginOperationsPartiallyFailed,
+		velerov1api.BackupPhaseFinalizing, velerov1api.BackupPhaseFinalizingPartiallyFailed:
+	default:
+		return fmt.Errorf("backup %q can't be cancelled, it has already finished processing with phase %q", name, backup.Status.Phase)
+	}
+	if boolptr.IsSetToTrue(backup.Spec.Cancel) {
+		return fmt.Errorf("the cancellation of backup %q has already been requested", name)
+	}
+
+	original := backup.DeepCopy()
+	backup.Spec.Cancel = boolptr.True()
+	if err := kbClient.Patch(context.TODO(), backup, kbclient.MergeFrom(original)); err != nil {
+		return fmt.Errorf("error requesting the cancellation of backup %q: %v", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+func TestRequestCancel(t *testing.T) {
+	tests := []struct {
+		name      string
+		backup    string
+		expectErr string
+	}{
+		{
+			name:   "in progress backup is cancelled",
+			backup: "in-progress",
+		},
+		{
+			name:   "finalizing backup is cancelled",
+			backup: "finalizing",
+		},
+		{
+			name:      "backup not found",
+			backup:    "not-exist",
+			expectErr: `backup "not-exist" does not exist`,
+		},
+		{
+			name:      "finished backup can't be cancelled",
+			backup:    "completed",
+			expectErr: `backup "completed" can't be cancelled, it has already finished processing with phase "Completed"`,
+		},
+		{
+			name:      "cancellation already requested",
+			backup:    "cancel-requested",
+			expectErr: `the cancellation of backup "cancel-requested" has already been requested`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := velerotest.NewFakeControllerRuntimeClient(t,
+				builder.ForBackup(cmdtest.VeleroNameSpace, "in-progress").Phase(velerov1api.BackupPhaseInProgress).Result(),
+				builder.ForBackup(cmdtest.VeleroNameSpace, "finalizing").Phase(velerov1api.BackupPhaseFinalizing).Result(),
+				builder.ForBackup(cmdtest.VeleroNameSpace, "completed").Phase(velerov1api.BackupPhaseCompleted).Result(),
+				builder.ForBackup(cmdtest.VeleroNameSpace, "cancel-requested").Phase(velerov1api.BackupPhaseInProgress).Cancel(true).Result(),
+			)
+
+			err := requestCancel(client, cmdtest.VeleroNameSpace, test.backup)
+			if test.expectErr != "" {
+				require.EqualError(t, err, test.expectErr)
+				return
+			}
+			require.NoError(t, err)
+
+			backup := new(velerov1api.Backup)
+			require.NoError(t, client.Get(context.TODO(), kbclient.ObjectKey{Namespace: cmdtest.VeleroNameSpace, Name: test.backup}, backup))
+			assert.True(t, boolptr.IsSetToTrue(backup.Spec.Cancel))
+		})
+	}
+}
@@ -0,0 +1,62 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+// downloadBackupContents downloads the tarball of a backup with a download
+// request, and extracts it into a temp directory. The caller has to remove the
+// directory.
+func downloadBackupContents(kbClient kbclient.Client, namespace, backupName string, timeout time.Duration, insecureSkipTLSVerify bool, caCertFile string) (string, error) {
+	file, err := os.CreateTemp("", fmt.Sprintf("%s-data.tar.gz", backupName))
+	if err != nil {
+		return "", errors.Wrap(err, "error creating temp file")
+	}
+	defer func() {
+		file.Close()
+		os.Remove(file.Name())
+	}()
+
+	if err := downloadrequest.Stream(context.Background(), kbClient, namespace, backupName, velerov1api.DownloadTargetKindBackupContents, file, timeout, insecureSkipTLSVerify, caCertFile); err != nil {
+		return "", errors.Wrapf(err, "error downloading backup %q", backupName)
+	}
+	if _, err := file.Seek(0, 0); err != nil {
+		return "", errors.Wrap(err, "error reading downloaded backup")
+	}
+
+	log := logrus.New()
+	log.SetOutput(os.Stderr)
+	dir, err := archive.NewExtractor(log, filesystem.NewFileSystem()).UnzipAndExtractBackup(file)
+	if err != nil {
+		return "", errors.Wrapf(err, "error extracting backup %q", backupName)
+	}
+	return dir, nil
+}
@@ -29,6 +29,7 @@ import (
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
@@ -66,12 +67,16 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
   velero backup create backup3 --snapshot-volumes=false -o yaml
 
   # Wait for a backup to complete before returning from the command.
-  velero backup create backup4 --wait`,
+  velero backup create backup4 --wait
+
+  # Preview the resources and volumes a backup would capture, without snapshotting or uploading anything.
+  velero backup create backup5 --include-namespaces nginx --dry-run`,
 	}
 
 	o.BindFlags(c.Flags())
 	o.BindWait(c.Flags())
 	o.BindFromSchedule(c.Flags())
+	o.BindDryRun(c.Flags())
 	output.BindFlags(c.Flags())
 	output.ClearOutputFlagDefault(c)
 
@@ -105,8 +110,11 @@ type CreateOptions struct {
 	CSISnapshotTimeout              time.Duration
 	ItemOperationTimeout            time.Duration
 	ResPoliciesConfigmap            string
+	ResourceModifierConfigMap       string
 	client                          kbclient.WithWatch
 	ParallelFilesUpload             int
+	Compression                     string
+	DryRun                          bool
 }
 
 func NewCreateOptions() *CreateOptions {
@@ -151,8 +159,10 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	f.NoOptDefVal = cmd.TRUE
 
 	flags.StringVar(&o.ResPoliciesConfigmap, "resource-policies-configmap", "", "Reference to the resource policies configmap that backup using")
+	flags.StringVar(&o.ResourceModifierConfigMap, "resource-modifier-configmap", "", "Reference to the resource modifier configmap applied to the resources before they are written into the backup")
 	flags.StringVar(&o.DataMover, "data-mover", "", "Specify the data mover to be used by the backup. If the parameter is not set or set as 'velero', the built-in data mover will be used")
 	flags.IntVar(&o.ParallelFilesUpload, "parallel-files-upload", 0, "Number of files uploads simultaneously when running a backup. This is only applicable for the kopia uploader")
+	flags.StringVar(&o.Compression, "compression", "", "Compression algorithm for the backup tarball. Valid values are gzip, zstd and none. If not set, the server's default compression is used.")
 }
 
 // BindWait binds the wait flag separately so it is not called by other create
@@ -167,6 +177,12 @@ func (o *CreateOptions) BindFromSchedule(flags *pflag.FlagSet) {
 	flags.StringVar(&o.FromSchedule, "from-schedule", "", "Create a backup from the template of an existing schedule. Cannot be used with any other filters. Backup name is optional if used.")
 }
 
+// BindDryRun binds the dry-run flag separately so it is not called by other create
+// commands that reuse CreateOptions's BindFlags method.
+func (o *CreateOptions) BindDryRun(flags *pflag.FlagSet) {
+	flags.BoolVar(&o.DryRun, "dry-run", o.DryRun, "Only preview the resources and volumes the backup would capture. No snapshots are taken, no hooks are run and no backup tarball is uploaded. Backup item actions other than the snapshot ones are still executed, and the operations they start are cancelled.")
+}
+
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
 	if err := output.ValidateFlags(c); err != nil {
 		return err
@@ -186,6 +202,10 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return kubeerrs.NewAggregate(errs)
 	}
 
+	if err := archive.ValidateCompression(velerov1api.BackupCompression(o.Compression)); err != nil {
+		return err
+	}
+
 	if o.oldAndNewFilterParametersUsedTogether() {
 		return fmt.Errorf("include-resources, exclude-resources and include-cluster-resources are old filter parameters.\n" +
 			"include-cluster-scoped-resources, exclude-cluster-scoped-resources, include-namespace-scoped-resources and exclude-namespace-scoped-resources are new filter parameters.\n" +
@@ -306,7 +326,8 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 				}
 
 				if backup.Status.Phase == velerov1api.BackupPhaseFailedValidation || backup.Status.Phase == velerov1api.BackupPhaseCompleted ||
-					backup.Status.Phase == velerov1api.BackupPhasePartiallyFailed || backup.Status.Phase == velerov1api.BackupPhaseFailed {
+					backup.Status.Phase == velerov1api.BackupPhasePartiallyFailed || backup.Status.Phase == velerov1api.BackupPhaseFailed ||
+					backup.Status.Phase == velerov1api.BackupPhaseCancelled {
 					fmt.Printf("\nBackup completed with status: %s. You may check for more information using the commands `velero backup describe %s` and `velero backup logs %s`.\n", backup.Status.Phase, backup.Name, backup.Name)
 					return nil
 				}
@@ -398,9 +419,19 @@ func (o *CreateOptions) BuildBackup(namespace string) (*velerov1api.Backup, erro
 		if o.ResPoliciesConfigmap != "" {
 			backupBuilder.ResourcePolicies(o.ResPoliciesConfigmap)
 		}
+		if o.ResourceModifierConfigMap != "" {
+			backupBuilder.ResourceModifier(o.ResourceModifierConfigMap)
+		}
 		if o.ParallelFilesUpload > 0 {
 			backupBuilder.ParallelFilesUpload(o.ParallelFilesUpload)
 		}
+		if o.Compression != "" {
+			backupBuilder.Compression(velerov1api.BackupCompression(o.Compression))
+		}
+	}
+
+	if o.DryRun {
+		backupBuilder.DryRun(true)
 	}
 
 	backup := backupBuilder.ObjectMeta(builder.WithLabelsMap(o.Labels.Data())).Result()
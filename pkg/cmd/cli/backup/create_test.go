@@ -184,6 +184,7 @@ func TestCreateCommand(t *testing.T) {
 		includeClusterResources := "true"
 		defaultVolumesToFsBackup := "true"
 		resPoliciesConfigmap := "cm-name-2"
+		resourceModifierConfigMap := "cm-name-3"
 		dataMover := "velero"
 		parallelFilesUpload := 10
 		flags := new(flag.FlagSet)
@@ -212,6 +213,7 @@ func TestCreateCommand(t *testing.T) {
 		flags.Parse([]string{"--include-cluster-resources", includeClusterResources})
 		flags.Parse([]string{"--default-volumes-to-fs-backup", defaultVolumesToFsBackup})
 		flags.Parse([]string{"--resource-policies-configmap", resPoliciesConfigmap})
+		flags.Parse([]string{"--resource-modifier-configmap", resourceModifierConfigMap})
 		flags.Parse([]string{"--data-mover", dataMover})
 		flags.Parse([]string{"--parallel-files-upload", fmt.Sprintf("%d", parallelFilesUpload)})
 		//flags.Parse([]string{"--wait"})
@@ -261,6 +263,7 @@ func TestCreateCommand(t *testing.T) {
 		require.Equal(t, includeClusterResources, o.IncludeClusterResources.String())
 		require.Equal(t, defaultVolumesToFsBackup, o.DefaultVolumesToFsBackup.String())
 		require.Equal(t, resPoliciesConfigmap, o.ResPoliciesConfigmap)
+		require.Equal(t, resourceModifierConfigMap, o.ResourceModifierConfigMap)
 		require.Equal(t, dataMover, o.DataMover)
 		require.Equal(t, parallelFilesUpload, o.ParallelFilesUpload)
 		//assert.Equal(t, true, o.Wait)
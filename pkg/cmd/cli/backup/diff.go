@@ -0,0 +1,458 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/sets"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+// volatileFields are the fields of the items which change without the items
+// being changed, and which aren't compared.
+var volatileFields = [][]string{
+	{"metadata", "resourceVersion"},
+	{"metadata", "uid"},
+	{"metadata", "generation"},
+	{"metadata", "creationTimestamp"},
+	{"metadata", "managedFields"},
+	{"metadata", "selfLink"},
+	{"status"},
+}
+
+type DiffOptions struct {
+	FromBackup            string
+	ToBackup              string
+	Details               bool
+	NamesOnly             bool
+	Output                string
+	Timeout               time.Duration
+	InsecureSkipTLSVerify bool
+	CaCertFile            string
+	Client                kbclient.Client
+}
+
+func NewDiffOptions() *DiffOptions {
+	config, err := client.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: Error reading config file: %v\n", err)
+	}
+
+	return &DiffOptions{
+		Output:     "text",
+		Timeout:    time.Minute,
+		CaCertFile: config.CACertFile(),
+	}
+}
+
+func (o *DiffOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.BoolVar(&o.Details, "details", o.Details, "Show the field-level changes of the changed items, as JSON merge patches.")
+	flags.BoolVar(&o.NamesOnly, "names-only", o.NamesOnly, "Only compare the resource lists of the backups, listing the added and removed items, without downloading the backups to find the changed items.")
+	flags.StringVarP(&o.Output, "output", "o", o.Output, "Output format. Valid values are 'text' and 'json'.")
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "Maximum time to wait to process each download request.")
+	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
+	flags.StringVar(&o.CaCertFile, "cacert", o.CaCertFile, "Path to a certificate bundle to use when verifying TLS connections.")
+}
+
+func (o *DiffOptions) Complete(args []string, f client.Factory) error {
+	o.FromBackup = args[0]
+	o.ToBackup = args[1]
+
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+	o.Client = kbClient
+	return nil
+}
+
+func (o *DiffOptions) Validate() error {
+	if o.Output != "text" && o.Output != "json" {
+		return errors.Errorf("invalid output format %q, valid values are 'text' and 'json'", o.Output)
+	}
+	if o.NamesOnly && o.Details {
+		return errors.New("--details can't be used with --names-only")
+	}
+	return nil
+}
+
+func (o *DiffOptions) Run(c *cobra.Command, f client.Factory) error {
+	for _, name := range []string{o.FromBackup, o.ToBackup} {
+		backup := new(velerov1api.Backup)
+		err := o.Client.Get(context.TODO(), kbclient.ObjectKey{Namespace: f.Namespace(), Name: name}, backup)
+		if apierrors.IsNotFound(err) {
+			return fmt.Errorf("backup %q does not exist", name)
+		} else if err != nil {
+			return fmt.Errorf("error checking for backup %q: %v", name, err)
+		}
+	}
+
+	var diff *backupDiff
+	var err error
+	if o.NamesOnly {
+		diff, err = o.diffResourceLists(f.Namespace())
+	} else {
+		diff, err = o.diffContents(f.Namespace())
+	}
+	if err != nil {
+		return err
+	}
+
+	if o.Output == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(diff)
+	}
+	printBackupDiff(diff, os.Stdout)
+	return nil
+}
+
+// backupDiff is what changed between two backups, per resource and namespace.
+type backupDiff struct {
+	From      string         `json:"from"`
+	To        string         `json:"to"`
+	Resources []resourceDiff `json:"resources"`
+}
+
+type resourceDiff struct {
+	Resource   string          `json:"resource"`
+	Namespaces []namespaceDiff `json:"namespaces"`
+}
+
+// namespaceDiff is what changed in a namespace, or in the cluster-scoped items
+// if the namespace is empty.
+type namespaceDiff struct {
+	Namespace string        `json:"namespace,omitempty"`
+	Added     []string      `json:"added,omitempty"`
+	Removed   []string      `json:"removed,omitempty"`
+	Changed   []changedItem `json:"changed,omitempty"`
+}
+
+type changedItem struct {
+	Name string `json:"name"`
+
+	// Diff is the JSON merge patch from the item in the first backup to the
+	// item in the second backup.
+	Diff json.RawMessage `json:"diff,omitempty"`
+}
+
+func (d *namespaceDiff) empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// diffContents downloads both backups and compares their items.
+func (o *DiffOptions) diffContents(namespace string) (*backupDiff, error) {
+	fromDir, err := downloadBackupContents(o.Client, namespace, o.FromBackup, o.Timeout, o.InsecureSkipTLSVerify, o.CaCertFile)
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(fromDir)
+
+	toDir, err := downloadBackupContents(o.Client, namespace, o.ToBackup, o.Timeout, o.InsecureSkipTLSVerify, o.CaCertFile)
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(toDir)
+
+	return diffBackupContents(o.FromBackup, fromDir, o.ToBackup, toDir, filesystem.NewFileSystem(), o.Details)
+}
+
+// diffBackupContents compares the items of two extracted backups, ignoring
+// their volatile fields. The field-level changes of the changed items are
+// included if details is true.
+func diffBackupContents(from, fromDir, to, toDir string, fs filesystem.Interface, details bool) (*backupDiff, error) {
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+	parser := archive.NewParser(log, fs)
+
+	fromResources, err := parser.Parse(fromDir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing backup %q", from)
+	}
+	toResources, err := parser.Parse(toDir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing backup %q", to)
+	}
+
+	diff := &backupDiff{From: from, To: to}
+	for _, groupResource := range sets.StringKeySet(fromResources).Union(sets.StringKeySet(toResources)).List() {
+		fromItems, toItems := itemsByNamespace(fromResources[groupResource]), itemsByNamespace(toResources[groupResource])
+
+		resource := resourceDiff{Resource: groupResource}
+		for _, ns := range sets.StringKeySet(fromItems).Union(sets.StringKeySet(toItems)).List() {
+			fromNames, toNames := sets.NewString(fromItems[ns]...), sets.NewString(toItems[ns]...)
+
+			nsDiff := namespaceDiff{
+				Namespace: ns,
+				Added:     difference(toNames, fromNames),
+				Removed:   difference(fromNames, toNames),
+			}
+			for _, name := range fromNames.Intersection(toNames).List() {
+				patch, err := diffItem(
+					fs,
+					archive.GetItemFilePath(fromDir, groupResource, ns, name),
+					archive.GetItemFilePath(toDir, groupResource, ns, name),
+				)
+				if err != nil {
+					return nil, errors.Wrapf(err, "error comparing %s %s", groupResource, strings.TrimPrefix(ns+"/"+name, "/"))
+				}
+				if patch == nil {
+					continue
+				}
+
+				item := changedItem{Name: name}
+				if details {
+					item.Diff = patch
+				}
+				nsDiff.Changed = append(nsDiff.Changed, item)
+			}
+
+			if !nsDiff.empty() {
+				resource.Namespaces = append(resource.Namespaces, nsDiff)
+			}
+		}
+
+		if len(resource.Namespaces) > 0 {
+			diff.Resources = append(diff.Resources, resource)
+		}
+	}
+
+	return diff, nil
+}
+
+// difference returns the sorted items of a which aren't in b, or nil if
+// there's none.
+func difference(a, b sets.String) []string {
+	diff := a.Difference(b)
+	if diff.Len() == 0 {
+		return nil
+	}
+	return diff.List()
+}
+
+func itemsByNamespace(items *archive.ResourceItems) map[string][]string {
+	if items == nil {
+		return nil
+	}
+	return items.ItemsByNamespace
+}
+
+// diffItem returns the JSON merge patch from the item in the first file to the
+// item in the second file, without their volatile fields, or nil if they're
+// the same.
+func diffItem(fs filesystem.Interface, fromPath, toPath string) (json.RawMessage, error) {
+	fromJSON, err := normalizedItem(fs, fromPath)
+	if err != nil {
+		return nil, err
+	}
+	toJSON, err := normalizedItem(fs, toPath)
+	if err != nil {
+		return nil, err
+	}
+	if bytes.Equal(fromJSON, toJSON) {
+		return nil, nil
+	}
+
+	patch, err := jsonpatch.CreateMergePatch(fromJSON, toJSON)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating merge patch")
+	}
+	if string(patch) == "{}" {
+		return nil, nil
+	}
+	return patch, nil
+}
+
+// normalizedItem returns the item of the file without its volatile fields, as
+// JSON with sorted keys.
+func normalizedItem(fs filesystem.Interface, path string) ([]byte, error) {
+	obj, err := archive.Unmarshal(fs, path)
+	if err != nil {
+		return nil, err
+	}
+	for _, field := range volatileFields {
+		unstructured.RemoveNestedField(obj.Object, field...)
+	}
+	return json.Marshal(obj.Object)
+}
+
+// diffResourceLists compares the resource lists of both backups, which lists
+// the items per API version and kind.
+func (o *DiffOptions) diffResourceLists(namespace string) (*backupDiff, error) {
+	fromList, err := o.resourceList(namespace, o.FromBackup)
+	if err != nil {
+		return nil, err
+	}
+	toList, err := o.resourceList(namespace, o.ToBackup)
+	if err != nil {
+		return nil, err
+	}
+
+	return diffBackupResourceLists(o.FromBackup, fromList, o.ToBackup, toList), nil
+}
+
+func (o *DiffOptions) resourceList(namespace, backupName string) (map[string][]string, error) {
+	buf := new(bytes.Buffer)
+	err := downloadrequest.Stream(context.Background(), o.Client, namespace, backupName, velerov1api.DownloadTargetKindBackupResourceList, buf, o.Timeout, o.InsecureSkipTLSVerify, o.CaCertFile)
+	if err == downloadrequest.ErrNotFound {
+		return nil, errors.Errorf("backup %q has no resource list, it was created by a Velero version which doesn't write one or it hasn't completed", backupName)
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "error downloading the resource list of backup %q", backupName)
+	}
+
+	var resourceList map[string][]string
+	if err := json.NewDecoder(buf).Decode(&resourceList); err != nil {
+		return nil, errors.Wrapf(err, "error decoding the resource list of backup %q", backupName)
+	}
+	return resourceList, nil
+}
+
+// diffBackupResourceLists compares the resource lists of two backups, keyed by
+// API version and kind, listing the items as namespace/name, or name for the
+// cluster-scoped items.
+func diffBackupResourceLists(from string, fromList map[string][]string, to string, toList map[string][]string) *backupDiff {
+	diff := &backupDiff{From: from, To: to}
+	for _, gvk := range sets.StringKeySet(fromList).Union(sets.StringKeySet(toList)).List() {
+		fromItems, toItems := splitByNamespace(fromList[gvk]), splitByNamespace(toList[gvk])
+
+		resource := resourceDiff{Resource: gvk}
+		for _, ns := range sets.StringKeySet(fromItems).Union(sets.StringKeySet(toItems)).List() {
+			nsDiff := namespaceDiff{
+				Namespace: ns,
+				Added:     difference(toItems[ns], fromItems[ns]),
+				Removed:   difference(fromItems[ns], toItems[ns]),
+			}
+			if !nsDiff.empty() {
+				resource.Namespaces = append(resource.Namespaces, nsDiff)
+			}
+		}
+
+		if len(resource.Namespaces) > 0 {
+			diff.Resources = append(diff.Resources, resource)
+		}
+	}
+	return diff
+}
+
+func splitByNamespace(items []string) map[string]sets.String {
+	byNamespace := make(map[string]sets.String)
+	for _, item := range items {
+		ns, name := "", item
+		if i := strings.Index(item, "/"); i >= 0 {
+			ns, name = item[:i], item[i+1:]
+		}
+		if byNamespace[ns] == nil {
+			byNamespace[ns] = sets.NewString()
+		}
+		byNamespace[ns].Insert(name)
+	}
+	return byNamespace
+}
+
+// printBackupDiff prints the added (+), removed (-) and changed (~) items per
+// resource and namespace.
+func printBackupDiff(diff *backupDiff, w io.Writer) {
+	var added, removed, changed int
+	for _, resource := range diff.Resources {
+		fmt.Fprintf(w, "%s:\n", resource.Resource)
+		for _, ns := range resource.Namespaces {
+			if ns.Namespace == "" {
+				fmt.Fprintf(w, "  <cluster-scoped>:\n")
+			} else {
+				fmt.Fprintf(w, "  %s:\n", ns.Namespace)
+			}
+
+			for _, name := range ns.Added {
+				fmt.Fprintf(w, "    + %s\n", name)
+			}
+			for _, name := range ns.Removed {
+				fmt.Fprintf(w, "    - %s\n", name)
+			}
+			for _, item := range ns.Changed {
+				fmt.Fprintf(w, "    ~ %s\n", item.Name)
+				if len(item.Diff) > 0 {
+					fmt.Fprintf(w, "        %s\n", item.Diff)
+				}
+			}
+
+			added += len(ns.Added)
+			removed += len(ns.Removed)
+			changed += len(ns.Changed)
+		}
+	}
+
+	if len(diff.Resources) == 0 {
+		fmt.Fprintf(w, "Backups %s and %s have the same items.\n", diff.From, diff.To)
+		return
+	}
+	fmt.Fprintf(w, "\nFrom backup %s to backup %s: %d added, %d removed, %d changed.\n", diff.From, diff.To, added, removed, changed)
+}
+
+func NewDiffCommand(f client.Factory) *cobra.Command {
+	o := NewDiffOptions()
+
+	c := &cobra.Command{
+		Use:   "diff FROM_BACKUP TO_BACKUP",
+		Short: "Compare the items of two backups",
+		Long: `Compare the items of two backups.
+
+Both backups are downloaded, and the items added (+), removed (-) and changed (~) from the first
+backup to the second one are listed per resource and namespace. The resource version, UID,
+generation, creation timestamp, managed fields and status of the items aren't compared.`,
+		Example: `  # List the items which changed between the backups "nightly-1" and "nightly-2".
+  velero backup diff nightly-1 nightly-2
+
+  # Show the field-level changes of the changed items too.
+  velero backup diff nightly-1 nightly-2 --details
+
+  # Only list the added and removed items, using the backups' resource lists.
+  velero backup diff nightly-1 nightly-2 --names-only`,
+		Args: cobra.ExactArgs(2),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
@@ -0,0 +1,208 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
+	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestNewDiffCommand(t *testing.T) {
+	t.Run("Flag test", func(t *testing.T) {
+		o := NewDiffOptions()
+		flags := new(flag.FlagSet)
+		o.BindFlags(flags)
+
+		flags.Parse([]string{"--details"})
+		flags.Parse([]string{"-o", "json"})
+
+		assert.True(t, o.Details)
+		assert.Equal(t, "json", o.Output)
+		assert.NoError(t, o.Validate())
+
+		o.NamesOnly = true
+		assert.EqualError(t, o.Validate(), "--details can't be used with --names-only")
+
+		o.Output = "yaml"
+		assert.EqualError(t, o.Validate(), `invalid output format "yaml", valid values are 'text' and 'json'`)
+	})
+
+	t.Run("Backup not exist test", func(t *testing.T) {
+		f := &factorymocks.Factory{}
+		kbClient := velerotest.NewFakeControllerRuntimeClient(t, builder.ForBackup(cmdtest.VeleroNameSpace, "backup-1").Result())
+		f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+		f.On("KubebuilderClient").Return(kbClient, nil)
+
+		c := NewDiffCommand(f)
+		assert.Equal(t, "Compare the items of two backups", c.Short)
+
+		o := NewDiffOptions()
+		require.NoError(t, o.Complete([]string{"backup-1", "not-exist"}, f))
+		assert.EqualError(t, o.Run(c, f), `backup "not-exist" does not exist`)
+	})
+}
+
+func TestDiffBackupContents(t *testing.T) {
+	withVolatileFields := func(obj metav1.Object, resourceVersion string) {
+		obj.SetResourceVersion(resourceVersion)
+		obj.SetUID(types.UID("uid-" + resourceVersion))
+		obj.SetManagedFields([]metav1.ManagedFieldsEntry{{Manager: "manager-" + resourceVersion}})
+	}
+
+	unchanged1 := builder.ForConfigMap("ns-1", "unchanged").Data("key", "value").Result()
+	withVolatileFields(unchanged1, "1")
+	unchanged2 := builder.ForConfigMap("ns-1", "unchanged").Data("key", "value").Result()
+	withVolatileFields(unchanged2, "2")
+
+	pod1 := builder.ForPod("ns-1", "pod-1").Phase(corev1api.PodPending).Result()
+	pod2 := builder.ForPod("ns-1", "pod-1").Phase(corev1api.PodRunning).Result()
+
+	fs := velerotest.NewFakeFileSystem()
+	extract := func(tw *velerotest.TarWriter) string {
+		dir, err := archive.NewExtractor(velerotest.NewLogger(), fs).UnzipAndExtractBackup(tw.Done())
+		require.NoError(t, err)
+		return dir
+	}
+
+	fromDir := extract(velerotest.NewTarWriter(t).
+		AddItems("configmaps",
+			unchanged1,
+			builder.ForConfigMap("ns-1", "changed").Data("key", "old").Result(),
+			builder.ForConfigMap("ns-1", "removed").Result(),
+		).
+		AddItems("pods", pod1).
+		AddItems("namespaces", builder.ForNamespace("ns-1").Result()).
+		AddItems("secrets", builder.ForSecret("ns-2", "removed").Result()))
+
+	toDir := extract(velerotest.NewTarWriter(t).
+		AddItems("configmaps",
+			unchanged2,
+			builder.ForConfigMap("ns-1", "changed").Data("key", "new").Result(),
+			builder.ForConfigMap("ns-2", "added").Result(),
+		).
+		AddItems("pods", pod2).
+		AddItems("namespaces", builder.ForNamespace("ns-1").Result(), builder.ForNamespace("ns-2").Result()))
+
+	t.Run("without details", func(t *testing.T) {
+		diff, err := diffBackupContents("backup-1", fromDir, "backup-2", toDir, fs, false)
+		require.NoError(t, err)
+
+		assert.Equal(t, &backupDiff{
+			From: "backup-1",
+			To:   "backup-2",
+			Resources: []resourceDiff{
+				{
+					Resource: "configmaps",
+					Namespaces: []namespaceDiff{
+						{Namespace: "ns-1", Removed: []string{"removed"}, Changed: []changedItem{{Name: "changed"}}},
+						{Namespace: "ns-2", Added: []string{"added"}},
+					},
+				},
+				{
+					Resource:   "namespaces",
+					Namespaces: []namespaceDiff{{Added: []string{"ns-2"}}},
+				},
+				{
+					Resource:   "secrets",
+					Namespaces: []namespaceDiff{{Namespace: "ns-2", Removed: []string{"removed"}}},
+				},
+			},
+		}, diff)
+	})
+
+	t.Run("with details", func(t *testing.T) {
+		diff, err := diffBackupContents("backup-1", fromDir, "backup-2", toDir, fs, true)
+		require.NoError(t, err)
+
+		require.Equal(t, "configmaps", diff.Resources[0].Resource)
+		assert.Equal(t, []changedItem{{Name: "changed", Diff: json.RawMessage(`{"data":{"key":"new"}}`)}}, diff.Resources[0].Namespaces[0].Changed)
+
+		buf := new(bytes.Buffer)
+		printBackupDiff(diff, buf)
+		assert.Equal(t, `configmaps:
+  ns-1:
+    - removed
+    ~ changed
+        {"data":{"key":"new"}}
+  ns-2:
+    + added
+namespaces:
+  <cluster-scoped>:
+    + ns-2
+secrets:
+  ns-2:
+    - removed
+
+From backup backup-1 to backup backup-2: 2 added, 2 removed, 1 changed.
+`, buf.String())
+	})
+
+	t.Run("same backups", func(t *testing.T) {
+		diff, err := diffBackupContents("backup-1", fromDir, "backup-1", fromDir, fs, true)
+		require.NoError(t, err)
+		assert.Empty(t, diff.Resources)
+
+		buf := new(bytes.Buffer)
+		printBackupDiff(diff, buf)
+		assert.Equal(t, "Backups backup-1 and backup-1 have the same items.\n", buf.String())
+	})
+}
+
+func TestDiffBackupResourceLists(t *testing.T) {
+	from := map[string][]string{
+		"v1/ConfigMap":       {"ns-1/cm-1", "ns-1/cm-2"},
+		"v1/Namespace":       {"ns-1"},
+		"apps/v1/Deployment": {"ns-1/deploy-1"},
+	}
+	to := map[string][]string{
+		"v1/ConfigMap":       {"ns-1/cm-1", "ns-2/cm-3"},
+		"v1/Namespace":       {"ns-1", "ns-2"},
+		"apps/v1/Deployment": {"ns-1/deploy-1"},
+	}
+
+	assert.Equal(t, &backupDiff{
+		From: "backup-1",
+		To:   "backup-2",
+		Resources: []resourceDiff{
+			{
+				Resource: "v1/ConfigMap",
+				Namespaces: []namespaceDiff{
+					{Namespace: "ns-1", Removed: []string{"cm-2"}},
+					{Namespace: "ns-2", Added: []string{"cm-3"}},
+				},
+			},
+			{
+				Resource:   "v1/Namespace",
+				Namespaces: []namespaceDiff{{Added: []string{"ns-2"}}},
+			},
+		},
+	}, diffBackupResourceLists("backup-1", from, "backup-2", to))
+}
@@ -0,0 +1,401 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	corev1api "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
+	"github.com/vmware-tanzu/velero/pkg/restore"
+	"github.com/vmware-tanzu/velero/pkg/util/encode"
+	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
+)
+
+type ExtractOptions struct {
+	BackupName                string
+	Resources                 flag.StringArray
+	Namespaces                flag.StringArray
+	Names                     flag.StringArray
+	Selector                  flag.LabelSelector
+	APIVersion                string
+	Output                    string
+	OutputDir                 string
+	ResourceModifierConfigMap string
+	NamespaceMappings         flag.Map
+	Timeout                   time.Duration
+	InsecureSkipTLSVerify     bool
+	CaCertFile                string
+	Client                    kbclient.Client
+}
+
+func NewExtractOptions() *ExtractOptions {
+	config, err := client.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: Error reading config file: %v\n", err)
+	}
+
+	return &ExtractOptions{
+		Output:            "yaml",
+		NamespaceMappings: flag.NewMap().WithEntryDelimiter(',').WithKeyValueDelimiter(':'),
+		Timeout:           time.Minute,
+		CaCertFile:        config.CACertFile(),
+	}
+}
+
+func (o *ExtractOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.Var(&o.Resources, "resource", "Resources to extract, formatted as resource.group, such as deployments.apps. The group may be omitted if the resource name is unique in the backup. Defaults to all resources.")
+	flags.Var(&o.Namespaces, "item-namespace", "Namespaces of the items to extract, as they are in the backup. Defaults to all namespaces and the cluster-scoped items.")
+	flags.Var(&o.Names, "name", "Names of the items to extract. Defaults to all items.")
+	flags.VarP(&o.Selector, "selector", "l", "Only extract the items matching this label selector.")
+	flags.StringVar(&o.APIVersion, "api-version", o.APIVersion, "API version of the items to extract, such as v1beta1, if the backup has several versions of the resources. Defaults to the preferred version.")
+	flags.StringVarP(&o.Output, "output", "o", o.Output, "Output format. Valid values are 'yaml' and 'json'.")
+	flags.StringVar(&o.OutputDir, "output-dir", o.OutputDir, "Directory to write the items into, one file per item, instead of printing them.")
+	flags.StringVar(&o.ResourceModifierConfigMap, "resource-modifier-configmap", o.ResourceModifierConfigMap, "Resource modifier configmap to apply to the items, as a restore would.")
+	flags.Var(&o.NamespaceMappings, "namespace-mappings", "Namespace mappings to apply to the items, as a restore would, in the form src1:dst1,src2:dst2,...")
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "Maximum time to wait to process the download request.")
+	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
+	flags.StringVar(&o.CaCertFile, "cacert", o.CaCertFile, "Path to a certificate bundle to use when verifying TLS connections.")
+}
+
+func (o *ExtractOptions) Complete(args []string, f client.Factory) error {
+	o.BackupName = args[0]
+
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+	o.Client = kbClient
+	return nil
+}
+
+func (o *ExtractOptions) Validate() error {
+	if o.Output != "yaml" && o.Output != "json" {
+		return errors.Errorf("invalid output format %q, valid values are 'yaml' and 'json'", o.Output)
+	}
+	return nil
+}
+
+func (o *ExtractOptions) Run(c *cobra.Command, f client.Factory) error {
+	backup := new(velerov1api.Backup)
+	err := o.Client.Get(context.TODO(), kbclient.ObjectKey{Namespace: f.Namespace(), Name: o.BackupName}, backup)
+	if apierrors.IsNotFound(err) {
+		return fmt.Errorf("backup %q does not exist", o.BackupName)
+	} else if err != nil {
+		return fmt.Errorf("error checking for backup %q: %v", o.BackupName, err)
+	}
+
+	modifiers, err := o.resourceModifiers(f.Namespace())
+	if err != nil {
+		return err
+	}
+
+	dir, err := downloadBackupContents(o.Client, f.Namespace(), o.BackupName, o.Timeout, o.InsecureSkipTLSVerify, o.CaCertFile)
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	items, err := o.extractItems(dir, filesystem.NewFileSystem(), modifiers, o.Client.Scheme())
+	if err != nil {
+		return err
+	}
+	if len(items) == 0 {
+		return fmt.Errorf("no items of backup %q match the filters", o.BackupName)
+	}
+
+	if o.OutputDir != "" {
+		if err := o.writeItems(items); err != nil {
+			return err
+		}
+		fmt.Printf("Extracted %d items of backup %s into %s\n", len(items), o.BackupName, o.OutputDir)
+		return nil
+	}
+	return o.printItems(items, os.Stdout)
+}
+
+// resourceModifiers returns the resource modifiers of the configmap to apply
+// to the items, if any.
+func (o *ExtractOptions) resourceModifiers(namespace string) (*resourcemodifiers.ResourceModifiers, error) {
+	if o.ResourceModifierConfigMap == "" {
+		return nil, nil
+	}
+
+	cm := new(corev1api.ConfigMap)
+	if err := o.Client.Get(context.TODO(), kbclient.ObjectKey{Namespace: namespace, Name: o.ResourceModifierConfigMap}, cm); err != nil {
+		return nil, errors.Wrapf(err, "error getting resource modifier configmap %q", o.ResourceModifierConfigMap)
+	}
+
+	modifiers, err := resourcemodifiers.GetResourceModifiersFromConfig(cm)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error parsing resource modifier configmap %q", o.ResourceModifierConfigMap)
+	}
+	if err := modifiers.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid resource modifier configmap %q", o.ResourceModifierConfigMap)
+	}
+	return modifiers, nil
+}
+
+// extractedItem is an item extracted from a backup, with the resource it's
+// stored under in the backup.
+type extractedItem struct {
+	groupResource string
+	obj           *unstructured.Unstructured
+}
+
+// extractItems returns the items of the extracted backup in dir which match
+// the filters, sorted by resource, namespace and name, with the namespace
+// mappings and the resource modifiers applied as a restore would.
+func (o *ExtractOptions) extractItems(dir string, fs filesystem.Interface, modifiers *resourcemodifiers.ResourceModifiers, scheme *runtime.Scheme) ([]extractedItem, error) {
+	log := logrus.New()
+	log.SetOutput(io.Discard)
+	parser := archive.NewParser(log, fs)
+
+	resources, err := parser.Parse(dir)
+	if err != nil {
+		return nil, errors.Wrap(err, "error parsing backup contents")
+	}
+
+	groupResources, err := o.selectResources(resources)
+	if err != nil {
+		return nil, err
+	}
+
+	var versions map[string]metav1.APIGroup
+	if o.APIVersion != "" {
+		if versions, err = parser.ParseGroupVersions(dir); err != nil {
+			return nil, errors.Wrap(err, "error parsing backup API group versions")
+		}
+	}
+
+	mapper, err := restore.NewNamespaceMapper(&velerov1api.RestoreSpec{NamespaceMapping: o.NamespaceMappings.Data()})
+	if err != nil {
+		return nil, err
+	}
+
+	selector := labels.Everything()
+	if o.Selector.LabelSelector != nil {
+		if selector, err = metav1.LabelSelectorAsSelector(o.Selector.LabelSelector); err != nil {
+			return nil, errors.Wrap(err, "invalid label selector")
+		}
+	}
+
+	var items []extractedItem
+	for _, groupResource := range groupResources {
+		versionDir := ""
+		if o.APIVersion != "" {
+			if versionDir, err = versionDirOf(versions[groupResource], o.APIVersion); err != nil {
+				return nil, errors.Wrapf(err, "error finding the items of %s", groupResource)
+			}
+		}
+
+		for _, namespace := range sets.StringKeySet(resources[groupResource].ItemsByNamespace).List() {
+			if len(o.Namespaces) > 0 && !contains(o.Namespaces, namespace) {
+				continue
+			}
+
+			names := append([]string(nil), resources[groupResource].ItemsByNamespace[namespace]...)
+			sort.Strings(names)
+			for _, name := range names {
+				if len(o.Names) > 0 && !contains(o.Names, name) {
+					continue
+				}
+
+				obj, err := archive.Unmarshal(fs, archive.GetVersionedItemFilePath(dir, groupResource, namespace, name, versionDir))
+				if err != nil {
+					return nil, errors.Wrapf(err, "error reading %s %s", groupResource, strings.TrimPrefix(namespace+"/"+name, "/"))
+				}
+				if !selector.Matches(labels.Set(obj.GetLabels())) {
+					continue
+				}
+
+				if modifiers != nil {
+					drop, errs := modifiers.ApplyResourceModifierRules(obj, groupResource, scheme, log)
+					if len(errs) > 0 {
+						return nil, errors.Wrapf(errs[0], "error applying resource modifiers to %s %s", groupResource, strings.TrimPrefix(namespace+"/"+name, "/"))
+					}
+					if drop {
+						continue
+					}
+				}
+
+				if target, ok := mapper.Map(namespace); ok {
+					obj.SetNamespace(target)
+				}
+				if groupResource == "namespaces" {
+					if target, ok := mapper.Map(name); ok {
+						obj.SetName(target)
+					}
+				}
+
+				items = append(items, extractedItem{groupResource: groupResource, obj: obj})
+			}
+		}
+	}
+
+	return items, nil
+}
+
+// selectResources returns the sorted resources of the backup matching the
+// resource filter. A resource in the filter without its group matches the
+// resource of any group, if there's only one.
+func (o *ExtractOptions) selectResources(resources map[string]*archive.ResourceItems) ([]string, error) {
+	all := sets.StringKeySet(resources).List()
+	if len(o.Resources) == 0 {
+		return all, nil
+	}
+
+	selected := sets.NewString()
+	for _, resource := range o.Resources {
+		var matches []string
+		for _, groupResource := range all {
+			if groupResource == resource {
+				matches = []string{groupResource}
+				break
+			}
+			if strings.HasPrefix(groupResource, resource+".") {
+				matches = append(matches, groupResource)
+			}
+		}
+		if len(matches) > 1 {
+			return nil, errors.Errorf("resource %q is ambiguous, it matches %s", resource, strings.Join(matches, ", "))
+		}
+		for _, match := range matches {
+			selected.Insert(match)
+		}
+	}
+	return selected.List(), nil
+}
+
+// versionDirOf returns the directory of the items of the API version in the
+// backup, which has a suffix if it's the preferred version.
+func versionDirOf(group metav1.APIGroup, version string) (string, error) {
+	for _, v := range group.Versions {
+		if v.Version != version {
+			continue
+		}
+		if v.Version == group.PreferredVersion.Version {
+			return version + velerov1api.PreferredVersionDir, nil
+		}
+		return version, nil
+	}
+	return "", errors.Errorf("API version %q isn't in the backup, it was backed up without the %s feature flag or the version isn't served", version, velerov1api.APIGroupVersionsFeatureFlag)
+}
+
+// printItems prints the items, as a list if there are several of them.
+func (o *ExtractOptions) printItems(items []extractedItem, w io.Writer) error {
+	var obj runtime.Object = items[0].obj
+	if len(items) > 1 {
+		list := &unstructured.UnstructuredList{Object: map[string]interface{}{}}
+		list.SetAPIVersion("v1")
+		list.SetKind("List")
+		for _, item := range items {
+			list.Items = append(list.Items, *item.obj)
+		}
+		obj = list
+	}
+
+	return encode.To(obj, o.Output, w)
+}
+
+// writeItems writes each item into its own file of the output directory, laid
+// out as <resource>/<namespace>/<name>, or <resource>/cluster/<name> for the
+// cluster-scoped items.
+func (o *ExtractOptions) writeItems(items []extractedItem) error {
+	for _, item := range items {
+		scope := item.obj.GetNamespace()
+		if scope == "" {
+			scope = velerov1api.ClusterScopedDir
+		}
+		dir := filepath.Join(o.OutputDir, item.groupResource, scope)
+		if err := os.MkdirAll(dir, 0755); err != nil {
+			return errors.Wrapf(err, "error creating directory %s", dir)
+		}
+
+		data, err := encode.Encode(item.obj, o.Output)
+		if err != nil {
+			return errors.Wrapf(err, "error encoding %s %s", item.groupResource, item.obj.GetName())
+		}
+		path := filepath.Join(dir, item.obj.GetName()+"."+o.Output)
+		if err := os.WriteFile(path, data, 0644); err != nil {
+			return errors.Wrapf(err, "error writing %s", path)
+		}
+	}
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func NewExtractCommand(f client.Factory) *cobra.Command {
+	o := NewExtractOptions()
+
+	c := &cobra.Command{
+		Use:   "extract NAME",
+		Short: "Extract Kubernetes manifests from a backup without restoring them",
+		Long: `Extract Kubernetes manifests from a backup without restoring them.
+
+The backup tarball is downloaded, and the items matching the filters are printed, or written
+into a directory with one file per item. The namespace mappings and the resource modifiers of
+a restore may be applied to the items, to see them as they would be restored.`,
+		Example: `  # Print the configmap "bar" of the namespace "foo" in the backup "backup-1".
+  velero backup extract backup-1 --resource configmaps --item-namespace foo --name bar -o yaml
+
+  # Write all deployments of the backup "backup-1" into the directory "manifests".
+  velero backup extract backup-1 --resource deployments.apps --output-dir manifests`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Validate())
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
@@ -0,0 +1,253 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"testing"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
+	"github.com/vmware-tanzu/velero/pkg/archive"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
+	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
+	veleroflag "github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestNewExtractCommand(t *testing.T) {
+	t.Run("Flag test", func(t *testing.T) {
+		o := NewExtractOptions()
+		flags := new(flag.FlagSet)
+		o.BindFlags(flags)
+
+		flags.Parse([]string{"--resource", "configmaps"})
+		flags.Parse([]string{"--item-namespace", "foo"})
+		flags.Parse([]string{"--name", "bar"})
+		flags.Parse([]string{"--api-version", "v1"})
+		flags.Parse([]string{"-o", "json"})
+		flags.Parse([]string{"--namespace-mappings", "foo:baz"})
+		flags.Parse([]string{"--resource-modifier-configmap", "modifiers"})
+
+		assert.Equal(t, "configmaps", o.Resources.String())
+		assert.Equal(t, "foo", o.Namespaces.String())
+		assert.Equal(t, "bar", o.Names.String())
+		assert.Equal(t, "v1", o.APIVersion)
+		assert.Equal(t, "json", o.Output)
+		assert.Equal(t, map[string]string{"foo": "baz"}, o.NamespaceMappings.Data())
+		assert.Equal(t, "modifiers", o.ResourceModifierConfigMap)
+		assert.NoError(t, o.Validate())
+
+		o.Output = "table"
+		assert.EqualError(t, o.Validate(), `invalid output format "table", valid values are 'yaml' and 'json'`)
+	})
+
+	t.Run("Backup not exist test", func(t *testing.T) {
+		f := &factorymocks.Factory{}
+		kbClient := velerotest.NewFakeControllerRuntimeClient(t)
+		f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+		f.On("KubebuilderClient").Return(kbClient, nil)
+
+		c := NewExtractCommand(f)
+		assert.Equal(t, "Extract Kubernetes manifests from a backup without restoring them", c.Short)
+
+		o := NewExtractOptions()
+		require.NoError(t, o.Complete([]string{"not-exist"}, f))
+		assert.EqualError(t, o.Run(c, f), `backup "not-exist" does not exist`)
+	})
+}
+
+func TestExtractItems(t *testing.T) {
+	tarball := velerotest.NewTarWriter(t).
+		AddItems("configmaps",
+			builder.ForConfigMap("foo", "bar").ObjectMeta(builder.WithLabels("app", "a")).Data("key", "value").Result(),
+			builder.ForConfigMap("foo", "other").Result(),
+			builder.ForConfigMap("ns-2", "bar").Result(),
+		).
+		AddItems("deployments.apps", builder.ForDeployment("foo", "deploy-1").Result()).
+		AddItems("namespaces", builder.ForNamespace("foo").Result()).
+		Add("resources/configmaps/v1-preferredversion/namespaces/foo/bar.json", builder.ForConfigMap("foo", "bar").Data("key", "preferred").Result()).
+		Add("resources/configmaps/v1beta1/namespaces/foo/bar.json", builder.ForConfigMap("foo", "bar").Data("key", "v1beta1").Result()).
+		Done()
+
+	fs := velerotest.NewFakeFileSystem()
+	dir, err := archive.NewExtractor(velerotest.NewLogger(), fs).UnzipAndExtractBackup(tarball)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name              string
+		options           ExtractOptions
+		namespaceMappings string
+		modifiers         *resourcemodifiers.ResourceModifiers
+		want              []string
+		wantData          string
+		wantErr           string
+	}{
+		{
+			name: "all items",
+			want: []string{"configmaps foo/bar", "configmaps foo/other", "configmaps ns-2/bar", "deployments.apps foo/deploy-1", "namespaces foo"},
+		},
+		{
+			name:     "item by resource, namespace and name",
+			options:  ExtractOptions{Resources: []string{"configmaps"}, Namespaces: []string{"foo"}, Names: []string{"bar"}},
+			want:     []string{"configmaps foo/bar"},
+			wantData: "value",
+		},
+		{
+			name:    "resource without its group",
+			options: ExtractOptions{Resources: []string{"deployments"}},
+			want:    []string{"deployments.apps foo/deploy-1"},
+		},
+		{
+			name:    "items by label selector",
+			options: ExtractOptions{Selector: labelSelector(t, "app=a")},
+			want:    []string{"configmaps foo/bar"},
+		},
+		{
+			name:     "item of a non-preferred API version",
+			options:  ExtractOptions{Resources: []string{"configmaps"}, Names: []string{"bar"}, Namespaces: []string{"foo"}, APIVersion: "v1beta1"},
+			want:     []string{"configmaps foo/bar"},
+			wantData: "v1beta1",
+		},
+		{
+			name:     "item of the preferred API version",
+			options:  ExtractOptions{Resources: []string{"configmaps"}, Names: []string{"bar"}, Namespaces: []string{"foo"}, APIVersion: "v1"},
+			want:     []string{"configmaps foo/bar"},
+			wantData: "preferred",
+		},
+		{
+			name:    "API version not in the backup",
+			options: ExtractOptions{Resources: []string{"configmaps"}, APIVersion: "v2"},
+			wantErr: `error finding the items of configmaps: API version "v2" isn't in the backup, it was backed up without the EnableAPIGroupVersions feature flag or the version isn't served`,
+		},
+		{
+			name:              "namespace mappings",
+			options:           ExtractOptions{Namespaces: []string{"", "foo"}, Resources: []string{"namespaces", "deployments.apps"}},
+			namespaceMappings: "foo:baz",
+			want:              []string{"deployments.apps baz/deploy-1", "namespaces baz"},
+		},
+		{
+			name:    "resource modifiers",
+			options: ExtractOptions{Resources: []string{"configmaps"}, Namespaces: []string{"foo"}},
+			modifiers: &resourcemodifiers.ResourceModifiers{
+				Version: "v1",
+				ResourceModifierRules: []resourcemodifiers.ResourceModifierRule{
+					{
+						Conditions: resourcemodifiers.Conditions{GroupResource: "configmaps", ResourceNameRegex: "^other$"},
+						Action:     resourcemodifiers.RuleActionDrop,
+					},
+					{
+						Conditions: resourcemodifiers.Conditions{GroupResource: "configmaps", ResourceNameRegex: "^bar$"},
+						Patches:    []resourcemodifiers.JSONPatch{{Operation: "replace", Path: "/data/key", Value: "modified"}},
+					},
+				},
+			},
+			want:     []string{"configmaps foo/bar"},
+			wantData: "modified",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			o := NewExtractOptions()
+			o.Resources = test.options.Resources
+			o.Namespaces = test.options.Namespaces
+			o.Names = test.options.Names
+			o.Selector = test.options.Selector
+			o.APIVersion = test.options.APIVersion
+			if test.namespaceMappings != "" {
+				require.NoError(t, o.NamespaceMappings.Set(test.namespaceMappings))
+			}
+
+			items, err := o.extractItems(dir, fs, test.modifiers, runtime.NewScheme())
+			if test.wantErr != "" {
+				require.EqualError(t, err, test.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			var got []string
+			for _, item := range items {
+				name := item.obj.GetName()
+				if item.obj.GetNamespace() != "" {
+					name = item.obj.GetNamespace() + "/" + name
+				}
+				got = append(got, item.groupResource+" "+name)
+			}
+			assert.Equal(t, test.want, got)
+
+			if test.wantData != "" {
+				assert.Equal(t, map[string]interface{}{"key": test.wantData}, items[0].obj.Object["data"])
+			}
+		})
+	}
+}
+
+func TestExtractAmbiguousResource(t *testing.T) {
+	o := NewExtractOptions()
+	o.Resources = []string{"events"}
+
+	_, err := o.selectResources(map[string]*archive.ResourceItems{
+		"events":                  {},
+		"events.events.k8s.io":    {},
+		"events.example.com":      {},
+		"eventsources.example.io": {},
+	})
+	require.NoError(t, err)
+
+	o.Resources = []string{"widgets"}
+	_, err = o.selectResources(map[string]*archive.ResourceItems{
+		"widgets.example.com": {},
+		"widgets.example.io":  {},
+	})
+	require.EqualError(t, err, `resource "widgets" is ambiguous, it matches widgets.example.com, widgets.example.io`)
+}
+
+func TestPrintExtractedItems(t *testing.T) {
+	tarball := velerotest.NewTarWriter(t).
+		AddItems("configmaps", builder.ForConfigMap("foo", "bar").Result(), builder.ForConfigMap("foo", "baz").Result()).
+		Done()
+	fs := velerotest.NewFakeFileSystem()
+	dir, err := archive.NewExtractor(velerotest.NewLogger(), fs).UnzipAndExtractBackup(tarball)
+	require.NoError(t, err)
+
+	o := NewExtractOptions()
+	items, err := o.extractItems(dir, fs, nil, runtime.NewScheme())
+	require.NoError(t, err)
+
+	buf := new(bytes.Buffer)
+	require.NoError(t, o.printItems(items[:1], buf))
+	assert.Contains(t, buf.String(), "kind: ConfigMap")
+	assert.NotContains(t, buf.String(), "kind: List")
+
+	buf.Reset()
+	require.NoError(t, o.printItems(items, buf))
+	assert.Contains(t, buf.String(), "kind: List")
+	assert.Contains(t, buf.String(), "name: baz")
+}
+
+func labelSelector(t *testing.T, selector string) veleroflag.LabelSelector {
+	t.Helper()
+	var s veleroflag.LabelSelector
+	require.NoError(t, s.Set(selector))
+	return s
+}
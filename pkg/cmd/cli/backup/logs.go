@@ -70,7 +70,7 @@ func (l *LogsOptions) Run(c *cobra.Command, f client.Factory) error {
 	}
 
 	switch backup.Status.Phase {
-	case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseFailed, velerov1api.BackupPhaseWaitingForPluginOperations, velerov1api.BackupPhaseWaitingForPluginOperationsPartiallyFailed:
+	case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseFailed, velerov1api.BackupPhaseCancelled, velerov1api.BackupPhaseWaitingForPluginOperations, velerov1api.BackupPhaseWaitingForPluginOperationsPartiallyFailed:
 		// terminal and waiting for plugin operations phases, do nothing.
 	default:
 		return fmt.Errorf("logs for backup %q are not available until it's finished processing, please wait "+
@@ -0,0 +1,213 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/integrity"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+type VerifyOptions struct {
+	Timeout               time.Duration
+	InsecureSkipTLSVerify bool
+	CaCertFile            string
+	Client                kbclient.Client
+	BackupName            string
+}
+
+func NewVerifyOptions() VerifyOptions {
+	config, err := client.LoadConfig()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "WARNING: Error reading config file: %v\n", err)
+	}
+
+	return VerifyOptions{
+		Timeout:    time.Minute,
+		CaCertFile: config.CACertFile(),
+	}
+}
+
+func (o *VerifyOptions) BindFlags(flags *pflag.FlagSet) {
+	flags.DurationVar(&o.Timeout, "timeout", o.Timeout, "Maximum time to wait to process each download request.")
+	flags.BoolVar(&o.InsecureSkipTLSVerify, "insecure-skip-tls-verify", o.InsecureSkipTLSVerify, "If true, the object store's TLS certificate will not be checked for validity. This is insecure and susceptible to man-in-the-middle attacks. Not recommended for production.")
+	flags.StringVar(&o.CaCertFile, "cacert", o.CaCertFile, "Path to a certificate bundle to use when verifying TLS connections.")
+}
+
+func (o *VerifyOptions) Complete(args []string, f client.Factory) error {
+	o.BackupName = args[0]
+
+	kbClient, err := f.KubebuilderClient()
+	if err != nil {
+		return err
+	}
+	o.Client = kbClient
+	return nil
+}
+
+func (o *VerifyOptions) Run(c *cobra.Command, f client.Factory) error {
+	backup := new(velerov1api.Backup)
+	err := o.Client.Get(context.TODO(), kbclient.ObjectKey{Namespace: f.Namespace(), Name: o.BackupName}, backup)
+	if apierrors.IsNotFound(err) {
+		return fmt.Errorf("backup %q does not exist", o.BackupName)
+	} else if err != nil {
+		return fmt.Errorf("error checking for backup %q: %v", o.BackupName, err)
+	}
+
+	signingKey, err := o.signingKey(f.Namespace(), backup)
+	if err != nil {
+		return err
+	}
+
+	manifest, err := o.manifest(f.Namespace())
+	if err != nil {
+		return err
+	}
+	if manifest == nil {
+		return fmt.Errorf("backup %q has no integrity manifest, it was created by a Velero version which doesn't write one", o.BackupName)
+	}
+
+	res, err := integrity.Verify(manifest, signingKey, o.fetch(f.Namespace()))
+	if err != nil {
+		return err
+	}
+
+	for _, mismatch := range res.Mismatches {
+		fmt.Printf("FAILED  %s\n", mismatch)
+	}
+	fmt.Printf("Verified %d files and %d resources of backup %s.\n", res.FilesVerified, res.ResourcesVerified, o.BackupName)
+
+	if !res.Verified() {
+		return fmt.Errorf("backup %q failed integrity verification with %d mismatch(es)", o.BackupName, len(res.Mismatches))
+	}
+	return nil
+}
+
+// signingKey returns the manifest signing key of the backup's storage
+// location, or nil if the location doesn't sign manifests.
+func (o *VerifyOptions) signingKey(namespace string, backup *velerov1api.Backup) ([]byte, error) {
+	location := new(velerov1api.BackupStorageLocation)
+	if err := o.Client.Get(context.TODO(), kbclient.ObjectKey{Namespace: namespace, Name: backup.Spec.StorageLocation}, location); err != nil {
+		return nil, errors.Wrapf(err, "error getting backup storage location %q", backup.Spec.StorageLocation)
+	}
+
+	if location.Spec.ObjectStorage == nil || location.Spec.ObjectStorage.Integrity == nil || location.Spec.ObjectStorage.Integrity.SigningKey == nil {
+		return nil, nil
+	}
+
+	key, err := kube.GetSecretKey(o.Client, namespace, location.Spec.ObjectStorage.Integrity.SigningKey)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting manifest signing key")
+	}
+
+	return key, nil
+}
+
+func (o *VerifyOptions) manifest(namespace string) (*integrity.Manifest, error) {
+	buf := new(bytes.Buffer)
+	err := downloadrequest.Stream(context.Background(), o.Client, namespace, o.BackupName, velerov1api.DownloadTargetKindBackupManifest, buf, o.Timeout, o.InsecureSkipTLSVerify, o.CaCertFile)
+	if err == downloadrequest.ErrNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "error downloading backup manifest")
+	}
+
+	manifest := integrity.NewManifest()
+	if err := json.NewDecoder(buf).Decode(manifest); err != nil {
+		return nil, errors.Wrap(err, "error decoding backup manifest")
+	}
+
+	return manifest, nil
+}
+
+// fetch returns a fetcher downloading the backup files to temp files, so the
+// backup tarball doesn't have to fit in memory.
+func (o *VerifyOptions) fetch(namespace string) integrity.Fetcher {
+	return func(kind velerov1api.DownloadTargetKind) (io.ReadCloser, error) {
+		file, err := os.CreateTemp("", fmt.Sprintf("%s-%s", o.BackupName, kind))
+		if err != nil {
+			return nil, errors.Wrap(err, "error creating temp file")
+		}
+
+		err = downloadrequest.StreamRaw(context.Background(), o.Client, namespace, o.BackupName, kind, file, o.Timeout, o.InsecureSkipTLSVerify, o.CaCertFile)
+		if err == nil {
+			_, err = file.Seek(0, 0)
+		}
+		if err != nil {
+			file.Close()
+			os.Remove(file.Name())
+			if err == downloadrequest.ErrNotFound {
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		return &tempFile{file}, nil
+	}
+}
+
+// tempFile is a file which is removed when it's closed.
+type tempFile struct {
+	*os.File
+}
+
+func (f *tempFile) Close() error {
+	defer os.Remove(f.Name())
+	return f.File.Close()
+}
+
+func NewVerifyCommand(f client.Factory) *cobra.Command {
+	o := NewVerifyOptions()
+
+	c := &cobra.Command{
+		Use:   "verify NAME",
+		Short: "Verify a backup against its integrity manifest",
+		Long: `Verify a backup against its integrity manifest.
+
+The files of the backup in object storage, and every resource in the backup tarball,
+are downloaded and compared to the digests recorded in the manifest when the backup
+was written. If the backup storage location has a manifest signing key, the manifest's
+signature is verified too.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			cmd.CheckError(o.Complete(args, f))
+			cmd.CheckError(o.Run(c, f))
+		},
+	}
+
+	o.BindFlags(c.Flags())
+
+	return c
+}
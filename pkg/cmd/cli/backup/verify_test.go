@@ -0,0 +1,94 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backup
+
+import (
+	"context"
+	"testing"
+
+	flag "github.com/spf13/pflag"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
+	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestNewVerifyCommand(t *testing.T) {
+	t.Run("Flag test", func(t *testing.T) {
+		o := NewVerifyOptions()
+		flags := new(flag.FlagSet)
+		o.BindFlags(flags)
+
+		flags.Parse([]string{"--timeout", "2m0s"})
+		flags.Parse([]string{"--insecure-skip-tls-verify"})
+		flags.Parse([]string{"--cacert", "ca.crt"})
+
+		assert.Equal(t, "2m0s", o.Timeout.String())
+		assert.True(t, o.InsecureSkipTLSVerify)
+		assert.Equal(t, "ca.crt", o.CaCertFile)
+	})
+
+	t.Run("Backup not exist test", func(t *testing.T) {
+		f := &factorymocks.Factory{}
+		kbClient := velerotest.NewFakeControllerRuntimeClient(t)
+		f.On("Namespace").Return(cmdtest.VeleroNameSpace)
+		f.On("KubebuilderClient").Return(kbClient, nil)
+
+		c := NewVerifyCommand(f)
+		assert.Equal(t, "Verify a backup against its integrity manifest", c.Short)
+
+		o := NewVerifyOptions()
+		require.NoError(t, o.Complete([]string{"not-exist"}, f))
+		assert.EqualError(t, o.Run(c, f), `backup "not-exist" does not exist`)
+	})
+}
+
+func TestVerifySigningKey(t *testing.T) {
+	kbClient := velerotest.NewFakeControllerRuntimeClient(t)
+	o := &VerifyOptions{Client: kbClient}
+
+	backup := builder.ForBackup(cmdtest.VeleroNameSpace, "backup-1").StorageLocation("default").Result()
+
+	// no signing key configured
+	location := builder.ForBackupStorageLocation(cmdtest.VeleroNameSpace, "default").Provider("aws").Bucket("bucket").Result()
+	require.NoError(t, kbClient.Create(context.Background(), location))
+
+	key, err := o.signingKey(cmdtest.VeleroNameSpace, backup)
+	require.NoError(t, err)
+	assert.Nil(t, key)
+
+	// signing key read from the location's secret
+	secret := builder.ForSecret(cmdtest.VeleroNameSpace, "integrity").Data(map[string][]byte{"key": []byte("signing-key")}).Result()
+	require.NoError(t, kbClient.Create(context.Background(), secret))
+
+	location.Spec.ObjectStorage.Integrity = &velerov1api.ObjectStorageIntegrity{
+		SigningKey: &corev1api.SecretKeySelector{
+			LocalObjectReference: corev1api.LocalObjectReference{Name: "integrity"},
+			Key:                  "key",
+		},
+	}
+	require.NoError(t, kbClient.Update(context.Background(), location))
+
+	key, err = o.signingKey(cmdtest.VeleroNameSpace, backup)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("signing-key"), key)
+}
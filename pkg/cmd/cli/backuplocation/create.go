@@ -73,13 +73,15 @@ type CreateOptions struct {
 	Labels                                flag.Map
 	CACertFile                            string
 	AccessMode                            *flag.Enum
+	EncryptionKey                         flag.Map
 }
 
 func NewCreateOptions() *CreateOptions {
 	return &CreateOptions{
-		Credential: flag.NewMap(),
-		Config:     flag.NewMap(),
-		Labels:     flag.NewMap(),
+		Credential:    flag.NewMap(),
+		Config:        flag.NewMap(),
+		Labels:        flag.NewMap(),
+		EncryptionKey: flag.NewMap(),
 		AccessMode: flag.NewEnum(
 			string(velerov1api.BackupStorageLocationAccessModeReadWrite),
 			string(velerov1api.BackupStorageLocationAccessModeReadWrite),
@@ -99,6 +101,7 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.Var(&o.Config, "config", "Configuration key-value pairs.")
 	flags.Var(&o.Labels, "labels", "Labels to apply to the backup storage location.")
 	flags.StringVar(&o.CACertFile, "cacert", o.CACertFile, "File containing a certificate bundle to use when verifying TLS connections to the object store. Optional.")
+	flags.Var(&o.EncryptionKey, "encryption-key", "The key used to encrypt backups before they are uploaded to this location, as a key-value pair, where the key is the Kubernetes Secret name, and the value is the data key name within the Secret. The data must be 32 bytes, either raw or base64-encoded. Optional, one value only.")
 	flags.Var(
 		o.AccessMode,
 		"access-mode",
@@ -127,6 +130,10 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--credential can only contain 1 key/value pair")
 	}
 
+	if len(o.EncryptionKey.Data()) > 1 {
+		return errors.New("--encryption-key can only contain 1 key/value pair")
+	}
+
 	return nil
 }
 
@@ -182,6 +189,13 @@ func (o *CreateOptions) BuildBackupStorageLocation(namespace string, setBackupSy
 		break
 	}
 
+	for secretName, secretKey := range o.EncryptionKey.Data() {
+		backupStorageLocation.Spec.ObjectStorage.Encryption = &velerov1api.ObjectStorageEncryption{
+			Key: builder.ForSecretKeySelector(secretName, secretKey).Result(),
+		}
+		break
+	}
+
 	return backupStorageLocation, nil
 }
 
@@ -29,6 +29,7 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	factorymocks "github.com/vmware-tanzu/velero/pkg/client/mocks"
 	veleroflag "github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
@@ -86,6 +87,26 @@ func TestBuildBackupStorageLocationSetsCredential(t *testing.T) {
 	}, bsl.Spec.Credential)
 }
 
+func TestBuildBackupStorageLocationSetsEncryptionKey(t *testing.T) {
+	o := NewCreateOptions()
+
+	bsl, err := o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Nil(t, bsl.Spec.ObjectStorage.Encryption)
+
+	setErr := o.EncryptionKey.Set("my-secret=encryption-key")
+	assert.NoError(t, setErr)
+
+	bsl, err = o.BuildBackupStorageLocation("velero-test-ns", false, false)
+	assert.NoError(t, err)
+	assert.Equal(t, &velerov1api.ObjectStorageEncryption{
+		Key: &v1.SecretKeySelector{
+			LocalObjectReference: v1.LocalObjectReference{Name: "my-secret"},
+			Key:                  "encryption-key",
+		},
+	}, bsl.Spec.ObjectStorage.Encryption)
+}
+
 func TestBuildBackupStorageLocationSetsLabels(t *testing.T) {
 	o := NewCreateOptions()
 
@@ -0,0 +1,81 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+func NewCancelCommand(f client.Factory, use string) *cobra.Command {
+	c := &cobra.Command{
+		Use:   use + " NAME",
+		Short: "Cancel a restore",
+		Long: `Cancel a restore which hasn't finished processing. The remaining items aren't restored,
+the unfinished data downloads and plugin operations are cancelled, and the items the restore
+created or updated are rolled back. The restore ends in phase Cancelled.`,
+		Example: `  # Cancel the restore "restore-1".
+  velero restore cancel restore-1`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			kbClient, err := f.KubebuilderClient()
+			cmd.CheckError(err)
+
+			cmd.CheckError(requestCancel(kbClient, f.Namespace(), args[0]))
+			fmt.Printf("Request to cancel restore %q submitted successfully.\nRun `velero restore describe %s` for more details.\n", args[0], args[0])
+		},
+	}
+
+	return c
+}
+
+// requestCancel sets the cancel flag of the restore so that the server stops it.
+func requestCancel(kbClient kbclient.Client, namespace, name string) error {
+	restore := new(velerov1api.Restore)
+	err := kbClient.Get(context.TODO(), kbclient.ObjectKey{Namespace: namespace, Name: name}, restore)
+	if apierrors.IsNotFound(err) {
+		return fmt.Errorf("restore %q does not exist", name)
+	} else if err != nil {
+		return fmt.Errorf("error checking for restore %q: %v", name, err)
+	}
+
+	switch restore.Status.Phase {
+	case "", velerov1api.RestorePhaseNew, velerov1api.RestorePhaseInProgress,
+		velerov1api.RestorePhaseWaitingForPluginOperations, velerov1api.RestorePhaseWaitingForPluginOperationsPartiallyFailed:
+	default:
+		return fmt.Errorf("restore %q can't be cancelled, it has already finished processing with phase %q", name, restore.Status.Phase)
+	}
+	if boolptr.IsSetToTrue(restore.Spec.Cancel) {
+		return fmt.Errorf("the cancellation of restore %q has already been requested", name)
+	}
+
+	original := restore.DeepCopy()
+	restore.Spec.Cancel = boolptr.True()
+	if err := kbClient.Patch(context.TODO(), restore, kbclient.MergeFrom(original)); err != nil {
+		return fmt.Errorf("error requesting the cancellation of restore %q: %v", name, err)
+	}
+	return nil
+}
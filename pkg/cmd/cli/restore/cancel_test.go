@@ -0,0 +1,86 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+func TestRequestCancel(t *testing.T) {
+	tests := []struct {
+		name      string
+		restore   string
+		expectErr string
+	}{
+		{
+			name:    "in progress restore is cancelled",
+			restore: "in-progress",
+		},
+		{
+			name:    "restore waiting for plugin operations is cancelled",
+			restore: "waiting",
+		},
+		{
+			name:      "restore not found",
+			restore:   "not-exist",
+			expectErr: `restore "not-exist" does not exist`,
+		},
+		{
+			name:      "finished restore can't be cancelled",
+			restore:   "completed",
+			expectErr: `restore "completed" can't be cancelled, it has already finished processing with phase "Completed"`,
+		},
+		{
+			name:      "cancellation already requested",
+			restore:   "cancel-requested",
+			expectErr: `the cancellation of restore "cancel-requested" has already been requested`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := velerotest.NewFakeControllerRuntimeClient(t,
+				builder.ForRestore(cmdtest.VeleroNameSpace, "in-progress").Phase(velerov1api.RestorePhaseInProgress).Result(),
+				builder.ForRestore(cmdtest.VeleroNameSpace, "waiting").Phase(velerov1api.RestorePhaseWaitingForPluginOperations).Result(),
+				builder.ForRestore(cmdtest.VeleroNameSpace, "completed").Phase(velerov1api.RestorePhaseCompleted).Result(),
+				builder.ForRestore(cmdtest.VeleroNameSpace, "cancel-requested").Phase(velerov1api.RestorePhaseInProgress).Cancel(true).Result(),
+			)
+
+			err := requestCancel(client, cmdtest.VeleroNameSpace, test.restore)
+			if test.expectErr != "" {
+				require.EqualError(t, err, test.expectErr)
+				return
+			}
+			require.NoError(t, err)
+
+			restore := new(velerov1api.Restore)
+			require.NoError(t, client.Get(context.TODO(), kbclient.ObjectKey{Namespace: cmdtest.VeleroNameSpace, Name: test.restore}, restore))
+			assert.True(t, boolptr.IsSetToTrue(restore.Spec.Cancel))
+		})
+	}
+}
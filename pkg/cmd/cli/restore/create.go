@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
@@ -37,6 +38,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/flag"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
@@ -60,7 +62,10 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
   velero restore create --from-schedule schedule-1 --allow-partially-failed
 
   # Create a restore for only persistentvolumeclaims and persistentvolumes within a backup.
-  velero restore create --from-backup backup-2 --include-resources persistentvolumeclaims,persistentvolumes`,
+  velero restore create --from-backup backup-2 --include-resources persistentvolumeclaims,persistentvolumes
+
+  # Preview what a restore from backup "backup-1" would create or update, without changing the cluster.
+  velero restore create --from-backup backup-1 --dry-run`,
 		Args: cobra.MaximumNArgs(1),
 		Run: func(c *cobra.Command, args []string) {
 			cmd.CheckError(o.Complete(args, f))
@@ -86,11 +91,15 @@ type CreateOptions struct {
 	IncludeNamespaces         flag.StringArray
 	ExcludeNamespaces         flag.StringArray
 	ExistingResourcePolicy    string
+	ExistingResourcePolicies  flag.Map
 	IncludeResources          flag.StringArray
 	ExcludeResources          flag.StringArray
 	StatusIncludeResources    flag.StringArray
 	StatusExcludeResources    flag.StringArray
 	NamespaceMappings         flag.Map
+	NamespaceMappingPrefix    string
+	NamespaceMappingSuffix    string
+	NamespaceMappingRegexes   []string
 	Selector                  flag.LabelSelector
 	OrSelector                flag.OrLabelSelector
 	IncludeClusterResources   flag.OptionalBool
@@ -99,18 +108,23 @@ type CreateOptions struct {
 	ItemOperationTimeout      time.Duration
 	ResourceModifierConfigMap string
 	WriteSparseFiles          flag.OptionalBool
+	IncludePaths              flag.StringArray
+	DryRun                    bool
+	RollbackOnFailure         flag.OptionalBool
 	client                    kbclient.WithWatch
 }
 
 func NewCreateOptions() *CreateOptions {
 	return &CreateOptions{
-		Labels:                  flag.NewMap(),
-		IncludeNamespaces:       flag.NewStringArray("*"),
-		NamespaceMappings:       flag.NewMap().WithEntryDelimiter(',').WithKeyValueDelimiter(':'),
-		RestoreVolumes:          flag.NewOptionalBool(nil),
-		PreserveNodePorts:       flag.NewOptionalBool(nil),
-		IncludeClusterResources: flag.NewOptionalBool(nil),
-		WriteSparseFiles:        flag.NewOptionalBool(nil),
+		Labels:                   flag.NewMap(),
+		ExistingResourcePolicies: flag.NewMap(),
+		IncludeNamespaces:        flag.NewStringArray("*"),
+		NamespaceMappings:        flag.NewMap().WithEntryDelimiter(',').WithKeyValueDelimiter(':'),
+		RestoreVolumes:           flag.NewOptionalBool(nil),
+		PreserveNodePorts:        flag.NewOptionalBool(nil),
+		IncludeClusterResources:  flag.NewOptionalBool(nil),
+		WriteSparseFiles:         flag.NewOptionalBool(nil),
+		RollbackOnFailure:        flag.NewOptionalBool(nil),
 	}
 }
 
@@ -120,10 +134,14 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	flags.Var(&o.IncludeNamespaces, "include-namespaces", "Namespaces to include in the restore (use '*' for all namespaces)")
 	flags.Var(&o.ExcludeNamespaces, "exclude-namespaces", "Namespaces to exclude from the restore.")
 	flags.Var(&o.NamespaceMappings, "namespace-mappings", "Namespace mappings from name in the backup to desired restored name in the form src1:dst1,src2:dst2,...")
+	flags.StringVar(&o.NamespaceMappingPrefix, "namespace-mapping-prefix", "", "Prefix added to the names of the restored namespaces which aren't in --namespace-mappings.")
+	flags.StringVar(&o.NamespaceMappingSuffix, "namespace-mapping-suffix", "", "Suffix added to the names of the restored namespaces which aren't in --namespace-mappings.")
+	flags.StringArrayVar(&o.NamespaceMappingRegexes, "namespace-mapping-regex", nil, "Namespace mappings by regular expression in the form REGEX=REPLACEMENT, such as 'app-(.*)=$1-restored'. The replacement may refer to the regex's capture groups. Optionally, can be specified multiple times, the first matching regex applies.")
 	flags.Var(&o.Labels, "labels", "Labels to apply to the restore.")
 	flags.Var(&o.IncludeResources, "include-resources", "Resources to include in the restore, formatted as resource.group, such as storageclasses.storage.k8s.io (use '*' for all resources).")
 	flags.Var(&o.ExcludeResources, "exclude-resources", "Resources to exclude from the restore, formatted as resource.group, such as storageclasses.storage.k8s.io.")
-	flags.StringVar(&o.ExistingResourcePolicy, "existing-resource-policy", "", "Restore Policy to be used during the restore workflow, can be - none or update")
+	flags.StringVar(&o.ExistingResourcePolicy, "existing-resource-policy", "", "Restore Policy to be used during the restore workflow, can be - none, update, recreate or merge")
+	flags.Var(&o.ExistingResourcePolicies, "existing-resource-policy-overrides", "Restore Policies overriding --existing-resource-policy for specific resources in the form resource1=policy1,resource2=policy2,..., such as deployments.apps=recreate,secrets=none.")
 	flags.Var(&o.StatusIncludeResources, "status-include-resources", "Resources to include in the restore status, formatted as resource.group, such as storageclasses.storage.k8s.io.")
 	flags.Var(&o.StatusExcludeResources, "status-exclude-resources", "Resources to exclude from the restore status, formatted as resource.group, such as storageclasses.storage.k8s.io.")
 	flags.VarP(&o.Selector, "selector", "l", "Only restore resources matching this label selector.")
@@ -151,6 +169,13 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 
 	f = flags.VarPF(&o.WriteSparseFiles, "write-sparse-files", "", "Whether to write sparse files during restoring volumes")
 	f.NoOptDefVal = cmd.TRUE
+
+	flags.Var(&o.IncludePaths, "include-paths", "Paths, relative to the root of the volumes, to restore from the pod volume and data mover snapshots instead of the whole volumes, such as /etc/app/app.conf.")
+
+	f = flags.VarPF(&o.RollbackOnFailure, "rollback-on-failure", "", "Whether to roll back the restore if it ends PartiallyFailed or Failed, deleting the items it created and reverting the items it updated.")
+	f.NoOptDefVal = cmd.TRUE
+
+	flags.BoolVar(&o.DryRun, "dry-run", o.DryRun, "Only preview what the restore would do to each item, without creating or updating anything in the cluster. Run 'velero restore describe --details' for the preview.")
 }
 
 func (o *CreateOptions) Complete(args []string, f client.Factory) error {
@@ -196,8 +221,22 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("either a 'selector' or an 'or-selector' can be specified, but not both")
 	}
 
-	if len(o.ExistingResourcePolicy) > 0 && !isResourcePolicyValid(o.ExistingResourcePolicy) {
-		return errors.New("existing-resource-policy has invalid value, it accepts only none, update as value")
+	if _, err := o.namespaceMappingPatterns(); err != nil {
+		return err
+	}
+
+	if len(o.IncludePaths) > 0 && o.RestoreVolumes.Value != nil && !*o.RestoreVolumes.Value {
+		return errors.New("--include-paths can't be used with --restore-volumes=false")
+	}
+
+	if len(o.ExistingResourcePolicy) > 0 && !pkgrestore.IsExistingResourcePolicyValid(api.PolicyType(o.ExistingResourcePolicy)) {
+		return errors.New("existing-resource-policy has invalid value, it accepts only none, update, recreate, merge as value")
+	}
+
+	for resource, policy := range o.ExistingResourcePolicies.Data() {
+		if !pkgrestore.IsExistingResourcePolicyValid(api.PolicyType(policy)) {
+			return errors.Errorf("existing-resource-policy-overrides has invalid value %q for resource %q, it accepts only none, update, recreate, merge as value", policy, resource)
+		}
 	}
 
 	switch {
@@ -223,6 +262,35 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 	return nil
 }
 
+// namespaceMappingPatterns returns the namespace mapping patterns of the
+// --namespace-mapping-regex flags, followed by a pattern for the prefix and
+// suffix flags. The prefix and suffix are added to the regexes' replacements
+// as well.
+func (o *CreateOptions) namespaceMappingPatterns() ([]api.NamespaceMappingPattern, error) {
+	var patterns []api.NamespaceMappingPattern
+	for _, regex := range o.NamespaceMappingRegexes {
+		i := strings.LastIndex(regex, "=")
+		if i <= 0 || i == len(regex)-1 {
+			return nil, errors.Errorf("invalid namespace mapping regex %q, it must be in the form REGEX=REPLACEMENT", regex)
+		}
+		patterns = append(patterns, api.NamespaceMappingPattern{
+			Regex:       regex[:i],
+			Replacement: regex[i+1:],
+			Prefix:      o.NamespaceMappingPrefix,
+			Suffix:      o.NamespaceMappingSuffix,
+		})
+	}
+
+	if o.NamespaceMappingPrefix != "" || o.NamespaceMappingSuffix != "" {
+		patterns = append(patterns, api.NamespaceMappingPattern{
+			Prefix: o.NamespaceMappingPrefix,
+			Suffix: o.NamespaceMappingSuffix,
+		})
+	}
+
+	return patterns, nil
+}
+
 // mostRecentBackup returns the backup with the most recent start timestamp that has a phase that's
 // in the provided list of allowed phases.
 func mostRecentBackup(backups []api.Backup, allowedPhases ...api.BackupPhase) *api.Backup {
@@ -288,6 +356,11 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		}
 	}
 
+	namespaceMappingPatterns, err := o.namespaceMappingPatterns()
+	if err != nil {
+		return err
+	}
+
 	var resModifiers *corev1.TypedLocalObjectReference = nil
 
 	if o.ResourceModifierConfigMap != "" {
@@ -306,29 +379,37 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 			Labels:    o.Labels.Data(),
 		},
 		Spec: api.RestoreSpec{
-			BackupName:              o.BackupName,
-			ScheduleName:            o.ScheduleName,
-			IncludedNamespaces:      o.IncludeNamespaces,
-			ExcludedNamespaces:      o.ExcludeNamespaces,
-			IncludedResources:       o.IncludeResources,
-			ExcludedResources:       o.ExcludeResources,
-			ExistingResourcePolicy:  api.PolicyType(o.ExistingResourcePolicy),
-			NamespaceMapping:        o.NamespaceMappings.Data(),
-			LabelSelector:           o.Selector.LabelSelector,
-			OrLabelSelectors:        o.OrSelector.OrLabelSelectors,
-			RestorePVs:              o.RestoreVolumes.Value,
-			PreserveNodePorts:       o.PreserveNodePorts.Value,
-			IncludeClusterResources: o.IncludeClusterResources.Value,
-			ResourceModifier:        resModifiers,
+			BackupName:                      o.BackupName,
+			ScheduleName:                    o.ScheduleName,
+			IncludedNamespaces:              o.IncludeNamespaces,
+			ExcludedNamespaces:              o.ExcludeNamespaces,
+			IncludedResources:               o.IncludeResources,
+			ExcludedResources:               o.ExcludeResources,
+			ExistingResourcePolicy:          api.PolicyType(o.ExistingResourcePolicy),
+			ExistingResourcePolicyOverrides: o.existingResourcePolicyOverrides(),
+			NamespaceMapping:                o.NamespaceMappings.Data(),
+			NamespaceMappingPatterns:        namespaceMappingPatterns,
+			LabelSelector:                   o.Selector.LabelSelector,
+			OrLabelSelectors:                o.OrSelector.OrLabelSelectors,
+			RestorePVs:                      o.RestoreVolumes.Value,
+			PreserveNodePorts:               o.PreserveNodePorts.Value,
+			IncludeClusterResources:         o.IncludeClusterResources.Value,
+			ResourceModifier:                resModifiers,
+			RollbackOnFailure:               o.RollbackOnFailure.Value,
 			ItemOperationTimeout: metav1.Duration{
 				Duration: o.ItemOperationTimeout,
 			},
 			UploaderConfig: &api.UploaderConfigForRestore{
 				WriteSparseFiles: o.WriteSparseFiles.Value,
+				IncludedPaths:    o.IncludePaths,
 			},
 		},
 	}
 
+	if o.DryRun {
+		restore.Spec.Preview = boolptr.True()
+	}
+
 	if len([]string(o.StatusIncludeResources)) > 0 {
 		restore.Spec.RestoreStatus = &api.RestoreStatusSpec{
 			IncludedResources: o.StatusIncludeResources,
@@ -384,7 +465,7 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		go restoreInformer.Run(stop)
 	}
 
-	err := o.client.Create(context.TODO(), restore, &kbclient.CreateOptions{})
+	err = o.client.Create(context.TODO(), restore, &kbclient.CreateOptions{})
 	if err != nil {
 		return err
 	}
@@ -406,7 +487,8 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 				}
 
 				if restore.Status.Phase == api.RestorePhaseFailedValidation || restore.Status.Phase == api.RestorePhaseCompleted ||
-					restore.Status.Phase == api.RestorePhasePartiallyFailed || restore.Status.Phase == api.RestorePhaseFailed {
+					restore.Status.Phase == api.RestorePhasePartiallyFailed || restore.Status.Phase == api.RestorePhaseFailed ||
+					restore.Status.Phase == api.RestorePhaseCancelled {
 					fmt.Printf("\nRestore completed with status: %s. You may check for more information using the commands `velero restore describe %s` and `velero restore logs %s`.\n", restore.Status.Phase, restore.Name, restore.Name)
 					return nil
 				}
@@ -421,9 +503,16 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 	return nil
 }
 
-func isResourcePolicyValid(resourcePolicy string) bool {
-	if resourcePolicy == string(api.PolicyTypeNone) || resourcePolicy == string(api.PolicyTypeUpdate) {
-		return true
+// existingResourcePolicyOverrides returns the restore policies of the
+// --existing-resource-policy-overrides flag, or nil if it isn't set.
+func (o *CreateOptions) existingResourcePolicyOverrides() map[string]api.PolicyType {
+	if len(o.ExistingResourcePolicies.Data()) == 0 {
+		return nil
+	}
+
+	overrides := make(map[string]api.PolicyType, len(o.ExistingResourcePolicies.Data()))
+	for resource, policy := range o.ExistingResourcePolicies.Data() {
+		overrides[resource] = api.PolicyType(policy)
 	}
-	return false
+	return overrides
 }
@@ -34,12 +34,6 @@ import (
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 )
 
-func TestIsResourcePolicyValid(t *testing.T) {
-	require.True(t, isResourcePolicyValid(string(velerov1api.PolicyTypeNone)))
-	require.True(t, isResourcePolicyValid(string(velerov1api.PolicyTypeUpdate)))
-	require.False(t, isResourcePolicyValid(""))
-}
-
 func TestMostRecentBackup(t *testing.T) {
 	backups := []velerov1api.Backup{
 		*builder.ForBackup(cmdtest.VeleroNameSpace, "backup0").StartTimestamp(time.Now().Add(3 * time.Second)).Phase(velerov1api.BackupPhaseDeleting).Result(),
@@ -54,6 +48,30 @@ func TestMostRecentBackup(t *testing.T) {
 	require.Equal(t, expectedBackup.Name, resultBackup.Name)
 }
 
+func TestNamespaceMappingPatterns(t *testing.T) {
+	flags := new(pflag.FlagSet)
+	o := NewCreateOptions()
+	o.BindFlags(flags)
+
+	require.NoError(t, flags.Parse([]string{
+		"--namespace-mapping-regex", "app-(.*)=$1-app",
+		"--namespace-mapping-regex", "a=b=c",
+		"--namespace-mapping-prefix", "restored-",
+	}))
+
+	patterns, err := o.namespaceMappingPatterns()
+	require.NoError(t, err)
+	require.Equal(t, []velerov1api.NamespaceMappingPattern{
+		{Regex: "app-(.*)", Replacement: "$1-app", Prefix: "restored-"},
+		{Regex: "a=b", Replacement: "c", Prefix: "restored-"},
+		{Prefix: "restored-"},
+	}, patterns)
+
+	require.NoError(t, flags.Parse([]string{"--namespace-mapping-regex", "app-.*"}))
+	_, err = o.namespaceMappingPatterns()
+	require.EqualError(t, err, `invalid namespace mapping regex "app-.*", it must be in the form REGEX=REPLACEMENT`)
+}
+
 func TestCreateCommand(t *testing.T) {
 	name := "nameToBeCreated"
 	args := []string{name}
@@ -75,6 +93,7 @@ func TestCreateCommand(t *testing.T) {
 		includeNamespaces := "app1,app2"
 		excludeNamespaces := "pod1,pod2,pod3"
 		existingResourcePolicy := "none"
+		existingResourcePolicyOverrides := "deployments.apps=recreate,secrets=merge"
 		includeResources := "sc,sts"
 		excludeResources := "job"
 		statusIncludeResources := "sc,sts"
@@ -85,6 +104,8 @@ func TestCreateCommand(t *testing.T) {
 		allowPartiallyFailed := "true"
 		itemOperationTimeout := "10m0s"
 		writeSparseFiles := "true"
+		rollbackOnFailure := "true"
+		includePaths := "/etc/app.conf,/data"
 
 		flags := new(pflag.FlagSet)
 		o := NewCreateOptions()
@@ -96,6 +117,7 @@ func TestCreateCommand(t *testing.T) {
 		flags.Parse([]string{"--preserve-nodeports", preserveNodePorts})
 		flags.Parse([]string{"--labels", labels})
 		flags.Parse([]string{"--existing-resource-policy", existingResourcePolicy})
+		flags.Parse([]string{"--existing-resource-policy-overrides", existingResourcePolicyOverrides})
 		flags.Parse([]string{"--include-namespaces", includeNamespaces})
 		flags.Parse([]string{"--exclude-namespaces", excludeNamespaces})
 		flags.Parse([]string{"--include-resources", includeResources})
@@ -108,6 +130,8 @@ func TestCreateCommand(t *testing.T) {
 		flags.Parse([]string{"--allow-partially-failed", allowPartiallyFailed})
 		flags.Parse([]string{"--item-operation-timeout", itemOperationTimeout})
 		flags.Parse([]string{"--write-sparse-files", writeSparseFiles})
+		flags.Parse([]string{"--rollback-on-failure", rollbackOnFailure})
+		flags.Parse([]string{"--include-paths", includePaths})
 		client := velerotest.NewFakeControllerRuntimeClient(t).(kbclient.WithWatch)
 
 		f.On("Namespace").Return(mock.Anything)
@@ -133,6 +157,8 @@ func TestCreateCommand(t *testing.T) {
 		require.Equal(t, includeNamespaces, o.IncludeNamespaces.String())
 		require.Equal(t, excludeNamespaces, o.ExcludeNamespaces.String())
 		require.Equal(t, existingResourcePolicy, o.ExistingResourcePolicy)
+		require.Equal(t, map[string]string{"deployments.apps": "recreate", "secrets": "merge"}, o.ExistingResourcePolicies.Data())
+		require.Equal(t, map[string]velerov1api.PolicyType{"deployments.apps": velerov1api.PolicyTypeRecreate, "secrets": velerov1api.PolicyTypeMerge}, o.existingResourcePolicyOverrides())
 		require.Equal(t, includeResources, o.IncludeResources.String())
 		require.Equal(t, excludeResources, o.ExcludeResources.String())
 
@@ -144,6 +170,8 @@ func TestCreateCommand(t *testing.T) {
 		require.Equal(t, allowPartiallyFailed, o.AllowPartiallyFailed.String())
 		require.Equal(t, itemOperationTimeout, o.ItemOperationTimeout.String())
 		require.Equal(t, writeSparseFiles, o.WriteSparseFiles.String())
+		require.Equal(t, rollbackOnFailure, o.RollbackOnFailure.String())
+		require.Equal(t, includePaths, o.IncludePaths.String())
 	})
 
 	t.Run("create a restore from schedule", func(t *testing.T) {
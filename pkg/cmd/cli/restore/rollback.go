@@ -0,0 +1,81 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/cmd"
+)
+
+func NewRollbackCommand(f client.Factory, use string) *cobra.Command {
+	c := &cobra.Command{
+		Use:   use + " NAME",
+		Short: "Roll back a restore",
+		Long: `Roll back a restore, deleting the items the restore created and reverting the items
+it updated to their version before the restore. The restore must have finished processing.`,
+		Example: `  # Roll back the restore "restore-1".
+  velero restore rollback restore-1`,
+		Args: cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			kbClient, err := f.KubebuilderClient()
+			cmd.CheckError(err)
+
+			cmd.CheckError(requestRollback(kbClient, f.Namespace(), args[0]))
+			fmt.Printf("Request to roll back restore %q submitted successfully.\nRun `velero restore describe %s` for more details.\n", args[0], args[0])
+		},
+	}
+
+	return c
+}
+
+// requestRollback annotates the restore so that the server rolls it back.
+func requestRollback(kbClient kbclient.Client, namespace, name string) error {
+	restore := new(velerov1api.Restore)
+	err := kbClient.Get(context.TODO(), kbclient.ObjectKey{Namespace: namespace, Name: name}, restore)
+	if apierrors.IsNotFound(err) {
+		return fmt.Errorf("restore %q does not exist", name)
+	} else if err != nil {
+		return fmt.Errorf("error checking for restore %q: %v", name, err)
+	}
+
+	switch restore.Status.Phase {
+	case velerov1api.RestorePhaseCompleted, velerov1api.RestorePhasePartiallyFailed, velerov1api.RestorePhaseFailed:
+	default:
+		return fmt.Errorf("restore %q can't be rolled back until it's finished processing, its phase is %q", name, restore.Status.Phase)
+	}
+	if restore.Status.Rollback != nil {
+		return fmt.Errorf("restore %q has already been rolled back, the rollback phase is %q", name, restore.Status.Rollback.Phase)
+	}
+
+	original := restore.DeepCopy()
+	if restore.Annotations == nil {
+		restore.Annotations = make(map[string]string)
+	}
+	restore.Annotations[velerov1api.RollbackRequestedAnnotation] = "true"
+	if err := kbClient.Patch(context.TODO(), restore, kbclient.MergeFrom(original)); err != nil {
+		return fmt.Errorf("error requesting the rollback of restore %q: %v", name, err)
+	}
+	return nil
+}
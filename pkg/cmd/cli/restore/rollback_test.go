@@ -0,0 +1,83 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	cmdtest "github.com/vmware-tanzu/velero/pkg/cmd/test"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestRequestRollback(t *testing.T) {
+	rolledBack := builder.ForRestore(cmdtest.VeleroNameSpace, "rolled-back").Phase(velerov1api.RestorePhaseCompleted).Result()
+	rolledBack.Status.Rollback = &velerov1api.RestoreRollbackStatus{Phase: velerov1api.RestoreRollbackPhaseCompleted}
+
+	tests := []struct {
+		name      string
+		restore   string
+		expectErr string
+	}{
+		{
+			name:    "finished restore is annotated",
+			restore: "completed",
+		},
+		{
+			name:      "restore not found",
+			restore:   "not-exist",
+			expectErr: `restore "not-exist" does not exist`,
+		},
+		{
+			name:      "in progress restore can't be rolled back",
+			restore:   "in-progress",
+			expectErr: `restore "in-progress" can't be rolled back until it's finished processing, its phase is "InProgress"`,
+		},
+		{
+			name:      "restore already rolled back",
+			restore:   "rolled-back",
+			expectErr: `restore "rolled-back" has already been rolled back, the rollback phase is "Completed"`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := velerotest.NewFakeControllerRuntimeClient(t,
+				builder.ForRestore(cmdtest.VeleroNameSpace, "completed").Phase(velerov1api.RestorePhasePartiallyFailed).Result(),
+				builder.ForRestore(cmdtest.VeleroNameSpace, "in-progress").Phase(velerov1api.RestorePhaseInProgress).Result(),
+				rolledBack,
+			)
+
+			err := requestRollback(client, cmdtest.VeleroNameSpace, test.restore)
+			if test.expectErr != "" {
+				require.EqualError(t, err, test.expectErr)
+				return
+			}
+			require.NoError(t, err)
+
+			restore := new(velerov1api.Restore)
+			require.NoError(t, client.Get(context.TODO(), kbclient.ObjectKey{Namespace: cmdtest.VeleroNameSpace, Name: test.restore}, restore))
+			assert.Equal(t, "true", restore.Annotations[velerov1api.RollbackRequestedAnnotation])
+		})
+	}
+}
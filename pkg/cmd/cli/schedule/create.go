@@ -19,6 +19,7 @@ package schedule
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
@@ -26,12 +27,14 @@ import (
 	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/cmd"
 	"github.com/vmware-tanzu/velero/pkg/cmd/cli/backup"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/output"
+	pkgschedule "github.com/vmware-tanzu/velero/pkg/schedule"
 )
 
 func NewCreateCommand(f client.Factory, use string) *cobra.Command {
@@ -40,7 +43,7 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
 	c := &cobra.Command{
 		Use:   use + " NAME --schedule",
 		Short: "Create a schedule",
-		Long: `The --schedule flag is required, in cron notation, using UTC time:
+		Long: `The --schedule flag is required, in cron notation, using UTC time unless --time-zone is set:
 
 | Character Position | Character Period | Acceptable Values |
 | -------------------|:----------------:| -----------------:|
@@ -52,7 +55,10 @@ func NewCreateCommand(f client.Factory, use string) *cobra.Command {
 
 The schedule can also be expressed using "@every <duration>" syntax. The duration
 can be specified using a combination of seconds (s), minutes (m), and hours (h), for
-example: "@every 2h30m".`,
+example: "@every 2h30m".
+
+Blackout windows, in which the backups due are skipped or deferred, can be set in the
+blackoutWindows field of the schedule.`,
 
 		Example: `  # Create a backup every 6 hours.
   velero create schedule NAME --schedule="0 */6 * * *"
@@ -63,8 +69,14 @@ example: "@every 2h30m".`,
   # Create a daily backup of the web namespace.
   velero create schedule NAME --schedule="@every 24h" --include-namespaces web
 
+  # Create a daily backup at 2am in the Paris time zone, following the daylight saving time changes.
+  velero create schedule NAME --schedule="0 2 * * *" --time-zone Europe/Paris
+
   # Create a weekly backup, each living for 90 days (2160 hours).
-  velero create schedule NAME --schedule="@every 168h" --ttl 2160h0m0s`,
+  velero create schedule NAME --schedule="@every 168h" --ttl 2160h0m0s
+
+  # Create a daily backup, keeping the backups of the last 7 days, 4 weeks and 12 months.
+  velero create schedule NAME --schedule="0 1 * * *" --keep-daily 7 --keep-weekly 4 --keep-monthly 12`,
 		Args: cobra.ExactArgs(1),
 		Run: func(c *cobra.Command, args []string) {
 			cmd.CheckError(o.Complete(args, f))
@@ -84,8 +96,12 @@ type CreateOptions struct {
 	BackupOptions              *backup.CreateOptions
 	SkipOptions                *SkipOptions
 	Schedule                   string
+	TimeZone                   string
+	OverlapPolicy              string
+	StartingDeadline           time.Duration
 	UseOwnerReferencesInBackup bool
 	Paused                     bool
+	Retention                  api.ScheduleRetention
 }
 
 func NewCreateOptions() *CreateOptions {
@@ -99,8 +115,17 @@ func (o *CreateOptions) BindFlags(flags *pflag.FlagSet) {
 	o.BackupOptions.BindFlags(flags)
 	o.SkipOptions.BindFlags(flags)
 	flags.StringVar(&o.Schedule, "schedule", o.Schedule, "A cron expression specifying a recurring schedule for this backup to run")
+	flags.StringVar(&o.TimeZone, "time-zone", o.TimeZone, "IANA name of the time zone the schedule is evaluated in, for example Europe/Paris. Defaults to UTC.")
+	flags.StringVar(&o.OverlapPolicy, "overlap-policy", o.OverlapPolicy, "What happens when the schedule is due while one of its backups is still running: Skip, Queue or Allow. Defaults to Queue.")
+	flags.DurationVar(&o.StartingDeadline, "starting-deadline", o.StartingDeadline, "How late after its scheduled time a backup can still be started, for example after the Velero server was down. Later runs are missed. Defaults to no deadline.")
 	flags.BoolVar(&o.UseOwnerReferencesInBackup, "use-owner-references-in-backup", o.UseOwnerReferencesInBackup, "Specifies whether to use OwnerReferences on backups created by this Schedule. Notice: if set to true, when schedule is deleted, backups will be deleted too.")
 	flags.BoolVar(&o.Paused, "paused", o.Paused, "Specifies whether the newly created schedule is paused or not.")
+	flags.IntVar(&o.Retention.KeepLast, "keep-last", o.Retention.KeepLast, "Number of latest completed backups to keep. If any --keep-* flag is set, the completed backups are deleted once no retention rule keeps them, instead of when their TTL expires.")
+	flags.IntVar(&o.Retention.KeepHourly, "keep-hourly", o.Retention.KeepHourly, "Number of latest hours to keep the latest completed backup for.")
+	flags.IntVar(&o.Retention.KeepDaily, "keep-daily", o.Retention.KeepDaily, "Number of latest days to keep the latest completed backup for.")
+	flags.IntVar(&o.Retention.KeepWeekly, "keep-weekly", o.Retention.KeepWeekly, "Number of latest weeks to keep the latest completed backup for.")
+	flags.IntVar(&o.Retention.KeepMonthly, "keep-monthly", o.Retention.KeepMonthly, "Number of latest months to keep the latest completed backup for.")
+	flags.IntVar(&o.Retention.KeepYearly, "keep-yearly", o.Retention.KeepYearly, "Number of latest years to keep the latest completed backup for.")
 }
 
 func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Factory) error {
@@ -108,6 +133,26 @@ func (o *CreateOptions) Validate(c *cobra.Command, args []string, f client.Facto
 		return errors.New("--schedule is required")
 	}
 
+	if _, err := time.LoadLocation(o.TimeZone); err != nil {
+		return errors.Wrapf(err, "invalid --time-zone %q", o.TimeZone)
+	}
+
+	switch api.ScheduleOverlapPolicy(o.OverlapPolicy) {
+	case "", api.ScheduleOverlapPolicySkip, api.ScheduleOverlapPolicyQueue, api.ScheduleOverlapPolicyAllow:
+	default:
+		return errors.Errorf("invalid --overlap-policy %q, valid values are Skip, Queue and Allow", o.OverlapPolicy)
+	}
+
+	if o.StartingDeadline < 0 {
+		return errors.New("--starting-deadline must not be negative")
+	}
+
+	if o.Retention != (api.ScheduleRetention{}) {
+		if errs := pkgschedule.ValidateRetention(&o.Retention); len(errs) > 0 {
+			return errors.New(errs[0])
+		}
+	}
+
 	return o.BackupOptions.Validate(c, args, f)
 }
 
@@ -159,8 +204,11 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 				ItemOperationTimeout:             metav1.Duration{Duration: o.BackupOptions.ItemOperationTimeout},
 				DataMover:                        o.BackupOptions.DataMover,
 				SnapshotMoveData:                 o.BackupOptions.SnapshotMoveData.Value,
+				Compression:                      api.BackupCompression(o.BackupOptions.Compression),
 			},
 			Schedule:                   o.Schedule,
+			TimeZone:                   o.TimeZone,
+			OverlapPolicy:              api.ScheduleOverlapPolicy(o.OverlapPolicy),
 			UseOwnerReferencesInBackup: &o.UseOwnerReferencesInBackup,
 			Paused:                     o.Paused,
 			SkipImmediately:            o.SkipOptions.SkipImmediately.Value,
@@ -171,6 +219,18 @@ func (o *CreateOptions) Run(c *cobra.Command, f client.Factory) error {
 		schedule.Spec.Template.ResourcePolicy = &v1.TypedLocalObjectReference{Kind: resourcepolicies.ConfigmapRefType, Name: o.BackupOptions.ResPoliciesConfigmap}
 	}
 
+	if o.BackupOptions.ResourceModifierConfigMap != "" {
+		schedule.Spec.Template.ResourceModifier = &v1.TypedLocalObjectReference{Kind: resourcemodifiers.ConfigmapRefType, Name: o.BackupOptions.ResourceModifierConfigMap}
+	}
+
+	if o.StartingDeadline > 0 {
+		schedule.Spec.StartingDeadline = &metav1.Duration{Duration: o.StartingDeadline}
+	}
+
+	if o.Retention != (api.ScheduleRetention{}) {
+		schedule.Spec.Retention = &o.Retention
+	}
+
 	if o.BackupOptions.ParallelFilesUpload > 0 {
 		schedule.Spec.Template.UploaderConfig = &api.UploaderConfigForBackup{
 			ParallelFilesUpload: o.BackupOptions.ParallelFilesUpload,
@@ -61,7 +61,18 @@ func NewDescribeCommand(f client.Factory, use string) *cobra.Command {
 
 			first := true
 			for i := range schedules.Items {
-				s := output.DescribeSchedule(&schedules.Items[i])
+				var backups []v1.Backup
+				if schedules.Items[i].Spec.Retention != nil {
+					backupList := new(v1.BackupList)
+					err := crClient.List(context.TODO(), backupList, &ctrlclient.ListOptions{
+						Namespace:     f.Namespace(),
+						LabelSelector: labels.SelectorFromSet(map[string]string{v1.ScheduleNameLabel: schedules.Items[i].Name}),
+					})
+					cmd.CheckError(err)
+					backups = backupList.Items
+				}
+
+				s := output.DescribeSchedule(&schedules.Items[i], backups)
 				if first {
 					first = false
 					fmt.Print(s)
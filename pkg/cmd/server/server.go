@@ -35,7 +35,6 @@ import (
 	corev1api "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
@@ -54,6 +53,7 @@ import (
 	"github.com/vmware-tanzu/velero/internal/storage"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	"github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/buildinfo"
 	"github.com/vmware-tanzu/velero/pkg/client"
@@ -109,6 +109,8 @@ const (
 
 	defaultMaxConcurrentK8SConnections = 30
 	defaultDisableInformerCache        = false
+	defaultItemBackupWorkers           = 1
+	defaultItemRestoreWorkers          = 1
 )
 
 type serverConfig struct {
@@ -135,6 +137,9 @@ type serverConfig struct {
 	defaultSnapshotMoveData                                                 bool
 	disableInformerCache                                                    bool
 	scheduleSkipImmediately                                                 bool
+	defaultBackupCompression                                                string
+	itemBackupWorkers                                                       int
+	itemRestoreWorkers                                                      int
 }
 
 func NewCommand(f client.Factory) *cobra.Command {
@@ -166,6 +171,9 @@ func NewCommand(f client.Factory) *cobra.Command {
 			defaultSnapshotMoveData:        false,
 			disableInformerCache:           defaultDisableInformerCache,
 			scheduleSkipImmediately:        false,
+			defaultBackupCompression:       string(velerov1api.BackupCompressionGzip),
+			itemBackupWorkers:              defaultItemBackupWorkers,
+			itemRestoreWorkers:             defaultItemRestoreWorkers,
 		}
 	)
 
@@ -239,6 +247,9 @@ func NewCommand(f client.Factory) *cobra.Command {
 	command.Flags().BoolVar(&config.defaultSnapshotMoveData, "default-snapshot-move-data", config.defaultSnapshotMoveData, "Move data by default for all snapshots supporting data movement.")
 	command.Flags().BoolVar(&config.disableInformerCache, "disable-informer-cache", config.disableInformerCache, "Disable informer cache for Get calls on restore. With this enabled, it will speed up restore in cases where there are backup resources which already exist in the cluster, but for very large clusters this will increase velero memory usage. Default is false (don't disable).")
 	command.Flags().BoolVar(&config.scheduleSkipImmediately, "schedule-skip-immediately", config.scheduleSkipImmediately, "Skip the first scheduled backup immediately after creating a schedule. Default is false (don't skip).")
+	command.Flags().IntVar(&config.itemBackupWorkers, "item-backup-workers", config.itemBackupWorkers, "Number of workers backing up the items of a resource concurrently within a backup. Items of resources with an order specified in the backup's orderedResources are always backed up one at a time.")
+	command.Flags().IntVar(&config.itemRestoreWorkers, "item-restore-workers", config.itemRestoreWorkers, "Number of workers restoring the items of a resource concurrently within a restore. Persistent volumes and persistent volume claims are always restored one at a time.")
+	command.Flags().StringVar(&config.defaultBackupCompression, "default-backup-compression", config.defaultBackupCompression, "Compression algorithm used for backup tarballs that don't specify one. Valid values are gzip, zstd and none. Default is gzip.")
 
 	return command
 }
@@ -270,6 +281,10 @@ type server struct {
 	credentialFileStore   credentials.FileStore
 	credentialSecretStore credentials.SecretStore
 	featureVerifier       features.Verifier
+	// the backups and restores which were in progress when the server
+	// started, which are resumed by their controllers
+	interruptedBackups  controller.InterruptedTracker
+	interruptedRestores controller.InterruptedTracker
 }
 
 func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*server, error) {
@@ -277,6 +292,10 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		return nil, err
 	}
 
+	if err := archive.ValidateCompression(velerov1api.BackupCompression(config.defaultBackupCompression)); err != nil {
+		return nil, err
+	}
+
 	if config.clientQPS < 0.0 {
 		return nil, errors.New("client-qps must be positive")
 	}
@@ -291,6 +310,14 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		return nil, errors.New("client-page-size must not be negative")
 	}
 
+	if config.itemBackupWorkers <= 0 {
+		return nil, errors.New("item-backup-workers must be positive")
+	}
+
+	if config.itemRestoreWorkers <= 0 {
+		return nil, errors.New("item-restore-workers must be positive")
+	}
+
 	kubeClient, err := f.KubeClient()
 	if err != nil {
 		return nil, err
@@ -399,6 +426,8 @@ func newServer(f client.Factory, config serverConfig, logger *logrus.Logger) (*s
 		credentialFileStore:   credentialFileStore,
 		credentialSecretStore: credentialSecretStore,
 		featureVerifier:       featureVerifier,
+		interruptedBackups:    controller.NewInterruptedTracker(),
+		interruptedRestores:   controller.NewInterruptedTracker(),
 	}
 
 	return s, nil
@@ -451,7 +480,7 @@ func (s *server) setupBeforeControllerRun() error {
 		return errors.WithStack(err)
 	}
 
-	markInProgressCRsFailed(s.ctx, client, s.namespace, s.logger)
+	trackInProgressCRs(s.ctx, client, s.namespace, s.interruptedBackups, s.interruptedRestores, s.logger)
 
 	if err := setDefaultBackupLocation(s.ctx, client, s.namespace, s.config.defaultBackupLocation, s.logger); err != nil {
 		return err
@@ -682,18 +711,20 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	// and BSL controller is mandatory for Velero to work.
 	// Note: all runtime type controllers that can be disabled are grouped separately, below:
 	enabledRuntimeControllers := map[string]struct{}{
-		controller.Backup:              {},
-		controller.BackupDeletion:      {},
-		controller.BackupFinalizer:     {},
-		controller.BackupOperations:    {},
-		controller.BackupRepo:          {},
-		controller.BackupSync:          {},
-		controller.DownloadRequest:     {},
-		controller.GarbageCollection:   {},
-		controller.Restore:             {},
-		controller.RestoreOperations:   {},
-		controller.Schedule:            {},
-		controller.ServerStatusRequest: {},
+		controller.Backup:                {},
+		controller.BackupDeletion:        {},
+		controller.BackupFinalizer:       {},
+		controller.BackupOperations:      {},
+		controller.BackupRepo:            {},
+		controller.BackupSync:            {},
+		controller.DownloadRequest:       {},
+		controller.GarbageCollection:     {},
+		controller.Restore:               {},
+		controller.RestoreOperations:     {},
+		controller.RestoreRollback:       {},
+		controller.Schedule:              {},
+		controller.ServerStatusRequest:   {},
+		controller.SnapshotBrowseRequest: {},
 	}
 
 	if s.config.restoreOnly {
@@ -736,8 +767,11 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	}
 
 	if _, ok := enabledRuntimeControllers[controller.Backup]; ok {
+		// the backupper gets PVCs and lists pods in the backed up namespaces to match the
+		// volume policies, which the manager's client can't do since its cache is limited
+		// to Velero's namespace
 		backupper, err := backup.NewKubernetesBackupper(
-			s.mgr.GetClient(),
+			s.crClient,
 			s.discoveryHelper,
 			client.NewDynamicFactory(s.dynamicClient),
 			podexec.NewPodCommandExecutor(s.kubeClientConfig, s.kubeClient.CoreV1().RESTClient()),
@@ -752,6 +786,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.config.defaultVolumesToFsBackup,
 			s.config.clientPageSize,
 			s.config.uploaderType,
+			s.config.itemBackupWorkers,
 		)
 		cmd.CheckError(err)
 		if err := controller.NewBackupReconciler(
@@ -776,7 +811,9 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.credentialFileStore,
 			s.config.maxConcurrentK8SConnections,
 			s.config.defaultSnapshotMoveData,
+			velerov1api.BackupCompression(s.config.defaultBackupCompression),
 			s.crClient,
+			s.interruptedBackups,
 		).SetupWithManager(s.mgr); err != nil {
 			s.logger.Fatal(err, "unable to create controller", "controller", controller.Backup)
 		}
@@ -815,8 +852,9 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 	}
 
 	if _, ok := enabledRuntimeControllers[controller.BackupFinalizer]; ok {
+		// the uncached client is used for the same reason as for the backup controller
 		backupper, err := backup.NewKubernetesBackupper(
-			s.mgr.GetClient(),
+			s.crClient,
 			s.discoveryHelper,
 			client.NewDynamicFactory(s.dynamicClient),
 			podexec.NewPodCommandExecutor(s.kubeClientConfig, s.kubeClient.CoreV1().RESTClient()),
@@ -831,6 +869,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.config.defaultVolumesToFsBackup,
 			s.config.clientPageSize,
 			s.config.uploaderType,
+			s.config.itemBackupWorkers,
 		)
 		cmd.CheckError(err)
 		r := controller.NewBackupFinalizerReconciler(
@@ -891,6 +930,20 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		}
 	}
 
+	if _, ok := enabledRuntimeControllers[controller.RestoreRollback]; ok {
+		r := controller.NewRestoreRollbackReconciler(
+			s.logger,
+			s.namespace,
+			s.mgr.GetClient(),
+			s.dynamicClient,
+			newPluginManager,
+			backupStoreGetter,
+		)
+		if err := r.SetupWithManager(s.mgr); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", controller.RestoreRollback)
+		}
+	}
+
 	if _, ok := enabledRuntimeControllers[controller.DownloadRequest]; ok {
 		r := controller.NewDownloadRequestReconciler(
 			s.mgr.GetClient(),
@@ -937,10 +990,14 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.config.resourceTimeout,
 			s.logger,
 			podexec.NewPodCommandExecutor(s.kubeClientConfig, s.kubeClient.CoreV1().RESTClient()),
+			// the headers secrets of the hooks are in the restored namespaces, which the
+			// manager's cache doesn't cover
+			podexec.NewPodHTTPExecutor(s.crClient),
 			s.kubeClient.CoreV1().RESTClient(),
 			s.credentialFileStore,
 			s.mgr.GetClient(),
 			s.featureVerifier,
+			s.config.itemRestoreWorkers,
 		)
 
 		cmd.CheckError(err)
@@ -958,6 +1015,7 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 			s.config.formatFlag.Parse(),
 			s.config.defaultItemOperationTimeout,
 			s.config.disableInformerCache,
+			s.interruptedRestores,
 		)
 
 		if err = r.SetupWithManager(s.mgr); err != nil {
@@ -983,6 +1041,19 @@ func (s *server) runControllers(defaultVolumeSnapshotLocations map[string]string
 		}
 	}
 
+	if _, ok := enabledRuntimeControllers[controller.SnapshotBrowseRequest]; ok {
+		if err := controller.NewSnapshotBrowseRequestReconciler(
+			s.mgr.GetClient(),
+			s.namespace,
+			s.repoEnsurer,
+			&credentials.CredentialGetter{FromFile: s.credentialFileStore, FromSecret: s.credentialSecretStore},
+			clock.RealClock{},
+			s.logger,
+		).SetupWithManager(s.mgr); err != nil {
+			s.logger.Fatal(err, "unable to create controller", "controller", controller.SnapshotBrowseRequest)
+		}
+	}
+
 	s.logger.Info("Server starting...")
 
 	if err := s.mgr.Start(s.ctx); err != nil {
@@ -1025,129 +1096,43 @@ func (s *server) runProfiler() {
 	}
 }
 
-// if there is a restarting during the reconciling of backups/restores/etc, these CRs may be stuck in progress status
-// markInProgressCRsFailed tries to mark the in progress CRs as failed when starting the server to avoid the issue
-func markInProgressCRsFailed(ctx context.Context, client ctrlclient.Client, namespace string, log logrus.FieldLogger) {
-	markInProgressBackupsFailed(ctx, client, namespace, log)
+// if there is a restarting during the reconciling of backups/restores, these CRs are left in progress
+// trackInProgressCRs records them when starting the server, so their controllers resume them
+func trackInProgressCRs(ctx context.Context, client ctrlclient.Client, namespace string, interruptedBackups, interruptedRestores controller.InterruptedTracker, log logrus.FieldLogger) {
+	trackInProgressBackups(ctx, client, namespace, interruptedBackups, log)
 
-	markInProgressRestoresFailed(ctx, client, namespace, log)
+	trackInProgressRestores(ctx, client, namespace, interruptedRestores, log)
 }
 
-func markInProgressBackupsFailed(ctx context.Context, client ctrlclient.Client, namespace string, log logrus.FieldLogger) {
+func trackInProgressBackups(ctx context.Context, client ctrlclient.Client, namespace string, interruptedBackups controller.InterruptedTracker, log logrus.FieldLogger) {
 	backups := &velerov1api.BackupList{}
 	if err := client.List(ctx, backups, &ctrlclient.ListOptions{Namespace: namespace}); err != nil {
 		log.WithError(errors.WithStack(err)).Error("failed to list backups")
 		return
 	}
 
-	for i, backup := range backups.Items {
+	for _, backup := range backups.Items {
 		if backup.Status.Phase != velerov1api.BackupPhaseInProgress {
 			log.Debugf("the status of backup %q is %q, skip", backup.GetName(), backup.Status.Phase)
 			continue
 		}
-		updated := backup.DeepCopy()
-		updated.Status.Phase = velerov1api.BackupPhaseFailed
-		updated.Status.FailureReason = fmt.Sprintf("found a backup with status %q during the server starting, mark it as %q", backup.Status.Phase, updated.Status.Phase)
-		updated.Status.CompletionTimestamp = &metav1.Time{Time: time.Now()}
-		if err := client.Patch(ctx, updated, ctrlclient.MergeFrom(&backups.Items[i])); err != nil {
-			log.WithError(errors.WithStack(err)).Errorf("failed to patch backup %q", backup.GetName())
-			continue
-		}
-		log.WithField("backup", backup.GetName()).Warn(updated.Status.FailureReason)
-		markDataUploadsCancel(ctx, client, backup, log)
+		interruptedBackups.Add(backup.Namespace, backup.Name)
+		log.WithField("backup", backup.GetName()).Infof("found a backup with status %q during the server starting, it will be resumed", backup.Status.Phase)
 	}
 }
 
-func markInProgressRestoresFailed(ctx context.Context, client ctrlclient.Client, namespace string, log logrus.FieldLogger) {
+func trackInProgressRestores(ctx context.Context, client ctrlclient.Client, namespace string, interruptedRestores controller.InterruptedTracker, log logrus.FieldLogger) {
 	restores := &velerov1api.RestoreList{}
 	if err := client.List(ctx, restores, &ctrlclient.ListOptions{Namespace: namespace}); err != nil {
 		log.WithError(errors.WithStack(err)).Error("failed to list restores")
 		return
 	}
-	for i, restore := range restores.Items {
+	for _, restore := range restores.Items {
 		if restore.Status.Phase != velerov1api.RestorePhaseInProgress {
 			log.Debugf("the status of restore %q is %q, skip", restore.GetName(), restore.Status.Phase)
 			continue
 		}
-		updated := restore.DeepCopy()
-		updated.Status.Phase = velerov1api.RestorePhaseFailed
-		updated.Status.FailureReason = fmt.Sprintf("found a restore with status %q during the server starting, mark it as %q", restore.Status.Phase, updated.Status.Phase)
-		updated.Status.CompletionTimestamp = &metav1.Time{Time: time.Now()}
-		if err := client.Patch(ctx, updated, ctrlclient.MergeFrom(&restores.Items[i])); err != nil {
-			log.WithError(errors.WithStack(err)).Errorf("failed to patch restore %q", restore.GetName())
-			continue
-		}
-		log.WithField("restore", restore.GetName()).Warn(updated.Status.FailureReason)
-		markDataDownloadsCancel(ctx, client, restore, log)
-	}
-}
-
-func markDataUploadsCancel(ctx context.Context, client ctrlclient.Client, backup velerov1api.Backup, log logrus.FieldLogger) {
-	dataUploads := &velerov2alpha1api.DataUploadList{}
-
-	if err := client.List(ctx, dataUploads, &ctrlclient.ListOptions{
-		Namespace: backup.GetNamespace(),
-		LabelSelector: labels.Set(map[string]string{
-			velerov1api.BackupUIDLabel: string(backup.GetUID()),
-		}).AsSelector(),
-	}); err != nil {
-		log.WithError(errors.WithStack(err)).Error("failed to list dataUploads")
-		return
-	}
-
-	for i := range dataUploads.Items {
-		du := dataUploads.Items[i]
-		if du.Status.Phase == velerov2alpha1api.DataUploadPhaseAccepted ||
-			du.Status.Phase == velerov2alpha1api.DataUploadPhasePrepared ||
-			du.Status.Phase == velerov2alpha1api.DataUploadPhaseInProgress ||
-			du.Status.Phase == velerov2alpha1api.DataUploadPhaseNew ||
-			du.Status.Phase == "" {
-			err := controller.UpdateDataUploadWithRetry(ctx, client, types.NamespacedName{Namespace: du.Namespace, Name: du.Name}, log.WithField("dataupload", du.Name),
-				func(dataUpload *velerov2alpha1api.DataUpload) {
-					dataUpload.Spec.Cancel = true
-					dataUpload.Status.Message = fmt.Sprintf("found a dataupload with status %q during the velero server starting, mark it as cancel", du.Status.Phase)
-				})
-
-			if err != nil {
-				log.WithError(errors.WithStack(err)).Errorf("failed to mark dataupload %q cancel", du.GetName())
-				continue
-			}
-			log.WithField("dataupload", du.GetName()).Warn(du.Status.Message)
-		}
-	}
-}
-
-func markDataDownloadsCancel(ctx context.Context, client ctrlclient.Client, restore velerov1api.Restore, log logrus.FieldLogger) {
-	dataDownloads := &velerov2alpha1api.DataDownloadList{}
-
-	if err := client.List(ctx, dataDownloads, &ctrlclient.ListOptions{
-		Namespace: restore.GetNamespace(),
-		LabelSelector: labels.Set(map[string]string{
-			velerov1api.RestoreUIDLabel: string(restore.GetUID()),
-		}).AsSelector(),
-	}); err != nil {
-		log.WithError(errors.WithStack(err)).Error("failed to list dataDownloads")
-		return
-	}
-
-	for i := range dataDownloads.Items {
-		dd := dataDownloads.Items[i]
-		if dd.Status.Phase == velerov2alpha1api.DataDownloadPhaseAccepted ||
-			dd.Status.Phase == velerov2alpha1api.DataDownloadPhasePrepared ||
-			dd.Status.Phase == velerov2alpha1api.DataDownloadPhaseInProgress ||
-			dd.Status.Phase == velerov2alpha1api.DataDownloadPhaseNew ||
-			dd.Status.Phase == "" {
-			err := controller.UpdateDataDownloadWithRetry(ctx, client, types.NamespacedName{Namespace: dd.Namespace, Name: dd.Name}, log.WithField("datadownload", dd.Name),
-				func(dataDownload *velerov2alpha1api.DataDownload) {
-					dataDownload.Spec.Cancel = true
-					dataDownload.Status.Message = fmt.Sprintf("found a datadownload with status %q during the velero server starting, mark it as cancel", dd.Status.Phase)
-				})
-
-			if err != nil {
-				log.WithError(errors.WithStack(err)).Errorf("failed to mark datadownload %q cancel", dd.GetName())
-				continue
-			}
-			log.WithField("datadownload", dd.GetName()).Warn(dd.Status.Message)
-		}
+		interruptedRestores.Add(restore.Namespace, restore.Name)
+		log.WithField("restore", restore.GetName()).Infof("found a restore with status %q during the server starting, it will be resumed", restore.Status.Phase)
 	}
 }
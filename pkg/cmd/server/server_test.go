@@ -129,6 +129,7 @@ func TestRemoveControllers(t *testing.T) {
 				controller.Restore,
 				controller.Schedule,
 				controller.ServerStatusRequest,
+				controller.SnapshotBrowseRequest,
 			},
 			errorExpected: false,
 		},
@@ -151,16 +152,17 @@ func TestRemoveControllers(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			enabledRuntimeControllers := map[string]struct{}{
-				controller.BackupSync:          {},
-				controller.Backup:              {},
-				controller.GarbageCollection:   {},
-				controller.Restore:             {},
-				controller.ServerStatusRequest: {},
-				controller.Schedule:            {},
-				controller.BackupDeletion:      {},
-				controller.BackupRepo:          {},
-				controller.DownloadRequest:     {},
-				controller.BackupOperations:    {},
+				controller.BackupSync:            {},
+				controller.Backup:                {},
+				controller.GarbageCollection:     {},
+				controller.Restore:               {},
+				controller.ServerStatusRequest:   {},
+				controller.Schedule:              {},
+				controller.BackupDeletion:        {},
+				controller.BackupRepo:            {},
+				controller.DownloadRequest:       {},
+				controller.BackupOperations:      {},
+				controller.SnapshotBrowseRequest: {},
 			}
 
 			totalNumOriginalControllers := len(enabledRuntimeControllers)
@@ -284,6 +286,7 @@ func Test_veleroResourcesExist(t *testing.T) {
 				{Kind: "BackupStorageLocation"},
 				{Kind: "VolumeSnapshotLocation"},
 				{Kind: "ServerStatusRequest"},
+				{Kind: "SnapshotBrowseRequest"},
 			},
 		},
 		{
@@ -297,7 +300,7 @@ func Test_veleroResourcesExist(t *testing.T) {
 	assert.Nil(t, server.veleroResourcesExist())
 }
 
-func Test_markInProgressBackupsFailed(t *testing.T) {
+func Test_trackInProgressBackups(t *testing.T) {
 	scheme := runtime.NewScheme()
 	velerov1api.AddToScheme(scheme)
 
@@ -326,18 +329,19 @@ func Test_markInProgressBackupsFailed(t *testing.T) {
 			},
 		}).
 		Build()
-	markInProgressBackupsFailed(context.Background(), c, "velero", logrus.New())
+	interruptedBackups := controller.NewInterruptedTracker()
+	trackInProgressBackups(context.Background(), c, "velero", interruptedBackups, logrus.New())
 
+	assert.True(t, interruptedBackups.Take("velero", "backup01"))
+	assert.False(t, interruptedBackups.Take("velero", "backup02"))
+
+	// the backup is left in progress for the backup controller to resume it
 	backup01 := &velerov1api.Backup{}
 	require.Nil(t, c.Get(context.Background(), client.ObjectKey{Namespace: "velero", Name: "backup01"}, backup01))
-	assert.Equal(t, velerov1api.BackupPhaseFailed, backup01.Status.Phase)
-
-	backup02 := &velerov1api.Backup{}
-	require.Nil(t, c.Get(context.Background(), client.ObjectKey{Namespace: "velero", Name: "backup02"}, backup02))
-	assert.Equal(t, velerov1api.BackupPhaseCompleted, backup02.Status.Phase)
+	assert.Equal(t, velerov1api.BackupPhaseInProgress, backup01.Status.Phase)
 }
 
-func Test_markInProgressRestoresFailed(t *testing.T) {
+func Test_trackInProgressRestores(t *testing.T) {
 	scheme := runtime.NewScheme()
 	velerov1api.AddToScheme(scheme)
 
@@ -366,15 +370,16 @@ func Test_markInProgressRestoresFailed(t *testing.T) {
 			},
 		}).
 		Build()
-	markInProgressRestoresFailed(context.Background(), c, "velero", logrus.New())
+	interruptedRestores := controller.NewInterruptedTracker()
+	trackInProgressRestores(context.Background(), c, "velero", interruptedRestores, logrus.New())
 
+	assert.True(t, interruptedRestores.Take("velero", "restore01"))
+	assert.False(t, interruptedRestores.Take("velero", "restore02"))
+
+	// the restore is left in progress for the restore controller to resume it
 	restore01 := &velerov1api.Restore{}
 	require.Nil(t, c.Get(context.Background(), client.ObjectKey{Namespace: "velero", Name: "restore01"}, restore01))
-	assert.Equal(t, velerov1api.RestorePhaseFailed, restore01.Status.Phase)
-
-	restore02 := &velerov1api.Restore{}
-	require.Nil(t, c.Get(context.Background(), client.ObjectKey{Namespace: "velero", Name: "restore02"}, restore02))
-	assert.Equal(t, velerov1api.RestorePhaseCompleted, restore02.Status.Phase)
+	assert.Equal(t, velerov1api.RestorePhaseInProgress, restore01.Status.Phase)
 }
 
 func Test_setDefaultBackupLocation(t *testing.T) {
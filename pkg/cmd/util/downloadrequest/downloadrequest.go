@@ -17,6 +17,7 @@ limitations under the License.
 package downloadrequest
 
 import (
+	"bufio"
 	"compress/gzip"
 	"context"
 	"crypto/tls"
@@ -34,6 +35,8 @@ import (
 
 	veleroV1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/util/encryption"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
 // ErrNotFound is exported for external packages to check for when a file is
@@ -50,6 +53,35 @@ func Stream(
 	timeout time.Duration,
 	insecureSkipTLSVerify bool,
 	caCertFile string,
+) error {
+	return stream(ctx, kbClient, namespace, name, kind, w, timeout, insecureSkipTLSVerify, caCertFile, true)
+}
+
+// StreamRaw is like Stream, but writes the file as Velero wrote it to object
+// storage, without decompressing it. Encrypted files are still decrypted.
+func StreamRaw(
+	ctx context.Context,
+	kbClient kbclient.Client,
+	namespace, name string,
+	kind veleroV1api.DownloadTargetKind,
+	w io.Writer,
+	timeout time.Duration,
+	insecureSkipTLSVerify bool,
+	caCertFile string,
+) error {
+	return stream(ctx, kbClient, namespace, name, kind, w, timeout, insecureSkipTLSVerify, caCertFile, false)
+}
+
+func stream(
+	ctx context.Context,
+	kbClient kbclient.Client,
+	namespace, name string,
+	kind veleroV1api.DownloadTargetKind,
+	w io.Writer,
+	timeout time.Duration,
+	insecureSkipTLSVerify bool,
+	caCertFile string,
+	decompress bool,
 ) error {
 	ctx, cancel := context.WithTimeout(ctx, timeout)
 	defer cancel()
@@ -59,7 +91,11 @@ func Stream(
 		return err
 	}
 
-	if err := download(ctx, downloadURL, kind, w, insecureSkipTLSVerify, caCertFile); err != nil {
+	getEncryptionKey := func() ([]byte, error) {
+		return encryptionKey(ctx, kbClient, namespace, name, kind)
+	}
+
+	if err := download(ctx, downloadURL, kind, w, insecureSkipTLSVerify, caCertFile, getEncryptionKey, decompress); err != nil {
 		return err
 	}
 
@@ -109,6 +145,8 @@ func download(
 	w io.Writer,
 	insecureSkipTLSVerify bool,
 	caCertFile string,
+	getEncryptionKey func() ([]byte, error),
+	decompress bool,
 ) error {
 	var caPool *x509.CertPool
 	if len(caCertFile) > 0 {
@@ -172,10 +210,24 @@ func download(
 		return errors.Errorf("request failed: %v", string(body))
 	}
 
-	reader := resp.Body
-	if kind != veleroV1api.DownloadTargetKindBackupContents {
+	// objects are encrypted if the backup storage location has an encryption key,
+	// in which case the key is fetched from the location's Secret to decrypt them
+	bufReader := bufio.NewReader(resp.Body)
+	var reader io.Reader = bufReader
+	if encryption.IsEncrypted(bufReader) {
+		key, err := getEncryptionKey()
+		if err != nil {
+			return err
+		}
+
+		if reader, err = encryption.NewDecryptingReader(key, bufReader); err != nil {
+			return err
+		}
+	}
+
+	if decompress && kind != veleroV1api.DownloadTargetKindBackupContents {
 		// need to decompress logs
-		gzipReader, err := gzip.NewReader(resp.Body)
+		gzipReader, err := gzip.NewReader(reader)
 		if err != nil {
 			return err
 		}
@@ -186,3 +238,48 @@ func download(
 	_, err = io.Copy(w, reader)
 	return err
 }
+
+// encryptionKey returns the encryption key of the backup storage location
+// holding the object targeted by the download request.
+func encryptionKey(
+	ctx context.Context,
+	kbClient kbclient.Client,
+	namespace, name string,
+	kind veleroV1api.DownloadTargetKind,
+) ([]byte, error) {
+	backupName := name
+	switch kind {
+	case veleroV1api.DownloadTargetKindRestoreLog,
+		veleroV1api.DownloadTargetKindRestoreResults,
+		veleroV1api.DownloadTargetKindRestoreResourceList,
+		veleroV1api.DownloadTargetKindRestoreItemOperations,
+		veleroV1api.DownloadTargetKindRestoreHookResults,
+		veleroV1api.DownloadTargetKindRestorePreview:
+		restore := &veleroV1api.Restore{}
+		if err := kbClient.Get(ctx, kbclient.ObjectKey{Namespace: namespace, Name: name}, restore); err != nil {
+			return nil, errors.Wrap(err, "error getting restore to find its encryption key")
+		}
+		backupName = restore.Spec.BackupName
+	}
+
+	backup := &veleroV1api.Backup{}
+	if err := kbClient.Get(ctx, kbclient.ObjectKey{Namespace: namespace, Name: backupName}, backup); err != nil {
+		return nil, errors.Wrap(err, "error getting backup to find its encryption key")
+	}
+
+	location := &veleroV1api.BackupStorageLocation{}
+	if err := kbClient.Get(ctx, kbclient.ObjectKey{Namespace: namespace, Name: backup.Spec.StorageLocation}, location); err != nil {
+		return nil, errors.Wrap(err, "error getting backup storage location to find its encryption key")
+	}
+
+	if location.Spec.ObjectStorage == nil || location.Spec.ObjectStorage.Encryption == nil || location.Spec.ObjectStorage.Encryption.Key == nil {
+		return nil, errors.Errorf("backup storage location %q has no encryption key configured", location.Name)
+	}
+
+	data, err := kube.GetSecretKey(kbClient, namespace, location.Spec.ObjectStorage.Encryption.Key)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting encryption key")
+	}
+
+	return encryption.ParseKey(data)
+}
@@ -34,6 +34,7 @@ import (
 	"github.com/fatih/color"
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/vmware-tanzu/velero/internal/hook"
 	veleroapishared "github.com/vmware-tanzu/velero/pkg/apis/velero/shared"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
@@ -82,14 +83,32 @@ func DescribeBackup(
 		if backup.Status.Phase == velerov1api.BackupPhaseFailed || backup.Status.Phase == velerov1api.BackupPhasePartiallyFailed {
 			logsNote = fmt.Sprintf(" (run `velero backup logs %s` for more information)", backup.Name)
 		}
+		if boolptr.IsSetToTrue(backup.Spec.Cancel) {
+			switch phase {
+			case velerov1api.BackupPhaseNew, velerov1api.BackupPhaseInProgress,
+				velerov1api.BackupPhaseWaitingForPluginOperations, velerov1api.BackupPhaseWaitingForPluginOperationsPartiallyFailed,
+				velerov1api.BackupPhaseFinalizing, velerov1api.BackupPhaseFinalizingPartiallyFailed:
+				logsNote = " (cancel requested)"
+			}
+		}
 
 		d.Printf("Phase:\t%s%s\n", phaseString, logsNote)
 
+		if boolptr.IsSetToTrue(backup.Spec.DryRun) {
+			d.Println()
+			d.Printf("Dry run:\ttrue (no snapshots were taken and no backup contents were uploaded, run `velero backup describe %s --details` for the resources and volumes that would be backed up)\n", backup.Name)
+		}
+
 		if backup.Spec.ResourcePolicy != nil {
 			d.Println()
 			DescribeResourcePolicies(d, backup.Spec.ResourcePolicy)
 		}
 
+		if backup.Spec.ResourceModifier != nil {
+			d.Println()
+			DescribeResourceModifier(d, backup.Spec.ResourceModifier)
+		}
+
 		if backup.Spec.UploaderConfig != nil && backup.Spec.UploaderConfig.ParallelFilesUpload > 0 {
 			d.Println()
 			DescribeUploaderConfigForBackup(d, backup.Spec)
@@ -127,6 +146,13 @@ func DescribeResourcePolicies(d *Describer, resPolicies *v1.TypedLocalObjectRefe
 	d.Printf("\tName:\t%s\n", resPolicies.Name)
 }
 
+// DescribeResourceModifier describes resource modifier in human-readable format
+func DescribeResourceModifier(d *Describer, resModifier *v1.TypedLocalObjectReference) {
+	d.Printf("Resource modifier:\n")
+	d.Printf("\tType:\t%s\n", resModifier.Kind)
+	d.Printf("\tName:\t%s\n", resModifier.Name)
+}
+
 // DescribeUploaderConfigForBackup describes uploader config in human-readable format
 func DescribeUploaderConfigForBackup(d *Describer, spec velerov1api.BackupSpec) {
 	d.Printf("Uploader config:\n")
@@ -288,6 +314,11 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 					d.Printf("\t\t\t\tOn Error:\t%s\n", hook.Exec.OnError)
 					d.Printf("\t\t\t\tTimeout:\t%s\n", hook.Exec.Timeout.Duration)
 				}
+				if hook.HTTP != nil {
+					d.Println()
+					d.Printf("\t\t\tPre HTTP Hook:\n")
+					describeHTTPHook(d, hook.HTTP)
+				}
 			}
 
 			for _, hook := range backupResourceHookSpec.PostHooks {
@@ -299,6 +330,11 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 					d.Printf("\t\t\t\tOn Error:\t%s\n", hook.Exec.OnError)
 					d.Printf("\t\t\t\tTimeout:\t%s\n", hook.Exec.Timeout.Duration)
 				}
+				if hook.HTTP != nil {
+					d.Println()
+					d.Printf("\t\t\tPost HTTP Hook:\n")
+					describeHTTPHook(d, hook.HTTP)
+				}
 			}
 		}
 	}
@@ -312,6 +348,50 @@ func DescribeBackupSpec(d *Describer, spec velerov1api.BackupSpec) {
 	}
 }
 
+func describeHTTPHook(d *Describer, hook *velerov1api.HTTPHook) {
+	d.Printf("\t\t\t\tTarget:\t%s\n", httpHookTarget(hook))
+	d.Printf("\t\t\t\tMethod:\t%s\n", httpHookMethod(hook))
+	if hook.HeadersSecret != "" {
+		d.Printf("\t\t\t\tHeaders Secret:\t%s\n", hook.HeadersSecret)
+	}
+	if len(hook.ExpectedStatusCodes) > 0 {
+		d.Printf("\t\t\t\tExpected Status Codes:\t%s\n", httpHookExpectedStatusCodes(hook))
+	}
+	d.Printf("\t\t\t\tOn Error:\t%s\n", hook.OnError)
+	d.Printf("\t\t\t\tTimeout:\t%s\n", hook.Timeout.Duration)
+}
+
+// httpHookTarget returns the URL of an HTTP hook, or its scheme, port and path on the pod.
+func httpHookTarget(hook *velerov1api.HTTPHook) string {
+	if hook.URL != "" {
+		return hook.URL
+	}
+	scheme := hook.Scheme
+	if scheme == "" {
+		scheme = "http"
+	}
+	path := hook.Path
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return fmt.Sprintf("%s://<pod IP>:%d%s", scheme, hook.Port, path)
+}
+
+func httpHookMethod(hook *velerov1api.HTTPHook) string {
+	if hook.Method == "" {
+		return "POST"
+	}
+	return hook.Method
+}
+
+func httpHookExpectedStatusCodes(hook *velerov1api.HTTPHook) string {
+	codes := make([]string, 0, len(hook.ExpectedStatusCodes))
+	for _, code := range hook.ExpectedStatusCodes {
+		codes = append(codes, strconv.Itoa(int(code)))
+	}
+	return strings.Join(codes, ", ")
+}
+
 // DescribeBackupStatus describes a backup status in human-readable format.
 func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Describer, backup *velerov1api.Backup, details bool,
 	insecureSkipTLSVerify bool, caCertPath string, podVolumeBackups []velerov1api.PodVolumeBackup) {
@@ -319,6 +399,7 @@ func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Desc
 
 	// Status.Version has been deprecated, use Status.FormatVersion
 	d.Printf("Backup Format Version:\t%s\n", status.FormatVersion)
+	d.Printf("Backup Compression:\t%s\n", backupCompression(backup))
 
 	d.Println()
 	// "<n/a>" output should only be applicable for backups that failed validation
@@ -365,6 +446,100 @@ func DescribeBackupStatus(ctx context.Context, kbClient kbclient.Client, d *Desc
 		d.Println()
 		d.Printf("HooksAttempted:\t%d\n", status.HookStatus.HooksAttempted)
 		d.Printf("HooksFailed:\t%d\n", status.HookStatus.HooksFailed)
+
+		if details {
+			d.Println()
+			describeHookResults(ctx, kbClient, d, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupHookResults, insecureSkipTLSVerify, caCertPath)
+		}
+	}
+}
+
+// describeHookResults downloads the results of the hooks of a backup or a restore, and describes
+// them in human-readable format.
+func describeHookResults(ctx context.Context, kbClient kbclient.Client, d *Describer, namespace, name string, kind velerov1api.DownloadTargetKind,
+	insecureSkipTLSVerify bool, caCertPath string) {
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.Stream(ctx, kbClient, namespace, name, kind, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+		if err == downloadrequest.ErrNotFound {
+			// the hook results are missing for backups and restores created before they were introduced
+			d.Println("Hook Results:\t<hook results not found>")
+		} else {
+			d.Printf("Hook Results:\t<error getting hook results: %v>\n", err)
+		}
+		return
+	}
+
+	var hookResults []hook.HookResult
+	if err := json.NewDecoder(buf).Decode(&hookResults); err != nil {
+		d.Printf("Hook Results:\t<error reading hook results: %v>\n", err)
+		return
+	}
+
+	describeHookResultList(d, hookResults)
+}
+
+func describeHookResultList(d *Describer, hookResults []hook.HookResult) {
+	if len(hookResults) == 0 {
+		d.Println("Hook Results:\t<none>")
+		return
+	}
+
+	d.Println("Hook Results:")
+	for _, result := range hookResults {
+		describeHookResult(d, result)
+	}
+}
+
+func describeHookResult(d *Describer, result hook.HookResult) {
+	status := "Completed"
+	switch {
+	case !result.Executed:
+		status = "Not executed"
+	case result.Failed:
+		status = "Failed"
+	}
+
+	hookName := result.HookName
+	if hookName == "" {
+		hookName = "<from-annotation>"
+	}
+	d.Printf("\t%s/%s %s:\n", result.PodNamespace, result.PodName, hookName)
+
+	hookType := result.HookType
+	if result.HookPhase != "" {
+		hookType = result.HookPhase + " " + hookType
+	}
+	d.Printf("\t\tStatus:\t%s\n", status)
+	d.Printf("\t\tType:\t%s (from %s)\n", hookType, result.HookSource)
+	if result.Container != "" {
+		d.Printf("\t\tContainer:\t%s\n", result.Container)
+	}
+	if result.StartTimestamp != nil {
+		d.Printf("\t\tStarted:\t%s\n", result.StartTimestamp.Time.Format("2006-01-02 15:04:05 -0700 MST"))
+		d.Printf("\t\tDuration:\t%s\n", result.Duration.Duration)
+	}
+	if result.ExitCode != nil {
+		d.Printf("\t\tExit Code:\t%d\n", *result.ExitCode)
+	}
+	if result.StatusCode != 0 {
+		d.Printf("\t\tStatus Code:\t%d\n", result.StatusCode)
+	}
+	if result.Error != "" {
+		d.Printf("\t\tError:\t%s\n", result.Error)
+	}
+	describeHookOutput(d, "Stdout", result.Stdout)
+	describeHookOutput(d, "Stderr", result.Stderr)
+}
+
+func describeHookOutput(d *Describer, name, output string) {
+	output = strings.TrimRight(output, "\n")
+	if output == "" {
+		return
+	}
+
+	d.Printf("\t\t%s:\n", name)
+	for _, line := range strings.Split(output, "\n") {
+		d.Printf("\t\t\t%s\n", line)
 	}
 }
 
@@ -914,3 +1089,12 @@ func DescribeBackupResults(ctx context.Context, kbClient kbclient.Client, d *Des
 		describeResult(d, "Errors", resultMap["errors"])
 	}
 }
+
+// backupCompression returns the compression of the backup tarball, backups
+// which don't record one are compressed with gzip.
+func backupCompression(backup *velerov1api.Backup) velerov1api.BackupCompression {
+	if backup.Spec.Compression == "" {
+		return velerov1api.BackupCompressionGzip
+	}
+	return backup.Spec.Compression
+}
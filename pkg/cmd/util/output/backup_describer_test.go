@@ -22,6 +22,7 @@ import (
 	"text/tabwriter"
 	"time"
 
+	"github.com/vmware-tanzu/velero/internal/hook"
 	"github.com/vmware-tanzu/velero/internal/volume"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 
@@ -29,6 +30,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/vmware-tanzu/velero/pkg/builder"
 
@@ -71,6 +73,138 @@ func TestDescribeResourcePolicies(t *testing.T) {
 	assert.Equal(t, expect, d.buf.String())
 }
 
+func TestDescribeHTTPHook(t *testing.T) {
+	tests := []struct {
+		name   string
+		hook   *velerov1api.HTTPHook
+		expect string
+	}{
+		{
+			name: "hook with a port on the pod",
+			hook: &velerov1api.HTTPHook{
+				Port:    8080,
+				Path:    "quiesce",
+				OnError: velerov1api.HookErrorModeFail,
+			},
+			expect: `        Target:    http://<pod IP>:8080/quiesce
+        Method:    POST
+        On Error:  Fail
+        Timeout:   0s
+`,
+		},
+		{
+			name: "hook with a URL",
+			hook: &velerov1api.HTTPHook{
+				URL:                 "https://example.com/quiesce",
+				Method:              "PUT",
+				HeadersSecret:       "headers",
+				ExpectedStatusCodes: []int32{200, 204},
+				Timeout:             metav1.Duration{Duration: time.Minute},
+			},
+			expect: `        Target:                 https://example.com/quiesce
+        Method:                 PUT
+        Headers Secret:         headers
+        Expected Status Codes:  200, 204
+        On Error:               
+        Timeout:                1m0s
+`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := &Describer{
+				Prefix: "",
+				out:    &tabwriter.Writer{},
+				buf:    &bytes.Buffer{},
+			}
+			d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+			describeHTTPHook(d, test.hook)
+			d.out.Flush()
+			assert.Equal(t, test.expect, d.buf.String())
+		})
+	}
+}
+
+func TestDescribeHookResultList(t *testing.T) {
+	exitCode := 1
+	tests := []struct {
+		name        string
+		hookResults []hook.HookResult
+		expect      string
+	}{
+		{
+			name:   "no hook results",
+			expect: "Hook Results:  <none>\n",
+		},
+		{
+			name: "executed and unexecuted hooks",
+			hookResults: []hook.HookResult{
+				{
+					PodNamespace:   "ns-1",
+					PodName:        "pod-1",
+					Container:      "db",
+					HookName:       "freeze",
+					HookSource:     hook.HookSourceSpec,
+					HookPhase:      "pre",
+					HookType:       hook.HookTypeExec,
+					Failed:         true,
+					Executed:       true,
+					ExitCode:       &exitCode,
+					StartTimestamp: &metav1.Time{Time: time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+					Duration:       metav1.Duration{Duration: 1500 * time.Millisecond},
+					Stdout:         "line 1\nline 2\n",
+					Stderr:         "permission denied",
+					Error:          "command terminated with exit code 1",
+				},
+				{
+					PodNamespace: "ns-1",
+					PodName:      "pod-2",
+					HookSource:   hook.HookSourceAnnotation,
+					HookType:     hook.HookTypeHTTP,
+					Container:    "app",
+					Failed:       true,
+					Error:        "hook not executed",
+				},
+			},
+			expect: `Hook Results:
+  ns-1/pod-1 freeze:
+    Status:     Failed
+    Type:       pre exec (from spec)
+    Container:  db
+    Started:    2024-01-02 03:04:05 +0000 UTC
+    Duration:   1.5s
+    Exit Code:  1
+    Error:      command terminated with exit code 1
+    Stdout:
+      line 1
+      line 2
+    Stderr:
+      permission denied
+  ns-1/pod-2 <from-annotation>:
+    Status:     Not executed
+    Type:       http (from annotation)
+    Container:  app
+    Error:      hook not executed
+`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			d := &Describer{
+				Prefix: "",
+				out:    &tabwriter.Writer{},
+				buf:    &bytes.Buffer{},
+			}
+			d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+			describeHookResultList(d, test.hookResults)
+			d.out.Flush()
+			assert.Equal(t, test.expect, d.buf.String())
+		})
+	}
+}
+
 func TestDescribeBackupSpec(t *testing.T) {
 	input1 := builder.ForBackup("test-ns", "test-backup-1").
 		IncludedNamespaces("inc-ns-1", "inc-ns-2").
@@ -28,6 +28,7 @@ import (
 
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/vmware-tanzu/velero/internal/hook"
 	"github.com/vmware-tanzu/velero/internal/volume"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
@@ -52,10 +53,18 @@ func DescribeBackupInSF(
 
 		d.Describe("phase", backup.Status.Phase)
 
+		if boolptr.IsSetToTrue(backup.Spec.DryRun) {
+			d.Describe("dryRun", true)
+		}
+
 		if backup.Spec.ResourcePolicy != nil {
 			DescribeResourcePoliciesInSF(d, backup.Spec.ResourcePolicy)
 		}
 
+		if backup.Spec.ResourceModifier != nil {
+			DescribeResourceModifierInSF(d, backup.Spec.ResourceModifier)
+		}
+
 		status := backup.Status
 		if len(status.ValidationErrors) > 0 {
 			d.Describe("validationErrors", status.ValidationErrors)
@@ -195,6 +204,16 @@ func DescribeBackupSpecInSF(d *StructuredDescriber, spec velerov1api.BackupSpec)
 		}
 		ResourceDetails["preExecHook"] = preHooks
 
+		preHTTPHooks := make([]map[string]interface{}, 0)
+		for _, hook := range backupResourceHookSpec.PreHooks {
+			if hook.HTTP != nil {
+				preHTTPHooks = append(preHTTPHooks, describeHTTPHookInSF(hook.HTTP))
+			}
+		}
+		if len(preHTTPHooks) > 0 {
+			ResourceDetails["preHTTPHook"] = preHTTPHooks
+		}
+
 		postHooks := make([]map[string]interface{}, 0)
 		for _, hook := range backupResourceHookSpec.PostHooks {
 			if hook.Exec != nil {
@@ -207,6 +226,16 @@ func DescribeBackupSpecInSF(d *StructuredDescriber, spec velerov1api.BackupSpec)
 			}
 		}
 		ResourceDetails["postExecHook"] = postHooks
+
+		postHTTPHooks := make([]map[string]interface{}, 0)
+		for _, hook := range backupResourceHookSpec.PostHooks {
+			if hook.HTTP != nil {
+				postHTTPHooks = append(postHTTPHooks, describeHTTPHookInSF(hook.HTTP))
+			}
+		}
+		if len(postHTTPHooks) > 0 {
+			ResourceDetails["postHTTPHook"] = postHTTPHooks
+		}
 		hooksResources[backupResourceHookSpec.Name] = ResourceDetails
 	}
 	if len(spec.Hooks.Resources) > 0 {
@@ -222,6 +251,21 @@ func DescribeBackupSpecInSF(d *StructuredDescriber, spec velerov1api.BackupSpec)
 	d.Describe("spec", backupSpecInfo)
 }
 
+func describeHTTPHookInSF(hook *velerov1api.HTTPHook) map[string]interface{} {
+	httpHook := make(map[string]interface{})
+	httpHook["target"] = httpHookTarget(hook)
+	httpHook["method"] = httpHookMethod(hook)
+	if hook.HeadersSecret != "" {
+		httpHook["headersSecret"] = hook.HeadersSecret
+	}
+	if len(hook.ExpectedStatusCodes) > 0 {
+		httpHook["expectedStatusCodes"] = httpHookExpectedStatusCodes(hook)
+	}
+	httpHook["onError"] = hook.OnError
+	httpHook["timeout"] = hook.Timeout.Duration.String()
+	return httpHook
+}
+
 // DescribeBackupStatusInSF describes a backup status in structured format.
 func DescribeBackupStatusInSF(ctx context.Context, kbClient kbclient.Client, d *StructuredDescriber, backup *velerov1api.Backup, details bool,
 	insecureSkipTLSVerify bool, caCertPath string, podVolumeBackups []velerov1api.PodVolumeBackup) {
@@ -230,6 +274,7 @@ func DescribeBackupStatusInSF(ctx context.Context, kbClient kbclient.Client, d *
 
 	// Status.Version has been deprecated, use Status.FormatVersion
 	backupStatusInfo["backupFormatVersion"] = status.FormatVersion
+	backupStatusInfo["backupCompression"] = backupCompression(backup)
 
 	// "<n/a>" output should only be applicable for backups that failed validation
 	if status.StartTimestamp == nil || status.StartTimestamp.Time.IsZero() {
@@ -269,7 +314,30 @@ func DescribeBackupStatusInSF(ctx context.Context, kbClient kbclient.Client, d *
 	if status.HookStatus != nil {
 		backupStatusInfo["hooksAttempted"] = status.HookStatus.HooksAttempted
 		backupStatusInfo["hooksFailed"] = status.HookStatus.HooksFailed
+
+		if details {
+			describeBackupHookResultsInSF(ctx, kbClient, backupStatusInfo, backup, insecureSkipTLSVerify, caCertPath)
+		}
+	}
+}
+
+func describeBackupHookResultsInSF(ctx context.Context, kbClient kbclient.Client, backupStatusInfo map[string]interface{}, backup *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) {
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.Stream(ctx, kbClient, backup.Namespace, backup.Name, velerov1api.DownloadTargetKindBackupHookResults, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+		if err == downloadrequest.ErrNotFound {
+			backupStatusInfo["errorGettingHookResults"] = "<hook results not found>"
+		} else {
+			backupStatusInfo["errorGettingHookResults"] = fmt.Sprintf("<error getting hook results: %v>", err)
+		}
+		return
+	}
+
+	var hookResults []hook.HookResult
+	if err := json.NewDecoder(buf).Decode(&hookResults); err != nil {
+		backupStatusInfo["errorGettingHookResults"] = fmt.Sprintf("<error reading hook results: %v>", err)
+		return
 	}
+	backupStatusInfo["hookResults"] = hookResults
 }
 
 func describeBackupResourceListInSF(ctx context.Context, kbClient kbclient.Client, backupStatusInfo map[string]interface{}, backup *velerov1api.Backup, insecureSkipTLSVerify bool, caCertPath string) {
@@ -581,6 +649,14 @@ func DescribeResourcePoliciesInSF(d *StructuredDescriber, resPolicies *v1.TypedL
 	d.Describe("resourcePolicies", policiesInfo)
 }
 
+// DescribeResourceModifierInSF describes resource modifier in structured format.
+func DescribeResourceModifierInSF(d *StructuredDescriber, resModifier *v1.TypedLocalObjectReference) {
+	modifierInfo := make(map[string]interface{})
+	modifierInfo["type"] = resModifier.Kind
+	modifierInfo["name"] = resModifier.Name
+	d.Describe("resourceModifier", modifierInfo)
+}
+
 func describeResultInSF(m map[string]interface{}, result results.Result) {
 	m["velero"], m["cluster"], m["namespace"] = []string{}, []string{}, []string{}
 
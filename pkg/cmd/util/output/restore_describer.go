@@ -29,6 +29,7 @@ import (
 
 	"github.com/fatih/color"
 
+	"github.com/vmware-tanzu/velero/internal/restorepreview"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/cmd/util/downloadrequest"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
@@ -63,8 +64,20 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 		if phase == velerov1api.RestorePhaseFailed || phase == velerov1api.RestorePhasePartiallyFailed {
 			resultsNote = fmt.Sprintf(" (run 'velero restore logs %s' for more information)", restore.Name)
 		}
+		if boolptr.IsSetToTrue(restore.Spec.Cancel) {
+			switch phase {
+			case velerov1api.RestorePhaseNew, velerov1api.RestorePhaseInProgress,
+				velerov1api.RestorePhaseWaitingForPluginOperations, velerov1api.RestorePhaseWaitingForPluginOperationsPartiallyFailed:
+				resultsNote = " (cancel requested)"
+			}
+		}
 
 		d.Printf("Phase:\t%s%s\n", phaseString, resultsNote)
+
+		if boolptr.IsSetToTrue(restore.Spec.Preview) {
+			d.Println()
+			d.Printf("Preview:\ttrue (nothing was created or updated in the cluster, run `velero restore describe %s --details` for what the restore would do)\n", restore.Name)
+		}
 		if restore.Status.Progress != nil {
 			if restore.Status.Phase == velerov1api.RestorePhaseInProgress {
 				d.Printf("Estimated total items to be restored:\t%d\n", restore.Status.Progress.TotalItems)
@@ -139,6 +152,13 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 
 		d.Println()
 		d.DescribeMap("Namespace mappings", restore.Spec.NamespaceMapping)
+		if len(restore.Spec.NamespaceMappingPatterns) > 0 {
+			patterns := make([]string, 0, len(restore.Spec.NamespaceMappingPatterns))
+			for _, pattern := range restore.Spec.NamespaceMappingPatterns {
+				patterns = append(patterns, namespaceMappingPatternString(pattern))
+			}
+			d.DescribeSlice(0, "Namespace mapping patterns", patterns)
+		}
 
 		d.Println()
 		s = emptyDisplay
@@ -173,12 +193,25 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 			s = string(restore.Spec.ExistingResourcePolicy)
 		}
 		d.Printf("Existing Resource Policy: \t%s\n", s)
+		if len(restore.Spec.ExistingResourcePolicyOverrides) > 0 {
+			overrides := make(map[string]string, len(restore.Spec.ExistingResourcePolicyOverrides))
+			for resource, policy := range restore.Spec.ExistingResourcePolicyOverrides {
+				overrides[resource] = string(policy)
+			}
+			d.DescribeMap("Existing Resource Policy Overrides", overrides)
+		}
 		d.Printf("ItemOperationTimeout:\t%s\n", restore.Spec.ItemOperationTimeout.Duration)
 
 		d.Println()
 		d.Printf("Preserve Service NodePorts:\t%s\n", BoolPointerString(restore.Spec.PreserveNodePorts, "false", "true", "auto"))
 
-		if restore.Spec.UploaderConfig != nil && boolptr.IsSetToTrue(restore.Spec.UploaderConfig.WriteSparseFiles) {
+		d.Println()
+		d.Printf("Rollback on failure:\t%s\n", BoolPointerString(restore.Spec.RollbackOnFailure, "false", "true", "false"))
+		if restore.Status.Rollback != nil {
+			describeRestoreRollback(d, restore.Status.Rollback)
+		}
+
+		if restore.Spec.UploaderConfig != nil && (boolptr.IsSetToTrue(restore.Spec.UploaderConfig.WriteSparseFiles) || len(restore.Spec.UploaderConfig.IncludedPaths) > 0) {
 			d.Println()
 			DescribeUploaderConfigForRestore(d, restore.Spec)
 		}
@@ -190,19 +223,58 @@ func DescribeRestore(ctx context.Context, kbClient kbclient.Client, restore *vel
 			d.Println()
 			d.Printf("HooksAttempted: \t%d\n", restore.Status.HookStatus.HooksAttempted)
 			d.Printf("HooksFailed: \t%d\n", restore.Status.HookStatus.HooksFailed)
+
+			if details {
+				d.Println()
+				describeHookResults(ctx, kbClient, d, restore.Namespace, restore.Name, velerov1api.DownloadTargetKindRestoreHookResults, insecureSkipTLSVerify, caCertFile)
+			}
 		}
 
 		if details {
 			d.Println()
 			describeRestoreResourceList(ctx, kbClient, d, restore, insecureSkipTLSVerify, caCertFile)
+
+			if boolptr.IsSetToTrue(restore.Spec.Preview) {
+				d.Println()
+				describeRestorePreview(ctx, kbClient, d, restore, insecureSkipTLSVerify, caCertFile)
+			}
 		}
 	})
 }
 
+// describeRestoreRollback describes the rollback status of a restore.
+func describeRestoreRollback(d *Describer, rollback *velerov1api.RestoreRollbackStatus) {
+	phase := string(rollback.Phase)
+	switch rollback.Phase {
+	case velerov1api.RestoreRollbackPhaseCompleted:
+		phase = color.GreenString(phase)
+	case velerov1api.RestoreRollbackPhasePartiallyFailed:
+		phase = color.RedString(phase)
+	}
+
+	d.Printf("Rollback:\n")
+	d.Printf("\tPhase:\t%s\n", phase)
+	if rollback.Errors > 0 {
+		d.Printf("\tErrors:\t%d\n", rollback.Errors)
+	}
+	if rollback.FailureReason != "" {
+		d.Printf("\tFailure reason:\t%s\n", rollback.FailureReason)
+	}
+	if rollback.StartTimestamp != nil && !rollback.StartTimestamp.IsZero() {
+		d.Printf("\tStarted:\t%s\n", rollback.StartTimestamp)
+	}
+	if rollback.CompletionTimestamp != nil && !rollback.CompletionTimestamp.IsZero() {
+		d.Printf("\tCompleted:\t%s\n", rollback.CompletionTimestamp)
+	}
+}
+
 // DescribeUploaderConfigForRestore describes uploader config in human-readable format
 func DescribeUploaderConfigForRestore(d *Describer, spec velerov1api.RestoreSpec) {
 	d.Printf("Uploader config:\n")
-	d.Printf("\tWrite Sparse Files:\t%T\n", boolptr.IsSetToTrue(spec.UploaderConfig.WriteSparseFiles))
+	d.Printf("\tWrite Sparse Files:\t%t\n", boolptr.IsSetToTrue(spec.UploaderConfig.WriteSparseFiles))
+	if len(spec.UploaderConfig.IncludedPaths) > 0 {
+		d.Printf("\tIncluded Paths:\t%s\n", strings.Join(spec.UploaderConfig.IncludedPaths, ", "))
+	}
 }
 
 func describeRestoreItemOperations(ctx context.Context, kbClient kbclient.Client, d *Describer, restore *velerov1api.Restore, details bool, insecureSkipTLSVerify bool, caCertPath string) {
@@ -405,3 +477,65 @@ func describeRestoreResourceList(ctx context.Context, kbClient kbclient.Client,
 		d.Printf("\t%s:\n\t\t- %s\n", gvk, strings.Join(resourceList[gvk], "\n\t\t- "))
 	}
 }
+
+func describeRestorePreview(ctx context.Context, kbClient kbclient.Client, d *Describer, restore *velerov1api.Restore, insecureSkipTLSVerify bool, caCertPath string) {
+	buf := new(bytes.Buffer)
+	if err := downloadrequest.Stream(ctx, kbClient, restore.Namespace, restore.Name, velerov1api.DownloadTargetKindRestorePreview, buf, downloadRequestTimeout, insecureSkipTLSVerify, caCertPath); err != nil {
+		if err == downloadrequest.ErrNotFound {
+			d.Println("Preview:\t<restore preview not found>")
+		} else {
+			d.Printf("Preview:\t<error getting restore preview: %v>\n", err)
+		}
+		return
+	}
+
+	var items []restorepreview.Item
+	if err := json.NewDecoder(buf).Decode(&items); err != nil {
+		d.Printf("Preview:\t<error reading restore preview: %v>\n", err)
+		return
+	}
+
+	describeRestorePreviewItems(d, items)
+}
+
+// describeRestorePreviewItems describes what the restore would do to each item,
+// with the diff against the in-cluster version of the items which exist.
+func describeRestorePreviewItems(d *Describer, items []restorepreview.Item) {
+	if len(items) == 0 {
+		d.Println("Preview:\t<none>")
+		return
+	}
+
+	d.Println("Preview:")
+	for _, item := range items {
+		name := item.Name
+		if item.Namespace != "" {
+			name = fmt.Sprintf("%s/%s", item.Namespace, item.Name)
+		}
+		d.Printf("\t%s %s: %s\n", item.GroupResource, name, item.Action)
+		if len(item.Diff) > 0 {
+			d.Printf("\t\tDiff: %s\n", string(item.Diff))
+		}
+		if item.Message != "" {
+			d.Printf("\t\tMessage: %s\n", item.Message)
+		}
+	}
+}
+
+// namespaceMappingPatternString returns the set fields of a namespace mapping pattern.
+func namespaceMappingPatternString(pattern velerov1api.NamespaceMappingPattern) string {
+	var fields []string
+	if pattern.Regex != "" {
+		fields = append(fields, fmt.Sprintf("regex=%s", pattern.Regex))
+	}
+	if pattern.Replacement != "" {
+		fields = append(fields, fmt.Sprintf("replacement=%s", pattern.Replacement))
+	}
+	if pattern.Prefix != "" {
+		fields = append(fields, fmt.Sprintf("prefix=%s", pattern.Prefix))
+	}
+	if pattern.Suffix != "" {
+		fields = append(fields, fmt.Sprintf("suffix=%s", pattern.Suffix))
+	}
+	return strings.Join(fields, " ")
+}
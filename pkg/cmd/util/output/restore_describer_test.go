@@ -8,7 +8,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/vmware-tanzu/velero/internal/restorepreview"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
@@ -117,6 +119,118 @@ func TestDescribeRestoreItemOperation(t *testing.T) {
 	assert.Equal(t, expected, d.buf.String())
 }
 
+func TestDescribeRestorePreviewItems(t *testing.T) {
+	testcases := []struct {
+		name   string
+		items  []restorepreview.Item
+		expect string
+	}{
+		{
+			name:   "no items",
+			expect: "Preview:  <none>\n",
+		},
+		{
+			name: "cluster-scoped and namespaced items",
+			items: []restorepreview.Item{
+				{GroupResource: "namespaces", Name: "ns-1", Action: restorepreview.ActionCreate},
+				{GroupResource: "pods", Namespace: "ns-1", Name: "pod-1", Action: restorepreview.ActionSkipExisting},
+				{GroupResource: "secrets", Namespace: "ns-1", Name: "secret-1", Action: restorepreview.ActionConflict, Diff: []byte(`{"data":{"foo":null}}`)},
+				{GroupResource: "secrets", Namespace: "ns-1", Name: "secret-2", Action: restorepreview.ActionConflict, Diff: []byte(`{"type":"foo"}`), Message: "the update would be rejected: field is immutable"},
+			},
+			expect: `Preview:
+  namespaces ns-1: create
+  pods ns-1/pod-1: skip-existing
+  secrets ns-1/secret-1: conflict
+    Diff: {"data":{"foo":null}}
+  secrets ns-1/secret-2: conflict
+    Diff: {"type":"foo"}
+    Message: the update would be rejected: field is immutable
+`,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			d := &Describer{
+				Prefix: "",
+				out:    &tabwriter.Writer{},
+				buf:    &bytes.Buffer{},
+			}
+			d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+			describeRestorePreviewItems(d, tc.items)
+			d.out.Flush()
+			assert.Equal(tt, tc.expect, d.buf.String())
+		})
+	}
+}
+
+func TestDescribeRestoreRollback(t *testing.T) {
+	start := time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC)
+	testcases := []struct {
+		name     string
+		rollback *velerov1api.RestoreRollbackStatus
+		expect   string
+	}{
+		{
+			name: "in progress",
+			rollback: &velerov1api.RestoreRollbackStatus{
+				Phase:          velerov1api.RestoreRollbackPhaseInProgress,
+				StartTimestamp: &metav1.Time{Time: start},
+			},
+			expect: `Rollback:
+  Phase:    InProgress
+  Started:  2023-06-01 10:00:00 +0000 UTC
+`,
+		},
+		{
+			name: "partially failed",
+			rollback: &velerov1api.RestoreRollbackStatus{
+				Phase:               velerov1api.RestoreRollbackPhasePartiallyFailed,
+				Errors:              1,
+				FailureReason:       "the rollback items weren't recorded",
+				StartTimestamp:      &metav1.Time{Time: start},
+				CompletionTimestamp: &metav1.Time{Time: start.Add(time.Minute)},
+			},
+			expect: `Rollback:
+  Phase:           PartiallyFailed
+  Errors:          1
+  Failure reason:  the rollback items weren't recorded
+  Started:         2023-06-01 10:00:00 +0000 UTC
+  Completed:       2023-06-01 10:01:00 +0000 UTC
+`,
+		},
+	}
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			d := &Describer{
+				Prefix: "",
+				out:    &tabwriter.Writer{},
+				buf:    &bytes.Buffer{},
+			}
+			d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+			describeRestoreRollback(d, tc.rollback)
+			d.out.Flush()
+			assert.Equal(tt, tc.expect, d.buf.String())
+		})
+	}
+}
+
+func TestDescribeUploaderConfigForRestore(t *testing.T) {
+	input := builder.ForRestore("test-ns", "test-restore-1").IncludedPaths("/etc/app.conf", "/data").Result().Spec
+	d := &Describer{
+		Prefix: "",
+		out:    &tabwriter.Writer{},
+		buf:    &bytes.Buffer{},
+	}
+	d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+	DescribeUploaderConfigForRestore(d, input)
+	d.out.Flush()
+	expect := `Uploader config:
+  Write Sparse Files:  false
+  Included Paths:      /etc/app.conf, /data
+`
+	assert.Equal(t, expect, d.buf.String())
+}
+
 func TestDescribePodVolumeRestores(t *testing.T) {
 	pvr1 := builder.ForPodVolumeRestore("velero", "pvr-1").
 		UploaderType("kopia").
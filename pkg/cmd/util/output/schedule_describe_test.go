@@ -1,9 +1,13 @@
 package output
 
 import (
+	"bytes"
 	"testing"
+	"text/tabwriter"
+	"time"
 
 	"github.com/stretchr/testify/assert"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
@@ -24,7 +28,8 @@ Validation errors:  validation failed
 
 Paused:  false
 
-Schedule:  
+Schedule:        
+Overlap Policy:  Queue
 
 Backup Template:
   Namespaces:
@@ -73,7 +78,8 @@ Uploader config:
 
 Paused:  false
 
-Schedule:  0 0 * * *
+Schedule:        0 0 * * *
+Overlap Policy:  Queue
 
 Backup Template:
   Namespaces:
@@ -124,7 +130,119 @@ Last Backup:  2023-06-25 15:04:05 +0000 UTC
 
 	for _, tc := range testcases {
 		t.Run(tc.name, func(tt *testing.T) {
-			assert.Equal(tt, tc.expect, DescribeSchedule(tc.input))
+			assert.Equal(tt, tc.expect, DescribeSchedule(tc.input, nil))
 		})
 	}
 }
+
+func TestDescribeScheduleRetention(t *testing.T) {
+	now := time.Date(2023, 6, 25, 12, 0, 0, 0, time.UTC)
+	backups := []velerov1api.Backup{
+		*builder.ForBackup("velero", "schedule-1-20230625110000").Phase(velerov1api.BackupPhaseCompleted).StartTimestamp(now.Add(-time.Hour)).Result(),
+		*builder.ForBackup("velero", "schedule-1-20230624110000").Phase(velerov1api.BackupPhaseCompleted).StartTimestamp(now.Add(-25 * time.Hour)).Result(),
+		*builder.ForBackup("velero", "schedule-1-20230624100000").Phase(velerov1api.BackupPhaseCompleted).StartTimestamp(now.Add(-26 * time.Hour)).Result(),
+		*builder.ForBackup("velero", "schedule-1-20230625120000").Phase(velerov1api.BackupPhaseInProgress).StartTimestamp(now).Result(),
+	}
+
+	testcases := []struct {
+		name    string
+		backups []velerov1api.Backup
+		expect  string
+	}{
+		{
+			name:    "retained and deleted backups",
+			backups: backups,
+			expect: `Retention:
+  Keep Last:   1
+  Keep Daily:  2
+
+Backup Retention:
+  schedule-1-20230625110000:  last, daily
+  schedule-1-20230624110000:  daily
+  schedule-1-20230624100000:  <not retained, to be deleted>
+`,
+		},
+		{
+			name: "no completed backups",
+			expect: `Retention:
+  Keep Last:   1
+  Keep Daily:  2
+
+Backup Retention:
+  <none>
+`,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.name, func(tt *testing.T) {
+			d := &Describer{
+				Prefix: "",
+				out:    &tabwriter.Writer{},
+				buf:    &bytes.Buffer{},
+			}
+			d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+			DescribeScheduleRetention(d, &velerov1api.ScheduleRetention{KeepLast: 1, KeepDaily: 2}, time.UTC, tc.backups)
+			d.out.Flush()
+			assert.Equal(tt, tc.expect, d.buf.String())
+		})
+	}
+}
+
+func TestDescribeScheduleTimeZoneAndBlackoutWindows(t *testing.T) {
+	schedule := builder.ForSchedule("velero", "schedule-1").
+		CronSchedule("0 2 * * *").
+		TimeZone("Europe/Paris").
+		BlackoutWindows(
+			velerov1api.ScheduleBlackoutWindow{Name: "peak-hours", Start: "0 9 * * 1-5", Duration: metav1.Duration{Duration: 8 * time.Hour}, Policy: velerov1api.BlackoutWindowPolicyDefer},
+			velerov1api.ScheduleBlackoutWindow{Start: "0 0 20 12 *", Duration: metav1.Duration{Duration: 336 * time.Hour}},
+		).Result()
+	schedule.Status.LastSkipped = &metav1.Time{Time: time.Date(2023, 12, 21, 2, 0, 0, 0, time.UTC)}
+	schedule.Status.LastSkippedReason = `the backup was due in the blackout window "0 0 20 12 *"`
+
+	d := &Describer{
+		Prefix: "",
+		out:    &tabwriter.Writer{},
+		buf:    &bytes.Buffer{},
+	}
+	d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+	DescribeScheduleStatus(d, schedule.Status)
+	d.out.Flush()
+	assert.Equal(t, `Last Backup:          <never>
+Last Skipped:         2023-12-21 02:00:00 +0000 UTC
+Last Skipped Reason:  the backup was due in the blackout window "0 0 20 12 *"
+`, d.buf.String())
+
+	description := DescribeSchedule(schedule, nil)
+	assert.Contains(t, description, `Schedule:        0 2 * * *
+Time Zone:       Europe/Paris
+Overlap Policy:  Queue
+
+Blackout Windows:
+  peak-hours:  start "0 9 * * 1-5", duration 8h0m0s, Defer
+  <unnamed>:   start "0 0 20 12 *", duration 336h0m0s, Skip
+`)
+}
+
+func TestDescribeScheduleStatusMissedRuns(t *testing.T) {
+	status := velerov1api.ScheduleStatus{
+		LastBackup:          &metav1.Time{Time: time.Date(2023, 6, 25, 12, 0, 0, 0, time.UTC)},
+		MissedRuns:          3,
+		LastMissedRun:       &metav1.Time{Time: time.Date(2023, 6, 25, 18, 0, 0, 0, time.UTC)},
+		LastMissedRunReason: "a backup of the schedule was still running",
+	}
+
+	d := &Describer{
+		Prefix: "",
+		out:    &tabwriter.Writer{},
+		buf:    &bytes.Buffer{},
+	}
+	d.out.Init(d.buf, 0, 8, 2, ' ', 0)
+	DescribeScheduleStatus(d, status)
+	d.out.Flush()
+	assert.Equal(t, `Last Backup:             2023-06-25 12:00:00 +0000 UTC
+Missed Runs:             3
+Last Missed Run:         2023-06-25 18:00:00 +0000 UTC
+Last Missed Run Reason:  a backup of the schedule was still running
+`, d.buf.String())
+}
@@ -18,13 +18,18 @@ package output
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/fatih/color"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgschedule "github.com/vmware-tanzu/velero/pkg/schedule"
 )
 
-func DescribeSchedule(schedule *v1.Schedule) string {
+// DescribeSchedule describes a schedule. The backups are the backups of the
+// schedule, used to describe its retention policy.
+func DescribeSchedule(schedule *v1.Schedule, backups []v1.Backup) string {
 	return Describe(func(d *Describer) {
 		d.DescribeMetadata(schedule.ObjectMeta)
 
@@ -70,11 +75,43 @@ func DescribeSchedule(schedule *v1.Schedule) string {
 
 		d.Println()
 		DescribeScheduleStatus(d, schedule.Status)
+
+		if schedule.Spec.Retention != nil {
+			d.Println()
+			DescribeScheduleRetention(d, schedule.Spec.Retention, pkgschedule.Location(schedule), backups)
+		}
 	})
 }
 
 func DescribeScheduleSpec(d *Describer, spec v1.ScheduleSpec) {
 	d.Printf("Schedule:\t%s\n", spec.Schedule)
+	if spec.TimeZone != "" {
+		d.Printf("Time Zone:\t%s\n", spec.TimeZone)
+	}
+	overlapPolicy := spec.OverlapPolicy
+	if overlapPolicy == "" {
+		overlapPolicy = v1.ScheduleOverlapPolicyQueue
+	}
+	d.Printf("Overlap Policy:\t%s\n", overlapPolicy)
+	if spec.StartingDeadline != nil {
+		d.Printf("Starting Deadline:\t%s\n", spec.StartingDeadline.Duration)
+	}
+
+	if len(spec.BlackoutWindows) > 0 {
+		d.Println()
+		d.Println("Blackout Windows:")
+		for _, window := range spec.BlackoutWindows {
+			name := window.Name
+			if name == "" {
+				name = "<unnamed>"
+			}
+			policy := window.Policy
+			if policy == "" {
+				policy = v1.BlackoutWindowPolicySkip
+			}
+			d.Printf("\t%s:\tstart %q, duration %s, %s\n", name, window.Start, window.Duration.Duration, policy)
+		}
+	}
 
 	d.Println()
 	d.Println("Backup Template:")
@@ -89,4 +126,57 @@ func DescribeScheduleStatus(d *Describer, status v1.ScheduleStatus) {
 		lastBackup = fmt.Sprintf("%v", status.LastBackup.Time)
 	}
 	d.Printf("Last Backup:\t%s\n", lastBackup)
+
+	if status.LastSkipped != nil && !status.LastSkipped.Time.IsZero() {
+		d.Printf("Last Skipped:\t%v\n", status.LastSkipped.Time)
+		if status.LastSkippedReason != "" {
+			d.Printf("Last Skipped Reason:\t%s\n", status.LastSkippedReason)
+		}
+	}
+
+	if status.MissedRuns > 0 {
+		d.Printf("Missed Runs:\t%d\n", status.MissedRuns)
+		if status.LastMissedRun != nil {
+			d.Printf("Last Missed Run:\t%v\n", status.LastMissedRun.Time)
+		}
+		d.Printf("Last Missed Run Reason:\t%s\n", status.LastMissedRunReason)
+	}
+}
+
+// DescribeScheduleRetention describes the retention policy of a schedule and
+// the rules retaining each completed backup of the schedule, with the
+// retention periods in the given location.
+func DescribeScheduleRetention(d *Describer, retention *v1.ScheduleRetention, loc *time.Location, backups []v1.Backup) {
+	d.Println("Retention:")
+	for _, rule := range []struct {
+		name  string
+		count int
+	}{
+		{"Keep Last", retention.KeepLast},
+		{"Keep Hourly", retention.KeepHourly},
+		{"Keep Daily", retention.KeepDaily},
+		{"Keep Weekly", retention.KeepWeekly},
+		{"Keep Monthly", retention.KeepMonthly},
+		{"Keep Yearly", retention.KeepYearly},
+	} {
+		if rule.count != 0 {
+			d.Printf("\t%s:\t%d\n", rule.name, rule.count)
+		}
+	}
+
+	d.Println()
+	d.Println("Backup Retention:")
+	candidates := pkgschedule.RetentionCandidates(backups)
+	if len(candidates) == 0 {
+		d.Printf("\t<none>\n")
+		return
+	}
+	retained := pkgschedule.RetainedBackups(retention, loc, backups)
+	for _, backup := range candidates {
+		rules := "<not retained, to be deleted>"
+		if names, ok := retained[backup.Name]; ok {
+			rules = strings.Join(names, ", ")
+		}
+		d.Printf("\t%s:\t%s\n", backup.Name, rules)
+	}
 }
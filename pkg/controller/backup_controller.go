@@ -38,13 +38,16 @@ import (
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	"github.com/vmware-tanzu/velero/internal/storage"
 	internalVolume "github.com/vmware-tanzu/velero/internal/volume"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/archive"
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/features"
+	"github.com/vmware-tanzu/velero/pkg/itemoperationmap"
 	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
@@ -86,7 +89,12 @@ type backupReconciler struct {
 	credentialFileStore         credentials.FileStore
 	maxConcurrentK8SConnections int
 	defaultSnapshotMoveData     bool
+	defaultCompression          velerov1api.BackupCompression
 	globalCRClient              kbclient.Client
+	interruptedBackups          InterruptedTracker
+	// itemOperationsMap uploads the item operations of the running backups
+	// for their checkpoints.
+	itemOperationsMap *itemoperationmap.BackupItemOperationsMap
 }
 
 func NewBackupReconciler(
@@ -111,7 +119,9 @@ func NewBackupReconciler(
 	credentialStore credentials.FileStore,
 	maxConcurrentK8SConnections int,
 	defaultSnapshotMoveData bool,
+	defaultCompression velerov1api.BackupCompression,
 	globalCRClient kbclient.Client,
+	interruptedBackups InterruptedTracker,
 ) *backupReconciler {
 	b := &backupReconciler{
 		ctx:                         ctx,
@@ -136,7 +146,10 @@ func NewBackupReconciler(
 		credentialFileStore:         credentialStore,
 		maxConcurrentK8SConnections: maxConcurrentK8SConnections,
 		defaultSnapshotMoveData:     defaultSnapshotMoveData,
+		defaultCompression:          defaultCompression,
 		globalCRClient:              globalCRClient,
+		interruptedBackups:          interruptedBackups,
+		itemOperationsMap:           itemoperationmap.NewBackupItemOperationsMap(),
 	}
 	b.updateTotalBackupMetric()
 	return b
@@ -228,9 +241,14 @@ func (b *backupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	// informer sees the update. In the latter case, after the informer has seen the update to
 	// InProgress, we still need this check so we can return nil to indicate we've finished processing
 	// this key (even though it was a no-op).
-	switch original.Status.Phase {
-	case "", velerov1api.BackupPhaseNew:
+	resumed := original.Status.Phase == velerov1api.BackupPhaseInProgress && b.interruptedBackups != nil &&
+		b.interruptedBackups.Take(original.Namespace, original.Name)
+	switch {
+	case original.Status.Phase == "" || original.Status.Phase == velerov1api.BackupPhaseNew:
 		// only process new backups
+	case resumed:
+		// and the backups interrupted by a restart of the server
+		log.Info("Resuming backup interrupted by a restart of the server")
 	default:
 		b.logger.WithFields(logrus.Fields{
 			"backup": kubeutil.NamespaceAndName(original),
@@ -239,13 +257,34 @@ func (b *backupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 		return ctrl.Result{}, nil
 	}
 
+	if boolptr.IsSetToTrue(original.Spec.Cancel) {
+		backup := original.DeepCopy()
+		if resumed {
+			log.Info("Backup was cancelled while it was interrupted")
+			cancelPodVolumeBackups(ctx, b.kbClient, backup, log)
+			cancelDataUploads(ctx, b.kbClient, backup, log)
+		} else {
+			log.Info("Backup was cancelled before it was started")
+		}
+		backup.Status.Phase = velerov1api.BackupPhaseCancelled
+		backup.Status.CompletionTimestamp = &metav1.Time{Time: b.clock.Now()}
+		if err := kubeutil.PatchResource(original, backup, b.kbClient); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "error updating Backup status to %s", backup.Status.Phase)
+		}
+		b.metrics.RegisterBackupCancelled(backup.Labels[velerov1api.ScheduleNameLabel])
+		return ctrl.Result{}, nil
+	}
+
 	log.Debug("Preparing backup request")
 	request := b.prepareBackupRequest(original, log)
 	if len(request.Status.ValidationErrors) > 0 {
 		request.Status.Phase = velerov1api.BackupPhaseFailedValidation
 	} else {
 		request.Status.Phase = velerov1api.BackupPhaseInProgress
-		request.Status.StartTimestamp = &metav1.Time{Time: b.clock.Now()}
+		// a resumed backup keeps the start time of its first run
+		if !resumed || request.Status.StartTimestamp == nil {
+			request.Status.StartTimestamp = &metav1.Time{Time: b.clock.Now()}
+		}
 	}
 
 	// update status
@@ -269,7 +308,8 @@ func (b *backupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	b.backupTracker.Add(request.Namespace, request.Name)
 	defer func() {
 		switch request.Status.Phase {
-		case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseFailed, velerov1api.BackupPhaseFailedValidation:
+		case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseFailed, velerov1api.BackupPhaseFailedValidation,
+			velerov1api.BackupPhaseCancelled:
 			b.backupTracker.Delete(request.Namespace, request.Name)
 		}
 	}()
@@ -279,7 +319,7 @@ func (b *backupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	b.metrics.RegisterBackupAttempt(backupScheduleName)
 
 	// execution & upload of backup
-	if err := b.runBackup(request); err != nil {
+	if err := b.runBackup(request, resumed); err != nil {
 		// even though runBackup sets the backup's phase prior
 		// to uploading artifacts to object storage, we have to
 		// check for an error again here and update the phase if
@@ -304,6 +344,9 @@ func (b *backupReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctr
 	case velerov1api.BackupPhaseFailedValidation:
 		b.metrics.RegisterBackupValidationFailure(backupScheduleName)
 		b.metrics.RegisterBackupLastStatus(backupScheduleName, metrics.BackupLastStatusFailure)
+	case velerov1api.BackupPhaseCancelled:
+		b.metrics.RegisterBackupCancelled(backupScheduleName)
+		b.metrics.RegisterBackupLastStatus(backupScheduleName, metrics.BackupLastStatusFailure)
 	}
 	log.Info("Updating backup's final status")
 	if err := kubeutil.PatchResource(original, request.Backup, b.kbClient); err != nil {
@@ -358,6 +401,14 @@ func (b *backupReconciler) prepareBackupRequest(backup *velerov1api.Backup, logg
 		request.Spec.SnapshotMoveData = &b.defaultSnapshotMoveData
 	}
 
+	// record the compression in the spec so the backup metadata tells which one was used
+	if request.Spec.Compression == "" {
+		request.Spec.Compression = b.defaultCompression
+	}
+	if err := archive.ValidateCompression(request.Spec.Compression); err != nil {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors, err.Error())
+	}
+
 	// find which storage location to use
 	var serverSpecified bool
 	if request.Spec.StorageLocation == "" {
@@ -489,9 +540,36 @@ func (b *backupReconciler) prepareBackupRequest(backup *velerov1api.Backup, logg
 		request.ResPolicies = res
 	}
 
+	if modifiers, err := getBackupResourceModifiers(b.kbClient, request.Backup); err != nil {
+		request.Status.ValidationErrors = append(request.Status.ValidationErrors, err.Error())
+	} else {
+		request.ResourceModifiers = modifiers
+	}
+
 	return request
 }
 
+// getBackupResourceModifiers loads and validates the resource modifiers referenced
+// by the backup. It returns nil if the backup doesn't reference any.
+func getBackupResourceModifiers(client kbclient.Client, backup *velerov1api.Backup) (*resourcemodifiers.ResourceModifiers, error) {
+	if backup.Spec.ResourceModifier == nil || !strings.EqualFold(backup.Spec.ResourceModifier.Kind, resourcemodifiers.ConfigmapRefType) {
+		return nil, nil
+	}
+
+	modifiersConfigmap := &corev1api.ConfigMap{}
+	if err := client.Get(context.Background(), kbclient.ObjectKey{Namespace: backup.Namespace, Name: backup.Spec.ResourceModifier.Name}, modifiersConfigmap); err != nil {
+		return nil, errors.Errorf("failed to get resource modifiers %s/%s configmap with err %v", backup.Namespace, backup.Spec.ResourceModifier.Name, err)
+	}
+	modifiers, err := resourcemodifiers.GetResourceModifiersFromConfig(modifiersConfigmap)
+	if err == nil {
+		err = modifiers.Validate()
+	}
+	if err != nil {
+		return nil, errors.Wrapf(err, "resource modifiers %s/%s", backup.Namespace, backup.Spec.ResourceModifier.Name)
+	}
+	return modifiers, nil
+}
+
 // validateAndGetSnapshotLocations gets a collection of VolumeSnapshotLocation objects that
 // this backup will use (returned as a map of provider name -> VSL), and ensures:
 //   - each location name in .spec.volumeSnapshotLocations exists as a location
@@ -604,9 +682,9 @@ func (b *backupReconciler) validateAndGetSnapshotLocations(backup *velerov1api.B
 
 // runBackup runs and uploads a validated backup. Any error returned from this function
 // causes the backup to be Failed; if no error is returned, the backup's status's Errors
-// field is checked to see if the backup was a partial failure.
-
-func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
+// field is checked to see if the backup was a partial failure. A resumed
+// backup continues from the checkpoint of its interrupted run.
+func (b *backupReconciler) runBackup(backup *pkgbackup.Request, resumed bool) error {
 	b.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).Info("Setting up backup log")
 
 	// Log the backup to both a backup log file and to stdout. This will help see what happened if the upload of the
@@ -652,14 +730,48 @@ func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 
 	backupItemActionsResolver := framework.NewBackupItemActionResolverV2(actions)
 
+	// a dry-run backup has nothing worth resuming, it's simply run again
+	checkpointed := !boolptr.IsSetToTrue(backup.Spec.DryRun)
+	if checkpointed {
+		defer b.itemOperationsMap.DeleteOperationsForBackup(backup.Name)
+		if resumed {
+			b.resumeBackup(backup, backupStore, backupLog)
+		}
+	}
+
+	stopWatching := watchCancellation(b.kbClient, backup.Backup, backupCancelRequested, backup.Cancel, backupLog)
+	stopCheckpointing := func() {}
+	if checkpointed {
+		stopCheckpointing = checkpointPeriodically(func() error { return b.checkpointBackup(backup, backupStore) }, backupLog)
+	}
 	var fatalErrs []error
 	if err := b.backupper.BackupWithResolvers(backupLog, backup, backupFile, backupItemActionsResolver, pluginManager); err != nil {
 		fatalErrs = append(fatalErrs, err)
 	}
+	stopCheckpointing()
+	stopWatching()
+
+	// the operations of the interrupted run which weren't adopted aren't part of the backup
+	if unadopted := backup.UnadoptedOperations(); len(unadopted) > 0 {
+		backupLog.Infof("Cancelling %d item operations started before the restart which weren't adopted", len(unadopted))
+		cancelBackupItemOperations(backup.Backup, pluginManager, unadopted, backupLog)
+	}
+
+	// The pod volume backups, data uploads and item operations started before the backup
+	// was cancelled may still be running, so they are cancelled too.
+	cancelled := backup.IsCancelled()
+	if cancelled {
+		cancelPodVolumeBackups(context.Background(), b.kbClient, backup.Backup, backupLog)
+		cancelDataUploads(context.Background(), b.kbClient, backup.Backup, backupLog)
+		cancelBackupItemOperations(backup.Backup, pluginManager, *backup.GetItemOperationsList(), backupLog)
+	}
 
 	// native snapshots phase will either be failed or completed right away
 	// https://github.com/vmware-tanzu/velero/blob/de3ea52f0cc478e99efa7b9524c7f353514261a4/pkg/backup/item_backupper.go#L632-L639
-	backup.Status.VolumeSnapshotsAttempted = len(backup.VolumeSnapshots)
+	// the snapshots of a dry-run backup are only planned, so they aren't counted as attempted.
+	if !boolptr.IsSetToTrue(backup.Spec.DryRun) {
+		backup.Status.VolumeSnapshotsAttempted = len(backup.VolumeSnapshots)
+	}
 	for _, snap := range backup.VolumeSnapshots {
 		if snap.Status.Phase == volume.SnapshotPhaseCompleted {
 			backup.Status.VolumeSnapshotsCompleted++
@@ -701,6 +813,8 @@ func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 	// artifacts to object storage so that the JSON representation of the
 	// backup in object storage has the terminal phase set.
 	switch {
+	case cancelled:
+		backup.Status.Phase = velerov1api.BackupPhaseCancelled
 	case len(fatalErrs) > 0:
 		backup.Status.Phase = velerov1api.BackupPhaseFailed
 	case logCounter.GetCount(logrus.ErrorLevel) > 0:
@@ -716,13 +830,27 @@ func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 			backup.Status.Phase = velerov1api.BackupPhaseFinalizing
 		}
 	}
+	// A dry-run backup has no data to wait for or to finalize, so it goes to the terminal phase directly.
+	if boolptr.IsSetToTrue(backup.Spec.DryRun) {
+		switch backup.Status.Phase {
+		case velerov1api.BackupPhaseWaitingForPluginOperations, velerov1api.BackupPhaseFinalizing:
+			backup.Status.Phase = velerov1api.BackupPhaseCompleted
+		case velerov1api.BackupPhaseWaitingForPluginOperationsPartiallyFailed, velerov1api.BackupPhaseFinalizingPartiallyFailed:
+			backup.Status.Phase = velerov1api.BackupPhasePartiallyFailed
+		}
+	}
 	// Mark completion timestamp before serializing and uploading.
 	// Otherwise, the JSON file in object storage has a CompletionTimestamp of 'null'.
 	if backup.Status.Phase == velerov1api.BackupPhaseFailed ||
 		backup.Status.Phase == velerov1api.BackupPhasePartiallyFailed ||
-		backup.Status.Phase == velerov1api.BackupPhaseCompleted {
+		backup.Status.Phase == velerov1api.BackupPhaseCompleted ||
+		backup.Status.Phase == velerov1api.BackupPhaseCancelled {
 		backup.Status.CompletionTimestamp = &metav1.Time{Time: b.clock.Now()}
 	}
+	// A cancelled backup expires right away, so the garbage collection deletes its partial data.
+	if backup.Status.Phase == velerov1api.BackupPhaseCancelled {
+		backup.Status.Expiration = backup.Status.CompletionTimestamp
+	}
 	recordBackupMetrics(backupLog, backup.Backup, backupFile, b.metrics, false)
 
 	// re-instantiate the backup store because credentials could have changed since the original
@@ -740,6 +868,11 @@ func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 			fatalErrs = append(fatalErrs, errs...)
 		}
 	}
+	if checkpointed {
+		if err := backupStore.DeleteBackupCheckpoint(backup.Name); err != nil {
+			b.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).WithError(err).Warn("Error deleting the checkpoint of the backup")
+		}
+	}
 
 	b.logger.WithField(Backup, kubeutil.NamespaceAndName(backup)).Infof("Initial backup processing complete, moving to %s", backup.Status.Phase)
 
@@ -748,6 +881,54 @@ func (b *backupReconciler) runBackup(backup *pkgbackup.Request) error {
 	return kerrors.NewAggregate(fatalErrs)
 }
 
+// checkpointBackup uploads the progress of the running backup and its item
+// operations, so it can be resumed after a restart of the server.
+func (b *backupReconciler) checkpointBackup(backup *pkgbackup.Request, backupStore persistence.BackupStore) error {
+	checkpoint, operations := backup.Checkpoint()
+	if len(operations) > 0 {
+		if err := b.itemOperationsMap.UploadProgressAndPutOperationsForBackup(backupStore,
+			&itemoperationmap.OperationsForBackup{Operations: operations}, backup.Name); err != nil {
+			return err
+		}
+	}
+
+	data, errs := encode.ToJSONGzip(checkpoint, "backup checkpoint")
+	if len(errs) > 0 {
+		return errs[0]
+	}
+	return backupStore.PutBackupCheckpoint(backup.Name, data)
+}
+
+// resumeBackup prepares the backup to continue from the checkpoint of its
+// interrupted run. The backup starts from the beginning if the checkpoint
+// can't be loaded, but it still adopts the pod volume backups, and cancels
+// the item operations of the interrupted run.
+func (b *backupReconciler) resumeBackup(backup *pkgbackup.Request, backupStore persistence.BackupStore, log logrus.FieldLogger) {
+	var checkpoint *pkgbackup.Checkpoint
+	if data, err := backupStore.GetBackupCheckpoint(backup.Name); err != nil {
+		log.WithError(err).Warn("Error getting the checkpoint of the backup, starting from the beginning")
+	} else if data == nil {
+		log.Warn("The backup has no checkpoint, starting from the beginning")
+	} else {
+		checkpoint = &pkgbackup.Checkpoint{}
+		if err := decodeCheckpoint(data, checkpoint); err != nil {
+			log.WithError(err).Warn("Error decoding the checkpoint of the backup, starting from the beginning")
+			checkpoint = nil
+		}
+	}
+
+	operations, err := b.itemOperationsMap.GetOperationsForBackup(backupStore, backup.Name)
+	if err != nil {
+		log.WithError(err).Warn("Error getting the item operations of the interrupted backup")
+		operations = &itemoperationmap.OperationsForBackup{}
+	}
+
+	if checkpoint != nil {
+		log.Infof("Resuming the backup from its checkpoint with %d items and %d item operations", len(checkpoint.Items), len(operations.Operations))
+	}
+	backup.Resume(checkpoint, operations.Operations)
+}
+
 func recordBackupMetrics(log logrus.FieldLogger, backup *velerov1api.Backup, backupFile *os.File, serverMetrics *metrics.ServerMetrics, finalize bool) {
 	backupScheduleName := backup.GetLabels()[velerov1api.ScheduleNameLabel]
 
@@ -848,6 +1029,11 @@ func persistBackup(backup *pkgbackup.Request,
 		persistErrs = append(persistErrs, errs...)
 	}
 
+	hookResults, errs := encode.ToJSONGzip(backup.GetHookTracker().Results(), "backup hook results")
+	if errs != nil {
+		persistErrs = append(persistErrs, errs...)
+	}
+
 	backup.FillVolumesInformation()
 
 	volumeInfoJSON, errs := encode.ToJSONGzip(backup.VolumesInformation.Result(
@@ -861,6 +1047,18 @@ func persistBackup(backup *pkgbackup.Request,
 		persistErrs = append(persistErrs, errs...)
 	}
 
+	if boolptr.IsSetToTrue(backup.Spec.DryRun) {
+		// A dry-run backup only uploads the metadata, logs, results, resource list and
+		// volume information. Everything else describes data that was never backed up.
+		backupContents = nil
+		nativeVolumeSnapshots = nil
+		backupItemOperations = nil
+		podVolumeBackups = nil
+		csiSnapshotJSON = nil
+		csiSnapshotContentsJSON = nil
+		csiSnapshotClassesJSON = nil
+	}
+
 	if len(persistErrs) > 0 {
 		// Don't upload the JSON files or backup tarball if encoding to json fails.
 		backupJSON = nil
@@ -873,6 +1071,7 @@ func persistBackup(backup *pkgbackup.Request,
 		csiSnapshotClassesJSON = nil
 		backupResult = nil
 		volumeInfoJSON = nil
+		hookResults = nil
 	}
 
 	backupInfo := persistence.BackupInfo{
@@ -889,6 +1088,7 @@ func persistBackup(backup *pkgbackup.Request,
 		CSIVolumeSnapshotContents: csiSnapshotContentsJSON,
 		CSIVolumeSnapshotClasses:  csiSnapshotClassesJSON,
 		BackupVolumeInfo:          volumeInfoJSON,
+		HookResults:               hookResults,
 	}
 	if err := backupStore.PutBackup(backupInfo); err != nil {
 		persistErrs = append(persistErrs, err)
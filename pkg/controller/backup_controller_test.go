@@ -50,6 +50,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/discovery"
 	"github.com/vmware-tanzu/velero/pkg/features"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/itemoperationmap"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
@@ -144,6 +145,27 @@ func TestProcessBackupNonProcessedItems(t *testing.T) {
 	}
 }
 
+func TestProcessBackupCancelledBeforeStarted(t *testing.T) {
+	backup := defaultBackup().Phase(velerov1api.BackupPhaseNew).Cancel(true).Result()
+	now := time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC)
+
+	c := &backupReconciler{
+		kbClient: velerotest.NewFakeControllerRuntimeClient(t, backup),
+		logger:   logging.DefaultLogger(logrus.DebugLevel, logging.FormatText),
+		clock:    testclocks.NewFakeClock(now),
+		metrics:  metrics.NewServerMetrics(),
+	}
+
+	_, err := c.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: backup.Namespace, Name: backup.Name}})
+	require.NoError(t, err)
+
+	res := &velerov1api.Backup{}
+	require.NoError(t, c.kbClient.Get(ctx, kbclient.ObjectKeyFromObject(backup), res))
+	assert.Equal(t, velerov1api.BackupPhaseCancelled, res.Status.Phase)
+	assert.Nil(t, res.Status.StartTimestamp)
+	assert.True(t, res.Status.CompletionTimestamp.Time.Equal(now))
+}
+
 func TestProcessBackupValidationFailures(t *testing.T) {
 	defaultBackupLocation := builder.ForBackupStorageLocation("velero", "loc-1").Result()
 
@@ -1337,6 +1359,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 				backupper:                backupper,
 				formatFlag:               formatFlag,
 				globalCRClient:           fakeGlobalClient,
+				itemOperationsMap:        itemoperationmap.NewBackupItemOperationsMap(),
 			}
 
 			pluginManager.On("GetBackupItemActionsV2").Return(nil, nil)
@@ -1357,6 +1380,7 @@ func TestProcessBackupCompletions(t *testing.T) {
 						strings.Contains(buf.String(), `"completionTimestamp": "2006-01-02T22:04:05Z"`))
 			}
 			backupStore.On("PutBackup", mock.MatchedBy(hasNameAndCompletionTimestampIfCompleted)).Return(nil)
+			backupStore.On("DeleteBackupCheckpoint", test.backup.Name).Return(nil)
 
 			// add the test's backup to the informer/lister store
 			require.NotNil(t, test.backup)
@@ -37,6 +37,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/encode"
 )
 
@@ -111,7 +112,8 @@ func (r *backupFinalizerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	original := backup.DeepCopy()
 	defer func() {
 		switch backup.Status.Phase {
-		case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseFailed, velerov1api.BackupPhaseFailedValidation:
+		case velerov1api.BackupPhaseCompleted, velerov1api.BackupPhasePartiallyFailed, velerov1api.BackupPhaseFailed, velerov1api.BackupPhaseFailedValidation,
+			velerov1api.BackupPhaseCancelled:
 			r.backupTracker.Delete(backup.Namespace, backup.Name)
 		}
 		// Always attempt to Patch the backup object and status after each reconciliation.
@@ -149,8 +151,10 @@ func (r *backupFinalizerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		StorageLocation:  location,
 		SkippedPVTracker: pkgbackup.NewSkipPVTracker(),
 	}
+	// A cancelled backup isn't finalized, its partial data is deleted when it expires.
+	cancelled := boolptr.IsSetToTrue(backup.Spec.Cancel)
 	var outBackupFile *os.File
-	if len(operations) > 0 {
+	if len(operations) > 0 && !cancelled {
 		// Call itemBackupper.BackupItem for the list of items updated by async operations
 		log.Info("Setting up finalized backup temp file")
 		inBackupFile, err := downloadToTempFile(backup.Name, backupStore, log)
@@ -172,6 +176,14 @@ func (r *backupFinalizerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 			return ctrl.Result{}, errors.WithStack(err)
 		}
 		backupItemActionsResolver := framework.NewBackupItemActionResolverV2(actions)
+
+		// the items updated by the async operations are modified like the
+		// other items of the backup
+		backupRequest.ResourceModifiers, err = getBackupResourceModifiers(r.client, backup)
+		if err != nil {
+			log.WithError(err).Error("error getting resource modifiers")
+			return ctrl.Result{}, errors.WithStack(err)
+		}
 		err = r.backupper.FinalizeBackup(log, backupRequest, inBackupFile, outBackupFile, backupItemActionsResolver, operations)
 		if err != nil {
 			log.WithError(err).Error("error finalizing Backup")
@@ -179,18 +191,25 @@ func (r *backupFinalizerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		}
 	}
 	backupScheduleName := backupRequest.GetLabels()[velerov1api.ScheduleNameLabel]
-	switch backup.Status.Phase {
-	case velerov1api.BackupPhaseFinalizing:
+	switch {
+	case cancelled:
+		backup.Status.Phase = velerov1api.BackupPhaseCancelled
+		r.metrics.RegisterBackupCancelled(backupScheduleName)
+		r.metrics.RegisterBackupLastStatus(backupScheduleName, metrics.BackupLastStatusFailure)
+	case backup.Status.Phase == velerov1api.BackupPhaseFinalizing:
 		backup.Status.Phase = velerov1api.BackupPhaseCompleted
 		r.metrics.RegisterBackupSuccess(backupScheduleName)
 		r.metrics.RegisterBackupLastStatus(backupScheduleName, metrics.BackupLastStatusSucc)
-	case velerov1api.BackupPhaseFinalizingPartiallyFailed:
+	case backup.Status.Phase == velerov1api.BackupPhaseFinalizingPartiallyFailed:
 		backup.Status.Phase = velerov1api.BackupPhasePartiallyFailed
 		r.metrics.RegisterBackupPartialFailure(backupScheduleName)
 		r.metrics.RegisterBackupLastStatus(backupScheduleName, metrics.BackupLastStatusFailure)
 	}
 
 	backup.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+	if cancelled {
+		backup.Status.Expiration = backup.Status.CompletionTimestamp
+	}
 	backup.Status.CSIVolumeSnapshotsCompleted = updateCSIVolumeSnapshotsCompleted(operations)
 
 	recordBackupMetrics(log, backup, outBackupFile, r.metrics, true)
@@ -204,7 +223,7 @@ func (r *backupFinalizerReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	if err != nil {
 		return ctrl.Result{}, errors.Wrap(err, "error uploading backup json")
 	}
-	if len(operations) > 0 {
+	if len(operations) > 0 && !cancelled {
 		err = backupStore.PutBackupContents(backup.Name, outBackupFile)
 		if err != nil {
 			return ctrl.Result{}, errors.Wrap(err, "error uploading backup final contents")
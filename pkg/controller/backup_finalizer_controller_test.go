@@ -35,6 +35,7 @@ import (
 	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/features"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
@@ -75,6 +76,8 @@ func TestBackupFinalizerReconcile(t *testing.T) {
 		expectError         bool
 		expectPhase         velerov1api.BackupPhase
 		expectedCompletedVS int
+		resourceModifiers   runtime.Object
+		expectModifiers     bool
 	}{
 		{
 			name: "Finalizing backup is completed",
@@ -192,6 +195,47 @@ func TestBackupFinalizerReconcile(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "Items updated by async operations are modified by the backup's resource modifiers",
+			backup: builder.ForBackup(velerov1api.DefaultNamespace, "backup-4").
+				StorageLocation("default").
+				ResourceModifier("modifiers").
+				ObjectMeta(builder.WithUID("foo")).
+				StartTimestamp(fakeClock.Now()).
+				Phase(velerov1api.BackupPhaseFinalizing).Result(),
+			backupLocation: defaultBackupLocation,
+			resourceModifiers: builder.ForConfigMap(velerov1api.DefaultNamespace, "modifiers").
+				Data("modifiers.yaml", "version: v1\nresourceModifierRules:\n- conditions:\n    groupResource: secrets\n  mergePatches:\n  - patchData: '{\"data\":null}'\n").
+				Result(),
+			expectPhase:     velerov1api.BackupPhaseCompleted,
+			expectModifiers: true,
+			backupOperations: []*itemoperation.BackupOperation{
+				{
+					Spec: itemoperation.BackupOperationSpec{
+						BackupName:       "backup-4",
+						BackupUID:        "foo",
+						BackupItemAction: "foo",
+						ResourceIdentifier: velero.ResourceIdentifier{
+							GroupResource: kuberesource.Pods,
+							Namespace:     "ns-1",
+							Name:          "pod-1",
+						},
+						PostOperationItems: []velero.ResourceIdentifier{
+							{
+								GroupResource: kuberesource.Secrets,
+								Namespace:     "ns-1",
+								Name:          "secret-1",
+							},
+						},
+						OperationID: "operation-4",
+					},
+					Status: itemoperation.OperationStatus{
+						Phase:   itemoperation.OperationPhaseCompleted,
+						Created: &metav1Now,
+					},
+				},
+			},
+		},
 	}
 
 	for _, test := range tests {
@@ -206,6 +250,9 @@ func TestBackupFinalizerReconcile(t *testing.T) {
 			if test.backupLocation != nil {
 				initObjs = append(initObjs, test.backupLocation)
 			}
+			if test.resourceModifiers != nil {
+				initObjs = append(initObjs, test.resourceModifiers)
+			}
 
 			if test.enableCSI {
 				features.Enable(velerov1api.CSIFeatureFlag)
@@ -237,6 +284,13 @@ func TestBackupFinalizerReconcile(t *testing.T) {
 			require.NoError(t, err)
 			assert.Equal(t, test.expectPhase, backupAfter.Status.Phase)
 			assert.Equal(t, test.expectedCompletedVS, backupAfter.Status.CSIVolumeSnapshotsCompleted)
+
+			for _, call := range backupper.Calls {
+				if call.Method == "FinalizeBackup" {
+					request := call.Arguments.Get(1).(*pkgbackup.Request)
+					assert.Equal(t, test.expectModifiers, request.ResourceModifiers != nil)
+				}
+			}
 		})
 	}
 }
@@ -39,6 +39,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/encode"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
@@ -174,7 +175,14 @@ func (c *backupOperationsReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		}
 		return ctrl.Result{}, errors.Wrap(err, "error getting backup operations")
 	}
+	opsCancelled := false
+	if boolptr.IsSetToTrue(backup.Spec.Cancel) {
+		log.Info("Backup was cancelled, cancelling its item operations")
+		opsCancelled = cancelBackupItemOperations(backup, pluginManager, operations.Operations, log)
+		cancelDataUploads(ctx, c.Client, backup, log)
+	}
 	stillInProgress, changes, opsCompleted, opsFailed, errs := getBackupItemOperationProgress(backup, pluginManager, operations.Operations)
+	changes = changes || opsCancelled
 	// if len(errs)>0, need to update backup errors and error log
 	operations.ErrsSinceUpdate = append(operations.ErrsSinceUpdate, errs...)
 	backup.Status.Errors += len(operations.ErrsSinceUpdate)
@@ -0,0 +1,233 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+const (
+	// cancellationCheckFrequency is how often a running backup or restore
+	// is checked for a cancellation request.
+	cancellationCheckFrequency = time.Second
+
+	backupCancelledError  = "the backup was cancelled"
+	restoreCancelledError = "the restore was cancelled"
+)
+
+// watchCancellation checks the object periodically until the returned stop
+// function is called, and calls cancel once cancelRequested returns true for
+// the latest version of the object.
+func watchCancellation(kbClient kbclient.Client, obj kbclient.Object, cancelRequested func(kbclient.Object) bool, cancel func(), log logrus.FieldLogger) (stop func()) {
+	key := kbclient.ObjectKeyFromObject(obj)
+	latest := obj.DeepCopyObject().(kbclient.Object)
+	done := make(chan struct{})
+
+	go func() {
+		ticker := time.NewTicker(cancellationCheckFrequency)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+
+			if err := kbClient.Get(context.Background(), key, latest); err != nil {
+				log.WithError(err).Debug("Error checking for a cancellation request")
+				continue
+			}
+			if cancelRequested(latest) {
+				log.Info("Cancellation requested")
+				cancel()
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(done) })
+	}
+}
+
+// cancelPodVolumeBackups requests the cancellation of the unfinished pod
+// volume backups of the backup.
+func cancelPodVolumeBackups(ctx context.Context, kbClient kbclient.Client, backup *velerov1api.Backup, log logrus.FieldLogger) {
+	pvbs := &velerov1api.PodVolumeBackupList{}
+	if err := kbClient.List(ctx, pvbs, kbclient.InNamespace(backup.Namespace),
+		kbclient.MatchingLabels{velerov1api.BackupUIDLabel: string(backup.UID)}); err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error listing pod volume backups to cancel")
+		return
+	}
+
+	for i := range pvbs.Items {
+		pvb := &pvbs.Items[i]
+		switch pvb.Status.Phase {
+		case "", velerov1api.PodVolumeBackupPhaseNew, velerov1api.PodVolumeBackupPhaseInProgress:
+		default:
+			continue
+		}
+		if pvb.Spec.Cancel {
+			continue
+		}
+
+		original := pvb.DeepCopy()
+		pvb.Spec.Cancel = true
+		if err := kbClient.Patch(ctx, pvb, kbclient.MergeFrom(original)); err != nil {
+			log.WithError(errors.WithStack(err)).Errorf("Error cancelling pod volume backup %s", pvb.Name)
+			continue
+		}
+		log.Infof("Cancelled pod volume backup %s", pvb.Name)
+	}
+}
+
+// cancelDataUploads requests the cancellation of the unfinished data uploads
+// of the backup.
+func cancelDataUploads(ctx context.Context, kbClient kbclient.Client, backup *velerov1api.Backup, log logrus.FieldLogger) {
+	dataUploads := &velerov2alpha1api.DataUploadList{}
+	if err := kbClient.List(ctx, dataUploads, kbclient.InNamespace(backup.Namespace),
+		kbclient.MatchingLabels{velerov1api.BackupUIDLabel: string(backup.UID)}); err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error listing data uploads to cancel")
+		return
+	}
+
+	for i := range dataUploads.Items {
+		du := &dataUploads.Items[i]
+		switch du.Status.Phase {
+		case "", velerov2alpha1api.DataUploadPhaseNew, velerov2alpha1api.DataUploadPhaseAccepted,
+			velerov2alpha1api.DataUploadPhasePrepared, velerov2alpha1api.DataUploadPhaseInProgress:
+		default:
+			continue
+		}
+		if du.Spec.Cancel {
+			continue
+		}
+
+		original := du.DeepCopy()
+		du.Spec.Cancel = true
+		if err := kbClient.Patch(ctx, du, kbclient.MergeFrom(original)); err != nil {
+			log.WithError(errors.WithStack(err)).Errorf("Error cancelling data upload %s", du.Name)
+			continue
+		}
+		log.Infof("Cancelled data upload %s", du.Name)
+	}
+}
+
+// cancelDataDownloads requests the cancellation of the unfinished data
+// downloads of the restore.
+func cancelDataDownloads(ctx context.Context, kbClient kbclient.Client, restore *velerov1api.Restore, log logrus.FieldLogger) {
+	dataDownloads := &velerov2alpha1api.DataDownloadList{}
+	if err := kbClient.List(ctx, dataDownloads, kbclient.InNamespace(restore.Namespace),
+		kbclient.MatchingLabels{velerov1api.RestoreUIDLabel: string(restore.UID)}); err != nil {
+		log.WithError(errors.WithStack(err)).Error("Error listing data downloads to cancel")
+		return
+	}
+
+	for i := range dataDownloads.Items {
+		dd := &dataDownloads.Items[i]
+		switch dd.Status.Phase {
+		case "", velerov2alpha1api.DataDownloadPhaseNew, velerov2alpha1api.DataDownloadPhaseAccepted,
+			velerov2alpha1api.DataDownloadPhasePrepared, velerov2alpha1api.DataDownloadPhaseInProgress:
+		default:
+			continue
+		}
+		if dd.Spec.Cancel {
+			continue
+		}
+
+		original := dd.DeepCopy()
+		dd.Spec.Cancel = true
+		if err := kbClient.Patch(ctx, dd, kbclient.MergeFrom(original)); err != nil {
+			log.WithError(errors.WithStack(err)).Errorf("Error cancelling data download %s", dd.Name)
+			continue
+		}
+		log.Infof("Cancelled data download %s", dd.Name)
+	}
+}
+
+// cancelBackupItemOperations cancels the unfinished asynchronous operations of
+// the backup and marks them Failed. It returns true if any operation changed.
+func cancelBackupItemOperations(backup *velerov1api.Backup, pluginManager clientmgmt.Manager, operations []*itemoperation.BackupOperation, log logrus.FieldLogger) bool {
+	changes := false
+	for _, operation := range operations {
+		if operation.Status.Phase != itemoperation.OperationPhaseNew &&
+			operation.Status.Phase != itemoperation.OperationPhaseInProgress {
+			continue
+		}
+
+		bia, err := pluginManager.GetBackupItemActionV2(operation.Spec.BackupItemAction)
+		if err != nil {
+			log.WithError(err).Errorf("Error getting backup item action %s to cancel operation %s", operation.Spec.BackupItemAction, operation.Spec.OperationID)
+		} else if err := bia.Cancel(operation.Spec.OperationID, backup); err != nil {
+			log.WithError(err).Errorf("Error cancelling operation %s", operation.Spec.OperationID)
+		}
+		operation.Status.Phase = itemoperation.OperationPhaseFailed
+		operation.Status.Error = backupCancelledError
+		changes = true
+	}
+	return changes
+}
+
+// cancelRestoreItemOperations cancels the unfinished asynchronous operations
+// of the restore and marks them Failed. It returns true if any operation
+// changed.
+func cancelRestoreItemOperations(restore *velerov1api.Restore, pluginManager clientmgmt.Manager, operations []*itemoperation.RestoreOperation, log logrus.FieldLogger) bool {
+	changes := false
+	for _, operation := range operations {
+		if operation.Status.Phase != itemoperation.OperationPhaseNew &&
+			operation.Status.Phase != itemoperation.OperationPhaseInProgress {
+			continue
+		}
+
+		ria, err := pluginManager.GetRestoreItemActionV2(operation.Spec.RestoreItemAction)
+		if err != nil {
+			log.WithError(err).Errorf("Error getting restore item action %s to cancel operation %s", operation.Spec.RestoreItemAction, operation.Spec.OperationID)
+		} else if err := ria.Cancel(operation.Spec.OperationID, restore); err != nil {
+			log.WithError(err).Errorf("Error cancelling operation %s", operation.Spec.OperationID)
+		}
+		operation.Status.Phase = itemoperation.OperationPhaseFailed
+		operation.Status.Error = restoreCancelledError
+		changes = true
+	}
+	return changes
+}
+
+// backupCancelRequested returns true if the cancellation of the backup was requested.
+func backupCancelRequested(obj kbclient.Object) bool {
+	backup, ok := obj.(*velerov1api.Backup)
+	return ok && boolptr.IsSetToTrue(backup.Spec.Cancel)
+}
+
+// restoreCancelRequested returns true if the cancellation of the restore was requested.
+func restoreCancelRequested(obj kbclient.Object) bool {
+	restore, ok := obj.(*velerov1api.Restore)
+	return ok && boolptr.IsSetToTrue(restore.Spec.Cancel)
+}
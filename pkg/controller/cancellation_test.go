@@ -0,0 +1,160 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	biav2mocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks/backupitemaction/v2"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+)
+
+func TestWatchCancellation(t *testing.T) {
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Phase(velerov1api.BackupPhaseInProgress).Result()
+	kbClient := velerotest.NewFakeControllerRuntimeClient(t, backup.DeepCopy())
+
+	cancelled := make(chan struct{})
+	stop := watchCancellation(kbClient, backup, backupCancelRequested, func() { close(cancelled) }, logrus.StandardLogger())
+	defer stop()
+
+	updated := &velerov1api.Backup{}
+	require.NoError(t, kbClient.Get(context.Background(), kbclient.ObjectKeyFromObject(backup), updated))
+	original := updated.DeepCopy()
+	updated.Spec.Cancel = boolptr.True()
+	require.NoError(t, kbClient.Patch(context.Background(), updated, kbclient.MergeFrom(original)))
+
+	select {
+	case <-cancelled:
+	case <-time.After(5 * cancellationCheckFrequency):
+		t.Fatal("the cancellation wasn't detected")
+	}
+}
+
+func TestWatchCancellationStopped(t *testing.T) {
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Cancel(true).Result()
+	kbClient := velerotest.NewFakeControllerRuntimeClient(t, backup.DeepCopy())
+
+	cancelled := make(chan struct{})
+	stop := watchCancellation(kbClient, backup, backupCancelRequested, func() { close(cancelled) }, logrus.StandardLogger())
+	stop()
+	stop()
+
+	select {
+	case <-cancelled:
+		t.Fatal("the cancellation was detected after the watch stopped")
+	case <-time.After(2 * cancellationCheckFrequency):
+	}
+}
+
+func TestCancelPodVolumeBackups(t *testing.T) {
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").ObjectMeta(builder.WithUID("backup-uid")).Result()
+	labels := builder.WithLabels(velerov1api.BackupUIDLabel, "backup-uid")
+	kbClient := velerotest.NewFakeControllerRuntimeClient(t,
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-new").ObjectMeta(labels).Phase(velerov1api.PodVolumeBackupPhaseNew).Result(),
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-in-progress").ObjectMeta(labels).Phase(velerov1api.PodVolumeBackupPhaseInProgress).Result(),
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-completed").ObjectMeta(labels).Phase(velerov1api.PodVolumeBackupPhaseCompleted).Result(),
+		builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-other-backup").ObjectMeta(builder.WithLabels(velerov1api.BackupUIDLabel, "other-uid")).
+			Phase(velerov1api.PodVolumeBackupPhaseInProgress).Result(),
+	)
+
+	cancelPodVolumeBackups(context.Background(), kbClient, backup, logrus.StandardLogger())
+
+	expected := map[string]bool{
+		"pvb-new":          true,
+		"pvb-in-progress":  true,
+		"pvb-completed":    false,
+		"pvb-other-backup": false,
+	}
+	for name, cancel := range expected {
+		pvb := &velerov1api.PodVolumeBackup{}
+		require.NoError(t, kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: velerov1api.DefaultNamespace, Name: name}, pvb))
+		assert.Equal(t, cancel, pvb.Spec.Cancel, name)
+	}
+}
+
+func TestCancelDataUploadsAndDownloads(t *testing.T) {
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").ObjectMeta(builder.WithUID("backup-uid")).Result()
+	restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").ObjectMeta(builder.WithUID("restore-uid")).Result()
+	kbClient := velerotest.NewFakeControllerRuntimeClient(t,
+		builder.ForDataUpload(velerov1api.DefaultNamespace, "du-accepted").Labels(map[string]string{velerov1api.BackupUIDLabel: "backup-uid"}).
+			Phase(velerov2alpha1api.DataUploadPhaseAccepted).Result(),
+		builder.ForDataUpload(velerov1api.DefaultNamespace, "du-completed").Labels(map[string]string{velerov1api.BackupUIDLabel: "backup-uid"}).
+			Phase(velerov2alpha1api.DataUploadPhaseCompleted).Result(),
+		builder.ForDataDownload(velerov1api.DefaultNamespace, "dd-in-progress").ObjectMeta(builder.WithLabels(velerov1api.RestoreUIDLabel, "restore-uid")).
+			Phase(velerov2alpha1api.DataDownloadPhaseInProgress).Result(),
+		builder.ForDataDownload(velerov1api.DefaultNamespace, "dd-failed").ObjectMeta(builder.WithLabels(velerov1api.RestoreUIDLabel, "restore-uid")).
+			Phase(velerov2alpha1api.DataDownloadPhaseFailed).Result(),
+	)
+
+	cancelDataUploads(context.Background(), kbClient, backup, logrus.StandardLogger())
+	cancelDataDownloads(context.Background(), kbClient, restore, logrus.StandardLogger())
+
+	for name, cancel := range map[string]bool{"du-accepted": true, "du-completed": false} {
+		du := &velerov2alpha1api.DataUpload{}
+		require.NoError(t, kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: velerov1api.DefaultNamespace, Name: name}, du))
+		assert.Equal(t, cancel, du.Spec.Cancel, name)
+	}
+	for name, cancel := range map[string]bool{"dd-in-progress": true, "dd-failed": false} {
+		dd := &velerov2alpha1api.DataDownload{}
+		require.NoError(t, kbClient.Get(context.Background(), kbclient.ObjectKey{Namespace: velerov1api.DefaultNamespace, Name: name}, dd))
+		assert.Equal(t, cancel, dd.Spec.Cancel, name)
+	}
+}
+
+func TestCancelBackupItemOperations(t *testing.T) {
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Result()
+	operation := func(id string, phase itemoperation.OperationPhase) *itemoperation.BackupOperation {
+		return &itemoperation.BackupOperation{
+			Spec:   itemoperation.BackupOperationSpec{BackupName: backup.Name, BackupItemAction: "foo", OperationID: id},
+			Status: itemoperation.OperationStatus{Phase: phase},
+		}
+	}
+	operations := []*itemoperation.BackupOperation{
+		operation("op-new", itemoperation.OperationPhaseNew),
+		operation("op-in-progress", itemoperation.OperationPhaseInProgress),
+		operation("op-completed", itemoperation.OperationPhaseCompleted),
+	}
+
+	action := &biav2mocks.BackupItemAction{}
+	action.On("Cancel", "op-new", backup).Return(nil)
+	action.On("Cancel", "op-in-progress", backup).Return(nil)
+	manager := &pluginmocks.Manager{}
+	manager.On("GetBackupItemActionV2", "foo").Return(action, nil)
+
+	assert.True(t, cancelBackupItemOperations(backup, manager, operations, logrus.StandardLogger()))
+	action.AssertExpectations(t)
+
+	assert.Equal(t, itemoperation.OperationPhaseFailed, operations[0].Status.Phase)
+	assert.Equal(t, backupCancelledError, operations[0].Status.Error)
+	assert.Equal(t, itemoperation.OperationPhaseFailed, operations[1].Status.Phase)
+	assert.Equal(t, itemoperation.OperationPhaseCompleted, operations[2].Status.Phase)
+
+	assert.False(t, cancelBackupItemOperations(backup, manager, operations, logrus.StandardLogger()))
+}
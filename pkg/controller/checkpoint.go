@@ -0,0 +1,76 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+)
+
+// checkpointFrequency is how often the progress of a running backup or
+// restore is checkpointed, so it can be resumed after a restart of the server.
+const checkpointFrequency = time.Minute
+
+// checkpointPeriodically calls checkpoint periodically until the returned stop
+// function is called.
+func checkpointPeriodically(checkpoint func() error, log logrus.FieldLogger) (stop func()) {
+	done := make(chan struct{})
+
+	go func() {
+		ticker := time.NewTicker(checkpointFrequency)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-done:
+				return
+			case <-ticker.C:
+			}
+
+			if err := checkpoint(); err != nil {
+				log.WithError(err).Warn("Error checkpointing the progress")
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() { close(done) })
+	}
+}
+
+// decodeCheckpoint decodes the gzipped JSON checkpoint and closes it.
+func decodeCheckpoint(checkpoint io.ReadCloser, into interface{}) error {
+	defer checkpoint.Close()
+
+	gzr, err := gzip.NewReader(checkpoint)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer gzr.Close()
+
+	if err := json.NewDecoder(gzr).Decode(into); err != nil {
+		return errors.Wrap(err, "error decoding checkpoint")
+	}
+	return nil
+}
@@ -0,0 +1,111 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/itemoperationmap"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/volume"
+)
+
+func TestCheckpointAndResumeBackup(t *testing.T) {
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Result()
+	operation := &itemoperation.BackupOperation{
+		Spec: itemoperation.BackupOperationSpec{
+			BackupName:       backup.Name,
+			BackupItemAction: "foo",
+			OperationID:      "op-1",
+			ResourceIdentifier: velero.ResourceIdentifier{
+				Name: "pvc-1",
+			},
+		},
+		Status: itemoperation.OperationStatus{Phase: itemoperation.OperationPhaseInProgress},
+	}
+	snapshot := &volume.Snapshot{
+		Spec:   volume.SnapshotSpec{BackupName: backup.Name, PersistentVolumeName: "pv-1"},
+		Status: volume.SnapshotStatus{Phase: volume.SnapshotPhaseCompleted, ProviderSnapshotID: "snap-1"},
+	}
+
+	request := &pkgbackup.Request{Backup: backup, VolumeSnapshots: []*volume.Snapshot{snapshot}}
+	*request.GetItemOperationsList() = append(*request.GetItemOperationsList(), operation)
+
+	var checkpoint []byte
+	backupStore := &persistencemocks.BackupStore{}
+	backupStore.On("PutBackupItemOperations", backup.Name, mock.Anything).Return(nil)
+	backupStore.On("PutBackupCheckpoint", backup.Name, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		data, err := io.ReadAll(args.Get(1).(io.Reader))
+		require.NoError(t, err)
+		checkpoint = data
+	})
+
+	b := &backupReconciler{itemOperationsMap: itemoperationmap.NewBackupItemOperationsMap()}
+	require.NoError(t, b.checkpointBackup(request, backupStore))
+	backupStore.AssertExpectations(t)
+
+	decoded := &pkgbackup.Checkpoint{}
+	require.NoError(t, decodeCheckpoint(io.NopCloser(bytes.NewReader(checkpoint)), decoded))
+	require.Len(t, decoded.VolumeSnapshots, 1)
+	assert.Equal(t, "snap-1", decoded.VolumeSnapshots[0].Status.ProviderSnapshotID)
+
+	// the operation of the interrupted run isn't adopted by the resumed
+	// backup since the item it was started for wasn't written
+	resumedBackupStore := &persistencemocks.BackupStore{}
+	resumedBackupStore.On("GetBackupCheckpoint", backup.Name).Return(io.NopCloser(bytes.NewReader(checkpoint)), nil)
+	resumedBackupStore.On("GetBackupItemOperations", backup.Name).Return([]*itemoperation.BackupOperation{operation}, nil)
+
+	resumed := &pkgbackup.Request{Backup: backup}
+	b = &backupReconciler{itemOperationsMap: itemoperationmap.NewBackupItemOperationsMap()}
+	b.resumeBackup(resumed, resumedBackupStore, logrus.StandardLogger())
+	resumedBackupStore.AssertExpectations(t)
+
+	unadopted := resumed.UnadoptedOperations()
+	require.Len(t, unadopted, 1)
+	assert.Equal(t, "op-1", unadopted[0].Spec.OperationID)
+}
+
+func TestResumeBackupWithoutCheckpoint(t *testing.T) {
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "backup-1").Result()
+	backupStore := &persistencemocks.BackupStore{}
+	backupStore.On("GetBackupCheckpoint", backup.Name).Return(nil, nil)
+	backupStore.On("GetBackupItemOperations", backup.Name).Return(nil, nil)
+
+	request := &pkgbackup.Request{Backup: backup}
+	b := &backupReconciler{itemOperationsMap: itemoperationmap.NewBackupItemOperationsMap()}
+	b.resumeBackup(request, backupStore, logrus.StandardLogger())
+	backupStore.AssertExpectations(t)
+
+	assert.Empty(t, request.UnadoptedOperations())
+}
+
+func TestDecodeCheckpointInvalid(t *testing.T) {
+	checkpoint := &pkgbackup.Checkpoint{}
+	assert.Error(t, decodeCheckpoint(io.NopCloser(bytes.NewReader([]byte("not gzipped"))), checkpoint))
+}
@@ -30,8 +30,10 @@ const (
 	PodVolumeRestore      = "pod-volume-restore"
 	Restore               = "restore"
 	RestoreOperations     = "restore-operations"
+	RestoreRollback       = "restore-rollback"
 	Schedule              = "schedule"
 	ServerStatusRequest   = "server-status-request"
+	SnapshotBrowseRequest = "snapshot-browse-request"
 )
 
 // DisableableControllers is a list of controllers that can be disabled
@@ -46,6 +48,8 @@ var DisableableControllers = []string{
 	BackupRepo,
 	Restore,
 	RestoreOperations,
+	RestoreRollback,
 	Schedule,
 	ServerStatusRequest,
+	SnapshotBrowseRequest,
 }
@@ -49,6 +49,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	repository "github.com/vmware-tanzu/velero/pkg/repository"
 	"github.com/vmware-tanzu/velero/pkg/uploader"
+	uploaderutil "github.com/vmware-tanzu/velero/pkg/uploader/util"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
@@ -333,7 +334,12 @@ func (r *DataDownloadReconciler) runCancelableDataPath(ctx context.Context, fsRe
 	}
 	log.WithField("path", path.ByPath).Info("fs init")
 
-	if err := fsRestore.StartRestore(dd.Spec.SnapshotID, path, dd.Spec.DataMoverConfig); err != nil {
+	dataMoverConfig, err := uploaderutil.StoreIncludedPaths(dd.Spec.DataMoverConfig, dd.Spec.IncludedPaths)
+	if err != nil {
+		return r.errorOut(ctx, dd, err, "error to get data mover config", log)
+	}
+
+	if err := fsRestore.StartRestore(dd.Spec.SnapshotID, path, dataMoverConfig); err != nil {
 		return r.errorOut(ctx, dd, err, fmt.Sprintf("error starting data path %s restore", path.ByPath), log)
 	}
 
@@ -34,6 +34,7 @@ import (
 	pkgbackup "github.com/vmware-tanzu/velero/pkg/backup"
 	veleroclient "github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/label"
+	pkgschedule "github.com/vmware-tanzu/velero/pkg/schedule"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
@@ -97,6 +98,7 @@ func (c *gcReconciler) SetupWithManager(mgr ctrl.Manager) error {
 // +kubebuilder:rbac:groups=velero.io,resources=deletebackuprequests,verbs=get;list;watch;create;
 // +kubebuilder:rbac:groups=velero.io,resources=deletebackuprequests/status,verbs=get
 // +kubebuilder:rbac:groups=velero.io,resources=backupstoragelocations,verbs=get
+// +kubebuilder:rbac:groups=velero.io,resources=schedules,verbs=get
 
 func (c *gcReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
 	log := c.logger.WithField("gc backup", req.String())
@@ -119,8 +121,11 @@ func (c *gcReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Re
 		},
 	)
 
-	now := c.clock.Now()
-	if backup.Status.Expiration == nil || backup.Status.Expiration.After(now) {
+	expired, err := c.isExpired(ctx, backup, c.clock.Now(), log)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if !expired {
 		log.Debug("Backup has not expired yet, skipping")
 		return ctrl.Result{}, nil
 	}
@@ -195,3 +200,31 @@ func (c *gcReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Re
 
 	return ctrl.Result{}, nil
 }
+
+// isExpired returns true if the backup has expired. The completed backups of a
+// schedule with a retention policy expire once the policy doesn't retain them,
+// the other backups when their TTL expires.
+func (c *gcReconciler) isExpired(ctx context.Context, backup *velerov1api.Backup, now time.Time, log logrus.FieldLogger) (bool, error) {
+	scheduleName := backup.Labels[velerov1api.ScheduleNameLabel]
+	if scheduleName != "" && pkgschedule.IsRetentionCandidate(backup) {
+		schedule := &velerov1api.Schedule{}
+		err := c.Get(ctx, client.ObjectKey{Namespace: backup.Namespace, Name: scheduleName}, schedule)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return false, errors.Wrapf(err, "error getting schedule %s", scheduleName)
+		}
+
+		if err == nil && schedule.Spec.Retention != nil && len(pkgschedule.ValidateRetention(schedule.Spec.Retention)) == 0 {
+			backups := &velerov1api.BackupList{}
+			if err := c.List(ctx, backups, client.InNamespace(backup.Namespace), client.MatchingLabels{velerov1api.ScheduleNameLabel: scheduleName}); err != nil {
+				return false, errors.Wrapf(err, "error listing backups of schedule %s", scheduleName)
+			}
+			if _, retained := pkgschedule.RetainedBackups(schedule.Spec.Retention, pkgschedule.Location(schedule), backups.Items)[backup.Name]; retained {
+				return false, nil
+			}
+			log.Infof("Backup isn't retained by the retention policy of schedule %s", scheduleName)
+			return true, nil
+		}
+	}
+
+	return backup.Status.Expiration != nil && !backup.Status.Expiration.After(now), nil
+}
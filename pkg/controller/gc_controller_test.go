@@ -145,3 +145,77 @@ func TestGCReconcile(t *testing.T) {
 		})
 	}
 }
+
+func TestGCReconcileRetention(t *testing.T) {
+	fakeClock := testclocks.NewFakeClock(time.Date(2023, 6, 25, 12, 0, 0, 0, time.UTC))
+	now := fakeClock.Now()
+	defaultBackupLocation := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result()
+	scheduleBackup := func(name string, phase velerov1api.BackupPhase, start time.Time) *builder.BackupBuilder {
+		return builder.ForBackup(velerov1api.DefaultNamespace, name).
+			ObjectMeta(builder.WithLabels(velerov1api.ScheduleNameLabel, "schedule-1")).
+			StorageLocation("default").Phase(phase).StartTimestamp(start)
+	}
+	retention := &velerov1api.ScheduleRetention{KeepLast: 1, KeepDaily: 2}
+
+	tests := []struct {
+		name          string
+		schedule      *velerov1api.Schedule
+		backup        *velerov1api.Backup
+		expectDeleted bool
+	}{
+		{
+			name:     "backup retained by the retention policy is not deleted though its TTL expired",
+			schedule: builder.ForSchedule(velerov1api.DefaultNamespace, "schedule-1").Retention(retention).Result(),
+			backup:   scheduleBackup("backup-2", velerov1api.BackupPhaseCompleted, now.Add(-24*time.Hour)).Expiration(now.Add(-time.Minute)).Result(),
+		},
+		{
+			name:          "backup not retained by the retention policy is deleted though its TTL didn't expire",
+			schedule:      builder.ForSchedule(velerov1api.DefaultNamespace, "schedule-1").Retention(retention).Result(),
+			backup:        scheduleBackup("backup-4", velerov1api.BackupPhaseCompleted, now.Add(-49*time.Hour)).Expiration(now.Add(time.Hour)).Result(),
+			expectDeleted: true,
+		},
+		{
+			name:     "failed backup is deleted with its TTL",
+			schedule: builder.ForSchedule(velerov1api.DefaultNamespace, "schedule-1").Retention(retention).Result(),
+			backup:   scheduleBackup("backup-5", velerov1api.BackupPhaseFailed, now.Add(-72*time.Hour)).Expiration(now.Add(time.Hour)).Result(),
+		},
+		{
+			name:          "backup of a schedule without retention policy is deleted with its TTL",
+			schedule:      builder.ForSchedule(velerov1api.DefaultNamespace, "schedule-1").Result(),
+			backup:        scheduleBackup("backup-2", velerov1api.BackupPhaseCompleted, now.Add(-24*time.Hour)).Expiration(now.Add(-time.Minute)).Result(),
+			expectDeleted: true,
+		},
+		{
+			name:          "backup of a deleted schedule is deleted with its TTL",
+			backup:        scheduleBackup("backup-2", velerov1api.BackupPhaseCompleted, now.Add(-24*time.Hour)).Expiration(now.Add(-time.Minute)).Result(),
+			expectDeleted: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			initObjs := []runtime.Object{
+				defaultBackupLocation,
+				scheduleBackup("backup-1", velerov1api.BackupPhaseCompleted, now.Add(-time.Hour)).Result(),
+				scheduleBackup("backup-3", velerov1api.BackupPhaseCompleted, now.Add(-48*time.Hour)).Result(),
+			}
+			initObjs = append(initObjs, test.backup)
+			if test.schedule != nil {
+				initObjs = append(initObjs, test.schedule)
+			}
+
+			fakeClient := velerotest.NewFakeControllerRuntimeClient(t, initObjs...)
+			reconciler := mockGCReconciler(fakeClient, fakeClock, defaultGCFrequency)
+			_, err := reconciler.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: test.backup.Namespace, Name: test.backup.Name}})
+			assert.NoError(t, err)
+
+			dbrs := &velerov1api.DeleteBackupRequestList{}
+			assert.NoError(t, fakeClient.List(context.TODO(), dbrs))
+			if test.expectDeleted {
+				assert.Len(t, dbrs.Items, 1)
+			} else {
+				assert.Empty(t, dbrs.Items)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// InterruptedTracker keeps track of the backups or restores which were in
+// progress when the server started, and which are resumed by their controller.
+type InterruptedTracker interface {
+	// Add informs the tracker that a backup or restore was interrupted.
+	Add(ns, name string)
+	// Take returns true if the backup or restore was interrupted and stops
+	// tracking it, so it's resumed only once.
+	Take(ns, name string) bool
+}
+
+type interruptedTracker struct {
+	lock  sync.Mutex
+	items sets.String
+}
+
+// NewInterruptedTracker returns a new InterruptedTracker.
+func NewInterruptedTracker() InterruptedTracker {
+	return &interruptedTracker{
+		items: sets.NewString(),
+	}
+}
+
+func (it *interruptedTracker) Add(ns, name string) {
+	it.lock.Lock()
+	defer it.lock.Unlock()
+
+	it.items.Insert(backupTrackerKey(ns, name))
+}
+
+func (it *interruptedTracker) Take(ns, name string) bool {
+	it.lock.Lock()
+	defer it.lock.Unlock()
+
+	key := backupTrackerKey(ns, name)
+	if !it.items.Has(key) {
+		return false
+	}
+	it.items.Delete(key)
+	return true
+}
@@ -0,0 +1,37 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInterruptedTracker(t *testing.T) {
+	it := NewInterruptedTracker()
+
+	assert.False(t, it.Take("ns", "name"))
+
+	it.Add("ns", "name")
+	it.Add("ns2", "name2")
+	assert.True(t, it.Take("ns", "name"))
+	assert.False(t, it.Take("ns", "name"))
+
+	assert.True(t, it.Take("ns2", "name2"))
+	assert.False(t, it.Take("ns2", "name2"))
+}
@@ -106,6 +106,20 @@ func (r *PodVolumeBackupReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 		return ctrl.Result{}, nil
 	}
 
+	if pvb.Spec.Cancel {
+		switch pvb.Status.Phase {
+		case "", velerov1api.PodVolumeBackupPhaseNew:
+			log.Info("PodVolumeBackup is canceled before it's started")
+			_ = UpdatePVBStatusToFailed(ctx, r.Client, &pvb, "PVB is canceled", r.clock.Now(), log)
+		case velerov1api.PodVolumeBackupPhaseInProgress:
+			if fsBackup := r.dataPathMgr.GetAsyncBR(pvb.Name); fsBackup != nil {
+				log.Info("Cancelling the data path of the PodVolumeBackup")
+				fsBackup.Cancel()
+			}
+		}
+		return ctrl.Result{}, nil
+	}
+
 	switch pvb.Status.Phase {
 	case "", velerov1api.PodVolumeBackupPhaseNew:
 		// Only process new items.
@@ -46,6 +46,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/repository"
 	"github.com/vmware-tanzu/velero/pkg/restorehelper"
 	"github.com/vmware-tanzu/velero/pkg/uploader"
+	uploaderutil "github.com/vmware-tanzu/velero/pkg/uploader/util"
 	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/filesystem"
 )
@@ -147,7 +148,12 @@ func (c *PodVolumeRestoreReconciler) Reconcile(ctx context.Context, req ctrl.Req
 		return c.errorOut(ctx, pvr, err, "error to initialize data path", log)
 	}
 
-	if err := fsRestore.StartRestore(pvr.Spec.SnapshotID, volumePath, pvr.Spec.UploaderSettings); err != nil {
+	uploaderSettings, err := uploaderutil.StoreIncludedPaths(pvr.Spec.UploaderSettings, pvr.Spec.IncludedPaths)
+	if err != nil {
+		return c.errorOut(ctx, pvr, err, "error to get uploader settings", log)
+	}
+
+	if err := fsRestore.StartRestore(pvr.Spec.SnapshotID, volumePath, uploaderSettings); err != nil {
 		return c.errorOut(ctx, pvr, err, "error starting data path restore", log)
 	}
 
@@ -44,16 +44,21 @@ import (
 
 	"github.com/vmware-tanzu/velero/internal/hook"
 	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
+	"github.com/vmware-tanzu/velero/internal/restorepreview"
+	"github.com/vmware-tanzu/velero/internal/restorerollback"
 	internalVolume "github.com/vmware-tanzu/velero/internal/volume"
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/itemoperationmap"
 	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
 	"github.com/vmware-tanzu/velero/pkg/plugin/framework"
 	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/collections"
+	"github.com/vmware-tanzu/velero/pkg/util/encode"
 	kubeutil "github.com/vmware-tanzu/velero/pkg/util/kube"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 	"github.com/vmware-tanzu/velero/pkg/util/results"
@@ -104,6 +109,10 @@ type restoreReconciler struct {
 	clock                       clock.WithTickerAndDelayedExecution
 	defaultItemOperationTimeout time.Duration
 	disableInformerCache        bool
+	interruptedRestores         InterruptedTracker
+	// itemOperationsMap uploads the item operations of the running restores
+	// for their checkpoints.
+	itemOperationsMap *itemoperationmap.RestoreItemOperationsMap
 
 	newPluginManager  func(logger logrus.FieldLogger) clientmgmt.Manager
 	backupStoreGetter persistence.ObjectBackupStoreGetter
@@ -127,6 +136,7 @@ func NewRestoreReconciler(
 	logFormat logging.Format,
 	defaultItemOperationTimeout time.Duration,
 	disableInformerCache bool,
+	interruptedRestores InterruptedTracker,
 ) *restoreReconciler {
 	r := &restoreReconciler{
 		ctx:                         ctx,
@@ -140,6 +150,8 @@ func NewRestoreReconciler(
 		clock:                       &clock.RealClock{},
 		defaultItemOperationTimeout: defaultItemOperationTimeout,
 		disableInformerCache:        disableInformerCache,
+		interruptedRestores:         interruptedRestores,
+		itemOperationsMap:           itemoperationmap.NewRestoreItemOperationsMap(),
 
 		// use variables to refer to these functions so they can be
 		// replaced with fakes for testing.
@@ -207,9 +219,14 @@ func (r *restoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		}
 	}
 
-	switch restore.Status.Phase {
-	case "", api.RestorePhaseNew:
+	resumed := restore.Status.Phase == api.RestorePhaseInProgress && r.interruptedRestores != nil &&
+		r.interruptedRestores.Take(restore.Namespace, restore.Name)
+	switch {
+	case restore.Status.Phase == "" || restore.Status.Phase == api.RestorePhaseNew:
 		// only process new restores
+	case resumed:
+		// and the restores interrupted by a restart of the server
+		log.Info("Resuming restore interrupted by a restart of the server")
 	default:
 		r.logger.WithFields(logrus.Fields{
 			"restore": kubeutil.NamespaceAndName(restore),
@@ -221,8 +238,46 @@ func (r *restoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	// store a copy of the original restore for creating patch
 	original := restore.DeepCopy()
 
+	if boolptr.IsSetToTrue(restore.Spec.Cancel) {
+		if resumed {
+			log.Info("Restore was cancelled while it was interrupted")
+			cancelDataDownloads(ctx, r.kbClient, restore, log)
+		} else {
+			log.Info("Restore was cancelled before it was started")
+		}
+		restore.Status.Phase = api.RestorePhaseCancelled
+		restore.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+		if err := kubeutil.PatchResource(original, restore, r.kbClient); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "error updating Restore phase to %s", restore.Status.Phase)
+		}
+		r.metrics.RegisterRestoreCancelled(restore.Spec.ScheduleName)
+		return ctrl.Result{}, nil
+	}
+
 	// Validate the restore and fetch the backup
-	info, resourceModifiers := r.validateAndComplete(restore)
+	var info backupInfo
+	var resourceModifiers *resourcemodifiers.ResourceModifiers
+	if resumed {
+		// the interrupted run filled in the schedule name from the backup,
+		// which must not be validated against the backup name again
+		validated := restore.DeepCopy()
+		validated.Spec.ScheduleName = ""
+		info, resourceModifiers = r.validateAndComplete(validated)
+		if len(validated.Status.ValidationErrors) > 0 {
+			log.Info("Interrupted restore is no longer valid")
+			cancelDataDownloads(ctx, r.kbClient, restore, log)
+			restore.Status.Phase = api.RestorePhaseFailed
+			restore.Status.FailureReason = fmt.Sprintf("error resuming the restore: %s", strings.Join(validated.Status.ValidationErrors, "; "))
+			restore.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+			if err := kubeutil.PatchResource(original, restore, r.kbClient); err != nil {
+				return ctrl.Result{}, errors.Wrapf(err, "error updating Restore phase to %s", restore.Status.Phase)
+			}
+			r.metrics.RegisterRestoreFailed(restore.Spec.ScheduleName)
+			return ctrl.Result{}, nil
+		}
+	} else {
+		info, resourceModifiers = r.validateAndComplete(restore)
+	}
 
 	// Register attempts after validation so we don't have to fetch the backup multiple times
 	backupScheduleName := restore.Spec.ScheduleName
@@ -232,7 +287,10 @@ func (r *restoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		restore.Status.Phase = api.RestorePhaseFailedValidation
 		r.metrics.RegisterRestoreValidationFailed(backupScheduleName)
 	} else {
-		restore.Status.StartTimestamp = &metav1.Time{Time: r.clock.Now()}
+		// a resumed restore keeps the start time of its first run
+		if !resumed || restore.Status.StartTimestamp == nil {
+			restore.Status.StartTimestamp = &metav1.Time{Time: r.clock.Now()}
+		}
 		restore.Status.Phase = api.RestorePhaseInProgress
 	}
 	if restore.Spec.ItemOperationTimeout.Duration == 0 {
@@ -256,7 +314,7 @@ func (r *restoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 		return ctrl.Result{}, nil
 	}
 
-	if err := r.runValidatedRestore(restore, info, resourceModifiers); err != nil {
+	if err := r.runValidatedRestore(restore, info, resourceModifiers, resumed); err != nil {
 		log.WithError(err).Debug("Restore failed")
 		restore.Status.Phase = api.RestorePhaseFailed
 		restore.Status.FailureReason = err.Error()
@@ -266,7 +324,8 @@ func (r *restoreReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ct
 	// mark completion if in terminal phase
 	if restore.Status.Phase == api.RestorePhaseFailed ||
 		restore.Status.Phase == api.RestorePhasePartiallyFailed ||
-		restore.Status.Phase == api.RestorePhaseCompleted {
+		restore.Status.Phase == api.RestorePhaseCompleted ||
+		restore.Status.Phase == api.RestorePhaseCancelled {
 		restore.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
 	}
 	log.Debug("Updating restore's final status")
@@ -313,6 +372,16 @@ func (r *restoreReconciler) validateAndComplete(restore *api.Restore) (backupInf
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid included/excluded namespace lists: %v", err))
 	}
 
+	// validate namespace mapping patterns
+	if _, err := pkgrestore.NewNamespaceMapper(&restore.Spec); err != nil {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid namespace mapping patterns: %v", err))
+	}
+
+	// validate existing resource policies
+	if err := pkgrestore.ValidateExistingResourcePolicies(&restore.Spec); err != nil {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Invalid existing resource policy: %v", err))
+	}
+
 	// validate that only one exists orLabelSelector or just labelSelector (singular)
 	if restore.Spec.OrLabelSelectors != nil && restore.Spec.LabelSelector != nil {
 		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, "encountered labelSelector as well as orLabelSelectors in restore spec, only one can be specified")
@@ -372,6 +441,11 @@ func (r *restoreReconciler) validateAndComplete(restore *api.Restore) (backupInf
 		return backupInfo{}, nil
 	}
 
+	if boolptr.IsSetToTrue(info.backup.Spec.DryRun) {
+		restore.Status.ValidationErrors = append(restore.Status.ValidationErrors, fmt.Sprintf("Backup %s is a dry-run backup and has no contents to restore", info.backup.Name))
+		return backupInfo{}, nil
+	}
+
 	// Fill in the ScheduleName so it's easier to consume for metrics.
 	if restore.Spec.ScheduleName == "" {
 		restore.Spec.ScheduleName = info.backup.GetLabels()[api.ScheduleNameLabel]
@@ -430,7 +504,7 @@ func mostRecentCompletedBackup(backups []api.Backup) api.Backup {
 	})
 
 	for _, backup := range backups {
-		if backup.Status.Phase == api.BackupPhaseCompleted {
+		if backup.Status.Phase == api.BackupPhaseCompleted && !boolptr.IsSetToTrue(backup.Spec.DryRun) {
 			return backup
 		}
 	}
@@ -468,8 +542,9 @@ func fetchBackupInfoInternal(kbClient client.Client, namespace, backupName strin
 // runValidatedRestore takes a validated restore API object and executes the restore process.
 // The log and results files are uploaded to backup storage. Any error returned from this function
 // means that the restore failed. This function updates the restore API object with warning and error
-// counts, but *does not* update its phase or patch it via the API.
-func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backupInfo, resourceModifiers *resourcemodifiers.ResourceModifiers) error {
+// counts, but *does not* update its phase or patch it via the API. A resumed restore continues
+// from the checkpoint of its interrupted run.
+func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backupInfo, resourceModifiers *resourcemodifiers.ResourceModifiers, resumed bool) error {
 	// instantiate the per-restore logger that will output both to a temp file
 	// (for upload to object storage) and to stdout.
 	restoreLog, err := logging.NewTempFileLogger(r.restoreLogLevel, r.logFormat, nil, logrus.Fields{"restore": kubeutil.NamespaceAndName(restore)})
@@ -498,6 +573,10 @@ func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backu
 	}
 	defer closeAndRemoveFile(backupFile, r.logger)
 
+	if err := verifyBackup(restore.Spec.BackupName, backupStore, backupFile, restoreLog); err != nil {
+		return err
+	}
+
 	listOpts := &client.ListOptions{
 		LabelSelector: labels.Set(map[string]string{
 			api.BackupNameLabel: label.GetValidName(restore.Spec.BackupName),
@@ -551,7 +630,38 @@ func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backu
 		CSIVolumeSnapshots:   csiVolumeSnapshots,
 		VolumeInfoMap:        backupVolumeInfoMap,
 	}
+
+	// a preview restore doesn't change the cluster, it's simply run again
+	checkpointed := !boolptr.IsSetToTrue(restore.Spec.Preview)
+	if checkpointed {
+		defer r.itemOperationsMap.DeleteOperationsForRestore(restore.Name)
+		if resumed {
+			r.resumeRestore(restoreReq, backupStore, restoreLog)
+		}
+	}
+
+	stopWatching := watchCancellation(r.kbClient, restore, restoreCancelRequested, restoreReq.Cancel, restoreLog)
+	stopCheckpointing := func() {}
+	if checkpointed {
+		stopCheckpointing = checkpointPeriodically(func() error { return r.checkpointRestore(restoreReq, backupStore) }, restoreLog)
+	}
 	restoreWarnings, restoreErrors := r.restorer.RestoreWithResolvers(restoreReq, actionsResolver, pluginManager)
+	stopCheckpointing()
+	stopWatching()
+
+	// the operations of the interrupted run which weren't adopted aren't part of the restore
+	if unadopted := restoreReq.UnadoptedOperations(); len(unadopted) > 0 {
+		restoreLog.Infof("Cancelling %d item operations started before the restart which weren't adopted", len(unadopted))
+		cancelRestoreItemOperations(restore, pluginManager, unadopted, restoreLog)
+	}
+
+	// The data downloads and item operations started before the restore was cancelled
+	// may still be running, so they are cancelled too.
+	cancelled := restoreReq.IsCancelled()
+	if cancelled {
+		cancelDataDownloads(context.Background(), r.kbClient, restore, restoreLog)
+		cancelRestoreItemOperations(restore, pluginManager, *restoreReq.GetItemOperationsList(), restoreLog)
+	}
 
 	// Iterate over restore item operations and update progress.
 	// Any errors on operations at this point should be added to restore errors.
@@ -610,6 +720,14 @@ func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backu
 		}
 	}
 
+	// the restore can't be rolled back without its rollback items, so failing to upload them is
+	// an error of the restore
+	if restoreReq.GetPreview() == nil {
+		if err := putRestoreRollbackItems(restore, restoreReq.GetRollbackRecorder().Items(), backupStore); err != nil {
+			restoreErrors.Velero = append(restoreErrors.Velero, fmt.Sprintf("error uploading restore rollback items to backup storage: %v", err))
+		}
+	}
+
 	// At this point, no further logs should be written to restoreLog since it's been uploaded
 	// to object storage.
 
@@ -636,11 +754,31 @@ func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backu
 		r.logger.WithError(err).Error("Error uploading restored resource list to backup storage")
 	}
 
+	if err := putHookResults(restore, restoreReq.GetHookTracker().Results(), backupStore); err != nil {
+		r.logger.WithError(err).Error("Error uploading restore hook results to backup storage")
+	}
+
+	if preview := restoreReq.GetPreview(); preview != nil {
+		if err := putRestorePreview(restore, preview.Items(), backupStore); err != nil {
+			r.logger.WithError(err).Error("Error uploading restore preview to backup storage")
+		}
+	}
+
 	if err := putOperationsForRestore(restore, *restoreReq.GetItemOperationsList(), backupStore); err != nil {
 		r.logger.WithError(err).Error("Error uploading restore item action operation resource list to backup storage")
 	}
 
-	if restore.Status.Errors > 0 {
+	if checkpointed {
+		if err := backupStore.DeleteRestoreCheckpoint(restore.Name); err != nil {
+			r.logger.WithError(err).Warn("Error deleting the checkpoint of the restore")
+		}
+	}
+
+	if cancelled {
+		r.logger.Debug("Restore cancelled")
+		restore.Status.Phase = api.RestorePhaseCancelled
+		r.metrics.RegisterRestoreCancelled(restore.Spec.ScheduleName)
+	} else if restore.Status.Errors > 0 {
 		if inProgressOperations {
 			r.logger.Debug("Restore WaitingForPluginOperationsPartiallyFailed")
 			restore.Status.Phase = api.RestorePhaseWaitingForPluginOperationsPartiallyFailed
@@ -662,6 +800,58 @@ func (r *restoreReconciler) runValidatedRestore(restore *api.Restore, info backu
 	return nil
 }
 
+// checkpointRestore uploads the progress of the running restore and its item
+// operations, so it can be resumed after a restart of the server.
+func (r *restoreReconciler) checkpointRestore(restore *pkgrestore.Request, backupStore persistence.BackupStore) error {
+	checkpoint, operations := restore.Checkpoint()
+	if checkpoint == nil {
+		// the restore hasn't started yet
+		return nil
+	}
+	if len(operations) > 0 {
+		if err := r.itemOperationsMap.UploadProgressAndPutOperationsForRestore(backupStore,
+			&itemoperationmap.OperationsForRestore{Operations: operations}, restore.Name); err != nil {
+			return err
+		}
+	}
+
+	data, errs := encode.ToJSONGzip(checkpoint, "restore checkpoint")
+	if len(errs) > 0 {
+		return errs[0]
+	}
+	return backupStore.PutRestoreCheckpoint(restore.Name, data)
+}
+
+// resumeRestore prepares the restore to continue from the checkpoint of its
+// interrupted run. The restore starts from the beginning if the checkpoint
+// can't be loaded, and the item operations of the interrupted run are then
+// cancelled.
+func (r *restoreReconciler) resumeRestore(restore *pkgrestore.Request, backupStore persistence.BackupStore, log logrus.FieldLogger) {
+	var checkpoint *pkgrestore.Checkpoint
+	if data, err := backupStore.GetRestoreCheckpoint(restore.Name); err != nil {
+		log.WithError(err).Warn("Error getting the checkpoint of the restore, starting from the beginning")
+	} else if data == nil {
+		log.Warn("The restore has no checkpoint, starting from the beginning")
+	} else {
+		checkpoint = &pkgrestore.Checkpoint{}
+		if err := decodeCheckpoint(data, checkpoint); err != nil {
+			log.WithError(err).Warn("Error decoding the checkpoint of the restore, starting from the beginning")
+			checkpoint = nil
+		}
+	}
+
+	operations, err := r.itemOperationsMap.GetOperationsForRestore(backupStore, restore.Name)
+	if err != nil {
+		log.WithError(err).Warn("Error getting the item operations of the interrupted restore")
+		operations = &itemoperationmap.OperationsForRestore{}
+	}
+
+	if checkpoint != nil {
+		log.Infof("Resuming the restore from its checkpoint with %d items and %d item operations", len(checkpoint.Items), len(operations.Operations))
+	}
+	restore.Resume(checkpoint, operations.Operations)
+}
+
 // updateTotalRestoreMetric update the velero_restore_total metric every minute.
 func (r *restoreReconciler) updateTotalRestoreMetric() {
 	go func() {
@@ -758,6 +948,66 @@ func putRestoredResourceList(restore *api.Restore, list map[string][]string, bac
 	return nil
 }
 
+func putHookResults(restore *api.Restore, hookResults []hook.HookResult, backupStore persistence.BackupStore) error {
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	defer gzw.Close()
+
+	if err := json.NewEncoder(gzw).Encode(hookResults); err != nil {
+		return errors.Wrap(err, "error encoding restore hook results to JSON")
+	}
+
+	if err := gzw.Close(); err != nil {
+		return errors.Wrap(err, "error closing gzip writer")
+	}
+
+	if err := backupStore.PutRestoreHookResults(restore.Name, buf); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func putRestorePreview(restore *api.Restore, items []restorepreview.Item, backupStore persistence.BackupStore) error {
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	defer gzw.Close()
+
+	if err := json.NewEncoder(gzw).Encode(items); err != nil {
+		return errors.Wrap(err, "error encoding restore preview to JSON")
+	}
+
+	if err := gzw.Close(); err != nil {
+		return errors.Wrap(err, "error closing gzip writer")
+	}
+
+	if err := backupStore.PutRestorePreview(restore.Name, buf); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func putRestoreRollbackItems(restore *api.Restore, items []restorerollback.Item, backupStore persistence.BackupStore) error {
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	defer gzw.Close()
+
+	if err := json.NewEncoder(gzw).Encode(items); err != nil {
+		return errors.Wrap(err, "error encoding restore rollback items to JSON")
+	}
+
+	if err := gzw.Close(); err != nil {
+		return errors.Wrap(err, "error closing gzip writer")
+	}
+
+	if err := backupStore.PutRestoreRollbackItems(restore.Name, buf); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func putOperationsForRestore(restore *api.Restore, operations []*itemoperation.RestoreOperation, backupStore persistence.BackupStore) error {
 	buf := new(bytes.Buffer)
 	gzw := gzip.NewWriter(buf)
@@ -778,6 +1028,36 @@ func putOperationsForRestore(restore *api.Restore, operations []*itemoperation.R
 	return nil
 }
 
+// verifyBackup checks the downloaded backup tarball and the backup's other files
+// against the backup's integrity manifest, and returns an error if any of them
+// doesn't match. Backups without a manifest are not verified, unless the backup
+// storage location signs manifests.
+func verifyBackup(backupName string, backupStore persistence.BackupStore, backupFile *os.File, logger logrus.FieldLogger) error {
+	res, err := backupStore.VerifyBackup(backupName, backupFile)
+	if err != nil {
+		return errors.Wrap(err, "error verifying backup")
+	}
+
+	if _, err := backupFile.Seek(0, 0); err != nil {
+		return errors.Wrap(err, "error resetting Backup file offset")
+	}
+
+	if res == nil {
+		logger.Infof("Backup %s has no integrity manifest, skipping verification", backupName)
+		return nil
+	}
+
+	for _, mismatch := range res.Mismatches {
+		logger.Errorf("Backup integrity verification failed: %s", mismatch)
+	}
+	if !res.Verified() {
+		return errors.Errorf("backup %s failed integrity verification with %d mismatch(es), first: %s", backupName, len(res.Mismatches), res.Mismatches[0])
+	}
+
+	logger.Infof("Verified %d files and %d resources of backup %s", res.FilesVerified, res.ResourcesVerified, backupName)
+	return nil
+}
+
 func downloadToTempFile(backupName string, backupStore persistence.BackupStore, logger logrus.FieldLogger) (*os.File, error) {
 	readCloser, err := backupStore.GetBackupContents(backupName)
 	if err != nil {
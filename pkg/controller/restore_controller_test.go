@@ -20,6 +20,7 @@ import (
 	"bytes"
 	"context"
 	"io"
+	"os"
 	"testing"
 	"time"
 
@@ -36,6 +37,7 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/vmware-tanzu/velero/internal/integrity"
 	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
 	internalVolume "github.com/vmware-tanzu/velero/internal/volume"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
@@ -48,6 +50,7 @@ import (
 	riav2 "github.com/vmware-tanzu/velero/pkg/plugin/velero/restoreitemaction/v2"
 	pkgrestore "github.com/vmware-tanzu/velero/pkg/restore"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/logging"
 	"github.com/vmware-tanzu/velero/pkg/util/results"
 	"github.com/vmware-tanzu/velero/pkg/volume"
@@ -116,6 +119,7 @@ func TestFetchBackupInfo(t *testing.T) {
 				formatFlag,
 				60*time.Minute,
 				false,
+				NewInterruptedTracker(),
 			)
 
 			if test.backupStoreError == nil {
@@ -194,6 +198,7 @@ func TestProcessQueueItemSkips(t *testing.T) {
 				formatFlag,
 				60*time.Minute,
 				false,
+				NewInterruptedTracker(),
 			)
 
 			_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{
@@ -206,6 +211,37 @@ func TestProcessQueueItemSkips(t *testing.T) {
 	}
 }
 
+func TestRestoreCancelledBeforeStarted(t *testing.T) {
+	fakeClient := velerotest.NewFakeControllerRuntimeClient(t)
+	restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Backup("backup-1").Phase(velerov1api.RestorePhaseNew).Cancel(true).Result()
+	require.NoError(t, fakeClient.Create(context.Background(), restore))
+
+	r := NewRestoreReconciler(
+		context.Background(),
+		velerov1api.DefaultNamespace,
+		&fakeRestorer{kbClient: fakeClient},
+		fakeClient,
+		velerotest.NewLogger(),
+		logrus.InfoLevel,
+		nil,
+		nil, // backupStoreGetter
+		metrics.NewServerMetrics(),
+		logging.FormatText,
+		60*time.Minute,
+		false,
+		NewInterruptedTracker(),
+	)
+
+	_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: restore.Namespace, Name: restore.Name}})
+	require.NoError(t, err)
+
+	res := &velerov1api.Restore{}
+	require.NoError(t, fakeClient.Get(context.Background(), client.ObjectKeyFromObject(restore), res))
+	assert.Equal(t, velerov1api.RestorePhaseCancelled, res.Status.Phase)
+	assert.Nil(t, res.Status.StartTimestamp)
+	assert.NotNil(t, res.Status.CompletionTimestamp)
+}
+
 func TestRestoreReconcile(t *testing.T) {
 
 	defaultStorageLocation := builder.ForBackupStorageLocation("velero", "default").Provider("myCloud").Bucket("bucket").Result()
@@ -233,6 +269,7 @@ func TestRestoreReconcile(t *testing.T) {
 		backupStoreGetBackupMetadataErr error
 		backupStoreGetBackupContentsErr error
 		putRestoreLogErr                error
+		putRestoreRollbackItemsErr      error
 		expectedFinalPhase              string
 		addValidFinalizer               bool
 		emptyVolumeInfo                 bool
@@ -297,6 +334,15 @@ func TestRestoreReconcile(t *testing.T) {
 			expectedValidationErrors:        []string{"Error retrieving backup: backup.velero.io \"backup-1\" not found"},
 			backupStoreGetBackupMetadataErr: errors.New("no backup here"),
 		},
+		{
+			name:                     "restore from a dry-run backup fails validation",
+			location:                 defaultStorageLocation,
+			restore:                  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).Result(),
+			backup:                   defaultBackup().StorageLocation("default").DryRun(true).Result(),
+			expectedErr:              false,
+			expectedPhase:            string(velerov1api.RestorePhaseFailedValidation),
+			expectedValidationErrors: []string{"Backup backup-1 is a dry-run backup and has no contents to restore"},
+		},
 		{
 			name:                  "restorer throwing an error causes the restore to fail",
 			location:              defaultStorageLocation,
@@ -311,6 +357,20 @@ func TestRestoreReconcile(t *testing.T) {
 			expectedRestoreErrors: 1,
 			expectedRestorerCall:  NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseInProgress).Result(),
 		},
+		{
+			name:                       "failing to upload the rollback items causes the restore to partially fail",
+			location:                   defaultStorageLocation,
+			restore:                    NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseNew).Result(),
+			backup:                     defaultBackup().StorageLocation("default").Result(),
+			putRestoreRollbackItemsErr: errors.New("blarg"),
+			expectedErr:                false,
+			expectedPhase:              string(velerov1api.RestorePhaseInProgress),
+			expectedFinalPhase:         string(velerov1api.RestorePhasePartiallyFailed),
+			expectedStartTime:          &timestamp,
+			expectedCompletedTime:      &timestamp,
+			expectedRestoreErrors:      1,
+			expectedRestorerCall:       NewRestore("foo", "bar", "backup-1", "ns-1", "", velerov1api.RestorePhaseInProgress).Result(),
+		},
 		{
 			name:                  "valid restore gets executed",
 			location:              defaultStorageLocation,
@@ -462,6 +522,7 @@ func TestRestoreReconcile(t *testing.T) {
 				formatFlag,
 				60*time.Minute,
 				false,
+				NewInterruptedTracker(),
 			)
 
 			r.clock = clocktesting.NewFakeClock(now)
@@ -485,6 +546,7 @@ func TestRestoreReconcile(t *testing.T) {
 			}
 			if test.expectedRestorerCall != nil {
 				backupStore.On("GetBackupContents", test.backup.Name).Return(io.NopCloser(bytes.NewReader([]byte("hello world"))), nil)
+				backupStore.On("VerifyBackup", test.backup.Name, mock.Anything).Return(&integrity.Result{FilesVerified: 1}, nil)
 				backupStore.On("GetCSIVolumeSnapshots", test.backup.Name).Return([]*snapshotv1api.VolumeSnapshot{}, nil)
 
 				restorer.On("RestoreWithResolvers", mock.Anything, mock.Anything, mock.Anything, mock.Anything,
@@ -494,7 +556,10 @@ func TestRestoreReconcile(t *testing.T) {
 
 				backupStore.On("PutRestoreResults", test.backup.Name, test.restore.Name, mock.Anything).Return(nil)
 				backupStore.On("PutRestoredResourceList", test.restore.Name, mock.Anything).Return(nil)
+				backupStore.On("PutRestoreHookResults", test.restore.Name, mock.Anything).Return(nil)
+				backupStore.On("PutRestoreRollbackItems", test.restore.Name, mock.Anything).Return(test.putRestoreRollbackItemsErr)
 				backupStore.On("PutRestoreItemOperations", mock.Anything, mock.Anything).Return(nil)
+				backupStore.On("DeleteRestoreCheckpoint", test.restore.Name).Return(nil)
 				if test.emptyVolumeInfo == true {
 					backupStore.On("GetBackupVolumeInfos", test.backup.Name).Return(nil, nil)
 				} else {
@@ -640,6 +705,7 @@ func TestValidateAndCompleteWhenScheduleNameSpecified(t *testing.T) {
 		formatFlag,
 		60*time.Minute,
 		false,
+		NewInterruptedTracker(),
 	)
 
 	restore := &velerov1api.Restore{
@@ -733,6 +799,7 @@ func TestValidateAndCompleteWithResourceModifierSpecified(t *testing.T) {
 		formatFlag,
 		60*time.Minute,
 		false,
+		NewInterruptedTracker(),
 	)
 
 	restore := &velerov1api.Restore{
@@ -938,6 +1005,21 @@ func TestMostRecentCompletedBackup(t *testing.T) {
 	backups = append(backups, expected)
 
 	assert.Equal(t, expected, mostRecentCompletedBackup(backups))
+
+	backups = append(backups, velerov1api.Backup{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "dry-run",
+		},
+		Spec: velerov1api.BackupSpec{
+			DryRun: boolptr.True(),
+		},
+		Status: velerov1api.BackupStatus{
+			Phase:          velerov1api.BackupPhaseCompleted,
+			StartTimestamp: &metav1.Time{Time: now.Add(2 * time.Second)},
+		},
+	})
+
+	assert.Equal(t, expected, mostRecentCompletedBackup(backups))
 }
 
 func NewRestore(ns, name, backup, includeNS, includeResource string, phase velerov1api.RestorePhase) *builder.RestoreBuilder {
@@ -985,3 +1067,56 @@ func (r *fakeRestorer) RestoreWithResolvers(req *pkgrestore.Request,
 
 	return res.Get(0).(results.Result), res.Get(1).(results.Result)
 }
+
+func TestVerifyBackup(t *testing.T) {
+	tests := []struct {
+		name        string
+		result      *integrity.Result
+		err         error
+		expectedErr string
+	}{
+		{
+			name:   "backup without a manifest is not verified",
+			result: nil,
+		},
+		{
+			name:   "verified backup",
+			result: &integrity.Result{FilesVerified: 3, ResourcesVerified: 10},
+		},
+		{
+			name:        "mismatches fail verification",
+			result:      &integrity.Result{FilesVerified: 2, Mismatches: []string{"file BackupContents: digest mismatch"}},
+			expectedErr: "backup backup-1 failed integrity verification with 1 mismatch(es), first: file BackupContents: digest mismatch",
+		},
+		{
+			name:        "error verifying backup",
+			err:         errors.New("bucket not found"),
+			expectedErr: "error verifying backup: bucket not found",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backupFile, err := os.CreateTemp("", "backup-1")
+			require.NoError(t, err)
+			defer closeAndRemoveFile(backupFile, velerotest.NewLogger())
+
+			_, err = backupFile.WriteString("contents")
+			require.NoError(t, err)
+
+			backupStore := new(persistencemocks.BackupStore)
+			backupStore.On("VerifyBackup", "backup-1", backupFile).Return(test.result, test.err)
+
+			err = verifyBackup("backup-1", backupStore, backupFile, velerotest.NewLogger())
+			if test.expectedErr != "" {
+				assert.EqualError(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+
+			offset, err := backupFile.Seek(0, io.SeekCurrent)
+			require.NoError(t, err)
+			assert.Zero(t, offset)
+		})
+	}
+}
@@ -35,6 +35,7 @@ import (
 	"github.com/vmware-tanzu/velero/pkg/metrics"
 	"github.com/vmware-tanzu/velero/pkg/persistence"
 	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
@@ -154,7 +155,15 @@ func (r *restoreOperationsReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		}
 		return ctrl.Result{}, errors.Wrap(err, "error getting restore operations")
 	}
+	cancelled := boolptr.IsSetToTrue(restore.Spec.Cancel)
+	opsCancelled := false
+	if cancelled {
+		log.Info("Restore was cancelled, cancelling its item operations")
+		opsCancelled = cancelRestoreItemOperations(restore, pluginManager, operations.Operations, log)
+		cancelDataDownloads(ctx, r.Client, restore, log)
+	}
 	stillInProgress, changes, opsCompleted, opsFailed, errs := getRestoreItemOperationProgress(restore, pluginManager, operations.Operations)
+	changes = changes || opsCancelled
 	// if len(errs)>0, need to update restore errors and error log
 	operations.ErrsSinceUpdate = append(operations.ErrsSinceUpdate, errs...)
 	restore.Status.Errors += len(operations.ErrsSinceUpdate)
@@ -177,7 +186,12 @@ func (r *restoreOperationsReconciler) Reconcile(ctx context.Context, req ctrl.Re
 	// RestorePhaseWaitingForPluginOperationsPartiallyFailed and needs update
 	// If the only changes are incremental progress, then no write is necessary, progress can remain in memory
 	if !stillInProgress {
-		if restore.Status.Phase == velerov1api.RestorePhaseWaitingForPluginOperations {
+		if cancelled {
+			log.Infof("Marking restore %s cancelled", restore.Name)
+			restore.Status.Phase = velerov1api.RestorePhaseCancelled
+			restore.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+			r.metrics.RegisterRestoreCancelled(restore.Spec.ScheduleName)
+		} else if restore.Status.Phase == velerov1api.RestorePhaseWaitingForPluginOperations {
 			log.Infof("Marking restore %s completed", restore.Name)
 			restore.Status.Phase = velerov1api.RestorePhaseCompleted
 			restore.Status.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
@@ -217,7 +231,8 @@ func (r *restoreOperationsReconciler) updateRestoreAndOperationsJSON(
 	defer func() {
 		// remove local operations list if complete
 		if removeIfComplete && (restore.Status.Phase == velerov1api.RestorePhaseCompleted ||
-			restore.Status.Phase == velerov1api.RestorePhasePartiallyFailed) {
+			restore.Status.Phase == velerov1api.RestorePhasePartiallyFailed ||
+			restore.Status.Phase == velerov1api.RestorePhaseCancelled) {
 			r.itemOperationsMap.DeleteOperationsForRestore(restore.Name)
 		} else if changes {
 			r.itemOperationsMap.PutOperationsForRestore(operations, restore.Name)
@@ -227,7 +242,8 @@ func (r *restoreOperationsReconciler) updateRestoreAndOperationsJSON(
 	// update restore and upload progress if errs or complete
 	if len(operations.ErrsSinceUpdate) > 0 ||
 		restore.Status.Phase == velerov1api.RestorePhaseCompleted ||
-		restore.Status.Phase == velerov1api.RestorePhasePartiallyFailed {
+		restore.Status.Phase == velerov1api.RestorePhasePartiallyFailed ||
+		restore.Status.Phase == velerov1api.RestorePhaseCancelled {
 		// update file store
 		if backupStore != nil {
 			if err := r.itemOperationsMap.UploadProgressAndPutOperationsForRestore(backupStore, operations, restore.Name); err != nil {
@@ -0,0 +1,193 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/dynamic"
+	clocks "k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	"github.com/vmware-tanzu/velero/internal/restorerollback"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/persistence"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// restoreRollbackReconciler rolls back the restores which are requested to be
+// rolled back, and the failed restores which have RollbackOnFailure set.
+type restoreRollbackReconciler struct {
+	client.Client
+	namespace         string
+	logger            logrus.FieldLogger
+	clock             clocks.WithTickerAndDelayedExecution
+	dynamicClient     dynamic.Interface
+	newPluginManager  func(logger logrus.FieldLogger) clientmgmt.Manager
+	backupStoreGetter persistence.ObjectBackupStoreGetter
+}
+
+func NewRestoreRollbackReconciler(
+	logger logrus.FieldLogger,
+	namespace string,
+	client client.Client,
+	dynamicClient dynamic.Interface,
+	newPluginManager func(logrus.FieldLogger) clientmgmt.Manager,
+	backupStoreGetter persistence.ObjectBackupStoreGetter,
+) *restoreRollbackReconciler {
+	return &restoreRollbackReconciler{
+		Client:            client,
+		namespace:         namespace,
+		logger:            logger,
+		clock:             clocks.RealClock{},
+		dynamicClient:     dynamicClient,
+		newPluginManager:  newPluginManager,
+		backupStoreGetter: backupStoreGetter,
+	}
+}
+
+func (r *restoreRollbackReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&velerov1api.Restore{}, builder.WithPredicates(predicate.NewPredicateFuncs(func(object client.Object) bool {
+			return rollbackRequested(object.(*velerov1api.Restore))
+		}))).
+		Named(RestoreRollback).
+		Complete(r)
+}
+
+// rollbackRequested returns true if the restore has ended and should be rolled
+// back, because the rollback was requested with the annotation, because the
+// restore failed and has RollbackOnFailure set, or because it was cancelled,
+// and if it hasn't been rolled back yet. A rollback still in progress, which
+// was interrupted, is requested again.
+func rollbackRequested(restore *velerov1api.Restore) bool {
+	if !restore.DeletionTimestamp.IsZero() {
+		return false
+	}
+	if restore.Status.Rollback != nil && restore.Status.Rollback.Phase != velerov1api.RestoreRollbackPhaseInProgress {
+		return false
+	}
+
+	requested := restore.Annotations[velerov1api.RollbackRequestedAnnotation] == "true"
+	switch restore.Status.Phase {
+	case velerov1api.RestorePhaseCompleted:
+		return requested
+	case velerov1api.RestorePhasePartiallyFailed, velerov1api.RestorePhaseFailed:
+		return requested || boolptr.IsSetToTrue(restore.Spec.RollbackOnFailure)
+	case velerov1api.RestorePhaseCancelled:
+		// the items a cancelled restore created are rolled back, unless it was
+		// cancelled before it was started or was a preview
+		return restore.Status.StartTimestamp != nil && !boolptr.IsSetToTrue(restore.Spec.Preview)
+	}
+	return false
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=restores,verbs=get;list;watch;update;patch
+// +kubebuilder:rbac:groups=velero.io,resources=restores/status,verbs=get
+
+func (r *restoreRollbackReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.logger.WithField("restore", req.String())
+
+	restore := &velerov1api.Restore{}
+	if err := r.Get(ctx, req.NamespacedName, restore); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("restore not found")
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, errors.Wrapf(err, "error getting restore %s", req.String())
+	}
+
+	if !rollbackRequested(restore) {
+		log.Debug("Restore is not requested to be rolled back, skipping")
+		return ctrl.Result{}, nil
+	}
+
+	original := restore.DeepCopy()
+	restore.Status.Rollback = &velerov1api.RestoreRollbackStatus{
+		Phase:          velerov1api.RestoreRollbackPhaseInProgress,
+		StartTimestamp: &metav1.Time{Time: r.clock.Now()},
+	}
+	if err := kube.PatchResource(original, restore, r.Client); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "error updating restore %s rollback phase to %s", req.String(), restore.Status.Rollback.Phase)
+	}
+	original = restore.DeepCopy()
+
+	log.Info("Rolling back restore")
+	var errs []error
+	if items, err := r.rollbackItems(restore); err != nil {
+		errs = append(errs, err)
+		restore.Status.Rollback.FailureReason = err.Error()
+	} else {
+		errs = restorerollback.Rollback(ctx, r.dynamicClient, r.Client, restore, items, log)
+	}
+
+	for _, err := range errs {
+		log.WithError(err).Error("Error rolling back restore")
+	}
+
+	restore.Status.Rollback.Errors = len(errs)
+	restore.Status.Rollback.CompletionTimestamp = &metav1.Time{Time: r.clock.Now()}
+	if len(errs) > 0 {
+		restore.Status.Rollback.Phase = velerov1api.RestoreRollbackPhasePartiallyFailed
+	} else {
+		restore.Status.Rollback.Phase = velerov1api.RestoreRollbackPhaseCompleted
+	}
+	log.Infof("Restore rollback ended with phase %s", restore.Status.Rollback.Phase)
+
+	if err := kube.PatchResource(original, restore, r.Client); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "error updating restore %s rollback phase to %s", req.String(), restore.Status.Rollback.Phase)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// rollbackItems returns what the restore did to the items, which was recorded
+// in the backup storage location of the restored backup. It returns an error if
+// the restore began restoring items but they weren't recorded, since what it
+// did can't be rolled back then.
+func (r *restoreRollbackReconciler) rollbackItems(restore *velerov1api.Restore) ([]restorerollback.Item, error) {
+	info, err := fetchBackupInfoInternal(r.Client, r.namespace, restore.Spec.BackupName)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting backup info")
+	}
+
+	pluginManager := r.newPluginManager(r.logger)
+	defer pluginManager.CleanupClients()
+
+	backupStore, err := r.backupStoreGetter.Get(info.location, pluginManager, r.logger)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting backup store")
+	}
+
+	items, err := backupStore.GetRestoreRollbackItems(restore.Name)
+	if err != nil {
+		return nil, errors.Wrap(err, "error getting restore rollback items")
+	}
+	if items == nil && restore.Status.Progress != nil && restore.Status.Progress.ItemsRestored > 0 {
+		return nil, errors.Errorf("restore %s restored %d items but its rollback items weren't recorded, the items can't be rolled back", restore.Name, restore.Status.Progress.ItemsRestored)
+	}
+	return items, nil
+}
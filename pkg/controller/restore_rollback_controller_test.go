@@ -0,0 +1,244 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	testclocks "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	"github.com/vmware-tanzu/velero/internal/restorerollback"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	persistencemocks "github.com/vmware-tanzu/velero/pkg/persistence/mocks"
+	"github.com/vmware-tanzu/velero/pkg/plugin/clientmgmt"
+	pluginmocks "github.com/vmware-tanzu/velero/pkg/plugin/mocks"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestRollbackRequested(t *testing.T) {
+	requested := builder.WithAnnotations(velerov1api.RollbackRequestedAnnotation, "true")
+	now := metav1.Now()
+
+	tests := []struct {
+		name    string
+		restore *velerov1api.Restore
+		expect  bool
+	}{
+		{
+			name:    "completed restore without the annotation isn't rolled back",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Phase(velerov1api.RestorePhaseCompleted).RollbackOnFailure(true).Result(),
+			expect:  false,
+		},
+		{
+			name:    "completed restore with the annotation is rolled back",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").ObjectMeta(requested).Phase(velerov1api.RestorePhaseCompleted).Result(),
+			expect:  true,
+		},
+		{
+			name:    "partially failed restore with rollback on failure is rolled back",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Phase(velerov1api.RestorePhasePartiallyFailed).RollbackOnFailure(true).Result(),
+			expect:  true,
+		},
+		{
+			name:    "failed restore with rollback on failure is rolled back",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Phase(velerov1api.RestorePhaseFailed).RollbackOnFailure(true).Result(),
+			expect:  true,
+		},
+		{
+			name:    "failed restore without rollback on failure isn't rolled back",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Phase(velerov1api.RestorePhaseFailed).Result(),
+			expect:  false,
+		},
+		{
+			name:    "cancelled restore is rolled back",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Phase(velerov1api.RestorePhaseCancelled).Cancel(true).StartTimestamp(now.Time).Result(),
+			expect:  true,
+		},
+		{
+			name:    "restore cancelled before it was started isn't rolled back",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Phase(velerov1api.RestorePhaseCancelled).Cancel(true).Result(),
+			expect:  false,
+		},
+		{
+			name:    "cancelled restore preview isn't rolled back",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Phase(velerov1api.RestorePhaseCancelled).Cancel(true).Preview(true).StartTimestamp(now.Time).Result(),
+			expect:  false,
+		},
+		{
+			name:    "in progress restore isn't rolled back",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").ObjectMeta(requested).Phase(velerov1api.RestorePhaseInProgress).Result(),
+			expect:  false,
+		},
+		{
+			name:    "restore being deleted isn't rolled back",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").ObjectMeta(requested, builder.WithDeletionTimestamp(now.Time)).Phase(velerov1api.RestorePhaseCompleted).Result(),
+			expect:  false,
+		},
+		{
+			name: "restore already rolled back isn't rolled back again",
+			restore: func() *velerov1api.Restore {
+				restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").ObjectMeta(requested).Phase(velerov1api.RestorePhaseCompleted).Result()
+				restore.Status.Rollback = &velerov1api.RestoreRollbackStatus{Phase: velerov1api.RestoreRollbackPhaseCompleted}
+				return restore
+			}(),
+			expect: false,
+		},
+		{
+			name: "interrupted rollback is rolled back again",
+			restore: func() *velerov1api.Restore {
+				restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").ObjectMeta(requested).Phase(velerov1api.RestorePhaseCompleted).Result()
+				restore.Status.Rollback = &velerov1api.RestoreRollbackStatus{Phase: velerov1api.RestoreRollbackPhaseInProgress}
+				return restore
+			}(),
+			expect: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.expect, rollbackRequested(test.restore))
+		})
+	}
+}
+
+func TestRestoreRollbackReconcile(t *testing.T) {
+	fakeClock := testclocks.NewFakeClock(time.Now())
+	location := builder.ForBackupStorageLocation(velerov1api.DefaultNamespace, "default").Result()
+
+	tests := []struct {
+		name                string
+		restore             *velerov1api.Restore
+		items               []restorerollback.Item
+		itemsErr            error
+		expectPhase         velerov1api.RestoreRollbackPhase
+		expectErrs          int
+		expectFailureReason string
+		expectGone          bool
+	}{
+		{
+			name: "restore is rolled back",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Backup("backup-1").
+				ObjectMeta(builder.WithAnnotations(velerov1api.RollbackRequestedAnnotation, "true")).
+				Phase(velerov1api.RestorePhaseCompleted).Result(),
+			items: []restorerollback.Item{
+				{Version: "v1", Resource: "configmaps", Namespace: "ns-1", Name: "cm-1", Action: restorerollback.ActionCreated},
+			},
+			expectPhase: velerov1api.RestoreRollbackPhaseCompleted,
+			expectGone:  true,
+		},
+		{
+			name: "rollback fails if the rollback items can't be fetched",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-2").Backup("backup-1").
+				Phase(velerov1api.RestorePhaseFailed).RollbackOnFailure(true).Result(),
+			itemsErr:            errors.New("storage is unavailable"),
+			expectPhase:         velerov1api.RestoreRollbackPhasePartiallyFailed,
+			expectErrs:          1,
+			expectFailureReason: "error getting restore rollback items: storage is unavailable",
+		},
+		{
+			name: "rollback fails if the restore restored items but its rollback items weren't recorded",
+			restore: func() *velerov1api.Restore {
+				restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-4").Backup("backup-1").
+					Phase(velerov1api.RestorePhasePartiallyFailed).RollbackOnFailure(true).Result()
+				restore.Status.Progress = &velerov1api.RestoreProgress{TotalItems: 3, ItemsRestored: 2}
+				return restore
+			}(),
+			expectPhase:         velerov1api.RestoreRollbackPhasePartiallyFailed,
+			expectErrs:          1,
+			expectFailureReason: "restore restore-4 restored 2 items but its rollback items weren't recorded, the items can't be rolled back",
+		},
+		{
+			name: "restore which didn't record any item is rolled back",
+			restore: func() *velerov1api.Restore {
+				restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-5").Backup("backup-1").
+					ObjectMeta(builder.WithAnnotations(velerov1api.RollbackRequestedAnnotation, "true")).
+					Phase(velerov1api.RestorePhaseCompleted).Result()
+				restore.Status.Progress = &velerov1api.RestoreProgress{TotalItems: 2, ItemsRestored: 2}
+				return restore
+			}(),
+			items:       []restorerollback.Item{},
+			expectPhase: velerov1api.RestoreRollbackPhaseCompleted,
+		},
+		{
+			name: "restore not requested to be rolled back is skipped",
+			restore: builder.ForRestore(velerov1api.DefaultNamespace, "restore-3").Backup("backup-1").
+				Phase(velerov1api.RestorePhaseFailed).Result(),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			backup := defaultBackup().StorageLocation("default").Result()
+			fakeClient := velerotest.NewFakeControllerRuntimeClient(t, test.restore, backup, location)
+
+			configMap := &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata":   map[string]interface{}{"namespace": "ns-1", "name": "cm-1"},
+			}}
+			dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme(), configMap)
+
+			pluginManager := &pluginmocks.Manager{}
+			pluginManager.On("CleanupClients").Return(nil)
+			backupStore := &persistencemocks.BackupStore{}
+			backupStore.On("GetRestoreRollbackItems", test.restore.Name).Return(test.items, test.itemsErr)
+
+			r := NewRestoreRollbackReconciler(
+				logrus.StandardLogger(),
+				velerov1api.DefaultNamespace,
+				fakeClient,
+				dynamicClient,
+				func(logrus.FieldLogger) clientmgmt.Manager { return pluginManager },
+				NewFakeSingleObjectBackupStoreGetter(backupStore),
+			)
+			r.clock = fakeClock
+
+			_, err := r.Reconcile(context.TODO(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: test.restore.Namespace, Name: test.restore.Name}})
+			require.NoError(t, err)
+
+			restoreAfter := &velerov1api.Restore{}
+			require.NoError(t, fakeClient.Get(context.TODO(), types.NamespacedName{Namespace: test.restore.Namespace, Name: test.restore.Name}, restoreAfter))
+
+			if test.expectPhase == "" {
+				assert.Nil(t, restoreAfter.Status.Rollback)
+				return
+			}
+			require.NotNil(t, restoreAfter.Status.Rollback)
+			assert.Equal(t, test.expectPhase, restoreAfter.Status.Rollback.Phase)
+			assert.Equal(t, test.expectErrs, restoreAfter.Status.Rollback.Errors)
+			assert.Equal(t, test.expectFailureReason, restoreAfter.Status.Rollback.FailureReason)
+			assert.NotNil(t, restoreAfter.Status.Rollback.CompletionTimestamp)
+
+			_, err = dynamicClient.Resource(schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}).Namespace("ns-1").Get(context.TODO(), "cm-1", metav1.GetOptions{})
+			assert.Equal(t, test.expectGone, err != nil)
+		})
+	}
+}
@@ -35,6 +35,7 @@ import (
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
 	"github.com/vmware-tanzu/velero/pkg/metrics"
+	pkgschedule "github.com/vmware-tanzu/velero/pkg/schedule"
 	"github.com/vmware-tanzu/velero/pkg/util/kube"
 )
 
@@ -112,6 +113,7 @@ func (c *scheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	if schedule.Spec.SkipImmediately != nil && *schedule.Spec.SkipImmediately {
 		*schedule.Spec.SkipImmediately = false
 		schedule.Status.LastSkipped = &metav1.Time{Time: c.clock.Now()}
+		schedule.Status.LastSkippedReason = "the schedule was created or unpaused with skipImmediately"
 	}
 
 	// validation - even if the item is Enabled, we can't trust it
@@ -119,6 +121,12 @@ func (c *scheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	currentPhase := schedule.Status.Phase
 
 	cronSchedule, errs := parseCronSchedule(schedule, c.logger)
+	errs = append(errs, pkgschedule.ValidateRetention(schedule.Spec.Retention)...)
+	if _, err := time.LoadLocation(schedule.Spec.TimeZone); err != nil {
+		errs = append(errs, fmt.Sprintf("invalid time zone %q: %v", schedule.Spec.TimeZone, err))
+	}
+	blackoutWindows, windowErrs := parseBlackoutWindows(schedule)
+	errs = append(errs, windowErrs...)
 	if len(errs) > 0 {
 		schedule.Status.Phase = velerov1.SchedulePhaseFailedValidation
 		schedule.Status.ValidationErrors = errs
@@ -154,13 +162,60 @@ func (c *scheduleReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	}
 
 	// Check for the schedule being due to run.
-	// If there are backup created by this schedule still in New or InProgress state,
-	// skip current backup creation to avoid running overlap backups.
 	// As the schedule must be validated before checking whether it's due, we cannot put the checking log in Predicate
-	if c.ifDue(schedule, cronSchedule) && !c.checkIfBackupInNewOrProgress(schedule) {
-		if err := c.submitBackup(ctx, schedule); err != nil {
-			return ctrl.Result{}, errors.Wrapf(err, "error submit backup for schedule %s", req.String())
+	if !c.ifDue(schedule, cronSchedule) {
+		return ctrl.Result{}, nil
+	}
+
+	// Only the latest of the runs due since the last one is run, the
+	// others are missed, except the ones in blackout windows.
+	now := c.clock.Now()
+	latestRun, earlierRuns := dueRuns(schedule, cronSchedule, now)
+	missedRuns := 0
+	for _, run := range earlierRuns {
+		if activeBlackoutWindow(blackoutWindows, run) == nil {
+			missedRuns++
+		}
+	}
+
+	if window := activeBlackoutWindow(blackoutWindows, now.In(pkgschedule.Location(schedule))); window != nil {
+		if window.Policy == velerov1.BlackoutWindowPolicyDefer {
+			log.WithField("blackoutWindow", window.name()).Info("Schedule is due in a blackout window, deferring the backup to the end of the window")
+			return ctrl.Result{}, nil
+		}
+		if err := c.skipBackup(ctx, schedule, fmt.Sprintf("the backup was due in the blackout window %s", window.name()), missedRuns); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "error skipping backup for schedule %s", req.String())
+		}
+		return ctrl.Result{}, nil
+	}
+
+	if deadline := schedule.Spec.StartingDeadline; deadline != nil && now.Sub(latestRun) > deadline.Duration {
+		reason := fmt.Sprintf("the backup due at %v couldn't start within the starting deadline of %s", latestRun, deadline.Duration)
+		if err := c.skipBackup(ctx, schedule, reason, missedRuns+1); err != nil {
+			return ctrl.Result{}, errors.Wrapf(err, "error skipping backup for schedule %s", req.String())
 		}
+		return ctrl.Result{}, nil
+	}
+
+	// If there are backups created by this schedule still in New or InProgress state,
+	// the overlap policy decides whether to run another one.
+	if c.checkIfBackupInNewOrProgress(schedule) {
+		switch schedule.Spec.OverlapPolicy {
+		case velerov1.ScheduleOverlapPolicyAllow:
+			log.Info("Schedule has running backups, running another backup concurrently")
+		case velerov1.ScheduleOverlapPolicySkip:
+			if err := c.skipBackup(ctx, schedule, "a backup of the schedule was still running", missedRuns+1); err != nil {
+				return ctrl.Result{}, errors.Wrapf(err, "error skipping backup for schedule %s", req.String())
+			}
+			return ctrl.Result{}, nil
+		default:
+			log.Debug("Schedule has running backups, queueing the backup")
+			return ctrl.Result{}, nil
+		}
+	}
+
+	if err := c.submitBackup(ctx, schedule, missedRuns); err != nil {
+		return ctrl.Result{}, errors.Wrapf(err, "error submit backup for schedule %s", req.String())
 	}
 
 	return ctrl.Result{}, nil
@@ -206,6 +261,68 @@ func parseCronSchedule(itm *velerov1.Schedule, logger logrus.FieldLogger) (cron.
 	return schedule, nil
 }
 
+// blackoutWindow is a blackout window of a schedule with its start parsed.
+type blackoutWindow struct {
+	velerov1.ScheduleBlackoutWindow
+	start cron.Schedule
+}
+
+func (w *blackoutWindow) name() string {
+	if w.Name != "" {
+		return w.Name
+	}
+	return fmt.Sprintf("%q", w.Start)
+}
+
+// contains returns true if the time is in an occurrence of the window, which
+// is the case if an occurrence starts in (t - duration, t].
+func (w *blackoutWindow) contains(t time.Time) bool {
+	start := w.start.Next(t.Add(-w.Duration.Duration))
+	return !start.IsZero() && !start.After(t)
+}
+
+func parseBlackoutWindows(schedule *velerov1.Schedule) ([]blackoutWindow, []string) {
+	var windows []blackoutWindow
+	var validationErrors []string
+	for _, spec := range schedule.Spec.BlackoutWindows {
+		window := blackoutWindow{ScheduleBlackoutWindow: spec}
+		start, err := parseCronExpression(spec.Start)
+		if err != nil {
+			validationErrors = append(validationErrors, fmt.Sprintf("invalid start of blackout window %s: %v", window.name(), err))
+			continue
+		}
+		if spec.Duration.Duration <= 0 {
+			validationErrors = append(validationErrors, fmt.Sprintf("the duration of blackout window %s must be positive", window.name()))
+			continue
+		}
+		window.start = start
+		windows = append(windows, window)
+	}
+	return windows, validationErrors
+}
+
+// parseCronExpression parses a standard cron expression, recovering from
+// the panics of cron.ParseStandard.
+func parseCronExpression(expression string) (schedule cron.Schedule, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Errorf("%v", r)
+		}
+	}()
+	return cron.ParseStandard(expression)
+}
+
+// activeBlackoutWindow returns the first blackout window containing the time,
+// nil if none does. The time must be in the location of the schedule.
+func activeBlackoutWindow(windows []blackoutWindow, t time.Time) *blackoutWindow {
+	for i := range windows {
+		if windows[i].contains(t) {
+			return &windows[i]
+		}
+	}
+	return nil
+}
+
 // checkIfBackupInNewOrProgress check whether there are backups created by this schedule still in New or InProgress state
 func (c *scheduleReconciler) checkIfBackupInNewOrProgress(schedule *velerov1.Schedule) bool {
 	log := c.logger.WithField("schedule", kube.NamespaceAndName(schedule))
@@ -246,7 +363,9 @@ func (c *scheduleReconciler) ifDue(schedule *velerov1.Schedule, cronSchedule cro
 }
 
 // submitBackup create a backup from schedule.
-func (c *scheduleReconciler) submitBackup(ctx context.Context, schedule *velerov1.Schedule) error {
+// The missed runs are the runs due since the last one, which are replaced by
+// the backup.
+func (c *scheduleReconciler) submitBackup(ctx context.Context, schedule *velerov1.Schedule, missedRuns int) error {
 	c.logger.WithField("schedule", schedule.Namespace+"/"+schedule.Name).Info("Schedule is due, going to submit backup.")
 
 	now := c.clock.Now()
@@ -259,14 +378,68 @@ func (c *scheduleReconciler) submitBackup(ctx context.Context, schedule *velerov
 
 	original := schedule.DeepCopy()
 	schedule.Status.LastBackup = &metav1.Time{Time: now}
+	c.setMissedRuns(schedule, missedRuns, "only the latest of the runs due since the last run was started")
 
 	if err := c.Patch(ctx, schedule, client.MergeFrom(original)); err != nil {
 		return errors.Wrapf(err, "error updating Schedule's LastBackup time to %v", schedule.Status.LastBackup)
 	}
+	c.metrics.RegisterScheduleMissedRuns(schedule.Name, missedRuns)
 
 	return nil
 }
 
+// skipBackup records that the backup the schedule is due for is skipped,
+// and the runs missed.
+func (c *scheduleReconciler) skipBackup(ctx context.Context, schedule *velerov1.Schedule, reason string, missedRuns int) error {
+	c.logger.WithField("schedule", kube.NamespaceAndName(schedule)).Infof("Schedule is due, but the backup is skipped: %s", reason)
+
+	original := schedule.DeepCopy()
+	schedule.Status.LastSkipped = &metav1.Time{Time: c.clock.Now()}
+	schedule.Status.LastSkippedReason = reason
+	c.setMissedRuns(schedule, missedRuns, reason)
+
+	if err := c.Patch(ctx, schedule, client.MergeFrom(original)); err != nil {
+		return errors.Wrapf(err, "error updating Schedule's LastSkipped time to %v", schedule.Status.LastSkipped)
+	}
+	c.metrics.RegisterScheduleMissedRuns(schedule.Name, missedRuns)
+
+	return nil
+}
+
+// setMissedRuns records missed runs in the status of the schedule.
+func (c *scheduleReconciler) setMissedRuns(schedule *velerov1.Schedule, missedRuns int, reason string) {
+	if missedRuns == 0 {
+		return
+	}
+
+	c.logger.WithField("schedule", kube.NamespaceAndName(schedule)).Warnf("%d runs of the schedule were missed: %s", missedRuns, reason)
+	schedule.Status.MissedRuns += int64(missedRuns)
+	schedule.Status.LastMissedRun = &metav1.Time{Time: c.clock.Now()}
+	schedule.Status.LastMissedRunReason = reason
+}
+
+// maxDueRuns bounds the number of runs due counted, for schedules running
+// very often which didn't run for a long time.
+const maxDueRuns = 100000
+
+// dueRuns returns the latest of the runs the schedule is due for as of the
+// given time, and the earlier ones.
+func dueRuns(schedule *velerov1.Schedule, cronSchedule cron.Schedule, asOf time.Time) (time.Time, []time.Time) {
+	var runs []time.Time
+	_, next := getNextRunTime(schedule, cronSchedule, asOf)
+	for !next.IsZero() && asOf.After(next) && len(runs) < maxDueRuns {
+		runs = append(runs, next)
+		next = cronSchedule.Next(next)
+	}
+	if len(runs) == 0 {
+		return time.Time{}, nil
+	}
+	return runs[len(runs)-1], runs[:len(runs)-1]
+}
+
+// getNextRunTime returns whether the schedule is due as of the given time,
+// and its next run time. The cron expression is evaluated in the time zone
+// of the schedule.
 func getNextRunTime(schedule *velerov1.Schedule, cronSchedule cron.Schedule, asOf time.Time) (bool, time.Time) {
 	var lastBackupTime time.Time
 	if schedule.Status.LastBackup != nil {
@@ -278,7 +451,7 @@ func getNextRunTime(schedule *velerov1.Schedule, cronSchedule cron.Schedule, asO
 		lastBackupTime = schedule.Status.LastSkipped.Time
 	}
 
-	nextRunTime := cronSchedule.Next(lastBackupTime)
+	nextRunTime := cronSchedule.Next(lastBackupTime.In(pkgschedule.Location(schedule)))
 
 	return asOf.After(nextRunTime), nextRunTime
 }
@@ -44,6 +44,9 @@ func TestReconcileOfSchedule(t *testing.T) {
 	newScheduleBuilder := func(phase velerov1.SchedulePhase) *builder.ScheduleBuilder {
 		return builder.ForSchedule("ns", "name").Phase(phase)
 	}
+	peakHours := velerov1.ScheduleBlackoutWindow{Name: "peak-hours", Start: "0 12 * * *", Duration: metav1.Duration{Duration: time.Hour}}
+	deferredPeakHours := peakHours
+	deferredPeakHours.Policy = velerov1.BlackoutWindowPolicyDefer
 
 	tests := []struct {
 		name                      string
@@ -55,6 +58,7 @@ func TestReconcileOfSchedule(t *testing.T) {
 		expectedBackupCreate      *velerov1.Backup
 		expectedLastBackup        string
 		expectedLastSkipped       string
+		expectedLastSkippedReason string
 		backup                    *velerov1.Backup
 		reconcilerSkipImmediately bool
 	}{
@@ -84,6 +88,61 @@ func TestReconcileOfSchedule(t *testing.T) {
 			expectedPhase:            string(velerov1.SchedulePhaseFailedValidation),
 			expectedValidationErrors: []string{"Schedule must be a non-empty valid Cron expression"},
 		},
+		{
+			name:                     "schedule with an invalid retention policy gets validated and failed",
+			schedule:                 newScheduleBuilder(velerov1.SchedulePhaseNew).CronSchedule("@every 5m").Retention(&velerov1.ScheduleRetention{}).Result(),
+			expectedPhase:            string(velerov1.SchedulePhaseFailedValidation),
+			expectedValidationErrors: []string{"retention must keep at least one backup"},
+		},
+		{
+			name:                     "schedule with an invalid time zone gets validated and failed",
+			schedule:                 newScheduleBuilder(velerov1.SchedulePhaseNew).CronSchedule("@every 5m").TimeZone("Mars/Olympus").Result(),
+			expectedPhase:            string(velerov1.SchedulePhaseFailedValidation),
+			expectedValidationErrors: []string{`invalid time zone "Mars/Olympus": unknown time zone Mars/Olympus`},
+		},
+		{
+			name: "schedule with an invalid blackout window gets validated and failed",
+			schedule: newScheduleBuilder(velerov1.SchedulePhaseNew).CronSchedule("@every 5m").
+				BlackoutWindows(velerov1.ScheduleBlackoutWindow{Start: "0 12 * * *"}).Result(),
+			expectedPhase:            string(velerov1.SchedulePhaseFailedValidation),
+			expectedValidationErrors: []string{`the duration of blackout window "0 12 * * *" must be positive`},
+		},
+		{
+			name:                      "schedule due in a blackout window skips the backup",
+			schedule:                  newScheduleBuilder(velerov1.SchedulePhaseEnabled).CronSchedule("0 * * * *").LastBackupTime("2017-01-01 10:00:00").BlackoutWindows(peakHours).Result(),
+			fakeClockTime:             "2017-01-01 12:00:30",
+			expectedPhase:             string(velerov1.SchedulePhaseEnabled),
+			expectedLastSkipped:       "2017-01-01 12:00:30",
+			expectedLastSkippedReason: "the backup was due in the blackout window peak-hours",
+		},
+		{
+			name:          "schedule due in a deferring blackout window doesn't trigger a backup",
+			schedule:      newScheduleBuilder(velerov1.SchedulePhaseEnabled).CronSchedule("0 * * * *").LastBackupTime("2017-01-01 10:00:00").BlackoutWindows(deferredPeakHours).Result(),
+			fakeClockTime: "2017-01-01 12:59:59",
+			expectedPhase: string(velerov1.SchedulePhaseEnabled),
+		},
+		{
+			name:                 "schedule due after a blackout window triggers a backup",
+			schedule:             newScheduleBuilder(velerov1.SchedulePhaseEnabled).CronSchedule("0 * * * *").LastBackupTime("2017-01-01 10:00:00").BlackoutWindows(deferredPeakHours).Result(),
+			fakeClockTime:        "2017-01-01 13:00:30",
+			expectedPhase:        string(velerov1.SchedulePhaseEnabled),
+			expectedBackupCreate: builder.ForBackup("ns", "name-20170101130030").ObjectMeta(builder.WithLabels(velerov1.ScheduleNameLabel, "name")).Result(),
+			expectedLastBackup:   "2017-01-01 13:00:30",
+		},
+		{
+			name:          "schedule in a time zone isn't due at the time in UTC",
+			schedule:      newScheduleBuilder(velerov1.SchedulePhaseEnabled).CronSchedule("0 2 * * *").TimeZone("America/New_York").LastBackupTime("2017-01-01 07:00:00").Result(),
+			fakeClockTime: "2017-01-02 06:30:00",
+			expectedPhase: string(velerov1.SchedulePhaseEnabled),
+		},
+		{
+			name:                 "blackout window in the time zone of the schedule",
+			schedule:             newScheduleBuilder(velerov1.SchedulePhaseEnabled).CronSchedule("0 * * * *").TimeZone("America/New_York").LastBackupTime("2017-01-01 10:00:00").BlackoutWindows(peakHours).Result(),
+			fakeClockTime:        "2017-01-01 12:00:30",
+			expectedPhase:        string(velerov1.SchedulePhaseEnabled),
+			expectedBackupCreate: builder.ForBackup("ns", "name-20170101120030").ObjectMeta(builder.WithLabels(velerov1.ScheduleNameLabel, "name")).Result(),
+			expectedLastBackup:   "2017-01-01 12:00:30",
+		},
 		{
 			name:                 "schedule with phase New gets validated and triggers a backup",
 			schedule:             newScheduleBuilder(velerov1.SchedulePhaseNew).CronSchedule("@every 5m").Result(),
@@ -93,11 +152,12 @@ func TestReconcileOfSchedule(t *testing.T) {
 			expectedLastBackup:   "2017-01-01 12:00:00",
 		},
 		{
-			name:                "schedule with phase New and SkipImmediately gets validated and does not trigger a backup",
-			schedule:            newScheduleBuilder(velerov1.SchedulePhaseNew).CronSchedule("@every 5m").SkipImmediately(pointer.Bool(true)).Result(),
-			fakeClockTime:       "2017-01-01 12:00:00",
-			expectedPhase:       string(velerov1.SchedulePhaseEnabled),
-			expectedLastSkipped: "2017-01-01 12:00:00",
+			name:                      "schedule with phase New and SkipImmediately gets validated and does not trigger a backup",
+			schedule:                  newScheduleBuilder(velerov1.SchedulePhaseNew).CronSchedule("@every 5m").SkipImmediately(pointer.Bool(true)).Result(),
+			fakeClockTime:             "2017-01-01 12:00:00",
+			expectedPhase:             string(velerov1.SchedulePhaseEnabled),
+			expectedLastSkipped:       "2017-01-01 12:00:00",
+			expectedLastSkippedReason: "the schedule was created or unpaused with skipImmediately",
 		},
 		{
 			name:                 "schedule with phase Enabled gets re-validated and triggers a backup if valid",
@@ -134,14 +194,16 @@ func TestReconcileOfSchedule(t *testing.T) {
 			fakeClockTime:             "2017-01-01 12:00:00",
 			expectedLastBackup:        "2000-01-01 00:00:00",
 			expectedLastSkipped:       "2017-01-01 12:00:00",
+			expectedLastSkippedReason: "the schedule was created or unpaused with skipImmediately",
 			reconcilerSkipImmediately: true,
 		},
 		{
-			name:                "schedule that's already run but has SkippedImmediately=true do not get LastBackup updated",
-			schedule:            newScheduleBuilder(velerov1.SchedulePhaseEnabled).CronSchedule("@every 5m").LastBackupTime("2000-01-01 00:00:00").SkipImmediately(pointer.Bool(true)).Result(),
-			fakeClockTime:       "2017-01-01 12:00:00",
-			expectedLastBackup:  "2000-01-01 00:00:00",
-			expectedLastSkipped: "2017-01-01 12:00:00",
+			name:                      "schedule that's already run but has SkippedImmediately=true do not get LastBackup updated",
+			schedule:                  newScheduleBuilder(velerov1.SchedulePhaseEnabled).CronSchedule("@every 5m").LastBackupTime("2000-01-01 00:00:00").SkipImmediately(pointer.Bool(true)).Result(),
+			fakeClockTime:             "2017-01-01 12:00:00",
+			expectedLastBackup:        "2000-01-01 00:00:00",
+			expectedLastSkipped:       "2017-01-01 12:00:00",
+			expectedLastSkippedReason: "the schedule was created or unpaused with skipImmediately",
 		},
 		{
 			name:          "schedule already has backup in New state.",
@@ -200,6 +262,10 @@ func TestReconcileOfSchedule(t *testing.T) {
 				require.NotNil(t, schedule.Status.LastBackup)
 				assert.Equal(t, parseTime(test.expectedLastBackup).Unix(), schedule.Status.LastBackup.Unix())
 			}
+			if test.schedule != nil {
+				require.Nil(t, err)
+				assert.Equal(t, test.expectedLastSkippedReason, schedule.Status.LastSkippedReason)
+			}
 			if len(test.expectedLastSkipped) > 0 {
 				require.Nil(t, err)
 				require.NotNil(t, schedule.Status.LastSkipped)
@@ -326,6 +392,52 @@ func TestGetNextRunTime(t *testing.T) {
 	}
 }
 
+func TestGetNextRunTimeInTimeZone(t *testing.T) {
+	tests := []struct {
+		name                string
+		timeZone            string
+		lastBackup          time.Time
+		expectedNextRunTime time.Time
+	}{
+		{
+			name:                "UTC",
+			lastBackup:          time.Date(2017, 3, 11, 3, 0, 0, 0, time.UTC),
+			expectedNextRunTime: time.Date(2017, 3, 12, 3, 0, 0, 0, time.UTC),
+		},
+		{
+			name:                "time zone",
+			timeZone:            "Europe/Paris",
+			lastBackup:          time.Date(2017, 1, 10, 2, 0, 0, 0, time.UTC),
+			expectedNextRunTime: time.Date(2017, 1, 11, 2, 0, 0, 0, time.UTC),
+		},
+		{
+			name:                "daylight saving time starts",
+			timeZone:            "America/New_York",
+			lastBackup:          time.Date(2017, 3, 11, 8, 0, 0, 0, time.UTC),
+			expectedNextRunTime: time.Date(2017, 3, 12, 7, 0, 0, 0, time.UTC),
+		},
+		{
+			name:                "daylight saving time ends",
+			timeZone:            "America/New_York",
+			lastBackup:          time.Date(2017, 11, 4, 7, 0, 0, 0, time.UTC),
+			expectedNextRunTime: time.Date(2017, 11, 5, 8, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			schedule := builder.ForSchedule("velero", "schedule-1").CronSchedule("0 3 * * *").TimeZone(test.timeZone).Result()
+			schedule.Status.LastBackup = &metav1.Time{Time: test.lastBackup}
+			cronSchedule, errs := parseCronSchedule(schedule, velerotest.NewLogger())
+			require.Empty(t, errs)
+
+			due, nextRunTime := getNextRunTime(schedule, cronSchedule, test.expectedNextRunTime.Add(-time.Second))
+			assert.False(t, due)
+			assert.True(t, test.expectedNextRunTime.Equal(nextRunTime), "expected %v, got %v", test.expectedNextRunTime, nextRunTime)
+		})
+	}
+}
+
 func TestParseCronSchedule(t *testing.T) {
 	// From https://github.com/vmware-tanzu/velero/issues/30, where we originally were using cron.Parse(),
 	// which treats the first field as seconds, and not minutes. We want to use cron.ParseStandard()
@@ -480,3 +592,112 @@ func TestCheckIfBackupInNewOrProgress(t *testing.T) {
 	result = reconciler.checkIfBackupInNewOrProgress(testSchedule)
 	assert.True(t, result)
 }
+
+func TestReconcileOfScheduleMissedRuns(t *testing.T) {
+	require.Nil(t, velerov1.AddToScheme(scheme.Scheme))
+
+	newScheduleBuilder := func() *builder.ScheduleBuilder {
+		return builder.ForSchedule("ns", "name").Phase(velerov1.SchedulePhaseEnabled).CronSchedule("0 * * * *").LastBackupTime("2017-01-01 10:00:00")
+	}
+	runningBackup := builder.ForBackup("ns", "name-20170101100000").ObjectMeta(builder.WithLabels(velerov1.ScheduleNameLabel, "name")).Phase(velerov1.BackupPhaseInProgress).Result()
+
+	tests := []struct {
+		name                        string
+		schedule                    *velerov1.Schedule
+		backup                      *velerov1.Backup
+		fakeClockTime               string
+		expectedBackups             int
+		expectedLastSkippedReason   string
+		expectedMissedRuns          int64
+		expectedLastMissedRunReason string
+	}{
+		{
+			name:            "schedule due on time runs without missed runs",
+			schedule:        newScheduleBuilder().Result(),
+			fakeClockTime:   "2017-01-01 11:00:30",
+			expectedBackups: 1,
+		},
+		{
+			name:                        "schedule due for several runs runs the latest and records the others as missed",
+			schedule:                    newScheduleBuilder().Result(),
+			fakeClockTime:               "2017-01-01 13:30:00",
+			expectedBackups:             1,
+			expectedMissedRuns:          2,
+			expectedLastMissedRunReason: "only the latest of the runs due since the last run was started",
+		},
+		{
+			name:                        "schedule due after its starting deadline misses the runs",
+			schedule:                    newScheduleBuilder().StartingDeadline(10 * time.Minute).Result(),
+			fakeClockTime:               "2017-01-01 13:30:00",
+			expectedLastSkippedReason:   "the backup due at 2017-01-01 13:00:00 +0000 UTC couldn't start within the starting deadline of 10m0s",
+			expectedMissedRuns:          3,
+			expectedLastMissedRunReason: "the backup due at 2017-01-01 13:00:00 +0000 UTC couldn't start within the starting deadline of 10m0s",
+		},
+		{
+			name:            "schedule due within its starting deadline runs",
+			schedule:        newScheduleBuilder().StartingDeadline(10 * time.Minute).Result(),
+			fakeClockTime:   "2017-01-01 11:05:00",
+			expectedBackups: 1,
+		},
+		{
+			name:            "schedule with a running backup queues the run by default",
+			schedule:        newScheduleBuilder().Result(),
+			backup:          runningBackup,
+			fakeClockTime:   "2017-01-01 11:00:30",
+			expectedBackups: 1,
+		},
+		{
+			name:                        "schedule with a running backup and the Skip overlap policy misses the run",
+			schedule:                    newScheduleBuilder().OverlapPolicy(velerov1.ScheduleOverlapPolicySkip).Result(),
+			backup:                      runningBackup,
+			fakeClockTime:               "2017-01-01 11:00:30",
+			expectedBackups:             1,
+			expectedLastSkippedReason:   "a backup of the schedule was still running",
+			expectedMissedRuns:          1,
+			expectedLastMissedRunReason: "a backup of the schedule was still running",
+		},
+		{
+			name:            "schedule with a running backup and the Allow overlap policy runs concurrently",
+			schedule:        newScheduleBuilder().OverlapPolicy(velerov1.ScheduleOverlapPolicyAllow).Result(),
+			backup:          runningBackup,
+			fakeClockTime:   "2017-01-01 11:00:30",
+			expectedBackups: 2,
+		},
+		{
+			name:            "runs in blackout windows aren't missed",
+			schedule:        newScheduleBuilder().BlackoutWindows(velerov1.ScheduleBlackoutWindow{Start: "0 11 * * *", Duration: metav1.Duration{Duration: 2 * time.Hour}, Policy: velerov1.BlackoutWindowPolicyDefer}).Result(),
+			fakeClockTime:   "2017-01-01 13:00:30",
+			expectedBackups: 1,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := (&fake.ClientBuilder{}).Build()
+			reconciler := NewScheduleReconciler("namespace", velerotest.NewLogger(), client, metrics.NewServerMetrics(), false)
+			reconciler.clock = testclocks.NewFakeClock(parseTime(test.fakeClockTime))
+
+			require.Nil(t, client.Create(ctx, test.schedule))
+			if test.backup != nil {
+				require.Nil(t, client.Create(ctx, test.backup.DeepCopy()))
+			}
+
+			_, err := reconciler.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "ns", Name: "name"}})
+			require.Nil(t, err)
+
+			schedule := &velerov1.Schedule{}
+			require.Nil(t, client.Get(ctx, types.NamespacedName{Namespace: "ns", Name: "name"}, schedule))
+			assert.Equal(t, test.expectedLastSkippedReason, schedule.Status.LastSkippedReason)
+			assert.Equal(t, test.expectedMissedRuns, schedule.Status.MissedRuns)
+			assert.Equal(t, test.expectedLastMissedRunReason, schedule.Status.LastMissedRunReason)
+			if test.expectedMissedRuns > 0 {
+				require.NotNil(t, schedule.Status.LastMissedRun)
+				assert.Equal(t, parseTime(test.fakeClockTime).Unix(), schedule.Status.LastMissedRun.Unix())
+			}
+
+			backups := &velerov1.BackupList{}
+			require.Nil(t, client.List(ctx, backups))
+			assert.Len(t, backups.Items, test.expectedBackups)
+		})
+	}
+}
@@ -0,0 +1,310 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"os"
+	"path"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	clocks "k8s.io/utils/clock"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/credentials"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/datamover"
+	"github.com/vmware-tanzu/velero/pkg/label"
+	"github.com/vmware-tanzu/velero/pkg/podvolume"
+	"github.com/vmware-tanzu/velero/pkg/repository"
+	repokey "github.com/vmware-tanzu/velero/pkg/repository/keys"
+	repoProvider "github.com/vmware-tanzu/velero/pkg/repository/provider"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+	"github.com/vmware-tanzu/velero/pkg/uploader/provider"
+)
+
+const (
+	snapshotBrowseRequestor = "snapshot-browse"
+	// maxSnapshotBrowseEntries is the maximum number of entries listed in a
+	// SnapshotBrowseRequest, which keeps the request well below the size
+	// limit of a Kubernetes object.
+	maxSnapshotBrowseEntries = 1000
+)
+
+// volumeSnapshot identifies a pod volume or data mover snapshot in its
+// backup repository.
+type volumeSnapshot struct {
+	snapshotID      string
+	uploaderType    string
+	repositoryType  string
+	backupLocation  string
+	volumeNamespace string
+}
+
+// snapshotBrowseRequestReconciler lists the directories of pod volume and data
+// mover snapshots which are requested by SnapshotBrowseRequests.
+type snapshotBrowseRequestReconciler struct {
+	client           client.Client
+	namespace        string
+	repoEnsurer      *repository.Ensurer
+	credentialGetter *credentials.CredentialGetter
+	clock            clocks.WithTickerAndDelayedExecution
+	log              logrus.FieldLogger
+
+	// listDirectory is a field so it can be replaced in tests.
+	listDirectory func(ctx context.Context, snapshot volumeSnapshot, dirPath string, log logrus.FieldLogger) ([]uploader.SnapshotEntry, error)
+}
+
+// NewSnapshotBrowseRequestReconciler initializes and returns snapshotBrowseRequestReconciler struct.
+func NewSnapshotBrowseRequestReconciler(
+	client client.Client,
+	namespace string,
+	repoEnsurer *repository.Ensurer,
+	credentialGetter *credentials.CredentialGetter,
+	clock clocks.WithTickerAndDelayedExecution,
+	log logrus.FieldLogger) *snapshotBrowseRequestReconciler {
+	r := &snapshotBrowseRequestReconciler{
+		client:           client,
+		namespace:        namespace,
+		repoEnsurer:      repoEnsurer,
+		credentialGetter: credentialGetter,
+		clock:            clock,
+		log:              log,
+	}
+	r.listDirectory = r.listSnapshotDirectory
+	return r
+}
+
+// +kubebuilder:rbac:groups=velero.io,resources=snapshotbrowserequests,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=velero.io,resources=snapshotbrowserequests/status,verbs=get;update;patch
+
+func (r *snapshotBrowseRequestReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	log := r.log.WithFields(logrus.Fields{
+		"controller":            SnapshotBrowseRequest,
+		"snapshotBrowseRequest": req.NamespacedName,
+	})
+
+	request := &velerov1api.SnapshotBrowseRequest{}
+	if err := r.client.Get(ctx, req.NamespacedName, request); err != nil {
+		if apierrors.IsNotFound(err) {
+			log.Debug("Unable to find SnapshotBrowseRequest")
+			return ctrl.Result{}, nil
+		}
+
+		log.WithError(err).Error("Error getting SnapshotBrowseRequest")
+		return ctrl.Result{}, err
+	}
+
+	switch request.Status.Phase {
+	case "", velerov1api.SnapshotBrowseRequestPhaseNew:
+		log.Info("Processing new SnapshotBrowseRequest")
+		original := request.DeepCopy()
+
+		entries, err := r.browse(ctx, request, log)
+		if err != nil {
+			log.WithError(err).Warn("Unable to list the snapshot directory")
+			request.Status.Phase = velerov1api.SnapshotBrowseRequestPhaseFailed
+			request.Status.Message = err.Error()
+		} else {
+			request.Status.Phase = velerov1api.SnapshotBrowseRequestPhaseProcessed
+			request.Status.Entries, request.Status.Truncated = snapshotEntries(entries)
+		}
+		request.Status.ProcessedTimestamp = &metav1.Time{Time: r.clock.Now()}
+
+		if err := r.client.Patch(ctx, request, client.MergeFrom(original)); err != nil {
+			log.WithError(err).Error("Error updating SnapshotBrowseRequest status")
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{RequeueAfter: ttl}, nil
+	case velerov1api.SnapshotBrowseRequestPhaseProcessed, velerov1api.SnapshotBrowseRequestPhaseFailed:
+		expiration := request.Status.ProcessedTimestamp.Add(ttl)
+		if expiration.After(r.clock.Now()) {
+			log.Debug("SnapshotBrowseRequest has not expired")
+			return ctrl.Result{RequeueAfter: expiration.Sub(r.clock.Now())}, nil
+		}
+
+		log.Debug("SnapshotBrowseRequest has expired, deleting it")
+		if err := r.client.Delete(ctx, request); err != nil && !apierrors.IsNotFound(err) {
+			log.WithError(err).Error("Unable to delete the request")
+		}
+		return ctrl.Result{}, nil
+	default:
+		return ctrl.Result{}, errors.New("unexpected SnapshotBrowseRequest phase")
+	}
+}
+
+func (r *snapshotBrowseRequestReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&velerov1api.SnapshotBrowseRequest{}).
+		Complete(r)
+}
+
+// browse lists the requested directory of the snapshot.
+func (r *snapshotBrowseRequestReconciler) browse(ctx context.Context, request *velerov1api.SnapshotBrowseRequest, log logrus.FieldLogger) ([]uploader.SnapshotEntry, error) {
+	snapshot, err := r.findSnapshot(ctx, request.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	// an empty volume is backed up without a snapshot
+	if snapshot.snapshotID == "" {
+		if path.Clean("/"+request.Spec.Path) != "/" {
+			return nil, errors.Errorf("path %s isn't in the snapshot, the volume was empty", request.Spec.Path)
+		}
+		return nil, nil
+	}
+
+	if snapshot.uploaderType != uploader.KopiaType {
+		return nil, errors.Errorf("browsing snapshots of the %s uploader is not supported", snapshot.uploaderType)
+	}
+
+	return r.listDirectory(ctx, snapshot, request.Spec.Path, log.WithField("snapshotID", snapshot.snapshotID))
+}
+
+// findSnapshot returns the snapshot of the pod volume backup or of the data
+// upload which backed up the requested volume.
+func (r *snapshotBrowseRequestReconciler) findSnapshot(ctx context.Context, spec velerov1api.SnapshotBrowseRequestSpec) (volumeSnapshot, error) {
+	switch {
+	case spec.BackupName == "":
+		return volumeSnapshot{}, errors.New("backupName must be specified")
+	case spec.Pod != "" && spec.PVC != "":
+		return volumeSnapshot{}, errors.New("only one of pod and pvc can be specified")
+	case spec.Pod != "" && spec.Volume == "":
+		return volumeSnapshot{}, errors.New("volume must be specified with pod")
+	case spec.Pod == "" && spec.PVC == "":
+		return volumeSnapshot{}, errors.New("either pod and volume, or pvc must be specified")
+	}
+
+	selector := client.MatchingLabels{velerov1api.BackupNameLabel: label.GetValidName(spec.BackupName)}
+
+	if spec.Pod != "" {
+		pvbs := &velerov1api.PodVolumeBackupList{}
+		if err := r.client.List(ctx, pvbs, client.InNamespace(r.namespace), selector); err != nil {
+			return volumeSnapshot{}, errors.Wrap(err, "error listing pod volume backups")
+		}
+		for i := range pvbs.Items {
+			pvb := &pvbs.Items[i]
+			if pvb.Spec.Pod.Namespace != spec.Namespace || pvb.Spec.Pod.Name != spec.Pod || pvb.Spec.Volume != spec.Volume {
+				continue
+			}
+			if pvb.Status.Phase != velerov1api.PodVolumeBackupPhaseCompleted {
+				return volumeSnapshot{}, errors.Errorf("pod volume backup %s is in phase %s", pvb.Name, pvb.Status.Phase)
+			}
+			return volumeSnapshot{
+				snapshotID:      pvb.Status.SnapshotID,
+				uploaderType:    pvb.Spec.UploaderType,
+				repositoryType:  podvolume.GetPvbRepositoryType(pvb),
+				backupLocation:  pvb.Spec.BackupStorageLocation,
+				volumeNamespace: pvb.Spec.Pod.Namespace,
+			}, nil
+		}
+		return volumeSnapshot{}, errors.Errorf("volume %s of pod %s/%s isn't backed up by a pod volume backup of backup %s", spec.Volume, spec.Namespace, spec.Pod, spec.BackupName)
+	}
+
+	dataUploads := &velerov2alpha1api.DataUploadList{}
+	if err := r.client.List(ctx, dataUploads, client.InNamespace(r.namespace), selector); err != nil {
+		return volumeSnapshot{}, errors.Wrap(err, "error listing data uploads")
+	}
+	for i := range dataUploads.Items {
+		du := &dataUploads.Items[i]
+		if du.Spec.SourceNamespace != spec.Namespace || du.Spec.SourcePVC != spec.PVC {
+			continue
+		}
+		if !datamover.IsBuiltInUploader(du.Spec.DataMover) {
+			return volumeSnapshot{}, errors.Errorf("data upload %s is handled by the data mover %s, which can't be browsed", du.Name, du.Spec.DataMover)
+		}
+		if du.Status.Phase != velerov2alpha1api.DataUploadPhaseCompleted {
+			return volumeSnapshot{}, errors.Errorf("data upload %s is in phase %s", du.Name, du.Status.Phase)
+		}
+		return volumeSnapshot{
+			snapshotID:      du.Status.SnapshotID,
+			uploaderType:    datamover.GetUploaderType(du.Spec.DataMover),
+			repositoryType:  velerov1api.BackupRepositoryTypeKopia,
+			backupLocation:  du.Spec.BackupStorageLocation,
+			volumeNamespace: du.Spec.SourceNamespace,
+		}, nil
+	}
+	return volumeSnapshot{}, errors.Errorf("PVC %s/%s isn't backed up by a data upload of backup %s", spec.Namespace, spec.PVC, spec.BackupName)
+}
+
+// listSnapshotDirectory connects to the backup repository of the snapshot and
+// lists the directory.
+func (r *snapshotBrowseRequestReconciler) listSnapshotDirectory(ctx context.Context, snapshot volumeSnapshot, dirPath string, log logrus.FieldLogger) ([]uploader.SnapshotEntry, error) {
+	location := &velerov1api.BackupStorageLocation{}
+	if err := r.client.Get(ctx, client.ObjectKey{Namespace: r.namespace, Name: snapshot.backupLocation}, location); err != nil {
+		return nil, errors.Wrapf(err, "error getting backup storage location %s", snapshot.backupLocation)
+	}
+
+	backupRepo, err := r.repoEnsurer.EnsureRepo(ctx, r.namespace, snapshot.volumeNamespace, snapshot.backupLocation, snapshot.repositoryType)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error to ensure backup repository %s-%s-%s", snapshot.backupLocation, snapshot.volumeNamespace, snapshot.repositoryType)
+	}
+
+	if err := repoProvider.NewUnifiedRepoProvider(*r.credentialGetter, snapshot.repositoryType, log).BoostRepoConnect(ctx, repoProvider.RepoParam{BackupLocation: location, BackupRepo: backupRepo}); err != nil {
+		return nil, errors.Wrapf(err, "error to connect backup repository %s", backupRepo.Name)
+	}
+
+	uploaderProv, err := provider.NewUploaderProvider(ctx, r.client, snapshot.uploaderType, snapshotBrowseRequestor, "", location, backupRepo, r.credentialGetter, repokey.RepoKeySelector(), log)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error creating uploader %s", snapshot.uploaderType)
+	}
+	defer func() {
+		if err := uploaderProv.Close(ctx); err != nil {
+			log.WithError(err).Warn("Failed to close uploader provider")
+		}
+	}()
+
+	return uploaderProv.ListDirectory(ctx, snapshot.snapshotID, dirPath)
+}
+
+// snapshotEntries converts the entries of a snapshot directory to API entries
+// sorted by name, keeping at most maxSnapshotBrowseEntries of them.
+func snapshotEntries(entries []uploader.SnapshotEntry) ([]velerov1api.SnapshotEntry, bool) {
+	sort.Slice(entries, func(i, j int) bool { return entries[i].Name < entries[j].Name })
+
+	truncated := len(entries) > maxSnapshotBrowseEntries
+	if truncated {
+		entries = entries[:maxSnapshotBrowseEntries]
+	}
+
+	var result []velerov1api.SnapshotEntry
+	for _, entry := range entries {
+		e := velerov1api.SnapshotEntry{
+			Name: entry.Name,
+			Type: velerov1api.SnapshotEntryTypeFile,
+			Mode: entry.Mode.String(),
+			Size: entry.Size,
+		}
+		switch {
+		case entry.Mode.IsDir():
+			e.Type = velerov1api.SnapshotEntryTypeDirectory
+		case entry.Mode&os.ModeSymlink != 0:
+			e.Type = velerov1api.SnapshotEntryTypeSymlink
+		}
+		if !entry.ModTime.IsZero() {
+			e.ModTime = &metav1.Time{Time: entry.ModTime}
+		}
+		result = append(result, e)
+	}
+	return result, truncated
+}
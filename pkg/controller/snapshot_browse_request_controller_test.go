@@ -0,0 +1,230 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	testclocks "k8s.io/utils/clock/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	velerov2alpha1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v2alpha1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+	"github.com/vmware-tanzu/velero/pkg/uploader"
+)
+
+func TestSnapshotBrowseRequestReconcile(t *testing.T) {
+	now := time.Date(2023, 6, 1, 10, 0, 0, 0, time.UTC)
+	modTime := now.Add(-time.Hour).Local()
+	backupLabel := builder.WithLabels(velerov1api.BackupNameLabel, "backup-1")
+
+	pvb := builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-1").ObjectMeta(backupLabel).
+		PodNamespace("ns-1").PodName("pod-1").Volume("vol-1").UploaderType("kopia").BackupStorageLocation("default").
+		SnapshotID("snapshot-1").Phase(velerov1api.PodVolumeBackupPhaseCompleted).Result()
+	resticPVB := builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-2").ObjectMeta(backupLabel).
+		PodNamespace("ns-1").PodName("pod-1").Volume("vol-2").UploaderType("restic").BackupStorageLocation("default").
+		SnapshotID("snapshot-2").Phase(velerov1api.PodVolumeBackupPhaseCompleted).Result()
+	emptyPVB := builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, "pvb-3").ObjectMeta(backupLabel).
+		PodNamespace("ns-1").PodName("pod-1").Volume("vol-3").UploaderType("kopia").BackupStorageLocation("default").
+		Phase(velerov1api.PodVolumeBackupPhaseCompleted).Result()
+	dataUpload := builder.ForDataUpload(velerov1api.DefaultNamespace, "du-1").Labels(map[string]string{velerov1api.BackupNameLabel: "backup-1"}).
+		SourceNamespace("ns-1").SourcePVC("pvc-1").BackupStorageLocation("default").
+		SnapshotID("snapshot-3").Phase(velerov2alpha1api.DataUploadPhaseCompleted).Result()
+
+	newRequest := func() *builder.SnapshotBrowseRequestBuilder {
+		return builder.ForSnapshotBrowseRequest(velerov1api.DefaultNamespace, "request-1").BackupName("backup-1")
+	}
+
+	tests := []struct {
+		name             string
+		request          *velerov1api.SnapshotBrowseRequest
+		wantSnapshot     volumeSnapshot
+		wantPhase        velerov1api.SnapshotBrowseRequestPhase
+		wantMessage      string
+		wantEntries      []velerov1api.SnapshotEntry
+		wantResult       ctrl.Result
+		wantDeleted      bool
+		wantListed       bool
+		listDirectoryErr error
+	}{
+		{
+			name:    "pod volume snapshot",
+			request: newRequest().PodVolume("ns-1", "pod-1", "vol-1").Path("/etc").Result(),
+			wantSnapshot: volumeSnapshot{
+				snapshotID:      "snapshot-1",
+				uploaderType:    "kopia",
+				repositoryType:  velerov1api.BackupRepositoryTypeKopia,
+				backupLocation:  "default",
+				volumeNamespace: "ns-1",
+			},
+			wantListed: true,
+			wantPhase:  velerov1api.SnapshotBrowseRequestPhaseProcessed,
+			wantEntries: []velerov1api.SnapshotEntry{
+				{Name: "app", Type: velerov1api.SnapshotEntryTypeDirectory, Mode: "drwxr-xr-x", ModTime: &metav1.Time{Time: modTime}},
+				{Name: "app.conf", Type: velerov1api.SnapshotEntryTypeFile, Mode: "-rw-r--r--", Size: 2048, ModTime: &metav1.Time{Time: modTime}},
+				{Name: "current", Type: velerov1api.SnapshotEntryTypeSymlink, Mode: "Lrwxrwxrwx", ModTime: &metav1.Time{Time: modTime}},
+			},
+			wantResult: ctrl.Result{RequeueAfter: ttl},
+		},
+		{
+			name:    "data mover snapshot",
+			request: newRequest().PVC("ns-1", "pvc-1").Result(),
+			wantSnapshot: volumeSnapshot{
+				snapshotID:      "snapshot-3",
+				uploaderType:    "kopia",
+				repositoryType:  velerov1api.BackupRepositoryTypeKopia,
+				backupLocation:  "default",
+				volumeNamespace: "ns-1",
+			},
+			wantListed: true,
+			wantPhase:  velerov1api.SnapshotBrowseRequestPhaseProcessed,
+			wantEntries: []velerov1api.SnapshotEntry{
+				{Name: "app", Type: velerov1api.SnapshotEntryTypeDirectory, Mode: "drwxr-xr-x", ModTime: &metav1.Time{Time: modTime}},
+				{Name: "app.conf", Type: velerov1api.SnapshotEntryTypeFile, Mode: "-rw-r--r--", Size: 2048, ModTime: &metav1.Time{Time: modTime}},
+				{Name: "current", Type: velerov1api.SnapshotEntryTypeSymlink, Mode: "Lrwxrwxrwx", ModTime: &metav1.Time{Time: modTime}},
+			},
+			wantResult: ctrl.Result{RequeueAfter: ttl},
+		},
+		{
+			name:       "empty volume",
+			request:    newRequest().PodVolume("ns-1", "pod-1", "vol-3").Result(),
+			wantPhase:  velerov1api.SnapshotBrowseRequestPhaseProcessed,
+			wantResult: ctrl.Result{RequeueAfter: ttl},
+		},
+		{
+			name:        "restic snapshot",
+			request:     newRequest().PodVolume("ns-1", "pod-1", "vol-2").Result(),
+			wantPhase:   velerov1api.SnapshotBrowseRequestPhaseFailed,
+			wantMessage: "browsing snapshots of the restic uploader is not supported",
+			wantResult:  ctrl.Result{RequeueAfter: ttl},
+		},
+		{
+			name:        "volume not backed up",
+			request:     newRequest().PodVolume("ns-1", "pod-1", "vol-4").Result(),
+			wantPhase:   velerov1api.SnapshotBrowseRequestPhaseFailed,
+			wantMessage: "volume vol-4 of pod ns-1/pod-1 isn't backed up by a pod volume backup of backup backup-1",
+			wantResult:  ctrl.Result{RequeueAfter: ttl},
+		},
+		{
+			name:        "pod and PVC",
+			request:     newRequest().PodVolume("ns-1", "pod-1", "vol-1").PVC("ns-1", "pvc-1").Result(),
+			wantPhase:   velerov1api.SnapshotBrowseRequestPhaseFailed,
+			wantMessage: "only one of pod and pvc can be specified",
+			wantResult:  ctrl.Result{RequeueAfter: ttl},
+		},
+		{
+			name:    "listing error",
+			request: newRequest().PVC("ns-1", "pvc-1").Path("/missing").Result(),
+			wantSnapshot: volumeSnapshot{
+				snapshotID:      "snapshot-3",
+				uploaderType:    "kopia",
+				repositoryType:  velerov1api.BackupRepositoryTypeKopia,
+				backupLocation:  "default",
+				volumeNamespace: "ns-1",
+			},
+			wantListed:       true,
+			listDirectoryErr: errors.New("path not found"),
+			wantPhase:        velerov1api.SnapshotBrowseRequestPhaseFailed,
+			wantMessage:      "path not found",
+			wantResult:       ctrl.Result{RequeueAfter: ttl},
+		},
+		{
+			name:       "processed request not expired",
+			request:    newRequest().Phase(velerov1api.SnapshotBrowseRequestPhaseProcessed).ProcessedTimestamp(now.Add(-10 * time.Second)).Result(),
+			wantPhase:  velerov1api.SnapshotBrowseRequestPhaseProcessed,
+			wantResult: ctrl.Result{RequeueAfter: 50 * time.Second},
+		},
+		{
+			name:        "expired request",
+			request:     newRequest().Phase(velerov1api.SnapshotBrowseRequestPhaseFailed).ProcessedTimestamp(now.Add(-2 * time.Minute)).Result(),
+			wantDeleted: true,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := velerotest.NewFakeControllerRuntimeClient(t, test.request, pvb, resticPVB, emptyPVB, dataUpload)
+			r := NewSnapshotBrowseRequestReconciler(client, velerov1api.DefaultNamespace, nil, nil, testclocks.NewFakeClock(now), velerotest.NewLogger())
+
+			listed := false
+			r.listDirectory = func(ctx context.Context, snapshot volumeSnapshot, dirPath string, log logrus.FieldLogger) ([]uploader.SnapshotEntry, error) {
+				listed = true
+				assert.Equal(t, test.wantSnapshot, snapshot)
+				assert.Equal(t, test.request.Spec.Path, dirPath)
+				if test.listDirectoryErr != nil {
+					return nil, test.listDirectoryErr
+				}
+				return []uploader.SnapshotEntry{
+					{Name: "current", Mode: os.ModeSymlink | 0777, ModTime: modTime},
+					{Name: "app.conf", Mode: 0644, Size: 2048, ModTime: modTime},
+					{Name: "app", Mode: os.ModeDir | 0755, ModTime: modTime},
+				}, nil
+			}
+
+			result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: test.request.Namespace, Name: test.request.Name}})
+			require.NoError(t, err)
+			assert.Equal(t, test.wantResult, result)
+			assert.Equal(t, test.wantListed, listed)
+
+			request := &velerov1api.SnapshotBrowseRequest{}
+			err = client.Get(context.Background(), types.NamespacedName{Namespace: test.request.Namespace, Name: test.request.Name}, request)
+			if test.wantDeleted {
+				assert.True(t, apierrors.IsNotFound(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.wantPhase, request.Status.Phase)
+			assert.Equal(t, test.wantMessage, request.Status.Message)
+			assert.Equal(t, test.wantEntries, request.Status.Entries)
+			assert.NotNil(t, request.Status.ProcessedTimestamp)
+		})
+	}
+}
+
+func TestSnapshotEntries(t *testing.T) {
+	var entries []uploader.SnapshotEntry
+	for i := 0; i < maxSnapshotBrowseEntries+1; i++ {
+		entries = append(entries, uploader.SnapshotEntry{Name: fmt.Sprintf("file-%04d", i)})
+	}
+
+	result, truncated := snapshotEntries(entries)
+	assert.True(t, truncated)
+	assert.Len(t, result, maxSnapshotBrowseEntries)
+	assert.Equal(t, "file-0000", result[0].Name)
+	assert.Nil(t, result[0].ModTime)
+
+	result, truncated = snapshotEntries(entries[:2])
+	assert.False(t, truncated)
+	assert.Len(t, result, 2)
+
+	result, truncated = snapshotEntries(nil)
+	assert.False(t, truncated)
+	assert.Nil(t, result)
+}
@@ -38,6 +38,7 @@ const (
 	backupPartialFailureTotal     = "backup_partial_failure_total"
 	backupFailureTotal            = "backup_failure_total"
 	backupValidationFailureTotal  = "backup_validation_failure_total"
+	backupCancelledTotal          = "backup_cancelled_total"
 	backupDurationSeconds         = "backup_duration_seconds"
 	backupDeletionAttemptTotal    = "backup_deletion_attempt_total"
 	backupDeletionSuccessTotal    = "backup_deletion_success_total"
@@ -47,12 +48,14 @@ const (
 	backupItemsErrorsGauge        = "backup_items_errors"
 	backupWarningTotal            = "backup_warning_total"
 	backupLastStatus              = "backup_last_status"
+	scheduleMissedRunTotal        = "schedule_missed_run_total"
 	restoreTotal                  = "restore_total"
 	restoreAttemptTotal           = "restore_attempt_total"
 	restoreValidationFailedTotal  = "restore_validation_failed_total"
 	restoreSuccessTotal           = "restore_success_total"
 	restorePartialFailureTotal    = "restore_partial_failure_total"
 	restoreFailedTotal            = "restore_failed_total"
+	restoreCancelledTotal         = "restore_cancelled_total"
 	volumeSnapshotAttemptTotal    = "volume_snapshot_attempt_total"
 	volumeSnapshotSuccessTotal    = "volume_snapshot_success_total"
 	volumeSnapshotFailureTotal    = "volume_snapshot_failure_total"
@@ -145,6 +148,14 @@ func NewServerMetrics() *ServerMetrics {
 				},
 				[]string{scheduleLabel},
 			),
+			backupCancelledTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      backupCancelledTotal,
+					Help:      "Total number of cancelled backups",
+				},
+				[]string{scheduleLabel},
+			),
 			backupValidationFailureTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
 					Namespace: metricNamespace,
@@ -220,6 +231,14 @@ func NewServerMetrics() *ServerMetrics {
 				},
 				[]string{scheduleLabel},
 			),
+			scheduleMissedRunTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      scheduleMissedRunTotal,
+					Help:      "Total number of missed runs of schedules",
+				},
+				[]string{scheduleLabel},
+			),
 			backupLastStatus: prometheus.NewGaugeVec(
 				prometheus.GaugeOpts{
 					Namespace: metricNamespace,
@@ -267,6 +286,14 @@ func NewServerMetrics() *ServerMetrics {
 				},
 				[]string{scheduleLabel},
 			),
+			restoreCancelledTotal: prometheus.NewCounterVec(
+				prometheus.CounterOpts{
+					Namespace: metricNamespace,
+					Name:      restoreCancelledTotal,
+					Help:      "Total number of cancelled restores",
+				},
+				[]string{scheduleLabel},
+			),
 			restoreValidationFailedTotal: prometheus.NewCounterVec(
 				prometheus.CounterOpts{
 					Namespace: metricNamespace,
@@ -446,6 +473,9 @@ func (m *ServerMetrics) InitSchedule(scheduleName string) {
 	if c, ok := m.metrics[backupFailureTotal].(*prometheus.CounterVec); ok {
 		c.WithLabelValues(scheduleName).Add(0)
 	}
+	if c, ok := m.metrics[backupCancelledTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(scheduleName).Add(0)
+	}
 	if c, ok := m.metrics[backupValidationFailureTotal].(*prometheus.CounterVec); ok {
 		c.WithLabelValues(scheduleName).Add(0)
 	}
@@ -470,6 +500,9 @@ func (m *ServerMetrics) InitSchedule(scheduleName string) {
 	if c, ok := m.metrics[backupLastStatus].(*prometheus.GaugeVec); ok {
 		c.WithLabelValues(scheduleName).Add(1)
 	}
+	if c, ok := m.metrics[scheduleMissedRunTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(scheduleName).Add(0)
+	}
 	if c, ok := m.metrics[restoreAttemptTotal].(*prometheus.CounterVec); ok {
 		c.WithLabelValues(scheduleName).Add(0)
 	}
@@ -479,6 +512,9 @@ func (m *ServerMetrics) InitSchedule(scheduleName string) {
 	if c, ok := m.metrics[restoreFailedTotal].(*prometheus.CounterVec); ok {
 		c.WithLabelValues(scheduleName).Add(0)
 	}
+	if c, ok := m.metrics[restoreCancelledTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(scheduleName).Add(0)
+	}
 	if c, ok := m.metrics[restoreSuccessTotal].(*prometheus.CounterVec); ok {
 		c.WithLabelValues(scheduleName).Add(0)
 	}
@@ -522,6 +558,9 @@ func (m *ServerMetrics) RemoveSchedule(scheduleName string) {
 	if c, ok := m.metrics[backupFailureTotal].(*prometheus.CounterVec); ok {
 		c.DeleteLabelValues(scheduleName)
 	}
+	if c, ok := m.metrics[backupCancelledTotal].(*prometheus.CounterVec); ok {
+		c.DeleteLabelValues(scheduleName)
+	}
 	if c, ok := m.metrics[backupValidationFailureTotal].(*prometheus.CounterVec); ok {
 		c.DeleteLabelValues(scheduleName)
 	}
@@ -552,6 +591,9 @@ func (m *ServerMetrics) RemoveSchedule(scheduleName string) {
 	if c, ok := m.metrics[backupLastStatus].(*prometheus.GaugeVec); ok {
 		c.DeleteLabelValues(scheduleName)
 	}
+	if c, ok := m.metrics[scheduleMissedRunTotal].(*prometheus.CounterVec); ok {
+		c.DeleteLabelValues(scheduleName)
+	}
 	if c, ok := m.metrics[restoreAttemptTotal].(*prometheus.CounterVec); ok {
 		c.DeleteLabelValues(scheduleName)
 	}
@@ -561,6 +603,9 @@ func (m *ServerMetrics) RemoveSchedule(scheduleName string) {
 	if c, ok := m.metrics[restoreFailedTotal].(*prometheus.CounterVec); ok {
 		c.DeleteLabelValues(scheduleName)
 	}
+	if c, ok := m.metrics[restoreCancelledTotal].(*prometheus.CounterVec); ok {
+		c.DeleteLabelValues(scheduleName)
+	}
 	if c, ok := m.metrics[restoreSuccessTotal].(*prometheus.CounterVec); ok {
 		c.DeleteLabelValues(scheduleName)
 	}
@@ -735,6 +780,13 @@ func (m *ServerMetrics) RegisterBackupFailed(backupSchedule string) {
 	}
 }
 
+// RegisterBackupCancelled records a cancelled backup.
+func (m *ServerMetrics) RegisterBackupCancelled(backupSchedule string) {
+	if c, ok := m.metrics[backupCancelledTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(backupSchedule).Inc()
+	}
+}
+
 // RegisterBackupValidationFailure records a validation failed backup.
 func (m *ServerMetrics) RegisterBackupValidationFailure(backupSchedule string) {
 	if c, ok := m.metrics[backupValidationFailureTotal].(*prometheus.CounterVec); ok {
@@ -792,6 +844,13 @@ func (m *ServerMetrics) RegisterBackupWarning(backupSchedule string) {
 	}
 }
 
+// RegisterScheduleMissedRuns records missed runs of a schedule.
+func (m *ServerMetrics) RegisterScheduleMissedRuns(scheduleName string, missedRuns int) {
+	if c, ok := m.metrics[scheduleMissedRunTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(scheduleName).Add(float64(missedRuns))
+	}
+}
+
 // RegisterBackupLastStatus records the last status of the backup.
 func (m *ServerMetrics) RegisterBackupLastStatus(backupSchedule string, lastStatus int64) {
 	if g, ok := m.metrics[backupLastStatus].(*prometheus.GaugeVec); ok {
@@ -840,6 +899,13 @@ func (m *ServerMetrics) RegisterRestoreFailed(backupSchedule string) {
 	}
 }
 
+// RegisterRestoreCancelled records a restore that was cancelled.
+func (m *ServerMetrics) RegisterRestoreCancelled(backupSchedule string) {
+	if c, ok := m.metrics[restoreCancelledTotal].(*prometheus.CounterVec); ok {
+		c.WithLabelValues(backupSchedule).Inc()
+	}
+}
+
 // RegisterRestoreValidationFailed records a restore that failed validation.
 func (m *ServerMetrics) RegisterRestoreValidationFailed(backupSchedule string) {
 	if c, ok := m.metrics[restoreValidationFailedTotal].(*prometheus.CounterVec); ok {
@@ -20,6 +20,9 @@ package mocks
 import (
 	io "io"
 
+	integrity "github.com/vmware-tanzu/velero/internal/integrity"
+	restorerollback "github.com/vmware-tanzu/velero/internal/restorerollback"
+
 	mock "github.com/stretchr/testify/mock"
 	volumesnapshotv1 "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 
@@ -71,6 +74,20 @@ func (_m *BackupStore) DeleteBackup(name string) error {
 	return r0
 }
 
+// DeleteBackupCheckpoint provides a mock function with given fields: name
+func (_m *BackupStore) DeleteBackupCheckpoint(name string) error {
+	ret := _m.Called(name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteRestore provides a mock function with given fields: name
 func (_m *BackupStore) DeleteRestore(name string) error {
 	ret := _m.Called(name)
@@ -85,6 +102,20 @@ func (_m *BackupStore) DeleteRestore(name string) error {
 	return r0
 }
 
+// DeleteRestoreCheckpoint provides a mock function with given fields: name
+func (_m *BackupStore) DeleteRestoreCheckpoint(name string) error {
+	ret := _m.Called(name)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(name)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetBackupContents provides a mock function with given fields: name
 func (_m *BackupStore) GetBackupContents(name string) (io.ReadCloser, error) {
 	ret := _m.Called(name)
@@ -108,6 +139,29 @@ func (_m *BackupStore) GetBackupContents(name string) (io.ReadCloser, error) {
 	return r0, r1
 }
 
+// GetBackupCheckpoint provides a mock function with given fields: name
+func (_m *BackupStore) GetBackupCheckpoint(name string) (io.ReadCloser, error) {
+	ret := _m.Called(name)
+
+	var r0 io.ReadCloser
+	if rf, ok := ret.Get(0).(func(string) io.ReadCloser); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetBackupItemOperations provides a mock function with given fields: name
 func (_m *BackupStore) GetBackupItemOperations(name string) ([]*itemoperation.BackupOperation, error) {
 	ret := _m.Called(name)
@@ -387,6 +441,20 @@ func (_m *BackupStore) PutBackup(info persistence.BackupInfo) error {
 	return r0
 }
 
+// PutBackupCheckpoint provides a mock function with given fields: backup, checkpoint
+func (_m *BackupStore) PutBackupCheckpoint(backup string, checkpoint io.Reader) error {
+	ret := _m.Called(backup, checkpoint)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(backup, checkpoint)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PutBackupContents provides a mock function with given fields: backup, backupContents
 func (_m *BackupStore) PutBackupContents(backup string, backupContents io.Reader) error {
 	ret := _m.Called(backup, backupContents)
@@ -429,6 +497,108 @@ func (_m *BackupStore) PutBackupMetadata(backup string, backupMetadata io.Reader
 	return r0
 }
 
+// PutRestoreHookResults provides a mock function with given fields: restore, results
+func (_m *BackupStore) PutRestoreHookResults(restore string, results io.Reader) error {
+	ret := _m.Called(restore, results)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(restore, results)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutRestoreCheckpoint provides a mock function with given fields: restore, checkpoint
+func (_m *BackupStore) PutRestoreCheckpoint(restore string, checkpoint io.Reader) error {
+	ret := _m.Called(restore, checkpoint)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(restore, checkpoint)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PutRestoreRollbackItems provides a mock function with given fields: restore, items
+func (_m *BackupStore) PutRestoreRollbackItems(restore string, items io.Reader) error {
+	ret := _m.Called(restore, items)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(restore, items)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetRestoreCheckpoint provides a mock function with given fields: name
+func (_m *BackupStore) GetRestoreCheckpoint(name string) (io.ReadCloser, error) {
+	ret := _m.Called(name)
+
+	var r0 io.ReadCloser
+	if rf, ok := ret.Get(0).(func(string) io.ReadCloser); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(io.ReadCloser)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRestoreRollbackItems provides a mock function with given fields: name
+func (_m *BackupStore) GetRestoreRollbackItems(name string) ([]restorerollback.Item, error) {
+	ret := _m.Called(name)
+
+	var r0 []restorerollback.Item
+	if rf, ok := ret.Get(0).(func(string) []restorerollback.Item); ok {
+		r0 = rf(name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]restorerollback.Item)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutRestorePreview provides a mock function with given fields: restore, preview
+func (_m *BackupStore) PutRestorePreview(restore string, preview io.Reader) error {
+	ret := _m.Called(restore, preview)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, io.Reader) error); ok {
+		r0 = rf(restore, preview)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PutRestoreItemOperations provides a mock function with given fields: restore, restoreItemOperations
 func (_m *BackupStore) PutRestoreItemOperations(restore string, restoreItemOperations io.Reader) error {
 	ret := _m.Called(restore, restoreItemOperations)
@@ -485,6 +655,29 @@ func (_m *BackupStore) PutRestoredResourceList(restore string, results io.Reader
 	return r0
 }
 
+// VerifyBackup provides a mock function with given fields: name, contents
+func (_m *BackupStore) VerifyBackup(name string, contents io.Reader) (*integrity.Result, error) {
+	ret := _m.Called(name, contents)
+
+	var r0 *integrity.Result
+	if rf, ok := ret.Get(0).(func(string, io.Reader) *integrity.Result); ok {
+		r0 = rf(name, contents)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*integrity.Result)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, io.Reader) error); ok {
+		r1 = rf(name, contents)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 type mockConstructorTestingTNewBackupStore interface {
 	mock.TestingT
 	Cleanup(func())
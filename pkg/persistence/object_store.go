@@ -18,8 +18,11 @@ package persistence
 
 import (
 	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"io"
+	"os"
 	"strings"
 	"time"
 
@@ -31,11 +34,15 @@ import (
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
+	"github.com/vmware-tanzu/velero/internal/integrity"
+	"github.com/vmware-tanzu/velero/internal/restorerollback"
 	internalVolume "github.com/vmware-tanzu/velero/internal/volume"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
 	"github.com/vmware-tanzu/velero/pkg/util"
+	"github.com/vmware-tanzu/velero/pkg/util/encode"
+	"github.com/vmware-tanzu/velero/pkg/util/encryption"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
@@ -52,7 +59,8 @@ type BackupInfo struct {
 	CSIVolumeSnapshots,
 	CSIVolumeSnapshotContents,
 	CSIVolumeSnapshotClasses,
-	BackupVolumeInfo io.Reader
+	BackupVolumeInfo,
+	HookResults io.Reader
 }
 
 // BackupStore defines operations for creating, retrieving, and deleting
@@ -76,16 +84,47 @@ type BackupStore interface {
 	GetCSIVolumeSnapshotClasses(name string) ([]*snapshotv1api.VolumeSnapshotClass, error)
 	GetBackupVolumeInfos(name string) ([]*internalVolume.VolumeInfo, error)
 
+	// VerifyBackup checks the backup's files and the resources in its tarball
+	// against the integrity manifest written with the backup. If contents is
+	// not nil, it's verified in place of the backup tarball in the store so an
+	// already downloaded tarball isn't fetched again. A nil result is returned
+	// if the backup has no manifest, unless the store signs manifests, in which
+	// case the missing manifest fails verification.
+	VerifyBackup(name string, contents io.Reader) (*integrity.Result, error)
+
 	// BackupExists checks if the backup metadata file exists in object storage.
 	BackupExists(bucket, backupName string) (bool, error)
 
+	// PutBackupCheckpoint saves the progress of an in-progress backup, so
+	// that it can be resumed after a restart of the server.
+	PutBackupCheckpoint(backup string, checkpoint io.Reader) error
+	// GetBackupCheckpoint returns the saved progress of the backup, or nil
+	// if there's none.
+	GetBackupCheckpoint(name string) (io.ReadCloser, error)
+	DeleteBackupCheckpoint(name string) error
+
 	DeleteBackup(name string) error
 
 	PutRestoreLog(backup, restore string, log io.Reader) error
 	PutRestoreResults(backup, restore string, results io.Reader) error
 	PutRestoredResourceList(restore string, results io.Reader) error
 	PutRestoreItemOperations(restore string, restoreItemOperations io.Reader) error
+	PutRestoreHookResults(restore string, results io.Reader) error
+	PutRestorePreview(restore string, preview io.Reader) error
+	PutRestoreRollbackItems(restore string, items io.Reader) error
 	GetRestoreItemOperations(name string) ([]*itemoperation.RestoreOperation, error)
+	// GetRestoreRollbackItems returns the items recorded for the rollback of
+	// the restore, which are nil if none were uploaded.
+	GetRestoreRollbackItems(name string) ([]restorerollback.Item, error)
+
+	// PutRestoreCheckpoint saves the progress of an in-progress restore, so
+	// that it can be resumed after a restart of the server.
+	PutRestoreCheckpoint(restore string, checkpoint io.Reader) error
+	// GetRestoreCheckpoint returns the saved progress of the restore, or nil
+	// if there's none.
+	GetRestoreCheckpoint(name string) (io.ReadCloser, error)
+	DeleteRestoreCheckpoint(name string) error
+
 	DeleteRestore(name string) error
 
 	GetDownloadURL(target velerov1api.DownloadTarget) (string, error)
@@ -99,6 +138,12 @@ type objectBackupStore struct {
 	bucket      string
 	layout      *ObjectStoreLayout
 	logger      logrus.FieldLogger
+	// encryptionKey is the key used to encrypt objects before they are
+	// uploaded. Objects are stored in plaintext if it's empty.
+	encryptionKey []byte
+	// signingKey is the key used to sign backup integrity manifests.
+	// Manifests are not signed if it's empty.
+	signingKey []byte
 }
 
 // ObjectStoreGetter is a type that can get a velero.ObjectStore
@@ -174,6 +219,37 @@ func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocatio
 		objectStoreConfig["credentialsFile"] = credsFile
 	}
 
+	// If the BSL specifies an encryption key, read it from its path on disk so
+	// objects can be encrypted before they're handed to the plugin.
+	var encryptionKey []byte
+	if location.Spec.ObjectStorage.Encryption != nil && location.Spec.ObjectStorage.Encryption.Key != nil {
+		keyFile, err := b.credentialStore.Path(location.Spec.ObjectStorage.Encryption.Key)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get encryption key")
+		}
+
+		data, err := os.ReadFile(keyFile)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to read encryption key")
+		}
+
+		if encryptionKey, err = encryption.ParseKey(data); err != nil {
+			return nil, errors.Wrap(err, "invalid encryption key")
+		}
+	}
+
+	var signingKey []byte
+	if location.Spec.ObjectStorage.Integrity != nil && location.Spec.ObjectStorage.Integrity.SigningKey != nil {
+		keyFile, err := b.credentialStore.Path(location.Spec.ObjectStorage.Integrity.SigningKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get manifest signing key")
+		}
+
+		if signingKey, err = os.ReadFile(keyFile); err != nil {
+			return nil, errors.Wrap(err, "unable to read manifest signing key")
+		}
+	}
+
 	objectStore, err := objectStoreGetter.GetObjectStore(location.Spec.Provider)
 	if err != nil {
 		return nil, err
@@ -189,10 +265,12 @@ func (b *objectBackupStoreGetter) Get(location *velerov1api.BackupStorageLocatio
 	}))
 
 	return &objectBackupStore{
-		objectStore: objectStore,
-		bucket:      bucket,
-		layout:      NewObjectStoreLayout(prefix),
-		logger:      log,
+		objectStore:   objectStore,
+		bucket:        bucket,
+		layout:        NewObjectStoreLayout(prefix),
+		logger:        log,
+		encryptionKey: encryptionKey,
+		signingKey:    signingKey,
 	}, nil
 }
 
@@ -246,57 +324,184 @@ func (s *objectBackupStore) ListBackups() ([]string, error) {
 }
 
 func (s *objectBackupStore) PutBackup(info BackupInfo) error {
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupLogKey(info.Name), info.Log); err != nil {
+	manifest := integrity.NewManifest()
+
+	if err := s.putBackupFile(manifest, info.Name, velerov1api.DownloadTargetKindBackupLog, info.Log); err != nil {
 		// Uploading the log file is best-effort; if it fails, we log the error but it doesn't impact the
 		// backup's status.
 		s.logger.WithError(err).WithField("backup", info.Name).Error("Error uploading log file")
 	}
 
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupMetadataKey(info.Name), info.Metadata); err != nil {
+	if err := s.seekAndPutObject(s.layout.getBackupMetadataKey(info.Name), info.Metadata); err != nil {
 		// failure to upload metadata file is a hard-stop
 		return err
 	}
 
-	if err := seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupContentsKey(info.Name), info.Contents); err != nil {
+	if err := s.putBackupFile(manifest, info.Name, velerov1api.DownloadTargetKindBackupContents, info.Contents); err != nil {
 		deleteErr := s.objectStore.DeleteObject(s.bucket, s.layout.getBackupMetadataKey(info.Name))
 		return kerrors.NewAggregate([]error{err, deleteErr})
 	}
 
 	// Since the logic for all of these files is the exact same except for the name and the contents,
 	// use a map literal to iterate through them and write them to the bucket.
-	var backupObjs = map[string]io.Reader{
-		s.layout.getPodVolumeBackupsKey(info.Name):          info.PodVolumeBackups,
-		s.layout.getBackupVolumeSnapshotsKey(info.Name):     info.VolumeSnapshots,
-		s.layout.getBackupItemOperationsKey(info.Name):      info.BackupItemOperations,
-		s.layout.getBackupResourceListKey(info.Name):        info.BackupResourceList,
-		s.layout.getCSIVolumeSnapshotKey(info.Name):         info.CSIVolumeSnapshots,
-		s.layout.getCSIVolumeSnapshotContentsKey(info.Name): info.CSIVolumeSnapshotContents,
-		s.layout.getCSIVolumeSnapshotClassesKey(info.Name):  info.CSIVolumeSnapshotClasses,
-		s.layout.getBackupResultsKey(info.Name):             info.BackupResults,
-		s.layout.getBackupVolumeInfoKey(info.Name):          info.BackupVolumeInfo,
-	}
-
-	for key, reader := range backupObjs {
-		if err := seekAndPutObject(s.objectStore, s.bucket, key, reader); err != nil {
-			errs := []error{err}
-
-			// attempt to clean up the backup contents and metadata if we fail to upload and of the extra files.
-			deleteErr := s.objectStore.DeleteObject(s.bucket, s.layout.getBackupContentsKey(info.Name))
-			errs = append(errs, deleteErr)
-
-			deleteErr = s.objectStore.DeleteObject(s.bucket, s.layout.getBackupMetadataKey(info.Name))
-			errs = append(errs, deleteErr)
-			return kerrors.NewAggregate(errs)
+	var backupObjs = map[velerov1api.DownloadTargetKind]io.Reader{
+		velerov1api.DownloadTargetKindBackupPodVolumeBackups:          info.PodVolumeBackups,
+		velerov1api.DownloadTargetKindBackupVolumeSnapshots:           info.VolumeSnapshots,
+		velerov1api.DownloadTargetKindBackupItemOperations:            info.BackupItemOperations,
+		velerov1api.DownloadTargetKindBackupResourceList:              info.BackupResourceList,
+		velerov1api.DownloadTargetKindCSIBackupVolumeSnapshots:        info.CSIVolumeSnapshots,
+		velerov1api.DownloadTargetKindCSIBackupVolumeSnapshotContents: info.CSIVolumeSnapshotContents,
+		velerov1api.DownloadTargetKindCSIBackupVolumeSnapshotClasses:  info.CSIVolumeSnapshotClasses,
+		velerov1api.DownloadTargetKindBackupResults:                   info.BackupResults,
+		velerov1api.DownloadTargetKindBackupVolumeInfos:               info.BackupVolumeInfo,
+		velerov1api.DownloadTargetKindBackupHookResults:               info.HookResults,
+	}
+
+	for kind, reader := range backupObjs {
+		if err := s.putBackupFile(manifest, info.Name, kind, reader); err != nil {
+			return s.cleanUpFailedBackup(info.Name, err)
 		}
 	}
 
+	// the manifest is written last, once the digests of all the other files are known
+	if err := s.putBackupManifest(info.Name, manifest); err != nil {
+		return s.cleanUpFailedBackup(info.Name, err)
+	}
+
 	return nil
 }
 
+// cleanUpFailedBackup attempts to delete the backup contents and metadata after
+// failing to upload one of the backup's other files.
+func (s *objectBackupStore) cleanUpFailedBackup(name string, err error) error {
+	errs := []error{err}
+
+	deleteErr := s.objectStore.DeleteObject(s.bucket, s.layout.getBackupContentsKey(name))
+	errs = append(errs, deleteErr)
+
+	deleteErr = s.objectStore.DeleteObject(s.bucket, s.layout.getBackupMetadataKey(name))
+	errs = append(errs, deleteErr)
+	return kerrors.NewAggregate(errs)
+}
+
+// putBackupFile uploads the backup file of the given kind and records its
+// digest in the manifest. The digests of the resources in the backup tarball
+// are recorded as well.
+func (s *objectBackupStore) putBackupFile(manifest *integrity.Manifest, backup string, kind velerov1api.DownloadTargetKind, file io.Reader) error {
+	if file == nil {
+		return nil
+	}
+
+	if err := seekToBeginning(file); err != nil {
+		return errors.WithStack(err)
+	}
+
+	key := s.layout.getBackupFileKey(backup, kind)
+
+	if kind != velerov1api.DownloadTargetKindBackupContents {
+		h := sha256.New()
+		if err := s.putObject(key, io.TeeReader(file, h)); err != nil {
+			return err
+		}
+		manifest.Files[kind] = hex.EncodeToString(h.Sum(nil))
+		return nil
+	}
+
+	digester := integrity.NewTarballDigester()
+	if err := s.putObject(key, io.TeeReader(file, digester)); err != nil {
+		digester.Finish()
+		return err
+	}
+
+	digest, resources, err := digester.Finish()
+	if err != nil {
+		// the tarball's digest is still recorded, so verification reports the unreadable tarball
+		s.logger.WithError(err).WithField("backup", backup).Warn("Error computing digests of the resources in the backup tarball")
+	}
+	manifest.Files[kind] = digest
+	manifest.Resources = resources
+
+	return nil
+}
+
+func (s *objectBackupStore) putBackupManifest(backup string, manifest *integrity.Manifest) error {
+	if len(s.signingKey) > 0 {
+		if err := manifest.Sign(s.signingKey); err != nil {
+			return err
+		}
+	}
+
+	buf, errs := encode.ToJSONGzip(manifest, "backup manifest")
+	if len(errs) > 0 {
+		return kerrors.NewAggregate(errs)
+	}
+
+	return s.putObject(s.layout.getBackupManifestKey(backup), buf)
+}
+
+// getBackupManifest returns the integrity manifest of the backup, or nil if
+// the backup doesn't have one.
+func (s *objectBackupStore) getBackupManifest(backup string) (*integrity.Manifest, error) {
+	res, err := s.tryGet(s.layout.getBackupManifestKey(backup))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	defer res.Close()
+
+	manifest := integrity.NewManifest()
+	if err := decode(res, manifest); err != nil {
+		return nil, err
+	}
+
+	return manifest, nil
+}
+
+// updateBackupFile replaces the backup file of the given kind and updates
+// its digest in the manifest, if the backup has one.
+func (s *objectBackupStore) updateBackupFile(backup string, kind velerov1api.DownloadTargetKind, file io.Reader) error {
+	manifest, err := s.getBackupManifest(backup)
+	if err != nil {
+		return err
+	}
+	if manifest == nil {
+		return s.seekAndPutObject(s.layout.getBackupFileKey(backup, kind), file)
+	}
+
+	if err := s.putBackupFile(manifest, backup, kind, file); err != nil {
+		return err
+	}
+
+	return s.putBackupManifest(backup, manifest)
+}
+
+func (s *objectBackupStore) VerifyBackup(name string, contents io.Reader) (*integrity.Result, error) {
+	manifest, err := s.getBackupManifest(name)
+	if err != nil {
+		return nil, err
+	}
+	if manifest == nil {
+		// deleting the manifest mustn't be a way around the verification
+		if len(s.signingKey) > 0 {
+			return integrity.MissingManifest(), nil
+		}
+		return nil, nil
+	}
+
+	return integrity.Verify(manifest, s.signingKey, func(kind velerov1api.DownloadTargetKind) (io.ReadCloser, error) {
+		if kind == velerov1api.DownloadTargetKindBackupContents && contents != nil {
+			return io.NopCloser(contents), nil
+		}
+		return s.tryGet(s.layout.getBackupFileKey(name, kind))
+	})
+}
+
 func (s *objectBackupStore) GetBackupMetadata(name string) (*velerov1api.Backup, error) {
 	metadataKey := s.layout.getBackupMetadataKey(name)
 
-	res, err := s.objectStore.GetObject(s.bucket, metadataKey)
+	res, err := s.getObject(metadataKey)
 	if err != nil {
 		return nil, err
 	}
@@ -324,14 +529,14 @@ func (s *objectBackupStore) GetBackupMetadata(name string) (*velerov1api.Backup,
 }
 
 func (s *objectBackupStore) PutBackupMetadata(backup string, backupMetadata io.Reader) error {
-	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupMetadataKey(backup), backupMetadata)
+	return s.seekAndPutObject(s.layout.getBackupMetadataKey(backup), backupMetadata)
 }
 
 func (s *objectBackupStore) GetBackupVolumeSnapshots(name string) ([]*volume.Snapshot, error) {
 	// if the volumesnapshots file doesn't exist, we don't want to return an error, since
 	// a legacy backup or a backup with no snapshots would not have this file, so check for
 	// its existence before attempting to get its contents.
-	res, err := tryGet(s.objectStore, s.bucket, s.layout.getBackupVolumeSnapshotsKey(name))
+	res, err := s.tryGet(s.layout.getBackupVolumeSnapshotsKey(name))
 	if err != nil {
 		return nil, err
 	}
@@ -352,7 +557,7 @@ func (s *objectBackupStore) GetBackupItemOperations(name string) ([]*itemoperati
 	// if the itemoperations file doesn't exist, we don't want to return an error, since
 	// a legacy backup or a backup with no async operations would not have this file, so check for
 	// its existence before attempting to get its contents.
-	res, err := tryGet(s.objectStore, s.bucket, s.layout.getBackupItemOperationsKey(name))
+	res, err := s.tryGet(s.layout.getBackupItemOperationsKey(name))
 	if err != nil {
 		return nil, err
 	}
@@ -373,7 +578,7 @@ func (s *objectBackupStore) GetRestoreItemOperations(name string) ([]*itemoperat
 	// if the itemoperations file doesn't exist, we don't want to return an error, since
 	// a legacy restore or a restore with no async operations would not have this file, so check for
 	// its existence before attempting to get its contents.
-	res, err := tryGet(s.objectStore, s.bucket, s.layout.getRestoreItemOperationsKey(name))
+	res, err := s.tryGet(s.layout.getRestoreItemOperationsKey(name))
 	if err != nil {
 		return nil, err
 	}
@@ -390,10 +595,35 @@ func (s *objectBackupStore) GetRestoreItemOperations(name string) ([]*itemoperat
 	return restoreItemOperations, nil
 }
 
+func (s *objectBackupStore) GetRestoreRollbackItems(name string) ([]restorerollback.Item, error) {
+	// restores which failed before restoring anything, or which were created
+	// by older versions, don't have this file
+	res, err := s.tryGet(s.layout.getRestoreRollbackItemsKey(name))
+	if err != nil {
+		return nil, err
+	}
+	if res == nil {
+		return nil, nil
+	}
+	defer res.Close()
+
+	var items []restorerollback.Item
+	if err := decode(res, &items); err != nil {
+		return nil, err
+	}
+	if items == nil {
+		// a restore which didn't record any item is told apart from one whose
+		// items weren't uploaded
+		items = []restorerollback.Item{}
+	}
+
+	return items, nil
+}
+
 // tryGet returns the object with the given key if it exists, nil if it does not exist,
 // or an error if it was unable to check existence or get the object.
-func tryGet(objectStore velero.ObjectStore, bucket, key string) (io.ReadCloser, error) {
-	exists, err := objectStore.ObjectExists(bucket, key)
+func (s *objectBackupStore) tryGet(key string) (io.ReadCloser, error) {
+	exists, err := s.objectStore.ObjectExists(s.bucket, key)
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
@@ -401,7 +631,30 @@ func tryGet(objectStore velero.ObjectStore, bucket, key string) (io.ReadCloser,
 		return nil, nil
 	}
 
-	return objectStore.GetObject(bucket, key)
+	return s.getObject(key)
+}
+
+// getObject returns the object with the given key, decrypting it if it was
+// encrypted when uploaded.
+func (s *objectBackupStore) getObject(key string) (io.ReadCloser, error) {
+	res, err := s.objectStore.GetObject(s.bucket, key)
+	if err != nil {
+		return nil, err
+	}
+
+	reader, err := encryption.NewDecryptingReader(s.encryptionKey, res)
+	if err != nil {
+		res.Close()
+		return nil, errors.Wrapf(err, "error reading object %s", key)
+	}
+
+	return &readCloser{Reader: reader, Closer: res}, nil
+}
+
+// readCloser closes the underlying object of a wrapping reader.
+type readCloser struct {
+	io.Reader
+	io.Closer
 }
 
 // decode extracts a .json.gz file reader into the object pointed to
@@ -421,7 +674,7 @@ func decode(jsongzReader io.Reader, into interface{}) error {
 }
 
 func (s *objectBackupStore) GetCSIVolumeSnapshotClasses(name string) ([]*snapshotv1api.VolumeSnapshotClass, error) {
-	res, err := tryGet(s.objectStore, s.bucket, s.layout.getCSIVolumeSnapshotClassesKey(name))
+	res, err := s.tryGet(s.layout.getCSIVolumeSnapshotClassesKey(name))
 	if err != nil {
 		return nil, err
 	}
@@ -439,7 +692,7 @@ func (s *objectBackupStore) GetCSIVolumeSnapshotClasses(name string) ([]*snapsho
 }
 
 func (s *objectBackupStore) GetCSIVolumeSnapshots(name string) ([]*snapshotv1api.VolumeSnapshot, error) {
-	res, err := tryGet(s.objectStore, s.bucket, s.layout.getCSIVolumeSnapshotKey(name))
+	res, err := s.tryGet(s.layout.getCSIVolumeSnapshotKey(name))
 	if err != nil {
 		return nil, err
 	}
@@ -457,7 +710,7 @@ func (s *objectBackupStore) GetCSIVolumeSnapshots(name string) ([]*snapshotv1api
 }
 
 func (s *objectBackupStore) GetCSIVolumeSnapshotContents(name string) ([]*snapshotv1api.VolumeSnapshotContent, error) {
-	res, err := tryGet(s.objectStore, s.bucket, s.layout.getCSIVolumeSnapshotContentsKey(name))
+	res, err := s.tryGet(s.layout.getCSIVolumeSnapshotContentsKey(name))
 	if err != nil {
 		return nil, err
 	}
@@ -478,7 +731,7 @@ func (s *objectBackupStore) GetPodVolumeBackups(name string) ([]*velerov1api.Pod
 	// if the podvolumebackups file doesn't exist, we don't want to return an error, since
 	// a legacy backup or a backup with no pod volume backups would not have this file, so
 	// check for its existence before attempting to get its contents.
-	res, err := tryGet(s.objectStore, s.bucket, s.layout.getPodVolumeBackupsKey(name))
+	res, err := s.tryGet(s.layout.getPodVolumeBackupsKey(name))
 	if err != nil {
 		return nil, err
 	}
@@ -498,7 +751,7 @@ func (s *objectBackupStore) GetPodVolumeBackups(name string) ([]*velerov1api.Pod
 func (s *objectBackupStore) GetBackupVolumeInfos(name string) ([]*internalVolume.VolumeInfo, error) {
 	volumeInfos := make([]*internalVolume.VolumeInfo, 0)
 
-	res, err := tryGet(s.objectStore, s.bucket, s.layout.getBackupVolumeInfoKey(name))
+	res, err := s.tryGet(s.layout.getBackupVolumeInfoKey(name))
 	if err != nil {
 		return volumeInfos, err
 	}
@@ -515,13 +768,50 @@ func (s *objectBackupStore) GetBackupVolumeInfos(name string) ([]*internalVolume
 }
 
 func (s *objectBackupStore) GetBackupContents(name string) (io.ReadCloser, error) {
-	return s.objectStore.GetObject(s.bucket, s.layout.getBackupContentsKey(name))
+	return s.getObject(s.layout.getBackupContentsKey(name))
 }
 
 func (s *objectBackupStore) BackupExists(bucket, backupName string) (bool, error) {
 	return s.objectStore.ObjectExists(bucket, s.layout.getBackupMetadataKey(backupName))
 }
 
+func (s *objectBackupStore) PutBackupCheckpoint(backup string, checkpoint io.Reader) error {
+	return s.putObject(s.layout.getBackupCheckpointKey(backup), checkpoint)
+}
+
+func (s *objectBackupStore) GetBackupCheckpoint(name string) (io.ReadCloser, error) {
+	return s.tryGet(s.layout.getBackupCheckpointKey(name))
+}
+
+func (s *objectBackupStore) DeleteBackupCheckpoint(name string) error {
+	return s.deleteIfExists(s.layout.getBackupCheckpointKey(name))
+}
+
+func (s *objectBackupStore) PutRestoreCheckpoint(restore string, checkpoint io.Reader) error {
+	return s.putObject(s.layout.getRestoreCheckpointKey(restore), checkpoint)
+}
+
+func (s *objectBackupStore) GetRestoreCheckpoint(name string) (io.ReadCloser, error) {
+	return s.tryGet(s.layout.getRestoreCheckpointKey(name))
+}
+
+func (s *objectBackupStore) DeleteRestoreCheckpoint(name string) error {
+	return s.deleteIfExists(s.layout.getRestoreCheckpointKey(name))
+}
+
+// deleteIfExists deletes the object with the given key, if it exists.
+func (s *objectBackupStore) deleteIfExists(key string) error {
+	exists, err := s.objectStore.ObjectExists(s.bucket, key)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	if !exists {
+		return nil
+	}
+
+	return errors.WithStack(s.objectStore.DeleteObject(s.bucket, key))
+}
+
 func (s *objectBackupStore) DeleteBackup(name string) error {
 	objects, err := s.objectStore.ListObjects(s.bucket, s.layout.getBackupDir(name))
 	if err != nil {
@@ -561,27 +851,39 @@ func (s *objectBackupStore) DeleteRestore(name string) error {
 }
 
 func (s *objectBackupStore) PutRestoreLog(backup string, restore string, log io.Reader) error {
-	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreLogKey(restore), log)
+	return s.putObject(s.layout.getRestoreLogKey(restore), log)
 }
 
 func (s *objectBackupStore) PutRestoreResults(backup string, restore string, results io.Reader) error {
-	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreResultsKey(restore), results)
+	return s.putObject(s.layout.getRestoreResultsKey(restore), results)
 }
 
 func (s *objectBackupStore) PutRestoredResourceList(restore string, list io.Reader) error {
-	return s.objectStore.PutObject(s.bucket, s.layout.getRestoreResourceListKey(restore), list)
+	return s.putObject(s.layout.getRestoreResourceListKey(restore), list)
 }
 
 func (s *objectBackupStore) PutRestoreItemOperations(restore string, restoreItemOperations io.Reader) error {
-	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getRestoreItemOperationsKey(restore), restoreItemOperations)
+	return s.seekAndPutObject(s.layout.getRestoreItemOperationsKey(restore), restoreItemOperations)
+}
+
+func (s *objectBackupStore) PutRestoreHookResults(restore string, results io.Reader) error {
+	return s.putObject(s.layout.getRestoreHookResultsKey(restore), results)
+}
+
+func (s *objectBackupStore) PutRestorePreview(restore string, preview io.Reader) error {
+	return s.putObject(s.layout.getRestorePreviewKey(restore), preview)
+}
+
+func (s *objectBackupStore) PutRestoreRollbackItems(restore string, items io.Reader) error {
+	return s.putObject(s.layout.getRestoreRollbackItemsKey(restore), items)
 }
 
 func (s *objectBackupStore) PutBackupItemOperations(backup string, backupItemOperations io.Reader) error {
-	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupItemOperationsKey(backup), backupItemOperations)
+	return s.updateBackupFile(backup, velerov1api.DownloadTargetKindBackupItemOperations, backupItemOperations)
 }
 
 func (s *objectBackupStore) PutBackupContents(backup string, backupContents io.Reader) error {
-	return seekAndPutObject(s.objectStore, s.bucket, s.layout.getBackupContentsKey(backup), backupContents)
+	return s.updateBackupFile(backup, velerov1api.DownloadTargetKindBackupContents, backupContents)
 }
 
 func (s *objectBackupStore) GetDownloadURL(target velerov1api.DownloadTarget) (string, error) {
@@ -604,6 +906,10 @@ func (s *objectBackupStore) GetDownloadURL(target velerov1api.DownloadTarget) (s
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreResultsKey(target.Name), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindRestoreResourceList:
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreResourceListKey(target.Name), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindRestoreHookResults:
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestoreHookResultsKey(target.Name), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindRestorePreview:
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getRestorePreviewKey(target.Name), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindCSIBackupVolumeSnapshots:
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getCSIVolumeSnapshotKey(target.Name), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindCSIBackupVolumeSnapshotContents:
@@ -612,6 +918,11 @@ func (s *objectBackupStore) GetDownloadURL(target velerov1api.DownloadTarget) (s
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupResultsKey(target.Name), DownloadURLTTL)
 	case velerov1api.DownloadTargetKindBackupVolumeInfos:
 		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupVolumeInfoKey(target.Name), DownloadURLTTL)
+	case velerov1api.DownloadTargetKindBackupPodVolumeBackups,
+		velerov1api.DownloadTargetKindCSIBackupVolumeSnapshotClasses,
+		velerov1api.DownloadTargetKindBackupManifest,
+		velerov1api.DownloadTargetKindBackupHookResults:
+		return s.objectStore.CreateSignedURL(s.bucket, s.layout.getBackupFileKey(target.Name, target.Kind), DownloadURLTTL)
 	default:
 		return "", errors.Errorf("unsupported download target kind %q", target.Kind)
 	}
@@ -627,7 +938,7 @@ func seekToBeginning(r io.Reader) error {
 	return err
 }
 
-func seekAndPutObject(objectStore velero.ObjectStore, bucket, key string, file io.Reader) error {
+func (s *objectBackupStore) seekAndPutObject(key string, file io.Reader) error {
 	if file == nil {
 		return nil
 	}
@@ -636,5 +947,19 @@ func seekAndPutObject(objectStore velero.ObjectStore, bucket, key string, file i
 		return errors.WithStack(err)
 	}
 
-	return objectStore.PutObject(bucket, key, file)
+	return s.putObject(key, file)
+}
+
+// putObject uploads the object with the given key, encrypting it first if the
+// location is configured with an encryption key.
+func (s *objectBackupStore) putObject(key string, file io.Reader) error {
+	if len(s.encryptionKey) > 0 {
+		encrypted, err := encryption.NewEncryptingReader(s.encryptionKey, file)
+		if err != nil {
+			return errors.Wrapf(err, "error encrypting object %s", key)
+		}
+		file = encrypted
+	}
+
+	return s.objectStore.PutObject(s.bucket, key, file)
 }
@@ -20,6 +20,8 @@ import (
 	"fmt"
 	"path"
 	"strings"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
 
 // ObjectStoreLayout defines how Velero's persisted files map to
@@ -93,6 +95,10 @@ func (l *ObjectStoreLayout) getBackupItemOperationsKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-itemoperations.json.gz", backup))
 }
 
+func (l *ObjectStoreLayout) getBackupCheckpointKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-checkpoint.json.gz", backup))
+}
+
 func (l *ObjectStoreLayout) getBackupResourceListKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-resource-list.json.gz", backup))
 }
@@ -113,6 +119,22 @@ func (l *ObjectStoreLayout) getRestoreItemOperationsKey(restore string) string {
 	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-itemoperations.json.gz", restore))
 }
 
+func (l *ObjectStoreLayout) getRestoreHookResultsKey(restore string) string {
+	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-hookresults.json.gz", restore))
+}
+
+func (l *ObjectStoreLayout) getRestorePreviewKey(restore string) string {
+	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-preview.json.gz", restore))
+}
+
+func (l *ObjectStoreLayout) getRestoreRollbackItemsKey(restore string) string {
+	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-rollback-items.json.gz", restore))
+}
+
+func (l *ObjectStoreLayout) getRestoreCheckpointKey(restore string) string {
+	return path.Join(l.subdirs["restores"], restore, fmt.Sprintf("restore-%s-checkpoint.json.gz", restore))
+}
+
 func (l *ObjectStoreLayout) getCSIVolumeSnapshotKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-csi-volumesnapshots.json.gz", backup))
 }
@@ -132,3 +154,46 @@ func (l *ObjectStoreLayout) getBackupResultsKey(backup string) string {
 func (l *ObjectStoreLayout) getBackupVolumeInfoKey(backup string) string {
 	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-volumeinfo.json.gz", backup))
 }
+
+func (l *ObjectStoreLayout) getBackupHookResultsKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-hookresults.json.gz", backup))
+}
+
+func (l *ObjectStoreLayout) getBackupManifestKey(backup string) string {
+	return path.Join(l.subdirs["backups"], backup, fmt.Sprintf("%s-manifest.json.gz", backup))
+}
+
+// getBackupFileKey returns the key of the backup file of the given download
+// target kind, or an empty string if the kind isn't a backup file.
+func (l *ObjectStoreLayout) getBackupFileKey(backup string, kind velerov1api.DownloadTargetKind) string {
+	switch kind {
+	case velerov1api.DownloadTargetKindBackupContents:
+		return l.getBackupContentsKey(backup)
+	case velerov1api.DownloadTargetKindBackupLog:
+		return l.getBackupLogKey(backup)
+	case velerov1api.DownloadTargetKindBackupVolumeSnapshots:
+		return l.getBackupVolumeSnapshotsKey(backup)
+	case velerov1api.DownloadTargetKindBackupItemOperations:
+		return l.getBackupItemOperationsKey(backup)
+	case velerov1api.DownloadTargetKindBackupResourceList:
+		return l.getBackupResourceListKey(backup)
+	case velerov1api.DownloadTargetKindBackupResults:
+		return l.getBackupResultsKey(backup)
+	case velerov1api.DownloadTargetKindCSIBackupVolumeSnapshots:
+		return l.getCSIVolumeSnapshotKey(backup)
+	case velerov1api.DownloadTargetKindCSIBackupVolumeSnapshotContents:
+		return l.getCSIVolumeSnapshotContentsKey(backup)
+	case velerov1api.DownloadTargetKindCSIBackupVolumeSnapshotClasses:
+		return l.getCSIVolumeSnapshotClassesKey(backup)
+	case velerov1api.DownloadTargetKindBackupVolumeInfos:
+		return l.getBackupVolumeInfoKey(backup)
+	case velerov1api.DownloadTargetKindBackupPodVolumeBackups:
+		return l.getPodVolumeBackupsKey(backup)
+	case velerov1api.DownloadTargetKindBackupManifest:
+		return l.getBackupManifestKey(backup)
+	case velerov1api.DownloadTargetKindBackupHookResults:
+		return l.getBackupHookResultsKey(backup)
+	default:
+		return ""
+	}
+}
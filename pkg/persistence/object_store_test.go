@@ -17,6 +17,8 @@ limitations under the License.
 package persistence
 
 import (
+	"archive/tar"
+	"bufio"
 	"bytes"
 	"compress/gzip"
 	"encoding/json"
@@ -34,6 +36,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 
 	"github.com/vmware-tanzu/velero/internal/credentials"
+	"github.com/vmware-tanzu/velero/internal/restorerollback"
 	internalVolume "github.com/vmware-tanzu/velero/internal/volume"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/builder"
@@ -43,6 +46,7 @@ import (
 	providermocks "github.com/vmware-tanzu/velero/pkg/plugin/velero/mocks"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
 	"github.com/vmware-tanzu/velero/pkg/util/encode"
+	"github.com/vmware-tanzu/velero/pkg/util/encryption"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
@@ -229,6 +233,7 @@ func TestPutBackup(t *testing.T) {
 		backupItemOperations io.Reader
 		resourceList         io.Reader
 		backupVolumeInfo     io.Reader
+		hookResults          io.Reader
 		expectedErr          string
 		expectedKeys         []string
 	}{
@@ -242,6 +247,7 @@ func TestPutBackup(t *testing.T) {
 			backupItemOperations: newStringReadSeeker("backupItemOperations"),
 			resourceList:         newStringReadSeeker("resourceList"),
 			backupVolumeInfo:     newStringReadSeeker("backupVolumeInfo"),
+			hookResults:          newStringReadSeeker("hookResults"),
 			expectedErr:          "",
 			expectedKeys: []string{
 				"backups/backup-1/velero-backup.json",
@@ -252,6 +258,8 @@ func TestPutBackup(t *testing.T) {
 				"backups/backup-1/backup-1-itemoperations.json.gz",
 				"backups/backup-1/backup-1-resource-list.json.gz",
 				"backups/backup-1/backup-1-volumeinfo.json.gz",
+				"backups/backup-1/backup-1-hookresults.json.gz",
+				"backups/backup-1/backup-1-manifest.json.gz",
 			},
 		},
 		{
@@ -275,6 +283,7 @@ func TestPutBackup(t *testing.T) {
 				"prefix-1/backups/backup-1/backup-1-itemoperations.json.gz",
 				"prefix-1/backups/backup-1/backup-1-resource-list.json.gz",
 				"prefix-1/backups/backup-1/backup-1-volumeinfo.json.gz",
+				"prefix-1/backups/backup-1/backup-1-manifest.json.gz",
 			},
 		},
 		{
@@ -321,6 +330,7 @@ func TestPutBackup(t *testing.T) {
 				"backups/backup-1/backup-1-itemoperations.json.gz",
 				"backups/backup-1/backup-1-resource-list.json.gz",
 				"backups/backup-1/backup-1-volumeinfo.json.gz",
+				"backups/backup-1/backup-1-manifest.json.gz",
 			},
 		},
 		{
@@ -340,6 +350,7 @@ func TestPutBackup(t *testing.T) {
 				"backups/backup-1/backup-1-volumesnapshots.json.gz",
 				"backups/backup-1/backup-1-resource-list.json.gz",
 				"backups/backup-1/backup-1-volumeinfo.json.gz",
+				"backups/backup-1/backup-1-manifest.json.gz",
 			},
 		},
 	}
@@ -358,6 +369,7 @@ func TestPutBackup(t *testing.T) {
 				BackupItemOperations: tc.backupItemOperations,
 				BackupResourceList:   tc.resourceList,
 				BackupVolumeInfo:     tc.backupVolumeInfo,
+				HookResults:          tc.hookResults,
 			}
 			err := harness.PutBackup(backupInfo)
 
@@ -507,6 +519,38 @@ func TestGetBackupItemOperations(t *testing.T) {
 	assert.EqualValues(t, operations, res)
 }
 
+func TestBackupAndRestoreCheckpoints(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	// a missing checkpoint should not error
+	res, err := harness.GetBackupCheckpoint("test-backup")
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+	assert.NoError(t, harness.DeleteBackupCheckpoint("test-backup"))
+
+	require.NoError(t, harness.PutBackupCheckpoint("test-backup", newStringReadSeeker("backup checkpoint")))
+	require.NoError(t, harness.PutRestoreCheckpoint("test-restore", newStringReadSeeker("restore checkpoint")))
+	assert.Contains(t, harness.objectStore.Data[harness.bucket], "backups/test-backup/test-backup-checkpoint.json.gz")
+	assert.Contains(t, harness.objectStore.Data[harness.bucket], "restores/test-restore/restore-test-restore-checkpoint.json.gz")
+
+	res, err = harness.GetBackupCheckpoint("test-backup")
+	require.NoError(t, err)
+	data, err := io.ReadAll(res)
+	require.NoError(t, err)
+	assert.Equal(t, "backup checkpoint", string(data))
+
+	res, err = harness.GetRestoreCheckpoint("test-restore")
+	require.NoError(t, err)
+	data, err = io.ReadAll(res)
+	require.NoError(t, err)
+	assert.Equal(t, "restore checkpoint", string(data))
+
+	require.NoError(t, harness.DeleteBackupCheckpoint("test-backup"))
+	require.NoError(t, harness.DeleteRestoreCheckpoint("test-restore"))
+	assert.NotContains(t, harness.objectStore.Data[harness.bucket], "backups/test-backup/test-backup-checkpoint.json.gz")
+	assert.NotContains(t, harness.objectStore.Data[harness.bucket], "restores/test-restore/restore-test-restore-checkpoint.json.gz")
+}
+
 func TestGetRestoreItemOperations(t *testing.T) {
 	harness := newObjectBackupStoreTestHarness("test-bucket", "")
 
@@ -556,6 +600,27 @@ func TestGetRestoreItemOperations(t *testing.T) {
 	assert.EqualValues(t, operations, res)
 }
 
+func TestGetRestoreRollbackItems(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+
+	// rollback items file not found returns nil items
+	res, err := harness.GetRestoreRollbackItems("test-restore")
+	assert.NoError(t, err)
+	assert.Nil(t, res)
+
+	// rollback items file without items returns empty items
+	obj := new(bytes.Buffer)
+	gzw := gzip.NewWriter(obj)
+	require.NoError(t, json.NewEncoder(gzw).Encode([]restorerollback.Item(nil)))
+	require.NoError(t, gzw.Close())
+	require.NoError(t, harness.objectStore.PutObject(harness.bucket, "restores/test-restore/restore-test-restore-rollback-items.json.gz", obj))
+
+	res, err = harness.GetRestoreRollbackItems("test-restore")
+	assert.NoError(t, err)
+	assert.NotNil(t, res)
+	assert.Empty(t, res)
+}
+
 func TestGetBackupContents(t *testing.T) {
 	harness := newObjectBackupStoreTestHarness("test-bucket", "")
 
@@ -570,6 +635,138 @@ func TestGetBackupContents(t *testing.T) {
 	assert.Equal(t, "foo", string(data))
 }
 
+func TestEncryptedObjects(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+	harness.encryptionKey = bytes.Repeat([]byte("k"), encryption.KeySize)
+
+	backup := builder.ForBackup(velerov1api.DefaultNamespace, "test-backup").Result()
+	metadata := new(bytes.Buffer)
+	require.NoError(t, encode.To(backup, "json", metadata))
+
+	require.NoError(t, harness.PutBackup(BackupInfo{
+		Name:     "test-backup",
+		Metadata: metadata,
+		Contents: newStringReadSeeker("contents"),
+		Log:      newStringReadSeeker("log"),
+	}))
+
+	// nothing is stored in plaintext
+	for key, data := range harness.objectStore.Data[harness.bucket] {
+		assert.True(t, encryption.IsEncrypted(bufio.NewReader(bytes.NewReader(data))), "object %s is not encrypted", key)
+	}
+
+	res, err := harness.GetBackupMetadata("test-backup")
+	require.NoError(t, err)
+	assert.Equal(t, backup.Name, res.Name)
+
+	rc, err := harness.GetBackupContents("test-backup")
+	require.NoError(t, err)
+	data, err := io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "contents", string(data))
+
+	// objects written before encryption was enabled are still readable
+	harness.objectStore.PutObject(harness.bucket, "backups/plain-backup/plain-backup.tar.gz", newStringReadSeeker("plain"))
+	rc, err = harness.GetBackupContents("plain-backup")
+	require.NoError(t, err)
+	data, err = io.ReadAll(rc)
+	require.NoError(t, err)
+	assert.Equal(t, "plain", string(data))
+
+	// encrypted objects can't be read without the key
+	harness.encryptionKey = nil
+	_, err = harness.GetBackupMetadata("test-backup")
+	assert.ErrorIs(t, err, encryption.ErrMissingKey)
+}
+
+func newTestTarball(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+
+	buf := new(bytes.Buffer)
+	gzw := gzip.NewWriter(buf)
+	tw := tar.NewWriter(gzw)
+	for name, data := range files {
+		require.NoError(t, tw.WriteHeader(&tar.Header{Name: name, Size: int64(len(data)), Typeflag: tar.TypeReg, Mode: 0755}))
+		_, err := tw.Write([]byte(data))
+		require.NoError(t, err)
+	}
+	require.NoError(t, tw.Close())
+	require.NoError(t, gzw.Close())
+
+	return buf.Bytes()
+}
+
+func TestVerifyBackup(t *testing.T) {
+	harness := newObjectBackupStoreTestHarness("test-bucket", "")
+	harness.signingKey = []byte("signing-key")
+
+	contents := newTestTarball(t, map[string]string{
+		"resources/pods/namespaces/ns-1/pod-1.json": `{"kind":"Pod"}`,
+		"resources/pods/namespaces/ns-1/pod-2.json": `{"kind":"Pod"}`,
+	})
+
+	require.NoError(t, harness.PutBackup(BackupInfo{
+		Name:                 "test-backup",
+		Metadata:             newStringReadSeeker("metadata"),
+		Contents:             bytes.NewReader(contents),
+		Log:                  newStringReadSeeker("log"),
+		BackupItemOperations: newStringReadSeeker("itemOperations"),
+	}))
+
+	manifest, err := harness.getBackupManifest("test-backup")
+	require.NoError(t, err)
+	require.NotNil(t, manifest)
+	assert.Len(t, manifest.Files, 3)
+	assert.Len(t, manifest.Resources, 2)
+	assert.NotEmpty(t, manifest.Signature)
+
+	res, err := harness.VerifyBackup("test-backup", nil)
+	require.NoError(t, err)
+	assert.True(t, res.Verified(), "%v", res.Mismatches)
+	assert.Equal(t, 3, res.FilesVerified)
+	assert.Equal(t, 2, res.ResourcesVerified)
+
+	// files replaced after the backup update the manifest
+	require.NoError(t, harness.PutBackupItemOperations("test-backup", newStringReadSeeker("updatedItemOperations")))
+	res, err = harness.VerifyBackup("test-backup", nil)
+	require.NoError(t, err)
+	assert.True(t, res.Verified(), "%v", res.Mismatches)
+
+	// an already downloaded tarball can be verified in place of the stored one
+	tampered := newTestTarball(t, map[string]string{
+		"resources/pods/namespaces/ns-1/pod-1.json": `{"kind":"Pod","tampered":true}`,
+	})
+	res, err = harness.VerifyBackup("test-backup", bytes.NewReader(tampered))
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"file BackupContents: digest mismatch",
+		"resource resources/pods/namespaces/ns-1/pod-1.json: digest mismatch",
+		"resource resources/pods/namespaces/ns-1/pod-2.json: missing from backup tarball",
+	}, res.Mismatches)
+
+	// a missing file and a manifest signed with another key are detected
+	require.NoError(t, harness.objectStore.DeleteObject(harness.bucket, "backups/test-backup/test-backup-logs.gz"))
+	harness.signingKey = []byte("other-key")
+	res, err = harness.VerifyBackup("test-backup", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"manifest: signature mismatch",
+		"file BackupLog: missing",
+	}, res.Mismatches)
+
+	// a deleted manifest fails verification when manifests are signed
+	require.NoError(t, harness.objectStore.DeleteObject(harness.bucket, "backups/test-backup/test-backup-manifest.json.gz"))
+	res, err = harness.VerifyBackup("test-backup", nil)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"manifest: missing"}, res.Mismatches)
+
+	// otherwise backups without a manifest aren't verified
+	harness.signingKey = nil
+	res, err = harness.VerifyBackup("other-backup", nil)
+	require.NoError(t, err)
+	assert.Nil(t, res)
+}
+
 func TestDeleteBackup(t *testing.T) {
 	tests := []struct {
 		name             string
@@ -690,6 +887,8 @@ func TestGetDownloadURL(t *testing.T) {
 				velerov1api.DownloadTargetKindBackupVolumeSnapshots: "backups/my-backup/my-backup-volumesnapshots.json.gz",
 				velerov1api.DownloadTargetKindBackupItemOperations:  "backups/my-backup/my-backup-itemoperations.json.gz",
 				velerov1api.DownloadTargetKindBackupResourceList:    "backups/my-backup/my-backup-resource-list.json.gz",
+				velerov1api.DownloadTargetKindBackupManifest:        "backups/my-backup/my-backup-manifest.json.gz",
+				velerov1api.DownloadTargetKindBackupHookResults:     "backups/my-backup/my-backup-hookresults.json.gz",
 			},
 		},
 		{
@@ -702,6 +901,7 @@ func TestGetDownloadURL(t *testing.T) {
 				velerov1api.DownloadTargetKindBackupVolumeSnapshots: "velero-backups/backups/my-backup/my-backup-volumesnapshots.json.gz",
 				velerov1api.DownloadTargetKindBackupItemOperations:  "velero-backups/backups/my-backup/my-backup-itemoperations.json.gz",
 				velerov1api.DownloadTargetKindBackupResourceList:    "velero-backups/backups/my-backup/my-backup-resource-list.json.gz",
+				velerov1api.DownloadTargetKindBackupManifest:        "velero-backups/backups/my-backup/my-backup-manifest.json.gz",
 			},
 		},
 		{
@@ -746,6 +946,8 @@ func TestGetDownloadURL(t *testing.T) {
 				velerov1api.DownloadTargetKindRestoreResults:        "restores/my-backup/restore-my-backup-results.gz",
 				velerov1api.DownloadTargetKindRestoreItemOperations: "restores/my-backup/restore-my-backup-itemoperations.json.gz",
 				velerov1api.DownloadTargetKindRestoreResourceList:   "restores/my-backup/restore-my-backup-resource-list.json.gz",
+				velerov1api.DownloadTargetKindRestoreHookResults:    "restores/my-backup/restore-my-backup-hookresults.json.gz",
+				velerov1api.DownloadTargetKindRestorePreview:        "restores/my-backup/restore-my-backup-preview.json.gz",
 			},
 		},
 		{
@@ -948,6 +1150,14 @@ func TestNewObjectBackupStoreGetter(t *testing.T) {
 			credFileStore: velerotest.NewFakeCredentialsFileStore("", fmt.Errorf("secret does not exist")),
 			wantErr:       "unable to get credentials: secret does not exist",
 		},
+		{
+			name: "when the encryption key selector is invalid, a backup store can't be retrieved",
+			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("bucket").EncryptionKey(
+				builder.ForSecretKeySelector("does-not-exist", "does-not-exist").Result(),
+			).Result(),
+			credFileStore: velerotest.NewFakeCredentialsFileStore("", fmt.Errorf("secret does not exist")),
+			wantErr:       "unable to get encryption key: secret does not exist",
+		},
 		{
 			name:     "when Bucket has a leading and trailing slash, they are both stripped",
 			location: builder.ForBackupStorageLocation("", "").Provider("provider-1").Bucket("/bucket/").Result(),
@@ -28,6 +28,7 @@ import (
 	kscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
 
 	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
@@ -41,6 +42,25 @@ type PodCommandExecutor interface {
 	ExecutePodCommand(log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *api.ExecHook) error
 }
 
+// ExecResult is the result of a command executed in a container in a pod.
+type ExecResult struct {
+	// ExitCode is the exit code of the command, or nil if it's unknown, e.g. because the command
+	// timed out.
+	ExitCode *int
+	Stdout   string
+	Stderr   string
+}
+
+// PodCommandResultExecutor is a PodCommandExecutor which can also return the result of the
+// executed command.
+type PodCommandResultExecutor interface {
+	PodCommandExecutor
+
+	// ExecutePodCommandWithResult executes a command in a container in a pod like ExecutePodCommand
+	// and returns the command's result. The result may be set even if an error is returned.
+	ExecutePodCommandWithResult(log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *api.ExecHook) (*ExecResult, error)
+}
+
 type poster interface {
 	Post() *rest.Request
 }
@@ -67,39 +87,46 @@ func NewPodCommandExecutor(restClientConfig *rest.Config, restClient poster) Pod
 // possible to ensure the command is terminated when the timeout occurs, so it may continue to run
 // in the background).
 func (e *defaultPodCommandExecutor) ExecutePodCommand(log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *api.ExecHook) error {
+	_, err := e.ExecutePodCommandWithResult(log, item, namespace, name, hookName, hook)
+	return err
+}
+
+// ExecutePodCommandWithResult executes a command like ExecutePodCommand, and returns the exit code
+// and the output of the command.
+func (e *defaultPodCommandExecutor) ExecutePodCommandWithResult(log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *api.ExecHook) (*ExecResult, error) {
 	if item == nil {
-		return errors.New("item is required")
+		return nil, errors.New("item is required")
 	}
 	if namespace == "" {
-		return errors.New("namespace is required")
+		return nil, errors.New("namespace is required")
 	}
 	if name == "" {
-		return errors.New("name is required")
+		return nil, errors.New("name is required")
 	}
 	if hookName == "" {
-		return errors.New("hookName is required")
+		return nil, errors.New("hookName is required")
 	}
 	if hook == nil {
-		return errors.New("hook is required")
+		return nil, errors.New("hook is required")
 	}
 
 	localHook := *hook
 
 	pod := new(corev1api.Pod)
 	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item, pod); err != nil {
-		return errors.WithStack(err)
+		return nil, errors.WithStack(err)
 	}
 
 	if localHook.Container == "" {
 		if err := setDefaultHookContainer(pod, &localHook); err != nil {
-			return err
+			return nil, err
 		}
 	} else if err := ensureContainerExists(pod, localHook.Container); err != nil {
-		return err
+		return nil, err
 	}
 
 	if len(localHook.Command) == 0 {
-		return errors.New("command is required")
+		return nil, errors.New("command is required")
 	}
 
 	switch localHook.OnError {
@@ -126,7 +153,7 @@ func (e *defaultPodCommandExecutor) ExecutePodCommand(log logrus.FieldLogger, it
 
 	if pod.Status.Phase == corev1api.PodSucceeded || pod.Status.Phase == corev1api.PodFailed {
 		hookLog.Infof("Pod entered phase %s before some post-backup exec hooks ran", pod.Status.Phase)
-		return nil
+		return nil, nil
 	}
 
 	hookLog.Info("running exec hook")
@@ -146,7 +173,7 @@ func (e *defaultPodCommandExecutor) ExecutePodCommand(log logrus.FieldLogger, it
 
 	executor, err := e.streamExecutorFactory.NewSPDYExecutor(e.restClientConfig, "POST", req.URL())
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	var stdout, stderr bytes.Buffer
@@ -173,13 +200,25 @@ func (e *defaultPodCommandExecutor) ExecutePodCommand(log logrus.FieldLogger, it
 	select {
 	case err = <-errCh:
 	case <-timeoutCh:
-		return errors.Errorf("timed out after %v", localHook.Timeout.Duration)
+		return &ExecResult{}, errors.Errorf("timed out after %v", localHook.Timeout.Duration)
 	}
 
 	hookLog.Infof("stdout: %s", stdout.String())
 	hookLog.Infof("stderr: %s", stderr.String())
 
-	return err
+	result := &ExecResult{
+		Stdout: stdout.String(),
+		Stderr: stderr.String(),
+	}
+	if err == nil {
+		exitCode := 0
+		result.ExitCode = &exitCode
+	} else if exitErr, ok := err.(utilexec.ExitError); ok && exitErr.Exited() {
+		exitCode := exitErr.ExitStatus()
+		result.ExitCode = &exitCode
+	}
+
+	return result, err
 }
 
 func ensureContainerExists(pod *corev1api.Pod, container string) error {
@@ -34,6 +34,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/remotecommand"
+	utilexec "k8s.io/client-go/util/exec"
 
 	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	velerotest "github.com/vmware-tanzu/velero/pkg/test"
@@ -244,6 +245,90 @@ func TestExecutePodCommand(t *testing.T) {
 	}
 }
 
+func TestExecutePodCommandWithResult(t *testing.T) {
+	tests := []struct {
+		name             string
+		hookError        error
+		expectedError    string
+		expectedExitCode *int
+	}{
+		{
+			name:             "command succeeded",
+			expectedExitCode: intPtr(0),
+		},
+		{
+			name:             "command exited with an error",
+			hookError:        utilexec.CodeExitError{Err: errors.New("command terminated with exit code 2"), Code: 2},
+			expectedError:    "command terminated with exit code 2",
+			expectedExitCode: intPtr(2),
+		},
+		{
+			name:          "stream error",
+			hookError:     errors.New("stream error"),
+			expectedError: "stream error",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			hook := v1.ExecHook{
+				Command: []string{"some", "command"},
+			}
+
+			pod, err := velerotest.GetAsMap(`
+{
+	"metadata": {
+		"namespace": "namespace",
+		"name": "name"
+	},
+	"spec": {
+		"containers": [
+			{"name": "foo"}
+		]
+	}
+}`)
+			require.NoError(t, err)
+
+			clientConfig := &rest.Config{}
+			poster := &mockPoster{}
+			podCommandExecutor := NewPodCommandExecutor(clientConfig, poster).(*defaultPodCommandExecutor)
+
+			streamExecutorFactory := &mockStreamExecutorFactory{}
+			podCommandExecutor.streamExecutorFactory = streamExecutorFactory
+
+			baseURL, _ := url.Parse("https://some.server")
+			contentConfig := rest.ClientContentConfig{
+				GroupVersion: schema.GroupVersion{Group: "", Version: "v1"},
+			}
+			poster.On("Post").Return(rest.NewRequestWithClient(baseURL, "/api/v1", contentConfig, nil))
+
+			streamExecutor := &mockStreamExecutor{}
+			streamExecutorFactory.On("NewSPDYExecutor", clientConfig, "POST", mock.Anything).Return(streamExecutor, nil)
+			streamExecutor.On("Stream", mock.Anything).Run(func(args mock.Arguments) {
+				options := args.Get(0).(remotecommand.StreamOptions)
+				options.Stdout.Write([]byte("out"))
+				options.Stderr.Write([]byte("err"))
+			}).Return(test.hookError)
+
+			result, err := podCommandExecutor.ExecutePodCommandWithResult(velerotest.NewLogger(), pod, "namespace", "name", "hookName", &hook)
+			if test.expectedError != "" {
+				assert.EqualError(t, err, test.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NotNil(t, result)
+			assert.Equal(t, test.expectedExitCode, result.ExitCode)
+			assert.Equal(t, "out", result.Stdout)
+			assert.Equal(t, "err", result.Stderr)
+		})
+	}
+}
+
+func intPtr(i int) *int {
+	return &i
+}
+
 func TestEnsureContainerExists(t *testing.T) {
 	pod := &corev1api.Pod{
 		Spec: corev1api.PodSpec{
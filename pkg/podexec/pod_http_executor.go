@@ -0,0 +1,214 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podexec
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	corev1api "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kbclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+const (
+	defaultHTTPHookScheme = "http"
+	defaultHTTPHookMethod = http.MethodPost
+
+	// maxHTTPHookResponseBody is the maximum number of bytes of a response body which are read.
+	maxHTTPHookResponseBody = 4096
+)
+
+// HTTPResult is the response to the request of an HTTP hook.
+type HTTPResult struct {
+	StatusCode int
+	// Body is the beginning of the response body.
+	Body string
+}
+
+// PodHTTPExecutor is capable of sending the request of an HTTP hook to an endpoint served by a pod.
+type PodHTTPExecutor interface {
+	// ExecutePodHTTPHook sends the request of an HTTP hook and checks the response's status code. If
+	// no response is received within the specified timeout, an error is returned. The response is
+	// returned whenever one is received, even along with an error.
+	ExecutePodHTTPHook(log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *api.HTTPHook) (*HTTPResult, error)
+}
+
+type defaultPodHTTPExecutor struct {
+	client kbclient.Client
+}
+
+// NewPodHTTPExecutor creates a new PodHTTPExecutor. The client is used to read the secrets holding
+// the hooks' headers.
+func NewPodHTTPExecutor(client kbclient.Client) PodHTTPExecutor {
+	return &defaultPodHTTPExecutor{
+		client: client,
+	}
+}
+
+// ExecutePodHTTPHook sends the request of an HTTP hook to the hook's URL, or to the pod's IP on the
+// hook's port and path. The hook fails if the response's status code isn't one of the expected ones.
+func (e *defaultPodHTTPExecutor) ExecutePodHTTPHook(log logrus.FieldLogger, item map[string]interface{}, namespace, name, hookName string, hook *api.HTTPHook) (*HTTPResult, error) {
+	if item == nil {
+		return nil, errors.New("item is required")
+	}
+	if namespace == "" {
+		return nil, errors.New("namespace is required")
+	}
+	if name == "" {
+		return nil, errors.New("name is required")
+	}
+	if hookName == "" {
+		return nil, errors.New("hookName is required")
+	}
+	if hook == nil {
+		return nil, errors.New("hook is required")
+	}
+
+	pod := new(corev1api.Pod)
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(item, pod); err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	url, err := httpHookURL(pod, hook)
+	if err != nil {
+		return nil, err
+	}
+
+	method := hook.Method
+	if method == "" {
+		method = defaultHTTPHookMethod
+	}
+
+	timeout := hook.Timeout.Duration
+	if timeout == 0 {
+		timeout = defaultTimeout
+	}
+
+	hookLog := log.WithFields(
+		logrus.Fields{
+			"hookName":    hookName,
+			"hookMethod":  method,
+			"hookURL":     url,
+			"hookOnError": hook.OnError,
+			"hookTimeout": timeout,
+		},
+	)
+	hookLog.Info("running http hook")
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "error creating request")
+	}
+
+	if hook.HeadersSecret != "" {
+		secret := new(corev1api.Secret)
+		if err := e.client.Get(ctx, kbclient.ObjectKey{Namespace: namespace, Name: hook.HeadersSecret}, secret); err != nil {
+			return nil, errors.Wrapf(err, "error getting headers secret %s", hook.HeadersSecret)
+		}
+		for header, value := range secret.Data {
+			req.Header.Set(header, string(value))
+		}
+	}
+
+	// Redirects aren't followed, so that the headers read from the secret are only sent to the
+	// hook's URL.
+	httpClient := &http.Client{
+		CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+	}
+	if hook.InsecureSkipTLSVerify {
+		httpClient.Transport = &http.Transport{
+			TLSClientConfig: &tls.Config{InsecureSkipVerify: true}, //nolint:gosec // Requested by the hook.
+		}
+	}
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		if ctx.Err() == context.DeadlineExceeded {
+			return nil, errors.Errorf("timed out after %v", timeout)
+		}
+		return nil, errors.Wrap(err, "error sending request")
+	}
+	defer resp.Body.Close()
+
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, maxHTTPHookResponseBody))
+	hookLog.Infof("http hook returned status code %d", resp.StatusCode)
+
+	result := &HTTPResult{
+		StatusCode: resp.StatusCode,
+		Body:       string(body),
+	}
+	if resp.StatusCode >= 300 && resp.StatusCode < 400 {
+		return result, errors.Errorf("unexpected redirect with status code %d to %q", resp.StatusCode, resp.Header.Get("Location"))
+	}
+	if !expectedStatusCode(hook, resp.StatusCode) {
+		return result, errors.Errorf("unexpected status code %d, response: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	return result, nil
+}
+
+// httpHookURL returns the hook's URL, or the URL of the hook's port and path on the pod's IP.
+func httpHookURL(pod *corev1api.Pod, hook *api.HTTPHook) (string, error) {
+	if hook.URL != "" {
+		return hook.URL, nil
+	}
+
+	if hook.Port == 0 {
+		return "", errors.New("either url or port is required")
+	}
+	if pod.Status.PodIP == "" {
+		return "", errors.Errorf("pod %s/%s has no IP", pod.Namespace, pod.Name)
+	}
+
+	scheme := hook.Scheme
+	if scheme == "" {
+		scheme = defaultHTTPHookScheme
+	}
+
+	path := hook.Path
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+
+	return scheme + "://" + net.JoinHostPort(pod.Status.PodIP, strconv.Itoa(int(hook.Port))) + path, nil
+}
+
+func expectedStatusCode(hook *api.HTTPHook, statusCode int) bool {
+	if len(hook.ExpectedStatusCodes) == 0 {
+		return statusCode >= 200 && statusCode < 300
+	}
+
+	for _, code := range hook.ExpectedStatusCodes {
+		if int(code) == statusCode {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,273 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package podexec
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1api "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	v1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	velerotest "github.com/vmware-tanzu/velero/pkg/test"
+)
+
+func TestExecutePodHTTPHookMissingInputs(t *testing.T) {
+	tests := []struct {
+		name      string
+		item      map[string]interface{}
+		podNS     string
+		podName   string
+		hookName  string
+		hook      *v1.HTTPHook
+		expectErr string
+	}{
+		{
+			name:      "missing item",
+			expectErr: "item is required",
+		},
+		{
+			name:      "missing pod namespace",
+			item:      map[string]interface{}{},
+			expectErr: "namespace is required",
+		},
+		{
+			name:      "missing pod name",
+			item:      map[string]interface{}{},
+			podNS:     "ns",
+			expectErr: "name is required",
+		},
+		{
+			name:      "missing hookName",
+			item:      map[string]interface{}{},
+			podNS:     "ns",
+			podName:   "pod",
+			expectErr: "hookName is required",
+		},
+		{
+			name:      "missing hook",
+			item:      map[string]interface{}{},
+			podNS:     "ns",
+			podName:   "pod",
+			hookName:  "hook",
+			expectErr: "hook is required",
+		},
+		{
+			name:      "missing url and port",
+			item:      map[string]interface{}{},
+			podNS:     "ns",
+			podName:   "pod",
+			hookName:  "hook",
+			hook:      &v1.HTTPHook{},
+			expectErr: "either url or port is required",
+		},
+		{
+			name:      "pod without IP",
+			item:      map[string]interface{}{},
+			podNS:     "ns",
+			podName:   "pod",
+			hookName:  "hook",
+			hook:      &v1.HTTPHook{Port: 8080},
+			expectErr: "pod / has no IP",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			e := NewPodHTTPExecutor(velerotest.NewFakeControllerRuntimeClient(t))
+			result, err := e.ExecutePodHTTPHook(velerotest.NewLogger(), test.item, test.podNS, test.podName, test.hookName, test.hook)
+			assert.EqualError(t, err, test.expectErr)
+			assert.Nil(t, result)
+		})
+	}
+}
+
+func TestExecutePodHTTPHook(t *testing.T) {
+	var (
+		lock   sync.Mutex
+		method string
+		path   string
+		header http.Header
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		method, path, header = r.Method, r.URL.Path, r.Header
+		lock.Unlock()
+		switch r.URL.Path {
+		case "/fail":
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte("not quiesced\n"))
+		case "/slow":
+			time.Sleep(time.Second)
+		case "/accepted":
+			w.WriteHeader(http.StatusAccepted)
+		}
+	}))
+	defer server.Close()
+
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+	host, portString, err := net.SplitHostPort(serverURL.Host)
+	require.NoError(t, err)
+	port, err := strconv.Atoi(portString)
+	require.NoError(t, err)
+
+	pod := builder.ForPod("ns", "pod").Result()
+	pod.Status.PodIP = host
+	item, err := runtime.DefaultUnstructuredConverter.ToUnstructured(pod)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		hook           *v1.HTTPHook
+		expectedMethod string
+		expectedPath   string
+		expectedHeader string
+		expectedResult *HTTPResult
+		expectErr      string
+	}{
+		{
+			name:           "request to the pod's port and path with the default method",
+			hook:           &v1.HTTPHook{Port: int32(port), Path: "quiesce"},
+			expectedMethod: http.MethodPost,
+			expectedPath:   "/quiesce",
+			expectedResult: &HTTPResult{StatusCode: http.StatusOK},
+		},
+		{
+			name:           "request to the URL with headers from the secret",
+			hook:           &v1.HTTPHook{URL: server.URL + "/unquiesce", Method: http.MethodPut, HeadersSecret: "headers"},
+			expectedMethod: http.MethodPut,
+			expectedPath:   "/unquiesce",
+			expectedHeader: "Bearer token",
+			expectedResult: &HTTPResult{StatusCode: http.StatusOK},
+		},
+		{
+			name:           "unexpected status code",
+			hook:           &v1.HTTPHook{URL: server.URL + "/fail"},
+			expectedMethod: http.MethodPost,
+			expectedPath:   "/fail",
+			expectedResult: &HTTPResult{StatusCode: http.StatusInternalServerError, Body: "not quiesced\n"},
+			expectErr:      "unexpected status code 500, response: not quiesced",
+		},
+		{
+			name:           "status code not in the expected ones",
+			hook:           &v1.HTTPHook{URL: server.URL + "/accepted", ExpectedStatusCodes: []int32{200}},
+			expectedMethod: http.MethodPost,
+			expectedPath:   "/accepted",
+			expectedResult: &HTTPResult{StatusCode: http.StatusAccepted},
+			expectErr:      "unexpected status code 202, response: ",
+		},
+		{
+			name:           "status code in the expected ones",
+			hook:           &v1.HTTPHook{URL: server.URL + "/accepted", ExpectedStatusCodes: []int32{200, 202}},
+			expectedMethod: http.MethodPost,
+			expectedPath:   "/accepted",
+			expectedResult: &HTTPResult{StatusCode: http.StatusAccepted},
+		},
+		{
+			name:           "timeout",
+			hook:           &v1.HTTPHook{URL: server.URL + "/slow", Timeout: metav1.Duration{Duration: 100 * time.Millisecond}},
+			expectedMethod: http.MethodPost,
+			expectedPath:   "/slow",
+			expectErr:      "timed out after 100ms",
+		},
+		{
+			name:      "missing headers secret",
+			hook:      &v1.HTTPHook{URL: server.URL, HeadersSecret: "missing"},
+			expectErr: `error getting headers secret missing: secrets "missing" not found`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			lock.Lock()
+			method, path, header = "", "", nil
+			lock.Unlock()
+
+			client := velerotest.NewFakeControllerRuntimeClient(t)
+			secret := builder.ForSecret("ns", "headers").Data(map[string][]byte{"Authorization": []byte("Bearer token")}).Result()
+			require.NoError(t, client.Create(context.Background(), secret))
+
+			e := NewPodHTTPExecutor(client)
+			result, err := e.ExecutePodHTTPHook(velerotest.NewLogger(), item, "ns", "pod", "hook", test.hook)
+			if test.expectErr != "" {
+				assert.EqualError(t, err, test.expectErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, test.expectedResult, result)
+
+			lock.Lock()
+			defer lock.Unlock()
+			assert.Equal(t, test.expectedMethod, method)
+			assert.Equal(t, test.expectedPath, path)
+			if test.expectedHeader != "" {
+				assert.Equal(t, test.expectedHeader, header.Get("Authorization"))
+			}
+		})
+	}
+}
+
+func TestExecutePodHTTPHookRedirect(t *testing.T) {
+	var otherHeader http.Header
+	other := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		otherHeader = r.Header
+	}))
+	defer other.Close()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, other.URL+"/quiesce", http.StatusTemporaryRedirect)
+	}))
+	defer server.Close()
+
+	client := velerotest.NewFakeControllerRuntimeClient(t)
+	secret := builder.ForSecret("ns", "headers").Data(map[string][]byte{"X-Token": []byte("token")}).Result()
+	require.NoError(t, client.Create(context.Background(), secret))
+
+	item, err := runtime.DefaultUnstructuredConverter.ToUnstructured(builder.ForPod("ns", "pod").Result())
+	require.NoError(t, err)
+
+	e := NewPodHTTPExecutor(client)
+	result, err := e.ExecutePodHTTPHook(velerotest.NewLogger(), item, "ns", "pod", "hook",
+		&v1.HTTPHook{URL: server.URL + "/quiesce", HeadersSecret: "headers", ExpectedStatusCodes: []int32{200, 307}})
+	assert.EqualError(t, err, `unexpected redirect with status code 307 to "`+other.URL+`/quiesce"`)
+	require.NotNil(t, result)
+	assert.Equal(t, http.StatusTemporaryRedirect, result.StatusCode)
+
+	// the headers from the secret aren't sent to the host redirected to
+	assert.Nil(t, otherHeader)
+}
+
+func TestHTTPHookURL(t *testing.T) {
+	pod := &corev1api.Pod{Status: corev1api.PodStatus{PodIP: "fd00::1"}}
+
+	url, err := httpHookURL(pod, &v1.HTTPHook{Scheme: "https", Port: 8443})
+	require.NoError(t, err)
+	assert.Equal(t, "https://[fd00::1]:8443/", url)
+}
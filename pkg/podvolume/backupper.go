@@ -43,10 +43,14 @@ import (
 
 // Backupper can execute pod volume backups of volumes in a pod.
 type Backupper interface {
-	// BackupPodVolumes backs up all specified volumes in a pod.
-	BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.Pod, volumesToBackup []string, resPolicies *resourcepolicies.Policies, log logrus.FieldLogger) ([]*velerov1api.PodVolumeBackup, *PVCBackupSummary, []error)
+	// BackupPodVolumes backs up all specified volumes in a pod. The podLabels conditions of the
+	// resource policies matched by a PVC are matched against the pods returned by podsUsingPVC.
+	BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.Pod, volumesToBackup []string, resPolicies *resourcepolicies.Policies, podsUsingPVC PodsUsingPVC, log logrus.FieldLogger) ([]*velerov1api.PodVolumeBackup, *PVCBackupSummary, []error)
 }
 
+// PodsUsingPVC returns the pods mounting the PVC, including pod.
+type PodsUsingPVC func(pvc *corev1api.PersistentVolumeClaim, pod *corev1api.Pod) ([]*corev1api.Pod, error)
+
 type backupper struct {
 	ctx          context.Context
 	repoLocker   *repository.RepoLocker
@@ -148,24 +152,30 @@ func resultsKey(ns, name string) string {
 	return fmt.Sprintf("%s/%s", ns, name)
 }
 
-func (b *backupper) getMatchAction(resPolicies *resourcepolicies.Policies, pvc *corev1api.PersistentVolumeClaim, volume *corev1api.Volume) (*resourcepolicies.Action, error) {
+func (b *backupper) getMatchAction(resPolicies *resourcepolicies.Policies, podsUsingPVC PodsUsingPVC, pod *corev1api.Pod, pvc *corev1api.PersistentVolumeClaim, volume *corev1api.Volume) (*resourcepolicies.Action, error) {
 	if pvc != nil {
 		pv := new(corev1api.PersistentVolume)
 		err := b.crClient.Get(context.TODO(), ctrlclient.ObjectKey{Name: pvc.Spec.VolumeName}, pv)
 		if err != nil {
 			return nil, errors.Wrapf(err, "error getting pv for pvc %s", pvc.Spec.VolumeName)
 		}
-		return resPolicies.GetMatchAction(pv)
+		pods := []*corev1api.Pod{pod}
+		if podsUsingPVC != nil {
+			if pods, err = podsUsingPVC(pvc, pod); err != nil {
+				return nil, err
+			}
+		}
+		return resPolicies.GetMatchAction(resourcepolicies.VolumeFilterData{PersistentVolume: pv, PVC: pvc, Pods: pods})
 	}
 
 	if volume != nil {
-		return resPolicies.GetMatchAction(volume)
+		return resPolicies.GetMatchAction(resourcepolicies.VolumeFilterData{PodVolume: volume, Pods: []*corev1api.Pod{pod}})
 	}
 
 	return nil, errors.Errorf("failed to check resource policies for empty volume")
 }
 
-func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.Pod, volumesToBackup []string, resPolicies *resourcepolicies.Policies, log logrus.FieldLogger) ([]*velerov1api.PodVolumeBackup, *PVCBackupSummary, []error) {
+func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.Pod, volumesToBackup []string, resPolicies *resourcepolicies.Policies, podsUsingPVC PodsUsingPVC, log logrus.FieldLogger) ([]*velerov1api.PodVolumeBackup, *PVCBackupSummary, []error) {
 	if len(volumesToBackup) == 0 {
 		return nil, nil, nil
 	}
@@ -207,15 +217,26 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 		return nil, nil, []error{err}
 	}
 
-	repo, err := b.repoEnsurer.EnsureRepo(b.ctx, backup.Namespace, pod.Namespace, backup.Spec.StorageLocation, repositoryType)
-	if err != nil {
-		return nil, nil, []error{err}
-	}
+	// a dry-run backup only reports the volumes that would be backed up, so
+	// neither the repository nor the PodVolumeBackups are created.
+	dryRun := boolptr.IsSetToTrue(backup.Spec.DryRun)
 
-	// get a single non-exclusive lock since we'll wait for all individual
-	// backups to be complete before releasing it.
-	b.repoLocker.Lock(repo.Name)
-	defer b.repoLocker.Unlock(repo.Name)
+	repoIdentifier := ""
+	if !dryRun {
+		repo, err := b.repoEnsurer.EnsureRepo(b.ctx, backup.Namespace, pod.Namespace, backup.Spec.StorageLocation, repositoryType)
+		if err != nil {
+			return nil, nil, []error{err}
+		}
+
+		// get a single non-exclusive lock since we'll wait for all individual
+		// backups to be complete before releasing it.
+		b.repoLocker.Lock(repo.Name)
+		defer b.repoLocker.Unlock(repo.Name)
+
+		if repositoryType == velerov1api.BackupRepositoryTypeRestic {
+			repoIdentifier = repo.Spec.ResticIdentifier
+		}
+	}
 
 	resultsChan := make(chan *velerov1api.PodVolumeBackup)
 
@@ -227,8 +248,39 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 		podVolumeBackups   []*velerov1api.PodVolumeBackup
 		mountedPodVolumes  = sets.String{}
 		attachedPodDevices = sets.String{}
+		// existingPVBs are the PodVolumeBackups of the pod's volumes created before
+		// a restart of the server, which a resumed backup adopts
+		existingPVBs = make(map[string]*velerov1api.PodVolumeBackup)
+		// finishedPVBs are the PodVolumeBackups already finished when the
+		// results channel was registered, their results are ignored
+		finishedPVBs = sets.String{}
 	)
 
+	if !dryRun {
+		pvbList := new(velerov1api.PodVolumeBackupList)
+		if err := b.crClient.List(b.ctx, pvbList, ctrlclient.InNamespace(backup.Namespace),
+			ctrlclient.MatchingLabels{velerov1api.BackupUIDLabel: string(backup.UID)}); err != nil {
+			errs = append(errs, errors.Wrap(err, "error listing existing pod volume backups"))
+		}
+		for i := range pvbList.Items {
+			pvb := &pvbList.Items[i]
+			if pvb.Spec.Pod.UID != pod.UID {
+				continue
+			}
+			switch pvb.Status.Phase {
+			case velerov1api.PodVolumeBackupPhaseFailed:
+				finishedPVBs.Insert(pvb.Name)
+				continue
+			case velerov1api.PodVolumeBackupPhaseCompleted:
+				finishedPVBs.Insert(pvb.Name)
+			}
+			if existing, ok := existingPVBs[pvb.Spec.Volume]; ok && existing.Status.Phase == velerov1api.PodVolumeBackupPhaseCompleted {
+				continue
+			}
+			existingPVBs[pvb.Spec.Volume] = pvb
+		}
+	}
+
 	for _, container := range pod.Spec.Containers {
 		for _, volumeMount := range container.VolumeMounts {
 			mountedPodVolumes.Insert(volumeMount.Name)
@@ -238,11 +290,6 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 		}
 	}
 
-	repoIdentifier := ""
-	if repositoryType == velerov1api.BackupRepositoryTypeRestic {
-		repoIdentifier = repo.Spec.ResticIdentifier
-	}
-
 	var numVolumeSnapshots int
 	for _, volumeName := range volumesToBackup {
 		volume, ok := podVolumes[volumeName]
@@ -290,17 +337,40 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 		}
 
 		if resPolicies != nil {
-			if action, err := b.getMatchAction(resPolicies, pvc, &volume); err != nil {
+			if action, err := b.getMatchAction(resPolicies, podsUsingPVC, pod, pvc, &volume); err != nil {
 				errs = append(errs, errors.Wrapf(err, "error getting pv for pvc %s", pvc.Spec.VolumeName))
 				continue
 			} else if action != nil && action.Type == resourcepolicies.Skip {
 				log.Infof("skip backup of volume %s for the matched resource policies", volumeName)
 				pvcSummary.addSkipped(volumeName, "matched action is 'skip' in chosen resource policies")
 				continue
+			} else if action != nil && (action.Type == resourcepolicies.Snapshot || action.Type == resourcepolicies.DataMover) {
+				log.Infof("skip backup of volume %s because the matched resource policies back it up with %s", volumeName, action.Type)
+				pvcSummary.addSkipped(volumeName, fmt.Sprintf("matched action is '%s' in chosen resource policies", action.Type))
+				continue
+			}
+		}
+
+		if existing, ok := existingPVBs[volumeName]; ok {
+			pvcSummary.addBackedup(volumeName)
+			if existing.Status.Phase == velerov1api.PodVolumeBackupPhaseCompleted {
+				log.Infof("Adopting completed pod volume backup %s of volume %s", existing.Name, volumeName)
+				podVolumeBackups = append(podVolumeBackups, existing)
+				continue
 			}
+			log.Infof("Waiting for pod volume backup %s of volume %s created before the restart", existing.Name, volumeName)
+			numVolumeSnapshots++
+			continue
 		}
 
 		volumeBackup := newPodVolumeBackup(backup, pod, volume, repoIdentifier, b.uploaderType, pvc)
+		if dryRun {
+			log.Infof("Backup is a dry run, volume %s would be backed up by pod volume backup", volumeName)
+			volumeBackup.Status.Phase = velerov1api.PodVolumeBackupPhaseNew
+			podVolumeBackups = append(podVolumeBackups, volumeBackup)
+			pvcSummary.addBackedup(volumeName)
+			continue
+		}
 		if err := veleroclient.CreateRetryGenerateName(b.crClient, b.ctx, volumeBackup); err != nil {
 			errs = append(errs, err)
 			continue
@@ -310,12 +380,20 @@ func (b *backupper) BackupPodVolumes(backup *velerov1api.Backup, pod *corev1api.
 	}
 
 ForEachVolume:
-	for i, count := 0, numVolumeSnapshots; i < count; i++ {
+	for pending := numVolumeSnapshots; pending > 0; {
 		select {
 		case <-b.ctx.Done():
-			errs = append(errs, errors.New("timed out waiting for all PodVolumeBackups to complete"))
+			if errors.Is(context.Cause(b.ctx), ErrCancelled) {
+				errs = append(errs, errors.New("stopped waiting for the PodVolumeBackups to complete because the backup was cancelled"))
+			} else {
+				errs = append(errs, errors.New("timed out waiting for all PodVolumeBackups to complete"))
+			}
 			break ForEachVolume
 		case res := <-resultsChan:
+			if finishedPVBs.Has(res.Name) {
+				continue
+			}
+			pending--
 			switch res.Status.Phase {
 			case velerov1api.PodVolumeBackupPhaseCompleted:
 				podVolumeBackups = append(podVolumeBackups, res)
@@ -326,9 +404,22 @@ ForEachVolume:
 		}
 	}
 
+	// drain the results sent until the channel is unregistered, so the event
+	// handler sending them doesn't block while holding the lock
+	unregistered := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-resultsChan:
+			case <-unregistered:
+				return
+			}
+		}
+	}()
 	b.resultsLock.Lock()
 	delete(b.results, resultsKey(pod.Namespace, pod.Name))
 	b.resultsLock.Unlock()
+	close(unregistered)
 
 	return podVolumeBackups, pvcSummary, errs
 }
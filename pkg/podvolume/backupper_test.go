@@ -194,7 +194,7 @@ func Test_backupper_BackupPodVolumes_log_test(t *testing.T) {
 			logOutput := bytes.Buffer{}
 			var log = logrus.New()
 			log.SetOutput(&logOutput)
-			b.BackupPodVolumes(tt.args.backup, tt.args.pod, tt.args.volumesToBackup, tt.args.resPolicies, log)
+			b.BackupPodVolumes(tt.args.backup, tt.args.pod, tt.args.volumesToBackup, tt.args.resPolicies, nil, log)
 			fmt.Println(logOutput.String())
 			assert.Contains(t, logOutput.String(), tt.wantLog)
 
@@ -310,6 +310,8 @@ func TestBackupPodVolumes(t *testing.T) {
 
 	ctxWithCancel, cancel := context.WithCancel(context.Background())
 	defer cancel()
+	ctxWithCause, cancelWithCause := context.WithCancelCause(context.Background())
+	defer cancelWithCause(nil)
 
 	failedPVB := createPVBObj(true, false, 1, "")
 	completedPVB := createPVBObj(false, false, 1, "")
@@ -516,6 +518,28 @@ func TestBackupPodVolumes(t *testing.T) {
 				"timed out waiting for all PodVolumeBackups to complete",
 			},
 		},
+		{
+			name: "backup cancelled",
+			ctx:  ctxWithCause,
+			volumes: []string{
+				"fake-volume-1",
+			},
+			sourcePod: createPodObj(true, true, true, 1),
+			kubeClientObj: []runtime.Object{
+				createNodeAgentPodObj(true),
+				createPVCObj(1),
+				createPVObj(1, false),
+			},
+			ctlClientObj: []runtime.Object{
+				createBackupRepoObj(),
+			},
+			runtimeScheme: scheme,
+			uploaderType:  "kopia",
+			bsl:           "fake-bsl",
+			errs: []string{
+				"stopped waiting for the PodVolumeBackups to complete because the backup was cancelled",
+			},
+		},
 		{
 			name: "return failed pvbs",
 			volumes: []string{
@@ -610,7 +634,11 @@ func TestBackupPodVolumes(t *testing.T) {
 			go func() {
 				if test.ctx != nil {
 					time.Sleep(time.Second)
-					cancel()
+					if test.ctx == ctxWithCause {
+						cancelWithCause(ErrCancelled)
+					} else {
+						cancel()
+					}
 				} else if test.retPVBs != nil {
 					time.Sleep(time.Second)
 					for _, pvb := range test.retPVBs {
@@ -620,7 +648,7 @@ func TestBackupPodVolumes(t *testing.T) {
 				}
 			}()
 
-			pvbs, _, errs := bp.BackupPodVolumes(backupObj, test.sourcePod, test.volumes, nil, velerotest.NewLogger())
+			pvbs, _, errs := bp.BackupPodVolumes(backupObj, test.sourcePod, test.volumes, nil, nil, velerotest.NewLogger())
 
 			if errs == nil {
 				assert.Nil(t, test.errs)
@@ -635,6 +663,123 @@ func TestBackupPodVolumes(t *testing.T) {
 	}
 }
 
+func TestBackupPodVolumesAdoptsExistingPVBs(t *testing.T) {
+	scheme := runtime.NewScheme()
+	velerov1api.AddToScheme(scheme)
+	corev1api.AddToScheme(scheme)
+
+	ctx := context.Background()
+	backupObj := builder.ForBackup(velerov1api.DefaultNamespace, "fake-backup").StorageLocation("fake-bsl").
+		ObjectMeta(builder.WithUID("fake-backup-uid")).Result()
+	existingPVB := func(name string, index int, phase velerov1api.PodVolumeBackupPhase) *velerov1api.PodVolumeBackup {
+		return builder.ForPodVolumeBackup(velerov1api.DefaultNamespace, name).
+			ObjectMeta(builder.WithLabels(velerov1api.BackupUIDLabel, "fake-backup-uid")).
+			PodName("fake-pod").PodNamespace("fake-ns").Volume(fmt.Sprintf("fake-volume-%d", index)).Phase(phase).Result()
+	}
+
+	sourcePod := createPodObj(true, true, true, 3)
+	kubeClientObj := []runtime.Object{
+		createNodeAgentPodObj(true),
+		createPVCObj(1),
+		createPVCObj(2),
+		createPVCObj(3),
+		createPVObj(1, false),
+		createPVObj(2, false),
+		createPVObj(3, false),
+	}
+	ctlClientObj := []runtime.Object{
+		createBackupRepoObj(),
+		existingPVB("fake-pvb-1", 1, velerov1api.PodVolumeBackupPhaseCompleted),
+		existingPVB("fake-pvb-2", 2, velerov1api.PodVolumeBackupPhaseInProgress),
+		existingPVB("fake-pvb-3-failed", 3, velerov1api.PodVolumeBackupPhaseFailed),
+	}
+	fakeCtrlClient := ctrlfake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(append(ctlClientObj, kubeClientObj...)...).Build()
+
+	fakeCRWatchClient := velerotest.NewFakeControllerRuntimeWatchClient(t, kubeClientObj...)
+	lw := kube.InternalLW{
+		Client:     fakeCRWatchClient,
+		Namespace:  velerov1api.DefaultNamespace,
+		ObjectList: new(velerov1api.PodVolumeBackupList),
+	}
+	pvbInformer := cache.NewSharedIndexInformer(&lw, &velerov1api.PodVolumeBackup{}, 0, cache.Indexers{})
+	go pvbInformer.Run(ctx.Done())
+	require.True(t, cache.WaitForCacheSync(ctx.Done(), pvbInformer.HasSynced))
+
+	ensurer := repository.NewEnsurer(fakeCtrlClient, velerotest.NewLogger(), time.Millisecond)
+
+	factory := NewBackupperFactory(repository.NewRepoLocker(), ensurer, fakeCtrlClient, pvbInformer, velerotest.NewLogger())
+	bp, err := factory.NewBackupper(ctx, backupObj, "kopia")
+	require.NoError(t, err)
+
+	go func() {
+		time.Sleep(time.Second)
+		results := bp.(*backupper).results[resultsKey(sourcePod.Namespace, sourcePod.Name)]
+		// the result of the PVB completed before the restart is ignored
+		results <- existingPVB("fake-pvb-1", 1, velerov1api.PodVolumeBackupPhaseCompleted)
+		results <- existingPVB("fake-pvb-2", 2, velerov1api.PodVolumeBackupPhaseCompleted)
+		results <- existingPVB("fake-pvb-3", 3, velerov1api.PodVolumeBackupPhaseCompleted)
+	}()
+
+	pvbs, summary, errs := bp.BackupPodVolumes(backupObj, sourcePod, []string{"fake-volume-1", "fake-volume-2", "fake-volume-3"}, nil, nil, velerotest.NewLogger())
+	require.Empty(t, errs)
+	require.Len(t, pvbs, 3)
+	assert.Equal(t, "fake-pvb-1", pvbs[0].Name)
+	assert.Equal(t, "fake-pvb-2", pvbs[1].Name)
+	assert.Equal(t, "fake-pvb-3", pvbs[2].Name)
+	assert.Len(t, summary.Backedup, 3)
+
+	// only the volume whose PVB failed is backed up again
+	created := new(velerov1api.PodVolumeBackupList)
+	require.NoError(t, fakeCtrlClient.List(ctx, created))
+	assert.Len(t, created.Items, 4)
+}
+
+func TestBackupPodVolumesDryRun(t *testing.T) {
+	scheme := runtime.NewScheme()
+	velerov1api.AddToScheme(scheme)
+	corev1api.AddToScheme(scheme)
+
+	ctx := context.Background()
+	sourcePod := createPodObj(true, true, true, 1)
+	kubeClientObj := []runtime.Object{
+		createNodeAgentPodObj(true),
+		createPVCObj(1),
+		createPVObj(1, false),
+	}
+
+	// no BackupRepository exists, a dry-run backup must not try to ensure one
+	fakeCtrlClient := ctrlfake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(kubeClientObj...).Build()
+
+	fakeCRWatchClient := velerotest.NewFakeControllerRuntimeWatchClient(t, kubeClientObj...)
+	lw := kube.InternalLW{
+		Client:     fakeCRWatchClient,
+		Namespace:  velerov1api.DefaultNamespace,
+		ObjectList: new(velerov1api.PodVolumeBackupList),
+	}
+	pvbInformer := cache.NewSharedIndexInformer(&lw, &velerov1api.PodVolumeBackup{}, 0, cache.Indexers{})
+	go pvbInformer.Run(ctx.Done())
+	require.True(t, cache.WaitForCacheSync(ctx.Done(), pvbInformer.HasSynced))
+
+	ensurer := repository.NewEnsurer(fakeCtrlClient, velerotest.NewLogger(), time.Millisecond)
+
+	backupObj := builder.ForBackup(velerov1api.DefaultNamespace, "fake-backup").StorageLocation("fake-bsl").DryRun(true).Result()
+
+	factory := NewBackupperFactory(repository.NewRepoLocker(), ensurer, fakeCtrlClient, pvbInformer, velerotest.NewLogger())
+	bp, err := factory.NewBackupper(ctx, backupObj, "kopia")
+	require.NoError(t, err)
+
+	pvbs, summary, errs := bp.BackupPodVolumes(backupObj, sourcePod, []string{"fake-volume-1"}, nil, nil, velerotest.NewLogger())
+	require.Empty(t, errs)
+	require.Len(t, pvbs, 1)
+	assert.Equal(t, velerov1api.PodVolumeBackupPhaseNew, pvbs[0].Status.Phase)
+	assert.Equal(t, "fake-volume-1", pvbs[0].Spec.Volume)
+	assert.Contains(t, summary.Backedup, "fake-volume-1")
+
+	created := new(velerov1api.PodVolumeBackupList)
+	require.NoError(t, fakeCtrlClient.List(ctx, created))
+	assert.Empty(t, created.Items)
+}
+
 func TestPVCBackupSummary(t *testing.T) {
 	pbs := NewPVCBackupSummary()
 	pbs.pvcMap["vol-1"] = builder.ForPersistentVolumeClaim("ns-1", "pvc-1").VolumeName("pv-1").Result()
@@ -661,3 +806,39 @@ func TestPVCBackupSummary(t *testing.T) {
 	assert.Equal(t, 0, len(pbs.Skipped))
 	assert.Equal(t, 2, len(pbs.Backedup))
 }
+
+func TestGetMatchActionWithSharedPVC(t *testing.T) {
+	policies, err := resourcepolicies.GetResourcePoliciesFromConfig(
+		builder.ForConfigMap("velero", "policies").Data("policies", `version: v1
+volumePolicies:
+- conditions:
+    podLabels:
+      app: db
+  action:
+    type: skip
+`).Result())
+	require.NoError(t, err)
+
+	pod := func(name, app string) *corev1api.Pod {
+		return builder.ForPod("fake-ns", name).
+			ObjectMeta(builder.WithLabels("app", app)).
+			Volumes(builder.ForVolume("fake-volume").PersistentVolumeClaimSource("fake-pvc").Result()).
+			Result()
+	}
+	web, db := pod("fake-pod-1", "web"), pod("fake-pod-2", "db")
+	pvc := builder.ForPersistentVolumeClaim("fake-ns", "fake-pvc").VolumeName("fake-pv").Result()
+
+	b := &backupper{crClient: velerotest.NewFakeControllerRuntimeClient(t, builder.ForPersistentVolume("fake-pv").Result())}
+
+	// only the pod being backed up is matched without the pods using the pvc
+	action, err := b.getMatchAction(policies, nil, web, pvc, &web.Spec.Volumes[0])
+	require.NoError(t, err)
+	assert.Nil(t, action)
+
+	podsUsingPVC := func(*corev1api.PersistentVolumeClaim, *corev1api.Pod) ([]*corev1api.Pod, error) {
+		return []*corev1api.Pod{web, db}, nil
+	}
+	action, err = b.getMatchAction(policies, podsUsingPVC, web, pvc, &web.Spec.Volumes[0])
+	require.NoError(t, err)
+	assert.Equal(t, &resourcepolicies.Action{Type: resourcepolicies.Skip}, action)
+}
@@ -223,7 +223,11 @@ ForEachVolume:
 	for i := 0; i < numRestores; i++ {
 		select {
 		case <-r.ctx.Done():
-			errs = append(errs, errors.New("timed out waiting for all PodVolumeRestores to complete"))
+			if errors.Is(context.Cause(r.ctx), ErrCancelled) {
+				errs = append(errs, errors.New("stopped waiting for the PodVolumeRestores to complete because the restore was cancelled"))
+			} else {
+				errs = append(errs, errors.New("timed out waiting for all PodVolumeRestores to complete"))
+			}
 			break ForEachVolume
 		case res := <-resultsChan:
 			if res.Status.Phase == velerov1api.PodVolumeRestorePhaseFailed {
@@ -289,6 +293,7 @@ func newPodVolumeRestore(restore *velerov1api.Restore, pod *corev1api.Pod, backu
 
 	if restore.Spec.UploaderConfig != nil {
 		pvr.Spec.UploaderSettings = uploaderutil.StoreRestoreConfig(restore.Spec.UploaderConfig)
+		pvr.Spec.IncludedPaths = restore.Spec.UploaderConfig.IncludedPaths
 	}
 
 	return pvr
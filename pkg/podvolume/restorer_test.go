@@ -157,6 +157,18 @@ type expectError struct {
 	prefixOnly bool
 }
 
+func TestNewPodVolumeRestoreIncludedPaths(t *testing.T) {
+	pod := builder.ForPod("ns-1", "pod-1").Result()
+
+	pvr := newPodVolumeRestore(builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").Result(), pod, "bsl-1", "vol-1", "snapshot-1", "repo-1", "kopia", "ns-1", nil)
+	assert.Nil(t, pvr.Spec.IncludedPaths)
+	assert.Nil(t, pvr.Spec.UploaderSettings)
+
+	restore := builder.ForRestore(velerov1api.DefaultNamespace, "restore-1").IncludedPaths("/etc/app.conf", "/data").Result()
+	pvr = newPodVolumeRestore(restore, pod, "bsl-1", "vol-1", "snapshot-1", "repo-1", "kopia", "ns-1", nil)
+	assert.Equal(t, []string{"/etc/app.conf", "/data"}, pvr.Spec.IncludedPaths)
+}
+
 func TestRestorePodVolumes(t *testing.T) {
 	scheme := runtime.NewScheme()
 	velerov1api.AddToScheme(scheme)
@@ -19,6 +19,7 @@ package podvolume
 import (
 	"strings"
 
+	"github.com/pkg/errors"
 	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -42,6 +43,10 @@ const (
 	DefaultVolumesToFsBackup = false
 )
 
+// ErrCancelled is the cause of the cancellation of the context of a Backupper
+// or a Restorer when the backup or restore is cancelled.
+var ErrCancelled = errors.New("cancelled")
+
 // volumeBackupInfo describes the backup info of a volume backed up by PodVolumeBackups
 type volumeBackupInfo struct {
 	snapshotID     string
@@ -0,0 +1,241 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/vmware-tanzu/velero/internal/restorerollback"
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+)
+
+// Checkpoint records the progress of a restore, so that a restore interrupted
+// by a restart of the server can be resumed. The items recorded here aren't
+// restored again when the restore is resumed, and the asynchronous operations
+// started for them are adopted. The items whose restore had warnings or errors
+// aren't recorded, so they're restored again and their warnings and errors are
+// in the results of the resumed restore.
+type Checkpoint struct {
+	// Items are the items whose restore finished without warnings or errors.
+	Items []RestoredItem `json:"items"`
+	// RenamedPVs maps the persistent volumes renamed by the restore to their new names.
+	RenamedPVs map[string]string `json:"renamedPVs,omitempty"`
+	// PVsToProvision are the persistent volumes dynamically provisioned for their pod volume backups.
+	PVsToProvision []string `json:"pvsToProvision,omitempty"`
+	// RollbackItems are what the restore did to the items, to roll it back.
+	RollbackItems []restorerollback.Item `json:"rollbackItems,omitempty"`
+}
+
+// RestoredItem is an item whose restore finished without warnings or errors.
+type RestoredItem struct {
+	Resource     string   `json:"resource"`
+	Namespace    string   `json:"namespace,omitempty"`
+	Name         string   `json:"name"`
+	Action       string   `json:"action"`
+	ItemExists   bool     `json:"itemExists,omitempty"`
+	OperationIDs []string `json:"operationIDs,omitempty"`
+}
+
+// resumeState holds the checkpoint and the item operations of the interrupted
+// run a restore resumes, and which of the operations were adopted.
+type resumeState struct {
+	checkpoint *Checkpoint
+	operations []*itemoperation.RestoreOperation
+	adopted    map[string]bool
+}
+
+// podVolumeRestoreCheckFrequency is how often a resumed restore checks the pod
+// volume restores created before the restart.
+const podVolumeRestoreCheckFrequency = time.Second
+
+// Checkpoint returns the progress of the restore so far, along with a copy
+// of its item operations. It returns nil if the restore hasn't started yet.
+func (r *Request) Checkpoint() (*Checkpoint, []*itemoperation.RestoreOperation) {
+	r.lock.Lock()
+	checkpointer := r.checkpointer
+	r.lock.Unlock()
+
+	if checkpointer == nil {
+		return nil, nil
+	}
+	return checkpointer()
+}
+
+// Resume prepares the restore to continue from the checkpoint of an
+// interrupted run, with the item operations of that run. It must be called
+// before the restore starts.
+func (r *Request) Resume(checkpoint *Checkpoint, operations []*itemoperation.RestoreOperation) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if checkpoint == nil {
+		checkpoint = &Checkpoint{}
+	}
+	r.resumed = &resumeState{
+		checkpoint: checkpoint,
+		operations: operations,
+		adopted:    make(map[string]bool),
+	}
+}
+
+// UnadoptedOperations returns the unfinished item operations of the
+// interrupted run which the resumed restore didn't adopt. They should be
+// cancelled since nothing refers to them anymore.
+func (r *Request) UnadoptedOperations() []*itemoperation.RestoreOperation {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.resumed == nil {
+		return nil
+	}
+
+	var unadopted []*itemoperation.RestoreOperation
+	for _, operation := range r.resumed.operations {
+		if r.resumed.adopted[operation.Spec.OperationID] {
+			continue
+		}
+		if operation.Status.Phase == itemoperation.OperationPhaseNew || operation.Status.Phase == itemoperation.OperationPhaseInProgress {
+			unadopted = append(unadopted, operation)
+		}
+	}
+	return unadopted
+}
+
+// checkpoint returns the progress of the restore so far, along with a copy of
+// its item operations.
+func (ctx *restoreContext) checkpoint() (*Checkpoint, []*itemoperation.RestoreOperation) {
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+
+	checkpoint := &Checkpoint{
+		RenamedPVs:     make(map[string]string),
+		PVsToProvision: ctx.pvsToProvision.List(),
+	}
+	for key, status := range ctx.restoredItems {
+		// the items still being restored, or whose restore failed, are restored again
+		if !ctx.finishedItems[key] {
+			continue
+		}
+		checkpoint.Items = append(checkpoint.Items, RestoredItem{
+			Resource:     key.resource,
+			Namespace:    key.namespace,
+			Name:         key.name,
+			Action:       status.action,
+			ItemExists:   status.itemExists,
+			OperationIDs: ctx.itemOperationIDs[key],
+		})
+	}
+	for oldName, newName := range ctx.renamedPVs {
+		checkpoint.RenamedPVs[oldName] = newName
+	}
+	if ctx.rollbackRecorder != nil {
+		checkpoint.RollbackItems = ctx.rollbackRecorder.Items()
+	}
+
+	var operations []*itemoperation.RestoreOperation
+	for _, operation := range *ctx.itemOperationsList {
+		operations = append(operations, operation.DeepCopy())
+	}
+	return checkpoint, operations
+}
+
+// resume fills the restore context with the progress of the interrupted run,
+// and adopts the item operations started for the items it restored unless
+// they failed.
+func (ctx *restoreContext) resume(resumed *resumeState) {
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+
+	operations := make(map[string]*itemoperation.RestoreOperation)
+	for _, operation := range resumed.operations {
+		operations[operation.Spec.OperationID] = operation
+	}
+
+	checkpoint := resumed.checkpoint
+	for _, item := range checkpoint.Items {
+		key := itemKey{resource: item.Resource, namespace: item.Namespace, name: item.Name}
+		ctx.restoredItems[key] = restoredItemStatus{action: item.Action, itemExists: item.ItemExists}
+		ctx.finishedItems[key] = true
+
+		for _, operationID := range item.OperationIDs {
+			operation, ok := operations[operationID]
+			if !ok || operation.Status.Phase == itemoperation.OperationPhaseFailed {
+				continue
+			}
+			resumed.adopted[operationID] = true
+			ctx.itemOperationIDs[key] = append(ctx.itemOperationIDs[key], operationID)
+			*ctx.itemOperationsList = append(*ctx.itemOperationsList, operation.DeepCopy())
+		}
+	}
+	for oldName, newName := range checkpoint.RenamedPVs {
+		ctx.renamedPVs[oldName] = newName
+	}
+	ctx.pvsToProvision.Insert(checkpoint.PVsToProvision...)
+	if ctx.rollbackRecorder != nil {
+		ctx.rollbackRecorder.Load(checkpoint.RollbackItems)
+	}
+	ctx.resumed = true
+
+	ctx.log.Infof("Resuming the restore, %d items were restored and %d item operations are adopted", len(checkpoint.Items), len(resumed.adopted))
+}
+
+// waitForExistingPodVolumeRestores waits for the pod volume restores created
+// before the restart of a resumed restore, since the pods they belong to
+// aren't restored again. The errors are sent on the ctx.podVolumeErrs channel.
+func (ctx *restoreContext) waitForExistingPodVolumeRestores() {
+	pvrs := new(velerov1api.PodVolumeRestoreList)
+	if err := ctx.kbClient.List(ctx.podVolumeContext, pvrs, crclient.InNamespace(ctx.restore.Namespace),
+		crclient.MatchingLabels{velerov1api.RestoreUIDLabel: string(ctx.restore.UID)}); err != nil {
+		ctx.log.WithError(err).Error("Error listing the pod volume restores created before the restart")
+		return
+	}
+
+	for i := range pvrs.Items {
+		pvr := &pvrs.Items[i]
+		ctx.podVolumeWaitGroup.Add(1)
+		go func() {
+			// Done() will only be called after all errors have been successfully
+			// sent on the ctx.podVolumeErrs channel
+			defer ctx.podVolumeWaitGroup.Done()
+
+			err := wait.PollImmediateUntil(podVolumeRestoreCheckFrequency, func() (bool, error) {
+				switch pvr.Status.Phase {
+				case velerov1api.PodVolumeRestorePhaseCompleted:
+					return true, nil
+				case velerov1api.PodVolumeRestorePhaseFailed:
+					return false, errors.Errorf("pod volume restore failed: %s", pvr.Status.Message)
+				}
+				if err := ctx.kbClient.Get(ctx.podVolumeContext, crclient.ObjectKeyFromObject(pvr), pvr); err != nil {
+					ctx.log.WithError(err).Debugf("Error getting pod volume restore %s", pvr.Name)
+				}
+				return false, nil
+			}, ctx.podVolumeContext.Done())
+			if err != nil {
+				if err == wait.ErrWaitTimeout {
+					err = errors.Errorf("stopped waiting for pod volume restore %s created before the restart", pvr.Name)
+				}
+				ctx.log.WithError(err).Errorf("Pod volume restore %s created before the restart didn't complete", pvr.Name)
+				ctx.podVolumeErrs <- err
+			}
+		}()
+	}
+}
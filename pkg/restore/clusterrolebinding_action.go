@@ -42,8 +42,11 @@ func (a *ClusterRoleBindingAction) AppliesTo() (velero.ResourceSelector, error)
 }
 
 func (a *ClusterRoleBindingAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
-	namespaceMapping := input.Restore.Spec.NamespaceMapping
-	if len(namespaceMapping) == 0 {
+	namespaceMapper, err := NewNamespaceMapper(&input.Restore.Spec)
+	if err != nil {
+		return nil, err
+	}
+	if namespaceMapper.Empty() {
 		return velero.NewRestoreItemActionExecuteOutput(&unstructured.Unstructured{Object: input.Item.UnstructuredContent()}), nil
 	}
 
@@ -53,7 +56,7 @@ func (a *ClusterRoleBindingAction) Execute(input *velero.RestoreItemActionExecut
 	}
 
 	for i, subject := range clusterRoleBinding.Subjects {
-		if newNamespace, ok := namespaceMapping[subject.Namespace]; ok {
+		if newNamespace, ok := namespaceMapper.Map(subject.Namespace); ok {
 			clusterRoleBinding.Subjects[i].Namespace = newNamespace
 		}
 	}
@@ -33,6 +33,7 @@ import (
 	veleroclient "github.com/vmware-tanzu/velero/pkg/client"
 	"github.com/vmware-tanzu/velero/pkg/label"
 	"github.com/vmware-tanzu/velero/pkg/plugin/velero"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 )
 
 type DataUploadRetrieveAction struct {
@@ -56,6 +57,13 @@ func (d *DataUploadRetrieveAction) AppliesTo() (velero.ResourceSelector, error)
 func (d *DataUploadRetrieveAction) Execute(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
 	d.logger.Info("Executing DataUploadRetrieveAction")
 
+	if boolptr.IsSetToTrue(input.Restore.Spec.Preview) {
+		d.logger.Info("Not creating the DataUploadResult ConfigMap because the restore is a preview")
+		return &velero.RestoreItemActionExecuteOutput{
+			SkipRestore: true,
+		}, nil
+	}
+
 	dataUpload := velerov2alpha1.DataUpload{}
 	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(input.ItemFromBackup.UnstructuredContent(), &dataUpload); err != nil {
 		d.logger.Errorf("unable to convert unstructured item to DataUpload: %s", err.Error())
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -68,6 +69,15 @@ func TestDataUploadRetrieveActionExectue(t *testing.T) {
 			},
 			expectedDataUploadResult: builder.ForConfigMap("velero", "").ObjectMeta(builder.WithGenerateName("testDU-"), builder.WithLabels(velerov1.PVCNamespaceNameLabel, "testNamespace.testPVC", velerov1.RestoreUIDLabel, "testingUID", velerov1.ResourceUsageLabel, string(velerov1.VeleroResourceUsageDataUploadResult))).Data("testingUID", `{"backupStorageLocation":"testLocation","sourceNamespace":"testNamespace"}`).Result(),
 		},
+		{
+			name:          "no DataUploadResult is created for a preview",
+			dataUpload:    builder.ForDataUpload("velero", "testDU").SourceNamespace("testNamespace").SourcePVC("testPVC").Result(),
+			restore:       builder.ForRestore("velero", "testRestore").ObjectMeta(builder.WithUID("testingUID")).Backup("testBackup").Preview(true).Result(),
+			runtimeScheme: scheme,
+			veleroObjs: []runtime.Object{
+				builder.ForBackup("velero", "testBackup").StorageLocation("testLocation").Result(),
+			},
+		},
 		{
 			name:          "Long source namespace and PVC name should also work",
 			dataUpload:    builder.ForDataUpload("velero", "testDU").SourceNamespace("migre209d0da-49c7-45ba-8d5a-3e59fd591ec1").SourcePVC("kibishii-data-kibishii-deployment-0").Result(),
@@ -110,6 +120,12 @@ func TestDataUploadRetrieveActionExectue(t *testing.T) {
 				require.NoError(t, err)
 			}
 
+			var cmList corev1.ConfigMapList
+			require.NoError(t, fakeClient.List(context.Background(), &cmList))
+			if tc.expectedDataUploadResult == nil {
+				assert.Empty(t, cmList.Items)
+			}
+
 			if tc.expectedDataUploadResult != nil {
 				var cmList corev1.ConfigMapList
 				err := fakeClient.List(context.Background(), &cmList, &client.ListOptions{
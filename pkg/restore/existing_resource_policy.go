@@ -0,0 +1,213 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/pkg/errors"
+	"github.com/sirupsen/logrus"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/jsonmergepatch"
+	"k8s.io/apimachinery/pkg/util/strategicpatch"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/client"
+	"github.com/vmware-tanzu/velero/pkg/discovery"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+	"github.com/vmware-tanzu/velero/pkg/util/kube"
+)
+
+// IsExistingResourcePolicyValid returns true if the policy is one of the
+// supported existing resource policies.
+func IsExistingResourcePolicyValid(policy velerov1api.PolicyType) bool {
+	switch policy {
+	case velerov1api.PolicyTypeNone, velerov1api.PolicyTypeUpdate, velerov1api.PolicyTypeRecreate, velerov1api.PolicyTypeMerge:
+		return true
+	}
+	return false
+}
+
+// ValidateExistingResourcePolicies checks the existing resource policy of the
+// restore spec, and the per-resource overrides of it.
+func ValidateExistingResourcePolicies(spec *velerov1api.RestoreSpec) error {
+	if spec.ExistingResourcePolicy != "" && !IsExistingResourcePolicyValid(spec.ExistingResourcePolicy) {
+		return errors.Errorf("invalid existing resource policy %q", spec.ExistingResourcePolicy)
+	}
+
+	resources := make([]string, 0, len(spec.ExistingResourcePolicyOverrides))
+	for resource := range spec.ExistingResourcePolicyOverrides {
+		resources = append(resources, resource)
+	}
+	sort.Strings(resources)
+
+	for _, resource := range resources {
+		if policy := spec.ExistingResourcePolicyOverrides[resource]; !IsExistingResourcePolicyValid(policy) {
+			return errors.Errorf("invalid existing resource policy %q for resource %q", policy, resource)
+		}
+	}
+	return nil
+}
+
+// resolveExistingResourcePolicyOverrides resolves the resource names of the
+// existing resource policy overrides to group resources via discovery. Names
+// which can't be resolved are used as they are.
+func resolveExistingResourcePolicyOverrides(helper discovery.Helper, overrides map[string]velerov1api.PolicyType, log logrus.FieldLogger) map[schema.GroupResource]velerov1api.PolicyType {
+	resolved := make(map[schema.GroupResource]velerov1api.PolicyType, len(overrides))
+	for resource, policy := range overrides {
+		groupResource := schema.ParseGroupResource(resource)
+		if gvr, _, err := helper.ResourceFor(groupResource.WithVersion("")); err == nil {
+			groupResource = gvr.GroupResource()
+		} else {
+			log.WithField("resource", resource).Warnf("Unable to resolve resource of existing resource policy override: %v", err)
+		}
+		resolved[groupResource] = policy
+	}
+	return resolved
+}
+
+// notRecreatedByDefault are the resources the recreate existing resource policy
+// of a restore doesn't apply to, since deleting them deletes the data of volumes
+// or everything in a namespace. They're updated instead, unless an override of
+// the policy for the resource asks for recreating them.
+var notRecreatedByDefault = map[schema.GroupResource]bool{
+	kuberesource.Namespaces:             true,
+	kuberesource.PersistentVolumes:      true,
+	kuberesource.PersistentVolumeClaims: true,
+}
+
+// existingResourcePolicy returns the existing resource policy which applies to
+// the group resource.
+func (ctx *restoreContext) existingResourcePolicy(groupResource schema.GroupResource) velerov1api.PolicyType {
+	if policy, ok := ctx.existingResourcePolicyOverrides[groupResource]; ok {
+		return policy
+	}
+	if ctx.restore.Spec.ExistingResourcePolicy == velerov1api.PolicyTypeRecreate && notRecreatedByDefault[groupResource] {
+		return velerov1api.PolicyTypeUpdate
+	}
+	return ctx.restore.Spec.ExistingResourcePolicy
+}
+
+// recreateResource deletes the in-cluster version of obj if it's different from
+// obj, waits for it to be gone, and creates obj instead. It returns the created
+// resource, which is nil if the in-cluster version is the same as obj, and
+// whether the in-cluster version was deleted, so that the caller can tell
+// whether it's left as it was when an error is returned. The in-cluster version
+// is recorded for the rollback of the restore before it's deleted, so it's
+// created again by the rollback even if obj can't be created.
+func (ctx *restoreContext) recreateResource(groupResource schema.GroupResource, fromCluster, obj *unstructured.Unstructured, resourceClient client.Dynamic) (*unstructured.Unstructured, bool, error) {
+	inCluster, err := resetMetadataAndStatus(fromCluster.DeepCopy())
+	if err != nil {
+		return nil, false, err
+	}
+	labels := obj.GetLabels()
+	addRestoreLabels(inCluster, labels[velerov1api.RestoreNameLabel], labels[velerov1api.BackupNameLabel])
+	if equality.Semantic.DeepEqual(inCluster, obj) {
+		return nil, false, nil
+	}
+
+	ctx.log.Infof("attempting to recreate %s %s", obj.GroupVersionKind().Kind, kube.NamespaceAndName(obj))
+
+	ctx.recordUpdated(groupResource, obj, fromCluster)
+
+	uid := fromCluster.GetUID()
+	if err := resourceClient.Delete(obj.GetName(), metav1.DeleteOptions{
+		Preconditions: &metav1.Preconditions{UID: &uid},
+	}); err != nil && !apierrors.IsNotFound(err) {
+		return nil, false, errors.Wrapf(err, "error deleting %s %s to recreate it", obj.GetKind(), kube.NamespaceAndName(obj))
+	}
+
+	err = wait.PollImmediate(time.Second, ctx.resourceTerminatingTimeout, func() (bool, error) {
+		current, err := resourceClient.Get(obj.GetName(), metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		if err != nil {
+			return false, err
+		}
+		if current.GetUID() != uid {
+			return false, errors.Errorf("%s %s was created again by another client", obj.GetKind(), kube.NamespaceAndName(obj))
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, true, errors.Wrapf(err, "error waiting for %s %s to terminate", obj.GetKind(), kube.NamespaceAndName(obj))
+	}
+
+	created, err := resourceClient.Create(obj)
+	if err != nil {
+		return nil, true, errors.Wrapf(err, "error creating %s %s again", obj.GetKind(), kube.NamespaceAndName(obj))
+	}
+
+	ctx.log.Infof("%s %s successfully recreated", obj.GroupVersionKind().Kind, kube.NamespaceAndName(obj))
+	return created, true, nil
+}
+
+// mergeResource returns the result of a three-way merge of the backed-up
+// version of a resource onto its in-cluster version. The backed-up version is
+// used as the original and the modified configuration, so fields which are
+// only set in the cluster are kept. Built-in kinds are merged with a strategic
+// merge, so that lists such as containers are merged by key, and other kinds
+// with a JSON merge.
+func mergeResource(fromCluster, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	backedUp, err := json.Marshal(obj.Object)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to marshal backed-up object")
+	}
+	current, err := json.Marshal(fromCluster.Object)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to marshal in-cluster object")
+	}
+
+	var merged []byte
+	if typed, err := scheme.Scheme.New(obj.GroupVersionKind()); err == nil {
+		patchMeta, err := strategicpatch.NewPatchMetaFromStruct(typed)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to get strategic merge patch metadata")
+		}
+		patch, err := strategicpatch.CreateThreeWayMergePatch(backedUp, backedUp, current, patchMeta, true)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to create strategic merge patch")
+		}
+		if merged, err = strategicpatch.StrategicMergePatchUsingLookupPatchMeta(current, patch, patchMeta); err != nil {
+			return nil, errors.Wrap(err, "unable to apply strategic merge patch")
+		}
+	} else {
+		patch, err := jsonmergepatch.CreateThreeWayJSONMergePatch(backedUp, backedUp, current)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to create merge patch")
+		}
+		if merged, err = jsonpatch.MergePatch(current, patch); err != nil {
+			return nil, errors.Wrap(err, "unable to apply merge patch")
+		}
+	}
+
+	res := new(unstructured.Unstructured)
+	if err := res.UnmarshalJSON(merged); err != nil {
+		return nil, errors.Wrap(err, "unable to unmarshal merged object")
+	}
+	return res, nil
+}
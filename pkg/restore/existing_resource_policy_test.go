@@ -0,0 +1,221 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+	"github.com/vmware-tanzu/velero/pkg/kuberesource"
+)
+
+func TestIsExistingResourcePolicyValid(t *testing.T) {
+	require.True(t, IsExistingResourcePolicyValid(velerov1api.PolicyTypeNone))
+	require.True(t, IsExistingResourcePolicyValid(velerov1api.PolicyTypeUpdate))
+	require.True(t, IsExistingResourcePolicyValid(velerov1api.PolicyTypeRecreate))
+	require.True(t, IsExistingResourcePolicyValid(velerov1api.PolicyTypeMerge))
+	require.False(t, IsExistingResourcePolicyValid(""))
+}
+
+func TestExistingResourcePolicy(t *testing.T) {
+	tests := []struct {
+		name          string
+		policy        velerov1api.PolicyType
+		overrides     map[schema.GroupResource]velerov1api.PolicyType
+		groupResource schema.GroupResource
+		want          velerov1api.PolicyType
+	}{
+		{
+			name:          "policy of the restore",
+			policy:        velerov1api.PolicyTypeRecreate,
+			groupResource: kuberesource.Secrets,
+			want:          velerov1api.PolicyTypeRecreate,
+		},
+		{
+			name:          "override of the resource",
+			policy:        velerov1api.PolicyTypeUpdate,
+			overrides:     map[schema.GroupResource]velerov1api.PolicyType{kuberesource.Secrets: velerov1api.PolicyTypeNone},
+			groupResource: kuberesource.Secrets,
+			want:          velerov1api.PolicyTypeNone,
+		},
+		{
+			name:          "persistent volume claims are updated instead of recreated",
+			policy:        velerov1api.PolicyTypeRecreate,
+			groupResource: kuberesource.PersistentVolumeClaims,
+			want:          velerov1api.PolicyTypeUpdate,
+		},
+		{
+			name:          "persistent volumes are updated instead of recreated",
+			policy:        velerov1api.PolicyTypeRecreate,
+			groupResource: kuberesource.PersistentVolumes,
+			want:          velerov1api.PolicyTypeUpdate,
+		},
+		{
+			name:          "namespaces are updated instead of recreated",
+			policy:        velerov1api.PolicyTypeRecreate,
+			groupResource: kuberesource.Namespaces,
+			want:          velerov1api.PolicyTypeUpdate,
+		},
+		{
+			name:          "override recreating persistent volume claims",
+			policy:        velerov1api.PolicyTypeRecreate,
+			overrides:     map[schema.GroupResource]velerov1api.PolicyType{kuberesource.PersistentVolumeClaims: velerov1api.PolicyTypeRecreate},
+			groupResource: kuberesource.PersistentVolumeClaims,
+			want:          velerov1api.PolicyTypeRecreate,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := &restoreContext{
+				restore:                         builder.ForRestore("velero", "restore-1").ExistingResourcePolicy(string(tc.policy)).Result(),
+				existingResourcePolicyOverrides: tc.overrides,
+			}
+			assert.Equal(t, tc.want, ctx.existingResourcePolicy(tc.groupResource))
+		})
+	}
+}
+
+func TestValidateExistingResourcePolicies(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    velerov1api.RestoreSpec
+		wantErr string
+	}{
+		{
+			name: "no policy",
+		},
+		{
+			name: "valid policy and overrides",
+			spec: velerov1api.RestoreSpec{
+				ExistingResourcePolicy: velerov1api.PolicyTypeUpdate,
+				ExistingResourcePolicyOverrides: map[string]velerov1api.PolicyType{
+					"deployments.apps": velerov1api.PolicyTypeRecreate,
+					"configmaps":       velerov1api.PolicyTypeMerge,
+					"secrets":          velerov1api.PolicyTypeNone,
+				},
+			},
+		},
+		{
+			name:    "invalid policy",
+			spec:    velerov1api.RestoreSpec{ExistingResourcePolicy: "replace"},
+			wantErr: `invalid existing resource policy "replace"`,
+		},
+		{
+			name: "invalid override",
+			spec: velerov1api.RestoreSpec{
+				ExistingResourcePolicyOverrides: map[string]velerov1api.PolicyType{
+					"secrets": "",
+				},
+			},
+			wantErr: `invalid existing resource policy "" for resource "secrets"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateExistingResourcePolicies(&tc.spec)
+			if tc.wantErr != "" {
+				assert.EqualError(t, err, tc.wantErr)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMergeResource(t *testing.T) {
+	tests := []struct {
+		name        string
+		fromCluster map[string]interface{}
+		obj         map[string]interface{}
+		want        map[string]interface{}
+	}{
+		{
+			name: "built-in kind lists are merged by key and in-cluster only fields are kept",
+			fromCluster: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"metadata":   map[string]interface{}{"name": "pod-1", "namespace": "ns-1", "labels": map[string]interface{}{"a": "1"}},
+				"spec": map[string]interface{}{
+					"nodeName": "node-1",
+					"containers": []interface{}{
+						map[string]interface{}{"name": "app", "image": "app:v2"},
+						map[string]interface{}{"name": "sidecar", "image": "sidecar:v1"},
+					},
+				},
+			},
+			obj: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"metadata":   map[string]interface{}{"name": "pod-1", "namespace": "ns-1", "labels": map[string]interface{}{"b": "2"}},
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "app", "image": "app:v1"},
+					},
+				},
+			},
+			want: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "Pod",
+				"metadata":   map[string]interface{}{"name": "pod-1", "namespace": "ns-1", "labels": map[string]interface{}{"a": "1", "b": "2"}},
+				"spec": map[string]interface{}{
+					"nodeName": "node-1",
+					"containers": []interface{}{
+						map[string]interface{}{"name": "app", "image": "app:v1"},
+						map[string]interface{}{"name": "sidecar", "image": "sidecar:v1"},
+					},
+				},
+			},
+		},
+		{
+			name: "custom resources are merged as JSON",
+			fromCluster: map[string]interface{}{
+				"apiVersion": "example.io/v1",
+				"kind":       "Widget",
+				"metadata":   map[string]interface{}{"name": "widget-1"},
+				"spec":       map[string]interface{}{"size": int64(2), "color": "red", "parts": []interface{}{"a", "b"}},
+			},
+			obj: map[string]interface{}{
+				"apiVersion": "example.io/v1",
+				"kind":       "Widget",
+				"metadata":   map[string]interface{}{"name": "widget-1"},
+				"spec":       map[string]interface{}{"size": int64(1), "parts": []interface{}{"c"}},
+			},
+			want: map[string]interface{}{
+				"apiVersion": "example.io/v1",
+				"kind":       "Widget",
+				"metadata":   map[string]interface{}{"name": "widget-1"},
+				"spec":       map[string]interface{}{"size": int64(1), "color": "red", "parts": []interface{}{"c"}},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			merged, err := mergeResource(&unstructured.Unstructured{Object: tc.fromCluster}, &unstructured.Unstructured{Object: tc.obj})
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, merged.Object)
+		})
+	}
+}
@@ -49,10 +49,20 @@ func (a *InitRestoreHookPodAction) Execute(input *velero.RestoreItemActionExecut
 	a.logger.Infof("Executing InitRestoreHookPodAction")
 	// handle any init container restore hooks for the pod
 	restoreHooks, err := hook.GetRestoreHooksFromSpec(&input.Restore.Spec.Hooks)
-	nsMapping := input.Restore.Spec.NamespaceMapping
 	if err != nil {
 		return nil, errors.WithStack(err)
 	}
+	namespaceMapper, err := NewNamespaceMapper(&input.Restore.Spec)
+	if err != nil {
+		return nil, err
+	}
+	// the hook handler only needs the mapping of the pod's namespace
+	var nsMapping map[string]string
+	if namespace := (&unstructured.Unstructured{Object: input.Item.UnstructuredContent()}).GetNamespace(); namespace != "" {
+		if target, ok := namespaceMapper.Map(namespace); ok {
+			nsMapping = map[string]string{namespace: target}
+		}
+	}
 	hookHandler := hook.InitContainerRestoreHookHandler{}
 	postHooksItem, err := hookHandler.HandleRestoreHooks(a.logger, kuberesource.Pods, input.Item, restoreHooks, nsMapping)
 	if err != nil {
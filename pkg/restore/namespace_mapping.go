@@ -0,0 +1,146 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// NamespaceMapper maps source namespace names to the target namespace names
+// to restore into, using a restore's NamespaceMapping and
+// NamespaceMappingPatterns.
+type NamespaceMapper struct {
+	mapping  map[string]string
+	patterns []namespaceMappingPattern
+}
+
+type namespaceMappingPattern struct {
+	regex *regexp.Regexp
+	velerov1api.NamespaceMappingPattern
+}
+
+// NewNamespaceMapper returns a NamespaceMapper for the restore spec, or an
+// error if any of the spec's namespace mapping patterns is invalid.
+func NewNamespaceMapper(spec *velerov1api.RestoreSpec) (*NamespaceMapper, error) {
+	m := &NamespaceMapper{
+		mapping: spec.NamespaceMapping,
+	}
+
+	for i, pattern := range spec.NamespaceMappingPatterns {
+		if pattern.Replacement == "" && pattern.Prefix == "" && pattern.Suffix == "" {
+			return nil, errors.Errorf("namespace mapping pattern %d must have a replacement, a prefix or a suffix", i)
+		}
+		if pattern.Replacement != "" && pattern.Regex == "" {
+			return nil, errors.Errorf("namespace mapping pattern %d has a replacement but no regex", i)
+		}
+
+		p := namespaceMappingPattern{NamespaceMappingPattern: pattern}
+		if pattern.Regex != "" {
+			// the regex has to match the whole namespace name
+			regex, err := regexp.Compile("^(?:" + pattern.Regex + ")$")
+			if err != nil {
+				return nil, errors.Wrapf(err, "namespace mapping pattern %d has an invalid regex", i)
+			}
+			p.regex = regex
+		}
+		m.patterns = append(m.patterns, p)
+	}
+
+	return m, nil
+}
+
+// Empty returns true if the mapper doesn't map any namespace.
+func (m *NamespaceMapper) Empty() bool {
+	return len(m.mapping) == 0 && len(m.patterns) == 0
+}
+
+// Map returns the target namespace of the source namespace, and whether the
+// source namespace is mapped at all. Cluster-scoped items, with an empty
+// namespace, are never mapped.
+func (m *NamespaceMapper) Map(namespace string) (string, bool) {
+	if target, ok := m.mapping[namespace]; ok {
+		return target, true
+	}
+	if namespace == "" {
+		return "", false
+	}
+
+	if target, ok := m.mapByPattern(namespace); ok {
+		return target, true
+	}
+	return namespace, false
+}
+
+func (m *NamespaceMapper) mapByPattern(namespace string) (string, bool) {
+	for _, p := range m.patterns {
+		target := namespace
+		if p.regex != nil {
+			match := p.regex.FindStringSubmatchIndex(namespace)
+			if match == nil {
+				continue
+			}
+			if p.Replacement != "" {
+				target = string(p.regex.ExpandString(nil, p.Replacement, namespace, match))
+			}
+		}
+		return p.Prefix + target + p.Suffix, true
+	}
+	return "", false
+}
+
+// Validate checks the mapping of the source namespaces. It returns an error
+// if a namespace is mapped by a pattern to an invalid namespace name, or to
+// the same namespace as another source namespace. Namespaces mapped to each
+// other by NamespaceMapping only aren't checked, for compatibility.
+func (m *NamespaceMapper) Validate(namespaces []string) error {
+	sorted := append([]string(nil), namespaces...)
+	sort.Strings(sorted)
+
+	sources := make(map[string][]string)
+	byPattern := make(map[string]bool)
+	for _, namespace := range sorted {
+		target, ok := m.Map(namespace)
+		if _, explicit := m.mapping[namespace]; ok && !explicit {
+			if errs := validation.IsDNS1123Label(target); len(errs) > 0 {
+				return errors.Errorf("namespace %q would be restored into invalid namespace %q: %s", namespace, target, strings.Join(errs, ", "))
+			}
+			byPattern[target] = true
+		}
+		sources[target] = append(sources[target], namespace)
+	}
+
+	targets := make([]string, 0, len(sources))
+	for target := range sources {
+		targets = append(targets, target)
+	}
+	sort.Strings(targets)
+
+	for _, target := range targets {
+		if len(sources[target]) > 1 && byPattern[target] {
+			return errors.Errorf("namespaces %q would all be restored into namespace %q", sources[target], target)
+		}
+	}
+
+	return nil
+}
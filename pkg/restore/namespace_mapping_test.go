@@ -0,0 +1,173 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+func TestNewNamespaceMapper(t *testing.T) {
+	tests := []struct {
+		name        string
+		patterns    []velerov1api.NamespaceMappingPattern
+		expectedErr string
+	}{
+		{
+			name: "valid patterns",
+			patterns: []velerov1api.NamespaceMappingPattern{
+				{Regex: "app-(.*)", Replacement: "$1"},
+				{Regex: "db-.*", Prefix: "restored-"},
+				{Suffix: "-restored"},
+			},
+		},
+		{
+			name:        "pattern without replacement, prefix or suffix",
+			patterns:    []velerov1api.NamespaceMappingPattern{{Regex: "app-.*"}},
+			expectedErr: "namespace mapping pattern 0 must have a replacement, a prefix or a suffix",
+		},
+		{
+			name:        "replacement without regex",
+			patterns:    []velerov1api.NamespaceMappingPattern{{Suffix: "-1"}, {Replacement: "app"}},
+			expectedErr: "namespace mapping pattern 1 has a replacement but no regex",
+		},
+		{
+			name:        "invalid regex",
+			patterns:    []velerov1api.NamespaceMappingPattern{{Regex: "app-(", Replacement: "app"}},
+			expectedErr: "namespace mapping pattern 0 has an invalid regex",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewNamespaceMapper(&velerov1api.RestoreSpec{NamespaceMappingPatterns: tc.patterns})
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
+
+func TestNamespaceMapperMap(t *testing.T) {
+	mapper, err := NewNamespaceMapper(&velerov1api.RestoreSpec{
+		NamespaceMapping: map[string]string{"app-1": "app-one"},
+		NamespaceMappingPatterns: []velerov1api.NamespaceMappingPattern{
+			{Regex: "app-(.*)", Replacement: "$1-app", Prefix: "new-"},
+			{Regex: "app", Replacement: "never-matched"},
+			{Regex: "db-.*", Suffix: "-restored"},
+		},
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		namespace      string
+		expected       string
+		expectedMapped bool
+	}{
+		{namespace: "app-1", expected: "app-one", expectedMapped: true},
+		{namespace: "app-2", expected: "new-2-app", expectedMapped: true},
+		{namespace: "db-1", expected: "db-1-restored", expectedMapped: true},
+		{namespace: "my-app", expected: "my-app"},
+		{namespace: "mydb-1", expected: "mydb-1"},
+		{namespace: "", expected: ""},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.namespace, func(t *testing.T) {
+			actual, mapped := mapper.Map(tc.namespace)
+			assert.Equal(t, tc.expected, actual)
+			assert.Equal(t, tc.expectedMapped, mapped)
+		})
+	}
+}
+
+func TestNamespaceMapperValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		spec        velerov1api.RestoreSpec
+		namespaces  []string
+		expectedErr string
+	}{
+		{
+			name: "no collisions",
+			spec: velerov1api.RestoreSpec{
+				NamespaceMappingPatterns: []velerov1api.NamespaceMappingPattern{{Prefix: "restored-"}},
+			},
+			namespaces: []string{"ns-1", "ns-2"},
+		},
+		{
+			name: "namespace mappings mapping two namespaces to the same namespace are allowed",
+			spec: velerov1api.RestoreSpec{
+				NamespaceMapping: map[string]string{"ns-1": "ns", "ns-2": "ns"},
+			},
+			namespaces: []string{"ns-1", "ns-2"},
+		},
+		{
+			name: "pattern mapping two namespaces to the same namespace",
+			spec: velerov1api.RestoreSpec{
+				NamespaceMappingPatterns: []velerov1api.NamespaceMappingPattern{{Regex: "(ns)-.*", Replacement: "$1"}},
+			},
+			namespaces:  []string{"ns-2", "ns-1", "other"},
+			expectedErr: `namespaces ["ns-1" "ns-2"] would all be restored into namespace "ns"`,
+		},
+		{
+			name: "pattern mapping a namespace to an unmapped namespace",
+			spec: velerov1api.RestoreSpec{
+				NamespaceMappingPatterns: []velerov1api.NamespaceMappingPattern{{Regex: "ns", Prefix: "app-"}},
+			},
+			namespaces:  []string{"ns", "app-ns"},
+			expectedErr: `namespaces ["app-ns" "ns"] would all be restored into namespace "app-ns"`,
+		},
+		{
+			name: "pattern mapping a namespace to a namespace of a namespace mapping",
+			spec: velerov1api.RestoreSpec{
+				NamespaceMapping:         map[string]string{"ns-1": "ns-1-restored"},
+				NamespaceMappingPatterns: []velerov1api.NamespaceMappingPattern{{Regex: "ns-2", Replacement: "ns-1-restored"}},
+			},
+			namespaces:  []string{"ns-1", "ns-2"},
+			expectedErr: `namespaces ["ns-1" "ns-2"] would all be restored into namespace "ns-1-restored"`,
+		},
+		{
+			name: "pattern mapping a namespace to an invalid namespace name",
+			spec: velerov1api.RestoreSpec{
+				NamespaceMappingPatterns: []velerov1api.NamespaceMappingPattern{{Suffix: "_restored"}},
+			},
+			namespaces:  []string{"ns-1"},
+			expectedErr: `namespace "ns-1" would be restored into invalid namespace "ns-1_restored"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mapper, err := NewNamespaceMapper(&tc.spec)
+			require.NoError(t, err)
+
+			err = mapper.Validate(tc.namespaces)
+			if tc.expectedErr == "" {
+				assert.NoError(t, err)
+			} else {
+				assert.ErrorContains(t, err, tc.expectedErr)
+			}
+		})
+	}
+}
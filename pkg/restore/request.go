@@ -20,15 +20,20 @@ import (
 	"fmt"
 	"io"
 	"sort"
+	"sync"
 
 	snapshotv1api "github.com/kubernetes-csi/external-snapshotter/client/v4/apis/volumesnapshot/v1"
 	"github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/runtime"
 
+	"github.com/vmware-tanzu/velero/internal/hook"
 	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
+	"github.com/vmware-tanzu/velero/internal/restorepreview"
+	"github.com/vmware-tanzu/velero/internal/restorerollback"
 	internalVolume "github.com/vmware-tanzu/velero/internal/volume"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/itemoperation"
+	"github.com/vmware-tanzu/velero/pkg/util/boolptr"
 	"github.com/vmware-tanzu/velero/pkg/volume"
 )
 
@@ -60,10 +65,22 @@ type Request struct {
 	BackupReader         io.Reader
 	RestoredItems        map[itemKey]restoredItemStatus
 	itemOperationsList   *[]*itemoperation.RestoreOperation
+	hookTracker          *hook.HookTracker
+	preview              *restorepreview.Preview
+	rollbackRecorder     *restorerollback.Recorder
 	ResourceModifiers    *resourcemodifiers.ResourceModifiers
 	DisableInformerCache bool
 	CSIVolumeSnapshots   []*snapshotv1api.VolumeSnapshot
 	VolumeInfoMap        map[string]internalVolume.VolumeInfo
+
+	// lock guards cancelled, which is closed when the cancellation of the
+	// restore is requested, and the checkpoint state.
+	lock      sync.Mutex
+	cancelled chan struct{}
+	// checkpointer returns the progress of the running restore, see Checkpoint.
+	checkpointer func() (*Checkpoint, []*itemoperation.RestoreOperation)
+	// resumed is set when the restore resumes an interrupted run, see Resume.
+	resumed *resumeState
 }
 
 type restoredItemStatus struct {
@@ -71,6 +88,12 @@ type restoredItemStatus struct {
 	itemExists bool
 }
 
+// restoredItemClaim records which worker is restoring an item.
+type restoredItemClaim struct {
+	worker int
+	done   chan struct{}
+}
+
 // GetItemOperationsList returns ItemOperationsList, initializing it if necessary
 func (r *Request) GetItemOperationsList() *[]*itemoperation.RestoreOperation {
 	if r.itemOperationsList == nil {
@@ -80,6 +103,73 @@ func (r *Request) GetItemOperationsList() *[]*itemoperation.RestoreOperation {
 	return r.itemOperationsList
 }
 
+// GetHookTracker returns the tracker of the restore's hooks, initializing it if necessary
+func (r *Request) GetHookTracker() *hook.HookTracker {
+	if r.hookTracker == nil {
+		r.hookTracker = hook.NewHookTracker()
+	}
+	return r.hookTracker
+}
+
+// GetPreview returns the preview of the restore, initializing it if necessary,
+// or nil if the restore isn't a preview
+func (r *Request) GetPreview() *restorepreview.Preview {
+	if !boolptr.IsSetToTrue(r.Restore.Spec.Preview) {
+		return nil
+	}
+	if r.preview == nil {
+		r.preview = restorepreview.New()
+	}
+	return r.preview
+}
+
+// GetRollbackRecorder returns the recorder of what the restore does to the
+// items, which is needed to roll it back, initializing it if necessary
+func (r *Request) GetRollbackRecorder() *restorerollback.Recorder {
+	if r.rollbackRecorder == nil {
+		r.rollbackRecorder = restorerollback.NewRecorder()
+	}
+	return r.rollbackRecorder
+}
+
+// Cancelled returns a channel which is closed when the cancellation of the
+// restore is requested.
+func (r *Request) Cancelled() <-chan struct{} {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if r.cancelled == nil {
+		r.cancelled = make(chan struct{})
+	}
+	return r.cancelled
+}
+
+// Cancel requests the cancellation of the restore: the items which haven't
+// been restored yet are skipped, and the restore stops waiting for its pod
+// volume restores and post-restore hooks.
+func (r *Request) Cancel() {
+	cancelled := r.Cancelled()
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	select {
+	case <-cancelled:
+	default:
+		close(r.cancelled)
+	}
+}
+
+// IsCancelled returns true if the cancellation of the restore was requested.
+func (r *Request) IsCancelled() bool {
+	select {
+	case <-r.Cancelled():
+		return true
+	default:
+		return false
+	}
+}
+
 // RestoredResourceList returns the list of restored resources grouped by the API
 // Version and Kind
 func (r *Request) RestoredResourceList() map[string][]string {
@@ -65,3 +65,15 @@ func TestRestoredResourceList(t *testing.T) {
 
 	assert.EqualValues(t, expected, request.RestoredResourceList())
 }
+
+func TestRequestCancel(t *testing.T) {
+	req := &Request{}
+	assert.False(t, req.IsCancelled())
+
+	cancelled := req.Cancelled()
+	req.Cancel()
+	req.Cancel()
+	assert.True(t, req.IsCancelled())
+	_, open := <-cancelled
+	assert.False(t, open)
+}
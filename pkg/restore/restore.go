@@ -41,6 +41,7 @@ import (
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
 	kubeerrs "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -52,6 +53,8 @@ import (
 	"github.com/vmware-tanzu/velero/internal/credentials"
 	"github.com/vmware-tanzu/velero/internal/hook"
 	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
+	"github.com/vmware-tanzu/velero/internal/restorepreview"
+	"github.com/vmware-tanzu/velero/internal/restorerollback"
 	internalVolume "github.com/vmware-tanzu/velero/internal/volume"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/archive"
@@ -112,10 +115,12 @@ type kubernetesRestorer struct {
 	pvRenamer                  func(string) (string, error)
 	logger                     logrus.FieldLogger
 	podCommandExecutor         podexec.PodCommandExecutor
+	podHTTPExecutor            podexec.PodHTTPExecutor
 	podGetter                  cache.Getter
 	credentialFileStore        credentials.FileStore
 	kbClient                   crclient.Client
 	featureVerifier            features.Verifier
+	itemRestoreWorkers         int
 }
 
 // NewKubernetesRestorer creates a new kubernetesRestorer.
@@ -130,10 +135,12 @@ func NewKubernetesRestorer(
 	resourceTimeout time.Duration,
 	logger logrus.FieldLogger,
 	podCommandExecutor podexec.PodCommandExecutor,
+	podHTTPExecutor podexec.PodHTTPExecutor,
 	podGetter cache.Getter,
 	credentialStore credentials.FileStore,
 	kbClient crclient.Client,
 	featureVerifier features.Verifier,
+	itemRestoreWorkers int,
 ) (Restorer, error) {
 	return &kubernetesRestorer{
 		discoveryHelper:            discoveryHelper,
@@ -155,10 +162,12 @@ func NewKubernetesRestorer(
 		},
 		fileSystem:          filesystem.NewFileSystem(),
 		podCommandExecutor:  podCommandExecutor,
+		podHTTPExecutor:     podHTTPExecutor,
 		podGetter:           podGetter,
 		credentialFileStore: credentialStore,
 		kbClient:            kbClient,
 		featureVerifier:     featureVerifier,
+		itemRestoreWorkers:  itemRestoreWorkers,
 	}, nil
 }
 
@@ -227,6 +236,11 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		Includes(req.Restore.Spec.IncludedNamespaces...).
 		Excludes(req.Restore.Spec.ExcludedNamespaces...)
 
+	namespaceMapper, err := NewNamespaceMapper(&req.Restore.Spec)
+	if err != nil {
+		return results.Result{}, results.Result{Velero: []string{err.Error()}}
+	}
+
 	resolvedActions, err := restoreItemActionResolver.ResolveActions(kr.discoveryHelper, kr.logger)
 	if err != nil {
 		return results.Result{}, results.Result{Velero: []string{err.Error()}}
@@ -245,8 +259,10 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		}
 	}
 
-	ctx, cancelFunc := go_context.WithTimeout(go_context.Background(), podVolumeTimeout)
-	defer cancelFunc()
+	timeoutCtx, timeoutCancelFunc := go_context.WithTimeout(go_context.Background(), podVolumeTimeout)
+	defer timeoutCancelFunc()
+	ctx, cancelFunc := go_context.WithCancelCause(timeoutCtx)
+	defer cancelFunc(nil)
 
 	var podVolumeRestorer podvolume.Restorer
 	if kr.podVolumeRestorerFactory != nil {
@@ -261,8 +277,20 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 		return results.Result{}, results.Result{Velero: []string{err.Error()}}
 	}
 	hooksCtx, hooksCancelFunc := go_context.WithCancel(go_context.Background())
+
+	// stop waiting for the pod volume restores and the post-restore hooks
+	// when the restore is cancelled
+	go func() {
+		select {
+		case <-req.Cancelled():
+			cancelFunc(podvolume.ErrCancelled)
+			hooksCancelFunc()
+		case <-ctx.Done():
+		}
+	}()
 	waitExecHookHandler := &hook.DefaultWaitExecHookHandler{
 		PodCommandExecutor: kr.podCommandExecutor,
+		PodHTTPExecutor:    kr.podHTTPExecutor,
 		ListWatchFactory: &hook.DefaultListWatchFactory{
 			PodsGetter: kr.podGetter,
 		},
@@ -282,102 +310,148 @@ func (kr *kubernetesRestorer) RestoreWithResolvers(
 	req.RestoredItems = make(map[itemKey]restoredItemStatus)
 
 	restoreCtx := &restoreContext{
-		backup:                         req.Backup,
-		backupReader:                   req.BackupReader,
-		restore:                        req.Restore,
-		resourceIncludesExcludes:       resourceIncludesExcludes,
-		resourceStatusIncludesExcludes: restoreStatusIncludesExcludes,
-		namespaceIncludesExcludes:      namespaceIncludesExcludes,
-		resourceMustHave:               sets.NewString(resourceMustHave...),
-		chosenGrpVersToRestore:         make(map[string]ChosenGroupVersion),
-		selector:                       selector,
-		OrSelectors:                    OrSelectors,
-		log:                            req.Log,
-		dynamicFactory:                 kr.dynamicFactory,
-		fileSystem:                     kr.fileSystem,
-		namespaceClient:                kr.namespaceClient,
-		restoreItemActions:             resolvedActions,
-		volumeSnapshotterGetter:        volumeSnapshotterGetter,
-		podVolumeRestorer:              podVolumeRestorer,
-		podVolumeErrs:                  make(chan error),
-		pvsToProvision:                 sets.NewString(),
-		pvRestorer:                     pvRestorer,
-		volumeSnapshots:                req.VolumeSnapshots,
-		csiVolumeSnapshots:             req.CSIVolumeSnapshots,
-		podVolumeBackups:               req.PodVolumeBackups,
-		resourceTerminatingTimeout:     kr.resourceTerminatingTimeout,
-		resourceTimeout:                kr.resourceTimeout,
-		resourceClients:                make(map[resourceClientKey]client.Dynamic),
-		restoredItems:                  req.RestoredItems,
-		renamedPVs:                     make(map[string]string),
-		pvRenamer:                      kr.pvRenamer,
-		discoveryHelper:                kr.discoveryHelper,
-		resourcePriorities:             kr.resourcePriorities,
-		resourceRestoreHooks:           resourceRestoreHooks,
-		hooksErrs:                      make(chan hook.HookErrInfo),
-		waitExecHookHandler:            waitExecHookHandler,
-		hooksContext:                   hooksCtx,
-		hooksCancelFunc:                hooksCancelFunc,
-		kbClient:                       kr.kbClient,
-		itemOperationsList:             req.GetItemOperationsList(),
-		resourceModifiers:              req.ResourceModifiers,
-		disableInformerCache:           req.DisableInformerCache,
-		featureVerifier:                kr.featureVerifier,
-		hookTracker:                    hook.NewHookTracker(),
-		volumeInfoMap:                  req.VolumeInfoMap,
+		backup:                          req.Backup,
+		backupReader:                    req.BackupReader,
+		restore:                         req.Restore,
+		resourceIncludesExcludes:        resourceIncludesExcludes,
+		resourceStatusIncludesExcludes:  restoreStatusIncludesExcludes,
+		namespaceIncludesExcludes:       namespaceIncludesExcludes,
+		namespaceMapper:                 namespaceMapper,
+		existingResourcePolicyOverrides: resolveExistingResourcePolicyOverrides(kr.discoveryHelper, req.Restore.Spec.ExistingResourcePolicyOverrides, req.Log),
+		resourceMustHave:                sets.NewString(resourceMustHave...),
+		chosenGrpVersToRestore:          make(map[string]ChosenGroupVersion),
+		selector:                        selector,
+		OrSelectors:                     OrSelectors,
+		log:                             req.Log,
+		dynamicFactory:                  kr.dynamicFactory,
+		fileSystem:                      kr.fileSystem,
+		namespaceClient:                 kr.namespaceClient,
+		restoreItemActions:              resolvedActions,
+		volumeSnapshotterGetter:         volumeSnapshotterGetter,
+		podVolumeRestorer:               podVolumeRestorer,
+		podVolumeErrs:                   make(chan error),
+		pvsToProvision:                  sets.NewString(),
+		pvRestorer:                      pvRestorer,
+		volumeSnapshots:                 req.VolumeSnapshots,
+		csiVolumeSnapshots:              req.CSIVolumeSnapshots,
+		podVolumeBackups:                req.PodVolumeBackups,
+		resourceTerminatingTimeout:      kr.resourceTerminatingTimeout,
+		resourceTimeout:                 kr.resourceTimeout,
+		resourceClients:                 make(map[resourceClientKey]client.Dynamic),
+		restoredItems:                   req.RestoredItems,
+		renamedPVs:                      make(map[string]string),
+		pvRenamer:                       kr.pvRenamer,
+		discoveryHelper:                 kr.discoveryHelper,
+		resourcePriorities:              kr.resourcePriorities,
+		resourceRestoreHooks:            resourceRestoreHooks,
+		hooksErrs:                       make(chan hook.HookErrInfo),
+		waitExecHookHandler:             waitExecHookHandler,
+		hooksContext:                    hooksCtx,
+		hooksCancelFunc:                 hooksCancelFunc,
+		kbClient:                        kr.kbClient,
+		itemOperationsList:              req.GetItemOperationsList(),
+		resourceModifiers:               req.ResourceModifiers,
+		disableInformerCache:            req.DisableInformerCache,
+		featureVerifier:                 kr.featureVerifier,
+		hookTracker:                     req.GetHookTracker(),
+		preview:                         req.GetPreview(),
+		rollbackRecorder:                req.GetRollbackRecorder(),
+		itemRestoreWorkers:              kr.itemRestoreWorkers,
+		volumeInfoMap:                   req.VolumeInfoMap,
+		cancelled:                       req.Cancelled(),
+		podVolumeContext:                ctx,
+		finishedItems:                   make(map[itemKey]bool),
+		itemOperationIDs:                make(map[itemKey][]string),
+		inProgressItems:                 make(map[itemKey]*restoredItemClaim),
+		waitingWorkers:                  make(map[int]int),
+	}
+
+	req.lock.Lock()
+	resumed := req.resumed
+	req.checkpointer = restoreCtx.checkpoint
+	req.lock.Unlock()
+	if resumed != nil {
+		restoreCtx.resume(resumed)
 	}
 
 	return restoreCtx.execute()
 }
 
 type restoreContext struct {
-	backup                         *velerov1api.Backup
-	backupReader                   io.Reader
-	restore                        *velerov1api.Restore
-	restoreDir                     string
-	resourceIncludesExcludes       *collections.IncludesExcludes
-	resourceStatusIncludesExcludes *collections.IncludesExcludes
-	namespaceIncludesExcludes      *collections.IncludesExcludes
-	resourceMustHave               sets.String
-	chosenGrpVersToRestore         map[string]ChosenGroupVersion
-	selector                       labels.Selector
-	OrSelectors                    []labels.Selector
-	log                            logrus.FieldLogger
-	dynamicFactory                 client.DynamicFactory
-	fileSystem                     filesystem.Interface
-	namespaceClient                corev1.NamespaceInterface
-	restoreItemActions             []framework.RestoreItemResolvedActionV2
-	volumeSnapshotterGetter        VolumeSnapshotterGetter
-	podVolumeRestorer              podvolume.Restorer
-	podVolumeWaitGroup             sync.WaitGroup
-	podVolumeErrs                  chan error
-	pvsToProvision                 sets.String
-	pvRestorer                     PVRestorer
-	volumeSnapshots                []*volume.Snapshot
-	csiVolumeSnapshots             []*snapshotv1api.VolumeSnapshot
-	podVolumeBackups               []*velerov1api.PodVolumeBackup
-	resourceTerminatingTimeout     time.Duration
-	resourceTimeout                time.Duration
-	resourceClients                map[resourceClientKey]client.Dynamic
-	dynamicInformerFactory         *informerFactoryWithContext
-	restoredItems                  map[itemKey]restoredItemStatus
-	renamedPVs                     map[string]string
-	pvRenamer                      func(string) (string, error)
-	discoveryHelper                discovery.Helper
-	resourcePriorities             Priorities
-	hooksWaitGroup                 sync.WaitGroup
-	hooksErrs                      chan hook.HookErrInfo
-	resourceRestoreHooks           []hook.ResourceRestoreHook
-	waitExecHookHandler            hook.WaitExecHookHandler
-	hooksContext                   go_context.Context
-	hooksCancelFunc                go_context.CancelFunc
-	kbClient                       crclient.Client
-	itemOperationsList             *[]*itemoperation.RestoreOperation
-	resourceModifiers              *resourcemodifiers.ResourceModifiers
-	disableInformerCache           bool
-	featureVerifier                features.Verifier
-	hookTracker                    *hook.HookTracker
-	volumeInfoMap                  map[string]internalVolume.VolumeInfo
+	backup                          *velerov1api.Backup
+	backupReader                    io.Reader
+	restore                         *velerov1api.Restore
+	restoreDir                      string
+	resourceIncludesExcludes        *collections.IncludesExcludes
+	resourceStatusIncludesExcludes  *collections.IncludesExcludes
+	namespaceIncludesExcludes       *collections.IncludesExcludes
+	namespaceMapper                 *NamespaceMapper
+	existingResourcePolicyOverrides map[schema.GroupResource]velerov1api.PolicyType
+	resourceMustHave                sets.String
+	chosenGrpVersToRestore          map[string]ChosenGroupVersion
+	selector                        labels.Selector
+	OrSelectors                     []labels.Selector
+	log                             logrus.FieldLogger
+	dynamicFactory                  client.DynamicFactory
+	fileSystem                      filesystem.Interface
+	namespaceClient                 corev1.NamespaceInterface
+	restoreItemActions              []framework.RestoreItemResolvedActionV2
+	volumeSnapshotterGetter         VolumeSnapshotterGetter
+	podVolumeRestorer               podvolume.Restorer
+	podVolumeWaitGroup              sync.WaitGroup
+	podVolumeErrs                   chan error
+	pvsToProvision                  sets.String
+	pvRestorer                      PVRestorer
+	volumeSnapshots                 []*volume.Snapshot
+	csiVolumeSnapshots              []*snapshotv1api.VolumeSnapshot
+	podVolumeBackups                []*velerov1api.PodVolumeBackup
+	resourceTerminatingTimeout      time.Duration
+	resourceTimeout                 time.Duration
+	resourceClients                 map[resourceClientKey]client.Dynamic
+	dynamicInformerFactory          *informerFactoryWithContext
+	restoredItems                   map[itemKey]restoredItemStatus
+	renamedPVs                      map[string]string
+	pvRenamer                       func(string) (string, error)
+	discoveryHelper                 discovery.Helper
+	resourcePriorities              Priorities
+	hooksWaitGroup                  sync.WaitGroup
+	hooksErrs                       chan hook.HookErrInfo
+	resourceRestoreHooks            []hook.ResourceRestoreHook
+	waitExecHookHandler             hook.WaitExecHookHandler
+	hooksContext                    go_context.Context
+	hooksCancelFunc                 go_context.CancelFunc
+	kbClient                        crclient.Client
+	itemOperationsList              *[]*itemoperation.RestoreOperation
+	resourceModifiers               *resourcemodifiers.ResourceModifiers
+	disableInformerCache            bool
+	featureVerifier                 features.Verifier
+	hookTracker                     *hook.HookTracker
+	preview                         *restorepreview.Preview
+	rollbackRecorder                *restorerollback.Recorder
+	volumeInfoMap                   map[string]internalVolume.VolumeInfo
+	itemRestoreWorkers              int
+	cancelled                       <-chan struct{}
+	// podVolumeContext is done when the restore stops waiting for its pod volume restores.
+	podVolumeContext go_context.Context
+	// finishedItems are the restored items whose restore finished without
+	// warnings or errors, and
+	// itemOperationIDs the item operations started for each item, which are
+	// recorded in the checkpoint of the restore.
+	finishedItems    map[itemKey]bool
+	itemOperationIDs map[itemKey][]string
+	// resumed is true if the restore resumes an interrupted run.
+	resumed bool
+	// inProgressItems holds the items claimed by a worker and not yet restored,
+	// and waitingWorkers maps each worker waiting for an item to the worker
+	// restoring it.
+	inProgressItems map[itemKey]*restoredItemClaim
+	waitingWorkers  map[int]int
+
+	// lock guards the state updated while the items are restored, which may be
+	// done by several workers concurrently: restoredItems, resourceClients,
+	// pvsToProvision, renamedPVs, itemOperationsList, finishedItems,
+	// itemOperationIDs, inProgressItems and waitingWorkers.
+	lock sync.Mutex
 }
 
 type resourceClientKey struct {
@@ -391,6 +465,39 @@ type informerFactoryWithContext struct {
 	cancel  go_context.CancelFunc
 }
 
+// isCancelled returns true if the cancellation of the restore was requested.
+func (ctx *restoreContext) isCancelled() bool {
+	select {
+	case <-ctx.cancelled:
+		return true
+	default:
+		return false
+	}
+}
+
+// backupNamespaces returns the namespaces of the items in the backup which are
+// included in the restore.
+func (ctx *restoreContext) backupNamespaces(backupResources map[string]*archive.ResourceItems) []string {
+	namespaces := sets.NewString()
+	for _, resource := range backupResources {
+		for namespace, items := range resource.ItemsByNamespace {
+			if namespace == "" && resource.GroupResource == kuberesource.Namespaces.String() {
+				namespaces.Insert(items...)
+			} else if namespace != "" {
+				namespaces.Insert(namespace)
+			}
+		}
+	}
+
+	var included []string
+	for _, namespace := range namespaces.List() {
+		if ctx.namespaceIncludesExcludes.ShouldInclude(namespace) {
+			included = append(included, namespace)
+		}
+	}
+	return included
+}
+
 // getOrderedResources returns an ordered list of resource identifiers to restore,
 // based on the provided resource priorities and backup contents. The returned list
 // begins with all of the high prioritized resources (in order), ends with all of
@@ -472,6 +579,11 @@ func (ctx *restoreContext) execute() (results.Result, results.Result) {
 		return warnings, errs
 	}
 
+	if err := ctx.namespaceMapper.Validate(ctx.backupNamespaces(backupResources)); err != nil {
+		errs.AddVeleroError(errors.Wrap(err, "error mapping namespaces"))
+		return warnings, errs
+	}
+
 	// TODO: Remove outer feature flag check to make this feature a default in Velero.
 	if features.IsEnabled(velerov1api.APIGroupVersionsFeatureFlag) {
 		if ctx.backup.Status.FormatVersion >= "1.1.0" {
@@ -482,6 +594,10 @@ func (ctx *restoreContext) execute() (results.Result, results.Result) {
 		}
 	}
 
+	if ctx.resumed {
+		ctx.waitForExistingPodVolumeRestores()
+	}
+
 	update := make(chan progressUpdate)
 
 	quit := make(chan struct{})
@@ -536,6 +652,10 @@ func (ctx *restoreContext) execute() (results.Result, results.Result) {
 	}
 
 	for _, selectedResource := range crdResourceCollection {
+		if ctx.isCancelled() {
+			break
+		}
+
 		var w, e results.Result
 		// Restore this resource, the update channel is set to nil, to avoid misleading value of "totalItems"
 		// more details see #5990
@@ -613,6 +733,10 @@ func (ctx *restoreContext) execute() (results.Result, results.Result) {
 	}
 
 	for _, selectedResource := range selectedResourceCollection {
+		if ctx.isCancelled() {
+			break
+		}
+
 		var w, e results.Result
 		// Restore this resource
 		processedItems, w, e = ctx.processSelectedResource(
@@ -629,6 +753,10 @@ func (ctx *restoreContext) execute() (results.Result, results.Result) {
 	// Close the progress update channel.
 	quit <- struct{}{}
 
+	if ctx.isCancelled() {
+		ctx.log.Warn("Restore was cancelled, the remaining items were skipped")
+	}
+
 	// Clean the DataUploadResult ConfigMaps
 	defer func() {
 		opts := []crclient.DeleteAllOfOption{
@@ -650,8 +778,8 @@ func (ctx *restoreContext) execute() (results.Result, results.Result) {
 	if updated.Status.Progress == nil {
 		updated.Status.Progress = &velerov1api.RestoreProgress{}
 	}
-	updated.Status.Progress.TotalItems = len(ctx.restoredItems)
-	updated.Status.Progress.ItemsRestored = len(ctx.restoredItems)
+	updated.Status.Progress.TotalItems = ctx.restoredItemCount()
+	updated.Status.Progress.ItemsRestored = ctx.restoredItemCount()
 
 	// Wait for all of the pod volume restore goroutines to be done, which is
 	// only possible once all of their errors have been received by the loop
@@ -705,7 +833,10 @@ func (ctx *restoreContext) execute() (results.Result, results.Result) {
 
 // Process and restore one restoreableResource from the backup and update restore progress
 // metadata. At this point, the resource has already been validated and counted for inclusion
-// in the expected total restore count.
+// in the expected total restore count. The items are restored concurrently by the item restore
+// workers, except for persistent volumes and persistent volume claims, which are restored one at
+// a time. All of the items have been restored when processSelectedResource returns, so the
+// resources are still restored one after another in priority order.
 func (ctx *restoreContext) processSelectedResource(
 	selectedResource restoreableResource,
 	totalItems int,
@@ -716,17 +847,94 @@ func (ctx *restoreContext) processSelectedResource(
 	warnings, errs := results.Result{}, results.Result{}
 	groupResource := schema.ParseGroupResource(selectedResource.resource)
 
+	// resultLock guards warnings, errs and processedItems, which are updated by the workers
+	resultLock := new(sync.Mutex)
+
+	restoreItemFromFile := func(worker int, selectedItem restoreableItem, targetNS string) {
+		obj, err := archive.Unmarshal(ctx.fileSystem, selectedItem.path)
+		if err != nil {
+			resultLock.Lock()
+			defer resultLock.Unlock()
+			errs.Add(
+				selectedItem.targetNamespace,
+				fmt.Errorf(
+					"error decoding %q: %v",
+					strings.Replace(selectedItem.path, ctx.restoreDir+"/", "", -1),
+					err,
+				),
+			)
+			return
+		}
+
+		w, e, _ := ctx.restoreItem(worker, obj, groupResource, targetNS)
+
+		resultLock.Lock()
+		defer resultLock.Unlock()
+
+		warnings.Merge(&w)
+		errs.Merge(&e)
+		processedItems++
+
+		// totalItems keeps the count of items previously known. There
+		// may be additional items restored by plugins. We want to include
+		// the additional items by looking at restoredItems at the same
+		// time, we don't want previously known items counted twice as
+		// they are present in both restoredItems and totalItems.
+		restoredItems := ctx.restoredItemCount()
+		actualTotalItems := restoredItems + (totalItems - processedItems)
+		if update != nil {
+			update <- progressUpdate{
+				totalItems:    actualTotalItems,
+				itemsRestored: restoredItems,
+			}
+		}
+		ctx.log.WithFields(map[string]interface{}{
+			"progress":  "",
+			"resource":  groupResource.String(),
+			"namespace": selectedItem.targetNamespace,
+			"name":      selectedItem.name,
+		}).Infof("Restored %d items out of an estimated total of %d (estimate will change throughout the restore)", restoredItems, actualTotalItems)
+	}
+
+	workers := ctx.itemRestoreWorkers
+	if groupResource == kuberesource.PersistentVolumes || groupResource == kuberesource.PersistentVolumeClaims {
+		workers = 1
+	}
+	if workers > selectedResource.totalItems {
+		workers = selectedResource.totalItems
+	}
+
+	type workItem struct {
+		selectedItem restoreableItem
+		targetNS     string
+	}
+	var itemChan chan workItem
+	wg := new(sync.WaitGroup)
+	if workers > 1 {
+		ctx.log.Infof("Restoring %d items of resource %s with %d workers", selectedResource.totalItems, groupResource, workers)
+		itemChan = make(chan workItem)
+		for i := 0; i < workers; i++ {
+			wg.Add(1)
+			go func(worker int) {
+				defer wg.Done()
+				for item := range itemChan {
+					restoreItemFromFile(worker, item.selectedItem, item.targetNS)
+				}
+			}(i)
+		}
+	}
+
 	for namespace, selectedItems := range selectedResource.selectedItemsByNamespace {
 		for _, selectedItem := range selectedItems {
+			if ctx.isCancelled() {
+				break
+			}
+
 			targetNS := selectedItem.targetNamespace
 			if groupResource == kuberesource.Namespaces {
 				// namespace is a cluster-scoped resource and doesn't have "targetNamespace" attribute in the restoreableItem instance
 				namespace = selectedItem.name
-				if n, ok := ctx.restore.Spec.NamespaceMapping[namespace]; ok {
-					targetNS = n
-				} else {
-					targetNS = namespace
-				}
+				targetNS, _ = ctx.namespaceMapper.Map(namespace)
 			}
 			// If we don't know whether this namespace exists yet, attempt to create
 			// it in order to ensure it exists. Try to get it from the backup tarball
@@ -740,26 +948,13 @@ func (ctx *restoreContext) processSelectedResource(
 					archive.GetItemFilePath(ctx.restoreDir, "namespaces", "", namespace),
 					targetNS,
 				)
-				_, nsCreated, err := kube.EnsureNamespaceExistsAndIsReady(
-					ns,
-					ctx.namespaceClient,
-					ctx.resourceTerminatingTimeout,
-				)
-				if err != nil {
+				if err := ctx.ensureNamespace(ns); err != nil {
+					resultLock.Lock()
 					errs.AddVeleroError(err)
+					resultLock.Unlock()
 					continue
 				}
 
-				// Add the newly created namespace to the list of restored items.
-				if nsCreated {
-					itemKey := itemKey{
-						resource:  resourceKey(ns),
-						namespace: ns.Namespace,
-						name:      ns.Name,
-					}
-					ctx.restoredItems[itemKey] = restoredItemStatus{action: itemRestoreResultCreated, itemExists: true}
-				}
-
 				// Keep track of namespaces that we know exist so we don't
 				// have to try to create them multiple times.
 				existingNamespaces.Insert(targetNS)
@@ -769,45 +964,19 @@ func (ctx *restoreContext) processSelectedResource(
 				continue
 			}
 
-			obj, err := archive.Unmarshal(ctx.fileSystem, selectedItem.path)
-			if err != nil {
-				errs.Add(
-					selectedItem.targetNamespace,
-					fmt.Errorf(
-						"error decoding %q: %v",
-						strings.Replace(selectedItem.path, ctx.restoreDir+"/", "", -1),
-						err,
-					),
-				)
-				continue
-			}
-
-			w, e, _ := ctx.restoreItem(obj, groupResource, targetNS)
-			warnings.Merge(&w)
-			errs.Merge(&e)
-			processedItems++
-
-			// totalItems keeps the count of items previously known. There
-			// may be additional items restored by plugins. We want to include
-			// the additional items by looking at restoredItems at the same
-			// time, we don't want previously known items counted twice as
-			// they are present in both restoredItems and totalItems.
-			actualTotalItems := len(ctx.restoredItems) + (totalItems - processedItems)
-			if update != nil {
-				update <- progressUpdate{
-					totalItems:    actualTotalItems,
-					itemsRestored: len(ctx.restoredItems),
-				}
+			if itemChan == nil {
+				restoreItemFromFile(0, selectedItem, targetNS)
+			} else {
+				itemChan <- workItem{selectedItem: selectedItem, targetNS: targetNS}
 			}
-			ctx.log.WithFields(map[string]interface{}{
-				"progress":  "",
-				"resource":  groupResource.String(),
-				"namespace": selectedItem.targetNamespace,
-				"name":      selectedItem.name,
-			}).Infof("Restored %d items out of an estimated total of %d (estimate will change throughout the restore)", len(ctx.restoredItems), actualTotalItems)
 		}
 	}
 
+	if itemChan != nil {
+		close(itemChan)
+		wg.Wait()
+	}
+
 	return processedItems, warnings, errs
 }
 
@@ -1041,6 +1210,9 @@ func getResourceClientKey(groupResource schema.GroupResource, version, namespace
 func (ctx *restoreContext) getResourceClient(groupResource schema.GroupResource, obj *unstructured.Unstructured, namespace string) (client.Dynamic, error) {
 	key := getResourceClientKey(groupResource, obj.GroupVersionKind().Version, namespace)
 
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+
 	if client, ok := ctx.resourceClients[key]; ok {
 		return client, nil
 	}
@@ -1090,6 +1262,40 @@ func getResourceID(groupResource schema.GroupResource, namespace, name string) s
 	return fmt.Sprintf("%s/%s/%s", groupResource.String(), namespace, name)
 }
 
+// ensureNamespace makes sure the namespace exists and is ready, creating it
+// if it doesn't exist. A preview only records whether it would be created.
+func (ctx *restoreContext) ensureNamespace(ns *v1.Namespace) error {
+	if ctx.preview != nil {
+		_, err := ctx.namespaceClient.Get(go_context.TODO(), ns.Name, metav1.GetOptions{})
+		if apierrors.IsNotFound(err) {
+			ctx.preview.Add(restorepreview.Item{
+				GroupResource: kuberesource.Namespaces.String(),
+				Name:          ns.Name,
+				Action:        restorepreview.ActionCreate,
+			})
+			return nil
+		}
+		return errors.Wrapf(err, "error getting namespace %s", ns.Name)
+	}
+
+	_, nsCreated, err := kube.EnsureNamespaceExistsAndIsReady(ns, ctx.namespaceClient, ctx.resourceTerminatingTimeout)
+	if err != nil {
+		return err
+	}
+
+	// Add the newly created namespace to the list of restored items.
+	if nsCreated {
+		itemKey := itemKey{
+			resource:  resourceKey(ns),
+			namespace: ns.Namespace,
+			name:      ns.Name,
+		}
+		ctx.setRestoredItem(itemKey, restoredItemStatus{action: itemRestoreResultCreated, itemExists: true})
+		ctx.recordCreated(v1.SchemeGroupVersion.WithResource("namespaces"), "", ns.Name, "")
+	}
+	return nil
+}
+
 func (ctx *restoreContext) getResource(groupResource schema.GroupResource, obj *unstructured.Unstructured, namespace, name string) (*unstructured.Unstructured, error) {
 	lister, err := ctx.getResourceLister(groupResource, obj, namespace)
 	if err != nil {
@@ -1108,7 +1314,139 @@ func (ctx *restoreContext) getResource(groupResource schema.GroupResource, obj *
 	return u, nil
 }
 
-func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupResource schema.GroupResource, namespace string) (results.Result, results.Result, bool) {
+// claimRestoredItem adds the item to restoredItems for the worker and returns
+// false, unless the item has already been claimed, in which case its status
+// is returned. If the item is still being restored by another worker,
+// claimRestoredItem waits until it's done, so the status is final when a
+// worker needs it as an additional item. It doesn't wait for an item the
+// worker is restoring itself, or if the other worker is itself waiting for
+// this worker, which would deadlock; inProgress is then true and the status
+// is the one recorded so far.
+func (ctx *restoreContext) claimRestoredItem(key itemKey, worker int) (status restoredItemStatus, exists bool, inProgress bool) {
+	ctx.lock.Lock()
+
+	status, exists = ctx.restoredItems[key]
+	if !exists {
+		ctx.restoredItems[key] = restoredItemStatus{}
+		ctx.inProgressItems[key] = &restoredItemClaim{worker: worker, done: make(chan struct{})}
+		ctx.lock.Unlock()
+		return restoredItemStatus{}, false, false
+	}
+
+	claim, inProgress := ctx.inProgressItems[key]
+	if !inProgress || ctx.waitsFor(claim.worker, worker) {
+		ctx.lock.Unlock()
+		return status, true, inProgress
+	}
+
+	ctx.waitingWorkers[worker] = claim.worker
+	ctx.lock.Unlock()
+
+	<-claim.done
+
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+	delete(ctx.waitingWorkers, worker)
+	return ctx.restoredItems[key], true, false
+}
+
+// waitsFor returns true if worker is, directly or transitively, waiting for
+// the other worker. A worker always waits for itself.
+func (ctx *restoreContext) waitsFor(worker, other int) bool {
+	for {
+		if worker == other {
+			return true
+		}
+		next, waiting := ctx.waitingWorkers[worker]
+		if !waiting {
+			return false
+		}
+		worker = next
+	}
+}
+
+// recordCreated records an item created by the restore, to delete it if the
+// restore is rolled back.
+func (ctx *restoreContext) recordCreated(gvr schema.GroupVersionResource, namespace, name string, uid types.UID) {
+	if ctx.rollbackRecorder != nil {
+		ctx.rollbackRecorder.Created(gvr, namespace, name, uid)
+	}
+}
+
+// recordUpdated records the in-cluster version of an item updated by the
+// restore, to revert it if the restore is rolled back.
+func (ctx *restoreContext) recordUpdated(groupResource schema.GroupResource, obj, inCluster *unstructured.Unstructured) {
+	if ctx.rollbackRecorder == nil {
+		return
+	}
+	if err := ctx.rollbackRecorder.Updated(groupResource.WithVersion(obj.GroupVersionKind().Version), inCluster); err != nil {
+		ctx.log.WithError(err).Warnf("Unable to record the in-cluster version of %s, it won't be reverted if the restore is rolled back", kube.NamespaceAndName(obj))
+	}
+}
+
+func (ctx *restoreContext) getRestoredItem(key itemKey) restoredItemStatus {
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+
+	return ctx.restoredItems[key]
+}
+
+func (ctx *restoreContext) setRestoredItem(key itemKey, status restoredItemStatus) {
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+
+	ctx.restoredItems[key] = status
+}
+
+// finishRestoredItem records that the restore of the item claimed with
+// claimRestoredItem finished, and releases the workers waiting for it. An item
+// whose restore had warnings or errors isn't recorded in finishedItems, so it's
+// restored again, and its warnings and errors reported again, if the restore is
+// resumed.
+func (ctx *restoreContext) finishRestoredItem(key itemKey, failed bool) {
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+
+	if !failed {
+		ctx.finishedItems[key] = true
+	}
+	if claim, ok := ctx.inProgressItems[key]; ok {
+		close(claim.done)
+		delete(ctx.inProgressItems, key)
+	}
+}
+
+// restoredItemCount returns the number of items in restoredItems.
+func (ctx *restoreContext) restoredItemCount() int {
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+
+	return len(ctx.restoredItems)
+}
+
+func (ctx *restoreContext) addPVToProvision(name string) {
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+
+	ctx.pvsToProvision.Insert(name)
+}
+
+func (ctx *restoreContext) pvToProvision(name string) bool {
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+
+	return ctx.pvsToProvision.Has(name)
+}
+
+func (ctx *restoreContext) renamedPV(name string) (string, bool) {
+	ctx.lock.Lock()
+	defer ctx.lock.Unlock()
+
+	newName, ok := ctx.renamedPVs[name]
+	return newName, ok
+}
+
+func (ctx *restoreContext) restoreItem(worker int, obj *unstructured.Unstructured, groupResource schema.GroupResource, namespace string) (results.Result, results.Result, bool) {
 	warnings, errs := results.Result{}, results.Result{}
 	// itemExists bool is used to determine whether to include this item in the "wait for additional items" list
 	itemExists := false
@@ -1144,20 +1482,10 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		// namespace into which the resource is being restored into exists.
 		// This is the *remapped* namespace that we are ensuring exists.
 		nsToEnsure := getNamespace(ctx.log, archive.GetItemFilePath(ctx.restoreDir, "namespaces", "", obj.GetNamespace()), namespace)
-		_, nsCreated, err := kube.EnsureNamespaceExistsAndIsReady(nsToEnsure, ctx.namespaceClient, ctx.resourceTerminatingTimeout)
-		if err != nil {
+		if err := ctx.ensureNamespace(nsToEnsure); err != nil {
 			errs.AddVeleroError(err)
 			return warnings, errs, itemExists
 		}
-		// Add the newly created namespace to the list of restored items.
-		if nsCreated {
-			itemKey := itemKey{
-				resource:  resourceKey(nsToEnsure),
-				namespace: nsToEnsure.Namespace,
-				name:      nsToEnsure.Name,
-			}
-			ctx.restoredItems[itemKey] = restoredItemStatus{action: itemRestoreResultCreated, itemExists: true}
-		}
 	} else {
 		if boolptr.IsSetToFalse(ctx.restore.Spec.IncludeClusterResources) {
 			restoreLogger.Info("Not restoring item because it's cluster-scoped")
@@ -1187,14 +1515,20 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		namespace: namespace,
 		name:      name,
 	}
-	if prevRestoredItemStatus, exists := ctx.restoredItems[itemKey]; exists {
-		ctx.log.Infof("Skipping %s because it's already been restored.", resourceID)
+	if prevRestoredItemStatus, exists, inProgress := ctx.claimRestoredItem(itemKey, worker); exists {
+		if inProgress {
+			ctx.log.Infof("Skipping %s because it's being restored.", resourceID)
+		} else {
+			ctx.log.Infof("Skipping %s because it's already been restored.", resourceID)
+		}
 		itemExists = prevRestoredItemStatus.itemExists
 		return warnings, errs, itemExists
 	}
-	ctx.restoredItems[itemKey] = restoredItemStatus{itemExists: itemExists}
 	defer func() {
-		itemStatus := ctx.restoredItems[itemKey]
+		ctx.finishRestoredItem(itemKey, !warnings.IsEmpty() || !errs.IsEmpty())
+	}()
+	defer func() {
+		itemStatus := ctx.getRestoredItem(itemKey)
 		// the action field is set explicitly
 		if len(itemStatus.action) > 0 {
 			return
@@ -1202,12 +1536,12 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		// no action specified, and no warnings and errors
 		if errs.IsEmpty() && warnings.IsEmpty() {
 			itemStatus.action = itemRestoreResultSkipped
-			ctx.restoredItems[itemKey] = itemStatus
+			ctx.setRestoredItem(itemKey, itemStatus)
 			return
 		}
 		// others are all failed
 		itemStatus.action = itemRestoreResultFailed
-		ctx.restoredItems[itemKey] = itemStatus
+		ctx.setRestoredItem(itemKey, itemStatus)
 	}()
 
 	// TODO: move to restore item action if/when we add a ShouldRestore() method
@@ -1239,7 +1573,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 			case internalVolume.PodVolumeBackup:
 				restoreLogger.Infof("Dynamically re-provisioning persistent volume because it has a pod volume backup to be restored.")
-				ctx.pvsToProvision.Insert(name)
+				ctx.addPVToProvision(name)
 
 				// Return early because we don't want to restore the PV itself, we
 				// want to dynamically re-provision it.
@@ -1247,7 +1581,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 			case internalVolume.CSISnapshot:
 				restoreLogger.Infof("Dynamically re-provisioning persistent volume because it has a CSI VolumeSnapshot or a related snapshot DataUpload.")
-				ctx.pvsToProvision.Insert(name)
+				ctx.addPVToProvision(name)
 
 				if ready, err := ctx.featureVerifier.Verify(velerov1api.CSIFeatureFlag); !ready {
 					ctx.log.Errorf("Failed to verify CSI modules, ready %v, err %v", ready, err)
@@ -1263,7 +1597,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			default:
 				if hasDeleteReclaimPolicy(obj.Object) {
 					restoreLogger.Infof("Dynamically re-provisioning persistent volume because it doesn't have a snapshot and its reclaim policy is Delete.")
-					ctx.pvsToProvision.Insert(name)
+					ctx.addPVToProvision(name)
 
 					// Return early because we don't want to restore the PV itself, we
 					// want to dynamically re-provision it.
@@ -1293,7 +1627,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 			case hasPodVolumeBackup(obj, ctx):
 				restoreLogger.Infof("Dynamically re-provisioning persistent volume because it has a pod volume backup to be restored.")
-				ctx.pvsToProvision.Insert(name)
+				ctx.addPVToProvision(name)
 
 				// Return early because we don't want to restore the PV itself, we
 				// want to dynamically re-provision it.
@@ -1303,7 +1637,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				fallthrough
 			case hasSnapshotDataUpload(ctx, obj):
 				restoreLogger.Infof("Dynamically re-provisioning persistent volume because it has a CSI VolumeSnapshot or a related snapshot DataUpload.")
-				ctx.pvsToProvision.Insert(name)
+				ctx.addPVToProvision(name)
 
 				if ready, err := ctx.featureVerifier.Verify(velerov1api.CSIFeatureFlag); !ready {
 					ctx.log.Errorf("Failed to verify CSI modules, ready %v, err %v", ready, err)
@@ -1316,7 +1650,7 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 			case hasDeleteReclaimPolicy(obj.Object):
 				restoreLogger.Infof("Dynamically re-provisioning persistent volume because it doesn't have a snapshot and its reclaim policy is Delete.")
-				ctx.pvsToProvision.Insert(name)
+				ctx.addPVToProvision(name)
 
 				// Return early because we don't want to restore the PV itself, we
 				// want to dynamically re-provision it.
@@ -1341,6 +1675,17 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 	ctx.log.Infof("restore status includes excludes: %+v", ctx.resourceStatusIncludesExcludes)
 
+	// The resource modifiers are applied after the restore item actions, so their patches
+	// take precedence over the changes of the actions, but whether they drop the item is
+	// checked beforehand, on a copy, so the actions aren't executed for a dropped item. The
+	// errors are reported when the modifiers are applied.
+	if ctx.resourceModifiers != nil {
+		if drop, _ := ctx.resourceModifiers.ApplyResourceModifierRules(obj.DeepCopy(), groupResource.String(), ctx.kbClient.Scheme(), ctx.log); drop {
+			ctx.log.Infof("Skipping restore of %s: %v because a resource modifier rule dropped it", obj.GroupVersionKind().Kind, name)
+			return warnings, errs, itemExists
+		}
+	}
+
 	for _, action := range ctx.getApplicableActions(groupResource, namespace) {
 		if !action.Selector.Matches(labels.Set(obj.GetLabels())) {
 			continue
@@ -1364,8 +1709,15 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			return warnings, errs, itemExists
 		}
 
-		// If async plugin started async operation, add it to the ItemOperations list
-		if executeOutput.OperationID != "" {
+		// A preview doesn't track the operations started by the actions, which are expected not to
+		// start any when the restore is a preview, so the ones started are cancelled right away.
+		if executeOutput.OperationID != "" && ctx.preview != nil {
+			ctx.log.Warnf("Cancelling operation %s started by action %s since the restore is a preview", executeOutput.OperationID, action.RestoreItemAction.Name())
+			if err := action.RestoreItemAction.Cancel(executeOutput.OperationID, ctx.restore); err != nil {
+				ctx.log.WithError(err).Warnf("Error cancelling operation %s", executeOutput.OperationID)
+			}
+		} else if executeOutput.OperationID != "" {
+			// If async plugin started async operation, add it to the ItemOperations list
 			resourceIdentifier := velero.ResourceIdentifier{
 				GroupResource: groupResource,
 				Namespace:     namespace,
@@ -1385,8 +1737,11 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 					Created: &now,
 				},
 			}
+			ctx.lock.Lock()
 			itemOperList := ctx.itemOperationsList
 			*itemOperList = append(*itemOperList, &newOperation)
+			ctx.itemOperationIDs[itemKey] = append(ctx.itemOperationIDs[itemKey], executeOutput.OperationID)
+			ctx.lock.Unlock()
 		}
 		if executeOutput.SkipRestore {
 			ctx.log.Infof("Skipping restore of %s: %v because a registered plugin discarded it", obj.GroupVersionKind().Kind, name)
@@ -1423,12 +1778,10 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 			additionalItemNamespace := additionalItem.Namespace
 			if additionalItemNamespace != "" {
-				if remapped, ok := ctx.restore.Spec.NamespaceMapping[additionalItemNamespace]; ok {
-					additionalItemNamespace = remapped
-				}
+				additionalItemNamespace, _ = ctx.namespaceMapper.Map(additionalItemNamespace)
 			}
 
-			w, e, additionalItemExists := ctx.restoreItem(additionalObj, additionalItem.GroupResource, additionalItemNamespace)
+			w, e, additionalItemExists := ctx.restoreItem(worker, additionalObj, additionalItem.GroupResource, additionalItemNamespace)
 			if additionalItemExists {
 				filteredAdditionalItems = append(filteredAdditionalItems, additionalItem)
 			}
@@ -1437,11 +1790,14 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			errs.Merge(&e)
 		}
 		executeOutput.AdditionalItems = filteredAdditionalItems
-		available, err := ctx.itemsAvailable(action, executeOutput)
-		if err != nil {
-			errs.Add(namespace, errors.Wrapf(err, "error verifying additional items are ready to use"))
-		} else if !available {
-			errs.Add(namespace, fmt.Errorf("additional items for %s are not ready to use", resourceID))
+		// a preview doesn't create the additional items, so there is nothing to wait for
+		if ctx.preview == nil {
+			available, err := ctx.itemsAvailable(action, executeOutput)
+			if err != nil {
+				errs.Add(namespace, errors.Wrapf(err, "error verifying additional items are ready to use"))
+			} else if !available {
+				errs.Add(namespace, fmt.Errorf("additional items for %s are not ready to use", resourceID))
+			}
 		}
 	}
 
@@ -1466,13 +1822,13 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 
 			// This is the case for PVB volumes, where we need to actually have an empty volume created instead of restoring one.
 			// The assumption is that any PV in pvsToProvision doesn't have an associated snapshot.
-			if ctx.pvsToProvision.Has(pvc.Spec.VolumeName) {
+			if ctx.pvToProvision(pvc.Spec.VolumeName) {
 				ctx.log.Infof("Resetting PersistentVolumeClaim %s/%s for dynamic provisioning", namespace, name)
 				unstructured.RemoveNestedField(obj.Object, "spec", "volumeName")
 			}
 		}
 
-		if newName, ok := ctx.renamedPVs[pvc.Spec.VolumeName]; ok {
+		if newName, ok := ctx.renamedPV(pvc.Spec.VolumeName); ok {
 			ctx.log.Infof("Updating persistent volume claim %s/%s to reference renamed persistent volume (%s -> %s)", namespace, name, pvc.Spec.VolumeName, newName)
 			if err := unstructured.SetNestedField(obj.Object, newName, "spec", "volumeName"); err != nil {
 				errs.Add(namespace, err)
@@ -1482,10 +1838,13 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	}
 
 	if ctx.resourceModifiers != nil {
-		if errList := ctx.resourceModifiers.ApplyResourceModifierRules(obj, groupResource.String(), ctx.kbClient.Scheme(), ctx.log); errList != nil {
-			for _, err := range errList {
-				errs.Add(namespace, err)
-			}
+		drop, errList := ctx.resourceModifiers.ApplyResourceModifierRules(obj, groupResource.String(), ctx.kbClient.Scheme(), ctx.log)
+		for _, err := range errList {
+			errs.Add(namespace, err)
+		}
+		if drop {
+			ctx.log.Infof("Skipping restore of %s: %v because a resource modifier rule dropped it", obj.GroupVersionKind().Kind, name)
+			return warnings, errs, itemExists
 		}
 	}
 
@@ -1510,6 +1869,14 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		return warnings, errs, itemExists
 	}
 
+	if ctx.preview != nil {
+		itemExists, err = ctx.previewItem(obj, groupResource, namespace, resourceClient)
+		if err != nil {
+			errs.Add(namespace, err)
+		}
+		return warnings, errs, itemExists
+	}
+
 	ctx.log.Infof("Attempting to restore %s: %v", obj.GroupVersionKind().Kind, name)
 
 	// check if we want to treat the error as a warning, in some cases the creation call might not get executed due to object API validations
@@ -1529,7 +1896,8 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		createdObj, restoreErr = resourceClient.Create(obj)
 		if restoreErr == nil {
 			itemExists = true
-			ctx.restoredItems[itemKey] = restoredItemStatus{action: itemRestoreResultCreated, itemExists: itemExists}
+			ctx.setRestoredItem(itemKey, restoredItemStatus{action: itemRestoreResultCreated, itemExists: itemExists})
+			ctx.recordCreated(groupResource.WithVersion(obj.GroupVersionKind().Version), createdObj.GetNamespace(), createdObj.GetName(), createdObj.GetUID())
 		}
 	}
 
@@ -1555,11 +1923,35 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 		}
 	}
 
+	resourcePolicy := ctx.existingResourcePolicy(groupResource)
+	if fromCluster != nil && resourcePolicy == velerov1api.PolicyTypeRecreate {
+		recreated, deleted, err := ctx.recreateResource(groupResource, fromCluster, obj, resourceClient)
+		if err != nil {
+			if !deleted {
+				// the in-cluster version is left as it is
+				ctx.log.Warnf("unable to recreate %s: %v", kube.NamespaceAndName(obj), err)
+				warnings.Add(namespace, err)
+				return warnings, errs, true
+			}
+			ctx.log.Errorf("error recreating %s: %v", kube.NamespaceAndName(obj), err)
+			errs.Add(namespace, err)
+			return warnings, errs, false
+		}
+		if recreated != nil {
+			// the recreated resource is restored like a created one from here on
+			fromCluster, createdObj, restoreErr = nil, recreated, nil
+			itemExists = true
+			ctx.setRestoredItem(itemKey, restoredItemStatus{action: itemRestoreResultUpdated, itemExists: itemExists})
+		}
+	}
+
 	if fromCluster != nil {
 		itemExists = true
-		itemStatus := ctx.restoredItems[itemKey]
+		itemStatus := ctx.getRestoredItem(itemKey)
 		itemStatus.itemExists = itemExists
-		ctx.restoredItems[itemKey] = itemStatus
+		ctx.setRestoredItem(itemKey, itemStatus)
+		// keep the in-cluster version to record it if the restore updates it
+		inCluster := fromCluster.DeepCopy()
 		// Remove insubstantial metadata.
 		fromCluster, err = resetMetadataAndStatus(fromCluster)
 		if err != nil {
@@ -1601,23 +1993,26 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 				if err != nil {
 					warnings.Add(namespace, err)
 					// check if there is existingResourcePolicy and if it is set to update policy
-					if len(ctx.restore.Spec.ExistingResourcePolicy) > 0 && ctx.restore.Spec.ExistingResourcePolicy == velerov1api.PolicyTypeUpdate {
+					if resourcePolicy == velerov1api.PolicyTypeUpdate || resourcePolicy == velerov1api.PolicyTypeMerge {
 						// remove restore labels so that we apply the latest backup/restore names on the object via patch
 						removeRestoreLabels(fromCluster)
 						//try patching just the backup/restore labels
-						warningsFromUpdate, errsFromUpdate := ctx.updateBackupRestoreLabels(fromCluster, fromClusterWithLabels, namespace, resourceClient)
+						warningsFromUpdate, errsFromUpdate, patched := ctx.updateBackupRestoreLabels(fromCluster, fromClusterWithLabels, namespace, resourceClient)
 						warnings.Merge(&warningsFromUpdate)
 						errs.Merge(&errsFromUpdate)
+						if patched {
+							ctx.recordUpdated(groupResource, obj, inCluster)
+						}
 					}
 				} else {
 					itemStatus.action = itemRestoreResultUpdated
-					ctx.restoredItems[itemKey] = itemStatus
+					ctx.setRestoredItem(itemKey, itemStatus)
+					ctx.recordUpdated(groupResource, obj, inCluster)
 					ctx.log.Infof("ServiceAccount %s successfully updated", kube.NamespaceAndName(obj))
 				}
 			default:
 				// check for the presence of existingResourcePolicy
-				if len(ctx.restore.Spec.ExistingResourcePolicy) > 0 {
-					resourcePolicy := ctx.restore.Spec.ExistingResourcePolicy
+				if len(resourcePolicy) > 0 {
 					ctx.log.Infof("restore API has resource policy defined %s , executing restore workflow accordingly for changed resource %s %s", resourcePolicy, fromCluster.GroupVersionKind().Kind, kube.NamespaceAndName(fromCluster))
 
 					// existingResourcePolicy is set as none, add warning
@@ -1628,13 +2023,28 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 						// existingResourcePolicy is set as update, attempt patch on the resource and add warning if it fails
 					} else if resourcePolicy == velerov1api.PolicyTypeUpdate {
 						// processing update as existingResourcePolicy
-						warningsFromUpdateRP, errsFromUpdateRP := ctx.processUpdateResourcePolicy(fromCluster, fromClusterWithLabels, obj, namespace, resourceClient)
+						warningsFromUpdateRP, errsFromUpdateRP, patched := ctx.processUpdateResourcePolicy(fromCluster, fromClusterWithLabels, obj, namespace, resourceClient)
 						if warningsFromUpdateRP.IsEmpty() && errsFromUpdateRP.IsEmpty() {
 							itemStatus.action = itemRestoreResultUpdated
-							ctx.restoredItems[itemKey] = itemStatus
+							ctx.setRestoredItem(itemKey, itemStatus)
+						}
+						if patched {
+							ctx.recordUpdated(groupResource, obj, inCluster)
 						}
 						warnings.Merge(&warningsFromUpdateRP)
 						errs.Merge(&errsFromUpdateRP)
+						// existingResourcePolicy is set as merge, attempt patch on the resource with the backed-up version merged onto it
+					} else if resourcePolicy == velerov1api.PolicyTypeMerge {
+						warningsFromMergeRP, errsFromMergeRP, patched := ctx.processMergeResourcePolicy(fromCluster, fromClusterWithLabels, obj, namespace, resourceClient)
+						if warningsFromMergeRP.IsEmpty() && errsFromMergeRP.IsEmpty() {
+							itemStatus.action = itemRestoreResultUpdated
+							ctx.setRestoredItem(itemKey, itemStatus)
+						}
+						if patched {
+							ctx.recordUpdated(groupResource, obj, inCluster)
+						}
+						warnings.Merge(&warningsFromMergeRP)
+						errs.Merge(&errsFromMergeRP)
 					}
 				} else {
 					// Preserved Velero behavior when existingResourcePolicy is not specified by the user
@@ -1646,16 +2056,18 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 			return warnings, errs, itemExists
 		}
 
-		//update backup/restore labels on the unchanged resources if existingResourcePolicy is set as update
-		if ctx.restore.Spec.ExistingResourcePolicy == velerov1api.PolicyTypeUpdate {
-			resourcePolicy := ctx.restore.Spec.ExistingResourcePolicy
+		//update backup/restore labels on the unchanged resources if existingResourcePolicy is set as update, merge or recreate
+		if resourcePolicy == velerov1api.PolicyTypeUpdate || resourcePolicy == velerov1api.PolicyTypeMerge || resourcePolicy == velerov1api.PolicyTypeRecreate {
 			ctx.log.Infof("restore API has resource policy defined %s , executing restore workflow accordingly for unchanged resource %s %s ", resourcePolicy, obj.GroupVersionKind().Kind, kube.NamespaceAndName(fromCluster))
 			// remove restore labels so that we apply the latest backup/restore names on the object via patch
 			removeRestoreLabels(fromCluster)
 			// try updating the backup/restore labels for the in-cluster object
-			warningsFromUpdate, errsFromUpdate := ctx.updateBackupRestoreLabels(fromCluster, obj, namespace, resourceClient)
+			warningsFromUpdate, errsFromUpdate, patched := ctx.updateBackupRestoreLabels(fromCluster, obj, namespace, resourceClient)
 			warnings.Merge(&warningsFromUpdate)
 			errs.Merge(&errsFromUpdate)
+			if patched {
+				ctx.recordUpdated(groupResource, obj, inCluster)
+			}
 		}
 
 		ctx.log.Infof("Restore of %s, %v skipped: it already exists in the cluster and is the same as the backed up version", obj.GroupVersionKind().Kind, name)
@@ -1748,6 +2160,117 @@ func (ctx *restoreContext) restoreItem(obj *unstructured.Unstructured, groupReso
 	return warnings, errs, itemExists
 }
 
+// previewItem records what restoring the item would do without creating or
+// updating anything, and returns whether the item exists in the cluster. It
+// mirrors how restoreItem handles items which already exist, and checks the
+// patches restoreItem would send with a dry run, so the recorded diff is what
+// the restore would actually apply.
+func (ctx *restoreContext) previewItem(obj *unstructured.Unstructured, groupResource schema.GroupResource, namespace string, resourceClient client.Dynamic) (bool, error) {
+	item := restorepreview.Item{
+		GroupResource: groupResource.String(),
+		Namespace:     namespace,
+		Name:          obj.GetName(),
+		Action:        restorepreview.ActionCreate,
+	}
+
+	var fromCluster *unstructured.Unstructured
+	var err error
+	if !ctx.disableInformerCache {
+		fromCluster, err = ctx.getResource(groupResource, obj, namespace, obj.GetName())
+	} else {
+		fromCluster, err = resourceClient.Get(obj.GetName(), metav1.GetOptions{})
+	}
+	if apierrors.IsNotFound(errors.Cause(err)) {
+		ctx.preview.Add(item)
+		return false, nil
+	}
+	if err != nil {
+		return false, errors.Wrapf(err, "error getting in-cluster version of %s", getResourceID(groupResource, namespace, obj.GetName()))
+	}
+
+	if fromCluster, err = resetMetadataAndStatus(fromCluster); err != nil {
+		return true, err
+	}
+	labels := obj.GetLabels()
+	addRestoreLabels(fromCluster, labels[velerov1api.RestoreNameLabel], labels[velerov1api.BackupNameLabel])
+	// the restore sets the backup and restore labels along with the changes it patches, and
+	// falls back to patching only them when the server rejects the changes
+	withoutLabels := fromCluster.DeepCopy()
+	removeRestoreLabels(withoutLabels)
+
+	resourcePolicy := ctx.existingResourcePolicy(groupResource)
+	updatesLabels := resourcePolicy == velerov1api.PolicyTypeUpdate || resourcePolicy == velerov1api.PolicyTypeMerge
+
+	var patch []byte
+	switch {
+	case equality.Semantic.DeepEqual(fromCluster, obj):
+		item.Action = restorepreview.ActionSkipExisting
+		if updatesLabels || resourcePolicy == velerov1api.PolicyTypeRecreate {
+			patch, err = generatePatch(withoutLabels, fromCluster)
+		}
+	case resourcePolicy == velerov1api.PolicyTypeRecreate:
+		item.Action = restorepreview.ActionUpdate
+		patch, err = generatePatch(withoutLabels, obj)
+	case groupResource == kuberesource.ServiceAccounts:
+		var desired *unstructured.Unstructured
+		if desired, err = mergeServiceAccounts(fromCluster, obj); err != nil {
+			return true, err
+		}
+		item.Action = restorepreview.ActionUpdate
+		if patch, err = generatePatch(fromCluster, desired); err == nil && patch != nil {
+			patch, err = ctx.previewPatch(&item, patch, withoutLabels, fromCluster, updatesLabels, resourceClient)
+		}
+	case updatesLabels:
+		desired := obj
+		if resourcePolicy == velerov1api.PolicyTypeMerge {
+			if desired, err = mergeResource(fromCluster, obj); err != nil {
+				return true, err
+			}
+		}
+		item.Action = restorepreview.ActionUpdate
+		if patch, err = generatePatch(withoutLabels, desired); err == nil && patch != nil {
+			patch, err = ctx.previewPatch(&item, patch, withoutLabels, fromCluster, updatesLabels, resourceClient)
+		}
+	default:
+		item.Action = restorepreview.ActionConflict
+		patch, err = generatePatch(fromCluster, obj)
+	}
+	if err != nil {
+		return true, errors.Wrapf(err, "error generating diff for %s", getResourceID(groupResource, namespace, obj.GetName()))
+	}
+
+	if patch == nil {
+		item.Action = restorepreview.ActionSkipExisting
+	} else if item.Action == restorepreview.ActionSkipExisting {
+		// only the backup and restore labels would be updated
+		item.Action = restorepreview.ActionUpdate
+	}
+	item.Diff = patch
+
+	ctx.preview.Add(item)
+	return true, nil
+}
+
+// previewPatch checks with a dry run whether the server accepts the patch of the item, and returns
+// the patch the restore would apply: the patch itself if it's accepted, otherwise the patch of the
+// backup and restore labels if the restore falls back to it, or nil if nothing would be patched.
+// The item's action and message are set when the patch is rejected.
+func (ctx *restoreContext) previewPatch(item *restorepreview.Item, patch []byte, withoutLabels, withLabels *unstructured.Unstructured, updatesLabels bool, resourceClient client.Dynamic) ([]byte, error) {
+	_, err := resourceClient.DryRunPatch(item.Name, patch)
+	if err == nil {
+		return patch, nil
+	}
+
+	if !updatesLabels {
+		item.Action = restorepreview.ActionConflict
+		item.Message = fmt.Sprintf("the update would be rejected: %v", err)
+		return patch, nil
+	}
+
+	item.Message = fmt.Sprintf("the update would be rejected, only the backup and restore labels would be updated: %v", err)
+	return generatePatch(withoutLabels, withLabels)
+}
+
 func isAlreadyExistsError(ctx *restoreContext, obj *unstructured.Unstructured, err error, client client.Dynamic) (bool, error) {
 	if err == nil {
 		return false, nil
@@ -1793,7 +2316,7 @@ func isAlreadyExistsError(ctx *restoreContext, obj *unstructured.Unstructured, e
 // original name already exists in-cluster, and (b) in the backup, the PV is claimed
 // by a PVC in a namespace that's being remapped during the restore.
 func shouldRenamePV(ctx *restoreContext, obj *unstructured.Unstructured, client client.Dynamic) (bool, error) {
-	if len(ctx.restore.Spec.NamespaceMapping) == 0 {
+	if ctx.namespaceMapper.Empty() {
 		ctx.log.Debugf("Persistent volume does not need to be renamed because restore is not remapping any namespaces")
 		return false, nil
 	}
@@ -1808,7 +2331,7 @@ func shouldRenamePV(ctx *restoreContext, obj *unstructured.Unstructured, client
 		return false, nil
 	}
 
-	if _, ok := ctx.restore.Spec.NamespaceMapping[pv.Spec.ClaimRef.Namespace]; !ok {
+	if _, ok := ctx.namespaceMapper.Map(pv.Spec.ClaimRef.Namespace); !ok {
 		ctx.log.Debugf("Persistent volume does not need to be renamed because it's not claimed by a PVC in a namespace that's being remapped")
 		return false, nil
 	}
@@ -1830,7 +2353,7 @@ func shouldRenamePV(ctx *restoreContext, obj *unstructured.Unstructured, client
 // restore's NamespaceMappings, if necessary. Returns true if the namespace was
 // remapped, false if it was not required.
 func remapClaimRefNS(ctx *restoreContext, obj *unstructured.Unstructured) (bool, error) { //nolint:unparam // ignore the result 0 (bool) is never used warning.
-	if len(ctx.restore.Spec.NamespaceMapping) == 0 {
+	if ctx.namespaceMapper.Empty() {
 		ctx.log.Debug("Persistent volume does not need to have the claimRef.namespace remapped because restore is not remapping any namespaces")
 		return false, nil
 	}
@@ -1847,7 +2370,7 @@ func remapClaimRefNS(ctx *restoreContext, obj *unstructured.Unstructured) (bool,
 		return false, nil
 	}
 
-	targetNS, ok := ctx.restore.Spec.NamespaceMapping[pv.Spec.ClaimRef.Namespace]
+	targetNS, ok := ctx.namespaceMapper.Map(pv.Spec.ClaimRef.Namespace)
 
 	if !ok {
 		ctx.log.Debugf("Persistent volume does not need to have the claimRef.namespace remapped because it's not claimed by a PVC in a namespace that's being remapped")
@@ -2255,10 +2778,7 @@ func (ctx *restoreContext) getSelectedRestoreableItems(resource string, original
 		restorable.selectedItemsByNamespace = make(map[string][]restoreableItem)
 	}
 
-	targetNamespace := originalNamespace
-	if target, ok := ctx.restore.Spec.NamespaceMapping[originalNamespace]; ok {
-		targetNamespace = target
-	}
+	targetNamespace, _ := ctx.namespaceMapper.Map(originalNamespace)
 
 	if targetNamespace != "" {
 		ctx.log.Infof("Resource '%s' will be restored into namespace '%s'", resource, targetNamespace)
@@ -2350,20 +2870,20 @@ func removeRestoreLabels(obj metav1.Object) {
 	obj.SetLabels(labels)
 }
 
-// updates the backup/restore labels
-func (ctx *restoreContext) updateBackupRestoreLabels(fromCluster, fromClusterWithLabels *unstructured.Unstructured, namespace string, resourceClient client.Dynamic) (warnings, errs results.Result) { //nolint:unparam // Ignore the warnings is nil warning.
+// updates the backup/restore labels, patched is true if the in-cluster resource is patched
+func (ctx *restoreContext) updateBackupRestoreLabels(fromCluster, fromClusterWithLabels *unstructured.Unstructured, namespace string, resourceClient client.Dynamic) (warnings, errs results.Result, patched bool) { //nolint:unparam // Ignore the warnings is nil warning.
 	patchBytes, err := generatePatch(fromCluster, fromClusterWithLabels)
 	if err != nil {
 		ctx.log.Errorf("error generating patch for %s %s: %v", fromCluster.GroupVersionKind().Kind, kube.NamespaceAndName(fromCluster), err)
 		errs.Add(namespace, err)
-		return warnings, errs
+		return warnings, errs, patched
 	}
 
 	if patchBytes == nil {
 		// In-cluster and desired state are the same, so move on to
 		// the next items
 		ctx.log.Errorf("skipped updating backup/restore labels for %s %s: in-cluster and desired state are the same along-with the labels", fromCluster.GroupVersionKind().Kind, kube.NamespaceAndName(fromCluster))
-		return warnings, errs
+		return warnings, errs, patched
 	}
 
 	// try patching the in-cluster resource (with only latest backup/restore labels)
@@ -2373,14 +2893,16 @@ func (ctx *restoreContext) updateBackupRestoreLabels(fromCluster, fromClusterWit
 		errs.Add(namespace, err)
 	} else {
 		ctx.log.Infof("backup/restore labels successfully updated for %s %s", fromCluster.GroupVersionKind().Kind, kube.NamespaceAndName(fromCluster))
+		patched = true
 	}
-	return warnings, errs
+	return warnings, errs, patched
 }
 
 // function to process existingResourcePolicy as update, tries to patch the diff between in-cluster and restore obj first
-// if the patch fails then tries to update the backup/restore labels for the in-cluster version
-func (ctx *restoreContext) processUpdateResourcePolicy(fromCluster, fromClusterWithLabels, obj *unstructured.Unstructured, namespace string, resourceClient client.Dynamic) (warnings, errs results.Result) {
-	ctx.log.Infof("restore API has existingResourcePolicy defined as update , executing restore workflow accordingly for changed resource %s %s ", obj.GroupVersionKind().Kind, kube.NamespaceAndName(fromCluster))
+// if the patch fails then tries to update the backup/restore labels for the in-cluster version.
+// patched is true if the in-cluster resource is patched, even if only its labels are.
+func (ctx *restoreContext) processUpdateResourcePolicy(fromCluster, fromClusterWithLabels, obj *unstructured.Unstructured, namespace string, resourceClient client.Dynamic) (warnings, errs results.Result, patched bool) {
+	ctx.log.Infof("restore API has existingResourcePolicy defined, executing restore workflow accordingly for changed resource %s %s ", obj.GroupVersionKind().Kind, kube.NamespaceAndName(fromCluster))
 	ctx.log.Infof("attempting patch on %s %q", fromCluster.GetKind(), fromCluster.GetName())
 	// remove restore labels so that we apply the latest backup/restore names on the object via patch
 	removeRestoreLabels(fromCluster)
@@ -2388,14 +2910,14 @@ func (ctx *restoreContext) processUpdateResourcePolicy(fromCluster, fromClusterW
 	if err != nil {
 		ctx.log.Errorf("error generating patch for %s %s: %v", obj.GroupVersionKind().Kind, kube.NamespaceAndName(obj), err)
 		errs.Add(namespace, err)
-		return warnings, errs
+		return warnings, errs, patched
 	}
 
 	if patchBytes == nil {
 		// In-cluster and desired state are the same, so move on to
 		// the next items
 		ctx.log.Errorf("skipped updating %s %s: in-cluster and desired state are the same", fromCluster.GroupVersionKind().Kind, kube.NamespaceAndName(fromCluster))
-		return warnings, errs
+		return warnings, errs, patched
 	}
 
 	// try patching the in-cluster resource (resource diff plus latest backup/restore labels)
@@ -2404,13 +2926,27 @@ func (ctx *restoreContext) processUpdateResourcePolicy(fromCluster, fromClusterW
 		ctx.log.Warnf("patch attempt failed for %s %s: %v", fromCluster.GroupVersionKind(), kube.NamespaceAndName(fromCluster), err)
 		warnings.Add(namespace, err)
 		// try just patching the labels
-		warningsFromUpdate, errsFromUpdate := ctx.updateBackupRestoreLabels(fromCluster, fromClusterWithLabels, namespace, resourceClient)
+		warningsFromUpdate, errsFromUpdate, labelsPatched := ctx.updateBackupRestoreLabels(fromCluster, fromClusterWithLabels, namespace, resourceClient)
 		warnings.Merge(&warningsFromUpdate)
 		errs.Merge(&errsFromUpdate)
+		patched = labelsPatched
 	} else {
 		ctx.log.Infof("%s %s successfully updated", obj.GroupVersionKind().Kind, kube.NamespaceAndName(obj))
+		patched = true
 	}
-	return warnings, errs
+	return warnings, errs, patched
+}
+
+// function to process existingResourcePolicy as merge, merges the restore obj onto the in-cluster version and
+// patches the diff between them like the update existingResourcePolicy
+func (ctx *restoreContext) processMergeResourcePolicy(fromCluster, fromClusterWithLabels, obj *unstructured.Unstructured, namespace string, resourceClient client.Dynamic) (warnings, errs results.Result, patched bool) {
+	merged, err := mergeResource(fromCluster, obj)
+	if err != nil {
+		ctx.log.Warnf("error merging %s %s: %v", obj.GroupVersionKind().Kind, kube.NamespaceAndName(obj), err)
+		warnings.Add(namespace, err)
+		return warnings, errs, patched
+	}
+	return ctx.processUpdateResourcePolicy(fromCluster, fromClusterWithLabels, merged, namespace, resourceClient)
 }
 
 func (ctx *restoreContext) handlePVHasNativeSnapshot(obj *unstructured.Unstructured, resourceClient client.Dynamic) (*unstructured.Unstructured, error) {
@@ -2450,10 +2986,14 @@ func (ctx *restoreContext) handlePVHasNativeSnapshot(obj *unstructured.Unstructu
 
 		// Even if we're renaming the PV, obj still has the old name here, because the pvRestorer
 		// uses the original name to look up metadata about the snapshot.
-		ctx.log.Infof("Restoring persistent volume from snapshot.")
-		retObj, err = ctx.pvRestorer.executePVAction(retObj)
-		if err != nil {
-			return nil, fmt.Errorf("error executing PVAction for %s: %v", getResourceID(kuberesource.PersistentVolumes, "", oldName), err)
+		if ctx.preview != nil {
+			ctx.log.Infof("Not restoring persistent volume from snapshot because the restore is a preview.")
+		} else {
+			ctx.log.Infof("Restoring persistent volume from snapshot.")
+			retObj, err = ctx.pvRestorer.executePVAction(retObj)
+			if err != nil {
+				return nil, fmt.Errorf("error executing PVAction for %s: %v", getResourceID(kuberesource.PersistentVolumes, "", oldName), err)
+			}
 		}
 
 		// VolumeSnapshotter has modified the PV name, we should rename the PV.
@@ -2476,7 +3016,9 @@ func (ctx *restoreContext) handlePVHasNativeSnapshot(obj *unstructured.Unstructu
 			pvName = retObj.GetName()
 		}
 
+		ctx.lock.Lock()
 		ctx.renamedPVs[oldName] = pvName
+		ctx.lock.Unlock()
 		retObj.SetName(pvName)
 
 		// Add the original PV name as an annotation.
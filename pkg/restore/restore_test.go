@@ -42,6 +42,8 @@ import (
 	"k8s.io/client-go/dynamic"
 	kubetesting "k8s.io/client-go/testing"
 
+	"github.com/vmware-tanzu/velero/internal/resourcemodifiers"
+	"github.com/vmware-tanzu/velero/internal/restorepreview"
 	internalVolume "github.com/vmware-tanzu/velero/internal/volume"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"github.com/vmware-tanzu/velero/pkg/archive"
@@ -797,6 +799,7 @@ func TestRestoreNamespaceMapping(t *testing.T) {
 		apiResources []*test.APIResource
 		tarball      io.Reader
 		want         map[*test.APIResource][]string
+		wantErrs     Result
 	}{
 		{
 			name:    "namespace mappings are applied",
@@ -834,6 +837,52 @@ func TestRestoreNamespaceMapping(t *testing.T) {
 				test.Pods(): {"mapped-ns-1/pod-1", "mapped-ns-2/pod-2"},
 			},
 		},
+		{
+			name: "namespace mapping patterns are applied to the namespaces not in the namespace mappings",
+			restore: defaultRestore().
+				NamespaceMappings("ns-1", "mapped-ns-1").
+				NamespaceMappingPatterns(
+					velerov1api.NamespaceMappingPattern{Regex: "app-(.*)", Replacement: "$1-app"},
+					velerov1api.NamespaceMappingPattern{Prefix: "restored-"},
+				).
+				Result(),
+			backup: defaultBackup().Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+			},
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").Result(),
+					builder.ForPod("app-2", "pod-2").Result(),
+					builder.ForPod("ns-3", "pod-3").Result(),
+				).
+				Done(),
+			want: map[*test.APIResource][]string{
+				test.Pods(): {"mapped-ns-1/pod-1", "2-app/pod-2", "restored-ns-3/pod-3"},
+			},
+		},
+		{
+			name: "namespace mapping patterns mapping two namespaces to the same namespace fail the restore",
+			restore: defaultRestore().
+				NamespaceMappingPatterns(velerov1api.NamespaceMappingPattern{Regex: "ns-.*", Replacement: "ns"}).
+				Result(),
+			backup: defaultBackup().Result(),
+			apiResources: []*test.APIResource{
+				test.Pods(),
+			},
+			tarball: test.NewTarWriter(t).
+				AddItems("pods",
+					builder.ForPod("ns-1", "pod-1").Result(),
+					builder.ForPod("ns-2", "pod-2").Result(),
+				).
+				Done(),
+			want: map[*test.APIResource][]string{
+				test.Pods(): {},
+			},
+			wantErrs: Result{
+				Velero: []string{`namespaces ["ns-1" "ns-2"] would all be restored into namespace "ns"`},
+			},
+		},
 	}
 
 	for _, tc := range tests {
@@ -859,12 +908,265 @@ func TestRestoreNamespaceMapping(t *testing.T) {
 				nil, // volume snapshotter getter
 			)
 
-			assertEmptyResults(t, warnings, errs)
+			if tc.wantErrs.Velero == nil {
+				assertEmptyResults(t, warnings, errs)
+			} else {
+				assertEmptyResults(t, warnings)
+				assertWantErrsOrWarnings(t, tc.wantErrs, errs)
+			}
 			assertAPIContents(t, h, tc.want)
 		})
 	}
 }
 
+// TestRestorePreview runs preview restores and verifies that nothing is created
+// or updated in the cluster, and that the preview reports what would be done to
+// each item.
+func TestRestorePreview(t *testing.T) {
+	tarball := func() io.Reader {
+		return test.NewTarWriter(t).
+			AddItems("pods",
+				builder.ForPod("ns-1", "pod-1").Result(),
+				builder.ForPod("ns-1", "pod-2").Result(),
+			).
+			AddItems("secrets", builder.ForSecret("ns-1", "secret-1").Data(map[string][]byte{"key-1": []byte("value-1")}).Result()).
+			Done()
+	}
+
+	tests := []struct {
+		name            string
+		restore         *velerov1api.Restore
+		disableInformer bool
+		rejectPatches   bool
+		want            []restorepreview.Item
+	}{
+		{
+			name:    "existing resource policy is not specified",
+			restore: defaultRestore().Preview(true).Result(),
+			want: []restorepreview.Item{
+				{GroupResource: "namespaces", Name: "ns-1", Action: restorepreview.ActionCreate},
+				{GroupResource: "pods", Namespace: "ns-1", Name: "pod-1", Action: restorepreview.ActionCreate},
+				{GroupResource: "pods", Namespace: "ns-1", Name: "pod-2", Action: restorepreview.ActionSkipExisting},
+				{GroupResource: "secrets", Namespace: "ns-1", Name: "secret-1", Action: restorepreview.ActionConflict, Diff: []byte(`{"data":{"foo":null,"key-1":"dmFsdWUtMQ=="}}`)},
+			},
+		},
+		{
+			name:            "existing resource policy is update, not using informer cache",
+			restore:         defaultRestore().Preview(true).ExistingResourcePolicy("update").Result(),
+			disableInformer: true,
+			want: []restorepreview.Item{
+				{GroupResource: "namespaces", Name: "ns-1", Action: restorepreview.ActionCreate},
+				{GroupResource: "pods", Namespace: "ns-1", Name: "pod-1", Action: restorepreview.ActionCreate},
+				{GroupResource: "pods", Namespace: "ns-1", Name: "pod-2", Action: restorepreview.ActionUpdate, Diff: []byte(`{"metadata":{"labels":{"velero.io/backup-name":"backup-1","velero.io/restore-name":"restore-1"}}}`)},
+				{GroupResource: "secrets", Namespace: "ns-1", Name: "secret-1", Action: restorepreview.ActionUpdate, Diff: []byte(`{"data":{"foo":null,"key-1":"dmFsdWUtMQ=="},"metadata":{"labels":{"velero.io/backup-name":"backup-1","velero.io/restore-name":"restore-1"}}}`)},
+			},
+		},
+		{
+			name:          "existing resource policy is update, update rejected by the server",
+			restore:       defaultRestore().Preview(true).ExistingResourcePolicy("update").Result(),
+			rejectPatches: true,
+			want: []restorepreview.Item{
+				{GroupResource: "namespaces", Name: "ns-1", Action: restorepreview.ActionCreate},
+				{GroupResource: "pods", Namespace: "ns-1", Name: "pod-1", Action: restorepreview.ActionCreate},
+				{GroupResource: "pods", Namespace: "ns-1", Name: "pod-2", Action: restorepreview.ActionUpdate, Diff: []byte(`{"metadata":{"labels":{"velero.io/backup-name":"backup-1","velero.io/restore-name":"restore-1"}}}`)},
+				{
+					GroupResource: "secrets",
+					Namespace:     "ns-1",
+					Name:          "secret-1",
+					Action:        restorepreview.ActionUpdate,
+					Diff:          []byte(`{"metadata":{"labels":{"velero.io/backup-name":"backup-1","velero.io/restore-name":"restore-1"}}}`),
+					Message:       "the update would be rejected, only the backup and restore labels would be updated: rejected",
+				},
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			h.AddItems(t, test.Pods(builder.ForPod("ns-1", "pod-2").Result()))
+			h.AddItems(t, test.Secrets(builder.ForSecret("ns-1", "secret-1").Data(map[string][]byte{"foo": []byte("bar")}).Result()))
+			if tc.rejectPatches {
+				h.DynamicClient.PrependReactor("patch", "*", func(kubetesting.Action) (bool, runtime.Object, error) {
+					return true, nil, errors.New("rejected")
+				})
+			}
+
+			data := &Request{
+				Log:                  h.log,
+				Restore:              tc.restore,
+				Backup:               defaultBackup().Result(),
+				BackupReader:         tarball(),
+				DisableInformerCache: tc.disableInformer,
+			}
+			// the operations started by the restore item actions are cancelled by a preview
+			action := &recordResourcesAction{operationID: "operation-1"}
+			warnings, errs := h.restorer.Restore(
+				data,
+				[]riav2.RestoreItemAction{action},
+				nil, // volume snapshotter getter
+			)
+
+			assertEmptyResults(t, warnings, errs)
+			assertAPIContents(t, h, map[*test.APIResource][]string{
+				test.Pods(): {"ns-1/pod-2"},
+			})
+			assert.Equal(t, tc.want, data.GetPreview().Items())
+			assert.ElementsMatch(t, []string{"ns-1/pod-1", "ns-1/pod-2", "ns-1/secret-1"}, action.ids)
+			assert.Equal(t, []string{"operation-1", "operation-1", "operation-1"}, action.cancelled)
+			assert.Empty(t, *data.GetItemOperationsList())
+		})
+	}
+}
+
+// TestRestoreRecordsRollbackItems verifies that the items created and updated
+// by a restore, even if only their labels are updated, are recorded with the
+// original of the updated items, so that the restore can be rolled back.
+func TestRestoreRecordsRollbackItems(t *testing.T) {
+	h := newHarness(t)
+	h.AddItems(t, test.Pods(builder.ForPod("ns-1", "pod-2").Result()))
+	h.AddItems(t, test.Secrets(builder.ForSecret("ns-1", "secret-1").Data(map[string][]byte{"foo": []byte("bar")}).Result()))
+
+	data := &Request{
+		Log:     h.log,
+		Restore: defaultRestore().ExistingResourcePolicy("update").Result(),
+		Backup:  defaultBackup().Result(),
+		BackupReader: test.NewTarWriter(t).
+			AddItems("pods",
+				builder.ForPod("ns-1", "pod-1").Result(),
+				builder.ForPod("ns-1", "pod-2").Result(),
+			).
+			AddItems("secrets", builder.ForSecret("ns-1", "secret-1").Data(map[string][]byte{"key-1": []byte("value-1")}).Result()).
+			Done(),
+		DisableInformerCache: true,
+	}
+	warnings, errs := h.restorer.Restore(
+		data,
+		nil, // restoreItemActions
+		nil, // volume snapshotter getter
+	)
+	assertEmptyResults(t, warnings, errs)
+
+	var got []string
+	for _, item := range data.GetRollbackRecorder().Items() {
+		got = append(got, fmt.Sprintf("%s %s %s/%s", item.Action, item.Resource, item.Namespace, item.Name))
+	}
+	assert.Equal(t, []string{
+		"created namespaces /ns-1",
+		"created pods ns-1/pod-1",
+		"updated pods ns-1/pod-2",
+		"updated secrets ns-1/secret-1",
+	}, got)
+
+	original := new(unstructured.Unstructured)
+	require.NoError(t, original.UnmarshalJSON(data.GetRollbackRecorder().Items()[3].Original))
+	assert.Equal(t, map[string]interface{}{"foo": "YmFy"}, original.Object["data"])
+}
+
+// TestRestoreRecordsRollbackItemsOfFailedRecreate verifies that an item deleted to
+// recreate it is recorded for the rollback even if it can't be created again.
+func TestRestoreRecordsRollbackItemsOfFailedRecreate(t *testing.T) {
+	h := newHarness(t)
+	h.AddItems(t, test.Secrets(builder.ForSecret("ns-1", "secret-1").Data(map[string][]byte{"foo": []byte("bar")}).Result()))
+	h.DynamicClient.PrependReactor("create", "secrets", func(kubetesting.Action) (bool, runtime.Object, error) {
+		return true, nil, errors.New("rejected")
+	})
+
+	data := &Request{
+		Log:     h.log,
+		Restore: defaultRestore().ExistingResourcePolicy("recreate").Result(),
+		Backup:  defaultBackup().Result(),
+		BackupReader: test.NewTarWriter(t).
+			AddItems("secrets", builder.ForSecret("ns-1", "secret-1").Data(map[string][]byte{"key-1": []byte("value-1")}).Result()).
+			Done(),
+		DisableInformerCache: true,
+	}
+	_, errs := h.restorer.Restore(
+		data,
+		nil, // restoreItemActions
+		nil, // volume snapshotter getter
+	)
+	assert.Len(t, errs.Namespaces["ns-1"], 1)
+
+	items := data.GetRollbackRecorder().Items()
+	require.Len(t, items, 2)
+	assert.Equal(t, "updated secrets ns-1/secret-1", fmt.Sprintf("%s %s %s/%s", items[1].Action, items[1].Resource, items[1].Namespace, items[1].Name))
+
+	original := new(unstructured.Unstructured)
+	require.NoError(t, original.UnmarshalJSON(items[1].Original))
+	assert.Equal(t, map[string]interface{}{"foo": "YmFy"}, original.Object["data"])
+}
+
+// TestRestoreWithItemRestoreWorkers verifies that items restored concurrently
+// are all restored once, including the additional items shared by several of
+// them, and that the restored items and progress are tracked.
+func TestRestoreWithItemRestoreWorkers(t *testing.T) {
+	h := newHarness(t)
+	h.restorer.itemRestoreWorkers = 4
+
+	var pods []metav1.Object
+	for i := 0; i < 20; i++ {
+		pods = append(pods, builder.ForPod(fmt.Sprintf("ns-%d", i%3), fmt.Sprintf("pod-%d", i)).Result())
+	}
+	h.AddItems(t, test.Pods())
+	h.AddItems(t, test.Secrets())
+	h.AddItems(t, test.PVs())
+
+	tarball := test.NewTarWriter(t).
+		AddItems("pods", pods...).
+		AddItems("secrets", builder.ForSecret("ns-0", "shared").Result()).
+		AddItems("persistentvolumes",
+			builder.ForPersistentVolume("pv-1").ReclaimPolicy(corev1api.PersistentVolumeReclaimRetain).Result(),
+			builder.ForPersistentVolume("pv-2").ReclaimPolicy(corev1api.PersistentVolumeReclaimRetain).Result(),
+		).
+		Done()
+
+	// every pod returns the same secret as additional item, and starts an operation
+	actions := []riav2.RestoreItemAction{
+		&pluggableAction{
+			selector: velero.ResourceSelector{IncludedResources: []string{"pods"}},
+			executeFunc: func(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+				obj, ok := input.Item.(*unstructured.Unstructured)
+				if !ok {
+					return nil, errors.Errorf("unexpected type %T", input.Item)
+				}
+				return &velero.RestoreItemActionExecuteOutput{
+					UpdatedItem:     obj,
+					OperationID:     obj.GetName() + "-1",
+					AdditionalItems: []velero.ResourceIdentifier{{GroupResource: kuberesource.Secrets, Namespace: "ns-0", Name: "shared"}},
+				}, nil
+			},
+		},
+	}
+
+	data := &Request{
+		Log:          h.log,
+		Restore:      defaultRestore().Result(),
+		Backup:       defaultBackup().Result(),
+		BackupReader: tarball,
+	}
+	warnings, errs := h.restorer.Restore(data, actions, nil)
+
+	assertEmptyResults(t, warnings, errs)
+
+	var podNames []string
+	for _, pod := range pods {
+		podNames = append(podNames, pod.GetNamespace()+"/"+pod.GetName())
+	}
+	assertAPIContents(t, h, map[*test.APIResource][]string{
+		test.Pods():    podNames,
+		test.Secrets(): {"ns-0/shared"},
+		test.PVs():     {"/pv-1", "/pv-2"},
+	})
+
+	// 20 pods, the shared secret, 2 PVs and 3 namespaces
+	assert.Len(t, data.RestoredItems, 26)
+	for key, status := range data.RestoredItems {
+		assert.Equal(t, itemRestoreResultCreated, status.action, key)
+	}
+	assert.Len(t, *data.GetItemOperationsList(), 20)
+}
+
 // TestRestoreResourcePriorities runs restores with resource priorities specified,
 // and verifies that the set of items created in the API are created in the expected
 // order. Validation is done by adding a Reactor to the fake dynamic client that records
@@ -1244,6 +1546,60 @@ func TestRestoreItems(t *testing.T) {
 				{resource: "v1/Secret", namespace: "ns-1", name: "sa-1"}: {action: "updated", itemExists: true},
 			},
 		},
+		{
+			name:    "recreate secret when secret exists in cluster and is not identical to the backed up one, existing resource policy is recreate",
+			restore: defaultRestore().ExistingResourcePolicy("recreate").Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("secrets", builder.ForSecret("ns-1", "sa-1").Data(map[string][]byte{"key-1": []byte("value-1")}).Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Secrets(builder.ForSecret("ns-1", "sa-1").Data(map[string][]byte{"foo": []byte("bar")}).Result()),
+			},
+			want: []*test.APIResource{
+				test.Secrets(builder.ForSecret("ns-1", "sa-1").ObjectMeta(builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).Data(map[string][]byte{"key-1": []byte("value-1")}).Result()),
+			},
+			expectedRestoreItems: map[itemKey]restoredItemStatus{
+				{resource: "v1/Namespace", namespace: "", name: "ns-1"}:  {action: "created", itemExists: true},
+				{resource: "v1/Secret", namespace: "ns-1", name: "sa-1"}: {action: "updated", itemExists: true},
+			},
+		},
+		{
+			name:    "merge secret data when secret exists in cluster and is not identical to the backed up one, existing resource policy is merge",
+			restore: defaultRestore().ExistingResourcePolicy("merge").Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("secrets", builder.ForSecret("ns-1", "sa-1").Data(map[string][]byte{"key-1": []byte("value-1")}).Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Secrets(builder.ForSecret("ns-1", "sa-1").Data(map[string][]byte{"foo": []byte("bar")}).Result()),
+			},
+			want: []*test.APIResource{
+				test.Secrets(builder.ForSecret("ns-1", "sa-1").ObjectMeta(builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).Data(map[string][]byte{"foo": []byte("bar"), "key-1": []byte("value-1")}).Result()),
+			},
+			expectedRestoreItems: map[itemKey]restoredItemStatus{
+				{resource: "v1/Namespace", namespace: "", name: "ns-1"}:  {action: "created", itemExists: true},
+				{resource: "v1/Secret", namespace: "ns-1", name: "sa-1"}: {action: "updated", itemExists: true},
+			},
+		},
+		{
+			name:    "update secret when existing resource policy is none but overridden as update for secrets",
+			restore: defaultRestore().ExistingResourcePolicy("none").ExistingResourcePolicyOverride("secrets", "update").Result(),
+			backup:  defaultBackup().Result(),
+			tarball: test.NewTarWriter(t).
+				AddItems("secrets", builder.ForSecret("ns-1", "sa-1").Data(map[string][]byte{"key-1": []byte("value-1")}).Result()).
+				Done(),
+			apiResources: []*test.APIResource{
+				test.Secrets(builder.ForSecret("ns-1", "sa-1").Data(map[string][]byte{"foo": []byte("bar")}).Result()),
+			},
+			want: []*test.APIResource{
+				test.Secrets(builder.ForSecret("ns-1", "sa-1").ObjectMeta(builder.WithLabels("velero.io/backup-name", "backup-1", "velero.io/restore-name", "restore-1")).Data(map[string][]byte{"key-1": []byte("value-1")}).Result()),
+			},
+			expectedRestoreItems: map[itemKey]restoredItemStatus{
+				{resource: "v1/Namespace", namespace: "", name: "ns-1"}:  {action: "created", itemExists: true},
+				{resource: "v1/Secret", namespace: "ns-1", name: "sa-1"}: {action: "updated", itemExists: true},
+			},
+		},
 		{
 			name:    "update service account labels when service account exists in cluster and is identical to the backed up one, existing resource policy is update",
 			restore: defaultRestore().ExistingResourcePolicy("update").Result(),
@@ -1426,6 +1782,7 @@ type recordResourcesAction struct {
 	ids                         []string
 	additionalItems             []velero.ResourceIdentifier
 	operationID                 string
+	cancelled                   []string
 	waitForAdditionalItems      bool
 	additionalItemsReadyTimeout time.Duration
 }
@@ -1465,6 +1822,7 @@ func (a *recordResourcesAction) Progress(operationID string, restore *velerov1ap
 }
 
 func (a *recordResourcesAction) Cancel(operationID string, restore *velerov1api.Restore) error {
+	a.cancelled = append(a.cancelled, operationID)
 	return nil
 }
 
@@ -1844,6 +2202,64 @@ func TestRestoreActionModifications(t *testing.T) {
 	}
 }
 
+// TestRestoreWithResourceModifiers verifies that the resource modifiers are applied
+// before the restore item actions, so the actions get the modified items and aren't
+// executed for the dropped ones.
+func TestRestoreWithResourceModifiers(t *testing.T) {
+	h := newHarness(t)
+	h.AddItems(t, test.Pods())
+
+	var executed []string
+	action := &pluggableAction{
+		executeFunc: func(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+			obj := input.Item.(*unstructured.Unstructured)
+			executed = append(executed, obj.GetName()+":"+obj.GetLabels()["patched"])
+			obj.SetLabels(map[string]string{"patched": "by-action", "action": "true"})
+			return velero.NewRestoreItemActionExecuteOutput(obj), nil
+		},
+	}
+
+	restore := defaultRestore().Result()
+	data := &Request{
+		Log:     h.log,
+		Restore: restore,
+		Backup:  defaultBackup().Result(),
+		BackupReader: test.NewTarWriter(t).AddItems("pods",
+			builder.ForPod("ns-1", "pod-1").Result(),
+			builder.ForPod("ns-1", "pod-2").ObjectMeta(builder.WithLabels("drop", "true")).Result(),
+		).Done(),
+		ResourceModifiers: &resourcemodifiers.ResourceModifiers{
+			Version: resourcemodifiers.ResourceModifierSupportedVersionV1,
+			ResourceModifierRules: []resourcemodifiers.ResourceModifierRule{
+				{
+					Conditions: resourcemodifiers.Conditions{
+						GroupResource: "pods",
+						LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"drop": "true"}},
+					},
+					Action: resourcemodifiers.RuleActionDrop,
+				},
+				{
+					Conditions:   resourcemodifiers.Conditions{GroupResource: "pods"},
+					MergePatches: []resourcemodifiers.JSONMergePatch{{PatchData: `{"metadata":{"labels":{"patched":"true"}}}`}},
+				},
+			},
+		},
+	}
+	warnings, errs := h.restorer.Restore(data, []riav2.RestoreItemAction{action}, nil)
+
+	assertEmptyResults(t, warnings, errs)
+	// the action isn't executed for the dropped item, and the patches are applied after it
+	assert.Equal(t, []string{"pod-1:"}, executed)
+	assertRestoredItems(t, h, []*test.APIResource{
+		test.Pods(builder.ForPod("ns-1", "pod-1").ObjectMeta(builder.WithLabels(
+			"action", "true",
+			"patched", "true",
+			"velero.io/backup-name", restore.Spec.BackupName,
+			"velero.io/restore-name", restore.Name,
+		)).Result()),
+	})
+}
+
 // TestRestoreWithAsyncOperations runs restores which return operationIDs and
 // verifies that the itemoperations are tracked as appropriate. Verification is done by
 // looking at the restore request's itemOperationsList field.
@@ -2012,6 +2428,132 @@ func TestRestoreWithAsyncOperations(t *testing.T) {
 	}
 }
 
+// TestRestoreResumedFromCheckpoint runs a restore, then resumes it from its
+// checkpoint and verifies that the items restored before are skipped, their
+// operations are adopted, and the pod volume restores created before are
+// waited for.
+func TestRestoreResumedFromCheckpoint(t *testing.T) {
+	executions := 0
+	action := &pluggableAction{
+		executeFunc: func(input *velero.RestoreItemActionExecuteInput) (*velero.RestoreItemActionExecuteOutput, error) {
+			executions++
+			obj := input.Item.(*unstructured.Unstructured)
+			return &velero.RestoreItemActionExecuteOutput{
+				UpdatedItem: obj,
+				OperationID: obj.GetName() + "-1",
+			}, nil
+		},
+	}
+	restore := defaultRestore().ObjectMeta(builder.WithUID("restore-uid")).Result()
+	newTarball := func() io.Reader {
+		return test.NewTarWriter(t).AddItems("pods", builder.ForPod("ns-1", "pod-1").Result(), builder.ForPod("ns-1", "pod-2").Result()).Done()
+	}
+
+	h := newHarness(t)
+	h.AddItems(t, test.Pods())
+	req := &Request{
+		Log:          h.log,
+		Restore:      restore,
+		Backup:       defaultBackup().Result(),
+		BackupReader: newTarball(),
+	}
+	warnings, errs := h.restorer.Restore(req, []riav2.RestoreItemAction{action}, nil)
+	assertEmptyResults(t, warnings, errs)
+	require.Equal(t, 2, executions)
+
+	checkpoint, operations := req.Checkpoint()
+	require.Len(t, checkpoint.Items, 2)
+	require.Len(t, operations, 2)
+
+	// the restore of pod-2 wasn't checkpointed before the restart
+	var resumedItems []RestoredItem
+	for _, item := range checkpoint.Items {
+		if item.Name == "pod-1" {
+			assert.Equal(t, itemRestoreResultCreated, item.Action)
+			assert.Equal(t, []string{"pod-1-1"}, item.OperationIDs)
+			resumedItems = append(resumedItems, item)
+		}
+	}
+	checkpoint.Items = resumedItems
+	for _, operation := range operations {
+		operation.Status.Phase = itemoperation.OperationPhaseInProgress
+	}
+
+	h = newHarness(t)
+	h.AddItems(t, test.Pods())
+	pvrLabels := builder.WithLabels(velerov1api.RestoreUIDLabel, "restore-uid")
+	require.NoError(t, h.restorer.kbClient.Create(context.Background(),
+		builder.ForPodVolumeRestore(velerov1api.DefaultNamespace, "pvr-1").ObjectMeta(pvrLabels).Phase(velerov1api.PodVolumeRestorePhaseCompleted).Result()))
+	require.NoError(t, h.restorer.kbClient.Create(context.Background(),
+		builder.ForPodVolumeRestore(velerov1api.DefaultNamespace, "pvr-2").ObjectMeta(pvrLabels).Phase(velerov1api.PodVolumeRestorePhaseFailed).Result()))
+
+	req = &Request{
+		Log:          h.log,
+		Restore:      restore,
+		Backup:       defaultBackup().Result(),
+		BackupReader: newTarball(),
+	}
+	req.Resume(checkpoint, operations)
+	warnings, errs = h.restorer.Restore(req, []riav2.RestoreItemAction{action}, nil)
+	assert.Empty(t, warnings.Namespaces)
+	assert.Equal(t, []string{"pod volume restore failed: "}, errs.Velero)
+	assert.Equal(t, 3, executions)
+
+	// pod-1 isn't restored again
+	assertAPIContents(t, h, map[*test.APIResource][]string{test.Pods(): {"ns-1/pod-2"}})
+	resumedOperations := *req.GetItemOperationsList()
+	require.Len(t, resumedOperations, 2)
+	assert.Equal(t, "pod-1-1", resumedOperations[0].Spec.OperationID)
+	assert.Equal(t, itemoperation.OperationPhaseInProgress, resumedOperations[0].Status.Phase)
+	assert.Equal(t, "pod-2-1", resumedOperations[1].Spec.OperationID)
+	assert.Equal(t, itemoperation.OperationPhaseNew, resumedOperations[1].Status.Phase)
+
+	unadopted := req.UnadoptedOperations()
+	require.Len(t, unadopted, 1)
+	assert.Equal(t, "pod-2-1", unadopted[0].Spec.OperationID)
+}
+
+// TestRestoreCheckpointWithFailedItems verifies that the items whose restore had
+// warnings or errors aren't checkpointed, so they're restored again, and their
+// warnings and errors reported again, when the restore is resumed.
+func TestRestoreCheckpointWithFailedItems(t *testing.T) {
+	restore := defaultRestore().Result()
+	newTarball := func() io.Reader {
+		return test.NewTarWriter(t).AddItems("pods", builder.ForPod("ns-1", "pod-1").Result(), builder.ForPod("ns-1", "pod-2").Result()).Done()
+	}
+	// pod-2 exists in the cluster with another version
+	existing := test.Pods(builder.ForPod("ns-1", "pod-2").ObjectMeta(builder.WithLabels("foo", "bar")).Result())
+
+	h := newHarness(t)
+	h.AddItems(t, existing)
+	req := &Request{
+		Log:          h.log,
+		Restore:      restore,
+		Backup:       defaultBackup().Result(),
+		BackupReader: newTarball(),
+	}
+	warnings, errs := h.restorer.Restore(req, nil, nil)
+	assert.Len(t, warnings.Namespaces["ns-1"], 1)
+	assert.True(t, errs.IsEmpty())
+
+	checkpoint, _ := req.Checkpoint()
+	require.Len(t, checkpoint.Items, 1)
+	assert.Equal(t, "pod-1", checkpoint.Items[0].Name)
+
+	h = newHarness(t)
+	h.AddItems(t, existing)
+	req = &Request{
+		Log:          h.log,
+		Restore:      restore,
+		Backup:       defaultBackup().Result(),
+		BackupReader: newTarball(),
+	}
+	req.Resume(checkpoint, nil)
+	resumedWarnings, errs := h.restorer.Restore(req, nil, nil)
+	assert.Equal(t, warnings.Namespaces, resumedWarnings.Namespaces)
+	assert.True(t, errs.IsEmpty())
+}
+
 // TestRestoreActionAdditionalItems runs restores with restore item actions that return additional items
 // to be restored, and verifies that that the correct set of items is created in the API. Verification is
 // done by looking at the namespaces/names of the items in the API; contents are not checked.
@@ -3879,6 +4421,56 @@ func Test_resetVolumeBindingInfo(t *testing.T) {
 	}
 }
 
+func TestClaimRestoredItem(t *testing.T) {
+	ctx := &restoreContext{
+		restoredItems:   map[itemKey]restoredItemStatus{},
+		finishedItems:   map[itemKey]bool{},
+		inProgressItems: map[itemKey]*restoredItemClaim{},
+		waitingWorkers:  map[int]int{},
+	}
+	pod := itemKey{resource: "v1/Pod", namespace: "ns1", name: "pod1"}
+	pvc := itemKey{resource: "v1/PersistentVolumeClaim", namespace: "ns1", name: "pvc1"}
+
+	_, exists, _ := ctx.claimRestoredItem(pod, 1)
+	assert.False(t, exists)
+
+	// an item claimed by the same worker isn't waited for
+	_, exists, inProgress := ctx.claimRestoredItem(pod, 1)
+	assert.True(t, exists)
+	assert.True(t, inProgress)
+
+	// worker 2 waits for worker 1 to restore the pod
+	_, exists, _ = ctx.claimRestoredItem(pvc, 2)
+	assert.False(t, exists)
+	claimed := make(chan restoredItemStatus)
+	go func() {
+		status, _, inProgress := ctx.claimRestoredItem(pod, 2)
+		assert.False(t, inProgress)
+		claimed <- status
+	}()
+
+	select {
+	case <-claimed:
+		assert.Fail(t, "claimRestoredItem returned before the item was restored")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// worker 1 doesn't wait for the PVC, since worker 2 is waiting for it
+	_, exists, inProgress = ctx.claimRestoredItem(pvc, 1)
+	assert.True(t, exists)
+	assert.True(t, inProgress)
+
+	ctx.setRestoredItem(pod, restoredItemStatus{action: itemRestoreResultCreated, itemExists: true})
+	ctx.finishRestoredItem(pod, false)
+	assert.Equal(t, restoredItemStatus{action: itemRestoreResultCreated, itemExists: true}, <-claimed)
+
+	ctx.finishRestoredItem(pvc, false)
+	status, exists, inProgress := ctx.claimRestoredItem(pvc, 1)
+	assert.True(t, exists)
+	assert.False(t, inProgress)
+	assert.Equal(t, restoredItemStatus{}, status)
+}
+
 func TestIsAlreadyExistsError(t *testing.T) {
 	tests := []struct {
 		name        string
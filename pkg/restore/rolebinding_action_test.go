@@ -43,6 +43,7 @@ func TestRoleBindingActionExecute(t *testing.T) {
 		name             string
 		namespaces       []string
 		namespaceMapping map[string]string
+		patterns         []api.NamespaceMappingPattern
 		expected         []string
 	}{
 		{
@@ -69,6 +70,13 @@ func TestRoleBindingActionExecute(t *testing.T) {
 			namespaceMapping: map[string]string{"a": "b", "c": "d"},
 			expected:         []string{"foo", "xyz"},
 		},
+		{
+			name:             "namespace mapping patterns enabled",
+			namespaces:       []string{"foo", "xyz"},
+			namespaceMapping: map[string]string{"foo": "bar"},
+			patterns:         []api.NamespaceMappingPattern{{Suffix: "-restored"}},
+			expected:         []string{"bar", "xyz-restored"},
+		},
 	}
 
 	for _, tc := range tests {
@@ -94,7 +102,8 @@ func TestRoleBindingActionExecute(t *testing.T) {
 				ItemFromBackup: &unstructured.Unstructured{Object: roleBindingUnstructured},
 				Restore: &api.Restore{
 					Spec: api.RestoreSpec{
-						NamespaceMapping: tc.namespaceMapping,
+						NamespaceMapping:         tc.namespaceMapping,
+						NamespaceMappingPatterns: tc.patterns,
 					},
 				},
 			})
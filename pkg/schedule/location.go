@@ -0,0 +1,34 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"time"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// Location returns the location of the time zone of a schedule. It's UTC
+// if the time zone is empty or invalid, invalid time zones are reported by
+// the validation of the schedule.
+func Location(schedule *velerov1api.Schedule) *time.Location {
+	loc, err := time.LoadLocation(schedule.Spec.TimeZone)
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
@@ -0,0 +1,141 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+)
+
+// The names of the rules of a retention policy.
+const (
+	RetentionRuleLast    = "last"
+	RetentionRuleHourly  = "hourly"
+	RetentionRuleDaily   = "daily"
+	RetentionRuleWeekly  = "weekly"
+	RetentionRuleMonthly = "monthly"
+	RetentionRuleYearly  = "yearly"
+)
+
+type retentionRule struct {
+	name  string
+	count int
+	// period returns the key of the period a backup time is in, nil for
+	// the rule keeping the latest backups.
+	period func(t time.Time) string
+}
+
+func retentionRules(retention *velerov1api.ScheduleRetention) []retentionRule {
+	return []retentionRule{
+		{name: RetentionRuleLast, count: retention.KeepLast},
+		{name: RetentionRuleHourly, count: retention.KeepHourly, period: func(t time.Time) string { return t.Format("2006-01-02 15") }},
+		{name: RetentionRuleDaily, count: retention.KeepDaily, period: func(t time.Time) string { return t.Format("2006-01-02") }},
+		{name: RetentionRuleWeekly, count: retention.KeepWeekly, period: func(t time.Time) string {
+			year, week := t.ISOWeek()
+			return fmt.Sprintf("%d-%d", year, week)
+		}},
+		{name: RetentionRuleMonthly, count: retention.KeepMonthly, period: func(t time.Time) string { return t.Format("2006-01") }},
+		{name: RetentionRuleYearly, count: retention.KeepYearly, period: func(t time.Time) string { return t.Format("2006") }},
+	}
+}
+
+// ValidateRetention returns the errors of a retention policy, if any.
+func ValidateRetention(retention *velerov1api.ScheduleRetention) []string {
+	if retention == nil {
+		return nil
+	}
+
+	var errs []string
+	keeps := false
+	for _, rule := range retentionRules(retention) {
+		if rule.count < 0 {
+			errs = append(errs, fmt.Sprintf("the count of the %s retention rule must not be negative", rule.name))
+		}
+		if rule.count > 0 {
+			keeps = true
+		}
+	}
+	if len(errs) == 0 && !keeps {
+		errs = append(errs, "retention must keep at least one backup")
+	}
+	return errs
+}
+
+// IsRetentionCandidate returns true if the backup is subject to the retention
+// policy of its schedule. Only the completed backups are, the others expire
+// with their TTL.
+func IsRetentionCandidate(backup *velerov1api.Backup) bool {
+	return backup.Status.Phase == velerov1api.BackupPhaseCompleted
+}
+
+// RetainedBackups evaluates the retention policy across the backups of a
+// schedule, with the periods in the given location. It returns the names of
+// the rules retaining each retained backup, keyed by the backup name. The
+// backups which aren't candidates of the retention aren't in the result.
+func RetainedBackups(retention *velerov1api.ScheduleRetention, loc *time.Location, backups []velerov1api.Backup) map[string][]string {
+	retained := map[string][]string{}
+	candidates := RetentionCandidates(backups)
+	for _, rule := range retentionRules(retention) {
+		periods := map[string]struct{}{}
+		for i, backup := range candidates {
+			if len(periods) >= rule.count {
+				break
+			}
+
+			period := fmt.Sprint(i)
+			if rule.period != nil {
+				period = rule.period(backupTime(backup).In(loc))
+			}
+			if _, found := periods[period]; found {
+				continue
+			}
+			periods[period] = struct{}{}
+			retained[backup.Name] = append(retained[backup.Name], rule.name)
+		}
+	}
+	return retained
+}
+
+// RetentionCandidates returns the backups which are candidates of the
+// retention, the latest first.
+func RetentionCandidates(backups []velerov1api.Backup) []*velerov1api.Backup {
+	var candidates []*velerov1api.Backup
+	for i := range backups {
+		if IsRetentionCandidate(&backups[i]) {
+			candidates = append(candidates, &backups[i])
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		ti, tj := backupTime(candidates[i]), backupTime(candidates[j])
+		if !ti.Equal(tj) {
+			return ti.After(tj)
+		}
+		return candidates[i].Name > candidates[j].Name
+	})
+	return candidates
+}
+
+// backupTime returns the time a backup is sorted and bucketed by.
+func backupTime(backup *velerov1api.Backup) time.Time {
+	if backup.Status.StartTimestamp != nil {
+		return backup.Status.StartTimestamp.Time
+	}
+	return backup.CreationTimestamp.Time
+}
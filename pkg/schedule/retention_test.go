@@ -0,0 +1,169 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
+	"github.com/vmware-tanzu/velero/pkg/builder"
+)
+
+func TestValidateRetention(t *testing.T) {
+	tests := []struct {
+		name      string
+		retention *velerov1api.ScheduleRetention
+		want      []string
+	}{
+		{
+			name: "no retention",
+		},
+		{
+			name:      "valid retention",
+			retention: &velerov1api.ScheduleRetention{KeepDaily: 7, KeepMonthly: 12},
+		},
+		{
+			name:      "negative count",
+			retention: &velerov1api.ScheduleRetention{KeepDaily: 7, KeepWeekly: -1},
+			want:      []string{"the count of the weekly retention rule must not be negative"},
+		},
+		{
+			name:      "retention keeping nothing",
+			retention: &velerov1api.ScheduleRetention{},
+			want:      []string{"retention must keep at least one backup"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, ValidateRetention(test.retention))
+		})
+	}
+}
+
+func TestRetainedBackups(t *testing.T) {
+	backup := func(name string, phase velerov1api.BackupPhase, start string) velerov1api.Backup {
+		startTime, err := time.Parse(time.RFC3339, start)
+		assert.NoError(t, err)
+		return *builder.ForBackup(velerov1api.DefaultNamespace, name).Phase(phase).StartTimestamp(startTime).Result()
+	}
+
+	// daily backups at 01:00 and an extra backup at 13:00 on 2023-12-31
+	backups := []velerov1api.Backup{
+		backup("b-20221231", velerov1api.BackupPhaseCompleted, "2022-12-31T01:00:00Z"),
+		backup("b-20231130", velerov1api.BackupPhaseCompleted, "2023-11-30T01:00:00Z"),
+		backup("b-20231224", velerov1api.BackupPhaseCompleted, "2023-12-24T01:00:00Z"),
+		backup("b-20231229", velerov1api.BackupPhaseCompleted, "2023-12-29T01:00:00Z"),
+		backup("b-20231230", velerov1api.BackupPhaseCompleted, "2023-12-30T01:00:00Z"),
+		backup("b-20231231", velerov1api.BackupPhaseCompleted, "2023-12-31T01:00:00Z"),
+		backup("b-20231231-2", velerov1api.BackupPhaseCompleted, "2023-12-31T13:00:00Z"),
+		backup("b-20240101", velerov1api.BackupPhaseFailed, "2024-01-01T01:00:00Z"),
+		backup("b-20240102", velerov1api.BackupPhaseInProgress, "2024-01-02T01:00:00Z"),
+	}
+
+	tests := []struct {
+		name      string
+		retention *velerov1api.ScheduleRetention
+		loc       *time.Location
+		want      map[string][]string
+	}{
+		{
+			name:      "keep last",
+			retention: &velerov1api.ScheduleRetention{KeepLast: 2},
+			loc:       time.UTC,
+			want: map[string][]string{
+				"b-20231231-2": {RetentionRuleLast},
+				"b-20231231":   {RetentionRuleLast},
+			},
+		},
+		{
+			name:      "keep hourly",
+			retention: &velerov1api.ScheduleRetention{KeepHourly: 2},
+			loc:       time.UTC,
+			want: map[string][]string{
+				"b-20231231-2": {RetentionRuleHourly},
+				"b-20231231":   {RetentionRuleHourly},
+			},
+		},
+		{
+			name:      "keep daily keeps the latest backup of a day",
+			retention: &velerov1api.ScheduleRetention{KeepDaily: 2},
+			loc:       time.UTC,
+			want: map[string][]string{
+				"b-20231231-2": {RetentionRuleDaily},
+				"b-20231230":   {RetentionRuleDaily},
+			},
+		},
+		{
+			name:      "grandfather-father-son",
+			retention: &velerov1api.ScheduleRetention{KeepDaily: 3, KeepWeekly: 2, KeepMonthly: 2, KeepYearly: 2},
+			loc:       time.UTC,
+			want: map[string][]string{
+				"b-20231231-2": {RetentionRuleDaily, RetentionRuleWeekly, RetentionRuleMonthly, RetentionRuleYearly},
+				"b-20231230":   {RetentionRuleDaily},
+				"b-20231229":   {RetentionRuleDaily},
+				"b-20231224":   {RetentionRuleWeekly},
+				"b-20231130":   {RetentionRuleMonthly},
+				"b-20221231":   {RetentionRuleYearly},
+			},
+		},
+		{
+			name:      "periods in the time zone of the schedule",
+			retention: &velerov1api.ScheduleRetention{KeepDaily: 2},
+			loc:       time.FixedZone("UTC-2", -2*60*60),
+			want: map[string][]string{
+				"b-20231231-2": {RetentionRuleDaily},
+				"b-20231231":   {RetentionRuleDaily},
+			},
+		},
+		{
+			name:      "more periods than backups",
+			retention: &velerov1api.ScheduleRetention{KeepYearly: 10},
+			loc:       time.UTC,
+			want: map[string][]string{
+				"b-20231231-2": {RetentionRuleYearly},
+				"b-20221231":   {RetentionRuleYearly},
+			},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			assert.Equal(t, test.want, RetainedBackups(test.retention, test.loc, backups))
+		})
+	}
+}
+
+func TestRetentionCandidates(t *testing.T) {
+	now := time.Date(2023, 6, 25, 12, 0, 0, 0, time.UTC)
+	backups := []velerov1api.Backup{
+		*builder.ForBackup(velerov1api.DefaultNamespace, "b-1").Phase(velerov1api.BackupPhaseCompleted).StartTimestamp(now.Add(-time.Hour)).Result(),
+		*builder.ForBackup(velerov1api.DefaultNamespace, "b-2").Phase(velerov1api.BackupPhaseCompleted).StartTimestamp(now).Result(),
+		*builder.ForBackup(velerov1api.DefaultNamespace, "b-3").Phase(velerov1api.BackupPhasePartiallyFailed).StartTimestamp(now).Result(),
+		*builder.ForBackup(velerov1api.DefaultNamespace, "b-4").Phase(velerov1api.BackupPhaseCompleted).
+			ObjectMeta(builder.WithCreationTimestamp(now.Add(-2 * time.Hour))).Result(),
+	}
+
+	var names []string
+	for _, backup := range RetentionCandidates(backups) {
+		names = append(names, backup.Name)
+	}
+	assert.Equal(t, []string{"b-2", "b-1", "b-4"}, names)
+}
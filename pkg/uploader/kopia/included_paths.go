@@ -0,0 +1,131 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopia
+
+import (
+	"context"
+	"path"
+	"strings"
+
+	"github.com/kopia/kopia/fs"
+	"github.com/kopia/kopia/snapshot/snapshotfs"
+	"github.com/pkg/errors"
+)
+
+// includedPathTree is the tree of the paths to restore from a snapshot, a
+// node with all set includes everything below it.
+type includedPathTree struct {
+	all      bool
+	children map[string]*includedPathTree
+}
+
+// splitSnapshotPath splits a path relative to the root of a snapshot into its
+// elements, the root itself has no elements.
+func splitSnapshotPath(p string) []string {
+	p = strings.TrimPrefix(path.Clean("/"+p), "/")
+	if p == "" {
+		return nil
+	}
+	return strings.Split(p, "/")
+}
+
+func newIncludedPathTree(paths []string) *includedPathTree {
+	root := &includedPathTree{}
+	for _, p := range paths {
+		node := root
+		for _, elem := range splitSnapshotPath(p) {
+			if node.all {
+				break
+			}
+			if node.children == nil {
+				node.children = map[string]*includedPathTree{}
+			}
+			if node.children[elem] == nil {
+				node.children[elem] = &includedPathTree{}
+			}
+			node = node.children[elem]
+		}
+		node.all = true
+		node.children = nil
+	}
+	return root
+}
+
+// filterIncludedPaths returns the root entry of a snapshot reduced to the
+// included paths and their parent directories, so only the contents of the
+// included files are read from the repository when it's restored.
+func filterIncludedPaths(ctx context.Context, rootEntry fs.Entry, paths []string) (fs.Entry, error) {
+	for _, p := range paths {
+		if _, err := snapshotfs.GetNestedEntry(ctx, rootEntry, splitSnapshotPath(p)); err != nil {
+			return nil, errors.Wrapf(err, "path %s isn't in the snapshot", p)
+		}
+	}
+	return filterEntry(rootEntry, newIncludedPathTree(paths)), nil
+}
+
+func filterEntry(entry fs.Entry, tree *includedPathTree) fs.Entry {
+	dir, ok := entry.(fs.Directory)
+	if tree.all || !ok {
+		return entry
+	}
+	return &includedPathsDirectory{Directory: dir, tree: tree}
+}
+
+// includedPathsDirectory is a snapshot directory which only has the entries on
+// the way to the included paths.
+type includedPathsDirectory struct {
+	fs.Directory
+	tree *includedPathTree
+}
+
+func (d *includedPathsDirectory) Child(ctx context.Context, name string) (fs.Entry, error) {
+	tree, ok := d.tree.children[name]
+	if !ok {
+		return nil, fs.ErrEntryNotFound
+	}
+
+	entry, err := d.Directory.Child(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return filterEntry(entry, tree), nil
+}
+
+func (d *includedPathsDirectory) Iterate(ctx context.Context) (fs.DirectoryIterator, error) {
+	iter, err := d.Directory.Iterate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &includedPathsIterator{DirectoryIterator: iter, tree: d.tree}, nil
+}
+
+type includedPathsIterator struct {
+	fs.DirectoryIterator
+	tree *includedPathTree
+}
+
+func (i *includedPathsIterator) Next(ctx context.Context) (fs.Entry, error) {
+	for {
+		entry, err := i.DirectoryIterator.Next(ctx)
+		if entry == nil || err != nil {
+			return entry, err
+		}
+		if tree, ok := i.tree.children[entry.Name()]; ok {
+			return filterEntry(entry, tree), nil
+		}
+	}
+}
@@ -0,0 +1,153 @@
+/*
+Copyright The Velero Contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kopia
+
+import (
+	"context"
+	"math"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/kopia/kopia/fs"
+	"github.com/kopia/kopia/fs/localfs"
+	"github.com/kopia/kopia/repo"
+	"github.com/kopia/kopia/snapshot/restore"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewIncludedPathTree(t *testing.T) {
+	tree := newIncludedPathTree([]string{"etc/app/app.conf", "/data/", "data/sub", "./var/../etc/hosts"})
+
+	assert.False(t, tree.all)
+	assert.ElementsMatch(t, []string{"etc", "data"}, keys(tree.children))
+	assert.True(t, tree.children["data"].all)
+	assert.Nil(t, tree.children["data"].children)
+	assert.ElementsMatch(t, []string{"app", "hosts"}, keys(tree.children["etc"].children))
+	assert.True(t, tree.children["etc"].children["app"].children["app.conf"].all)
+
+	assert.True(t, newIncludedPathTree([]string{"/"}).all)
+}
+
+func TestFilterIncludedPaths(t *testing.T) {
+	source := t.TempDir()
+	for _, file := range []string{"etc/app/app.conf", "etc/app/other.conf", "etc/hosts", "data/big/file-1", "data/big/file-2"} {
+		require.NoError(t, os.MkdirAll(filepath.Join(source, filepath.Dir(file)), 0755))
+		require.NoError(t, os.WriteFile(filepath.Join(source, file), []byte(file), 0644))
+	}
+
+	rootEntry, err := localfs.NewEntry(source)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name      string
+		paths     []string
+		wantFiles []string
+		wantErr   string
+	}{
+		{
+			name:      "a single file",
+			paths:     []string{"/etc/app/app.conf"},
+			wantFiles: []string{"etc/app/app.conf"},
+		},
+		{
+			name:      "a file and a directory",
+			paths:     []string{"etc/hosts", "/data/big"},
+			wantFiles: []string{"data/big/file-1", "data/big/file-2", "etc/hosts"},
+		},
+		{
+			name:      "the root",
+			paths:     []string{"/"},
+			wantFiles: []string{"data/big/file-1", "data/big/file-2", "etc/app/app.conf", "etc/app/other.conf", "etc/hosts"},
+		},
+		{
+			name:    "a path not in the snapshot",
+			paths:   []string{"/etc/missing"},
+			wantErr: "path /etc/missing isn't in the snapshot",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filtered, err := filterIncludedPaths(context.Background(), rootEntry, test.paths)
+			if test.wantErr != "" {
+				require.ErrorContains(t, err, test.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			target := t.TempDir()
+			output := &restore.FilesystemOutput{TargetPath: target, OverwriteDirectories: true, OverwriteFiles: true}
+			require.NoError(t, output.Init(context.Background()))
+			_, err = restore.Entry(context.Background(), nil, output, filtered, restore.Options{RestoreDirEntryAtDepth: math.MaxInt32})
+			require.NoError(t, err)
+
+			var files []string
+			require.NoError(t, filepath.Walk(target, func(path string, info os.FileInfo, err error) error {
+				if err == nil && !info.IsDir() {
+					rel, _ := filepath.Rel(target, path)
+					files = append(files, filepath.ToSlash(rel))
+				}
+				return err
+			}))
+			assert.Equal(t, test.wantFiles, files)
+		})
+	}
+
+	filtered, err := filterIncludedPaths(context.Background(), rootEntry, []string{"/etc/hosts"})
+	require.NoError(t, err)
+	_, err = filtered.(fs.Directory).Child(context.Background(), "data")
+	assert.ErrorIs(t, err, fs.ErrEntryNotFound)
+}
+
+func TestListDirectory(t *testing.T) {
+	source := t.TempDir()
+	require.NoError(t, os.MkdirAll(filepath.Join(source, "etc", "app"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(source, "etc", "hosts"), []byte("127.0.0.1 localhost"), 0644))
+
+	original := filesystemEntryFunc
+	defer func() { filesystemEntryFunc = original }()
+	filesystemEntryFunc = func(ctx context.Context, rep repo.Repository, rootID string, consistentAttributes bool) (fs.Entry, error) {
+		return localfs.NewEntry(source)
+	}
+
+	entries, err := ListDirectory(context.Background(), nil, "snapshot-1", "/etc", logrus.New())
+	require.NoError(t, err)
+	require.Len(t, entries, 2)
+	assert.Equal(t, "app", entries[0].Name)
+	assert.True(t, entries[0].Mode.IsDir())
+	assert.Equal(t, "hosts", entries[1].Name)
+	assert.Equal(t, int64(19), entries[1].Size)
+
+	_, err = ListDirectory(context.Background(), nil, "snapshot-1", "/etc/hosts", logrus.New())
+	assert.EqualError(t, err, "path /etc/hosts of snapshot snapshot-1 is not a directory")
+
+	_, err = ListDirectory(context.Background(), nil, "snapshot-1", "/missing", logrus.New())
+	assert.ErrorContains(t, err, "Unable to find path /missing in snapshot snapshot-1")
+}
+
+func keys(m map[string]*includedPathTree) []string {
+	var result []string
+	for k := range m {
+		result = append(result, k)
+	}
+	sort.Strings(result)
+	return result
+}
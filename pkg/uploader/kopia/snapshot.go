@@ -392,6 +392,22 @@ func Restore(ctx context.Context, rep repo.RepositoryWriter, progress *Progress,
 		return 0, 0, errors.Wrapf(err, "Unable to get filesystem entry for snapshot %v", snapshotID)
 	}
 
+	includedPaths, err := uploaderutil.GetIncludedPaths(uploaderCfg)
+	if err != nil {
+		return 0, 0, errors.Wrap(err, "failed to get uploader config")
+	}
+	if len(includedPaths) > 0 {
+		if volMode == uploader.PersistentVolumeBlock {
+			return 0, 0, errors.New("included paths are not supported for block volumes")
+		}
+
+		log.Infof("Restore paths %v from snapshot %s", includedPaths, snapshotID)
+		rootEntry, err = filterIncludedPaths(kopiaCtx, rootEntry, includedPaths)
+		if err != nil {
+			return 0, 0, errors.Wrapf(err, "Unable to get included paths of snapshot %v", snapshotID)
+		}
+	}
+
 	path, err := filepath.Abs(dest)
 	if err != nil {
 		return 0, 0, errors.Wrapf(err, "Unable to resolve path %v", dest)
@@ -443,3 +459,40 @@ func Restore(ctx context.Context, rep repo.RepositoryWriter, progress *Progress,
 	}
 	return stat.RestoredTotalFileSize, stat.RestoredFileCount, nil
 }
+
+// ListDirectory returns the entries of a directory of the snapshot with given snapshotID, the
+// directory path is relative to the root of the snapshot
+func ListDirectory(ctx context.Context, rep repo.Repository, snapshotID, dirPath string, log logrus.FieldLogger) ([]uploader.SnapshotEntry, error) {
+	kopiaCtx := kopia.SetupKopiaLog(ctx, log)
+
+	rootEntry, err := filesystemEntryFunc(kopiaCtx, rep, snapshotID, false)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to get filesystem entry for snapshot %v", snapshotID)
+	}
+
+	entry, err := snapshotfs.GetNestedEntry(kopiaCtx, rootEntry, splitSnapshotPath(dirPath))
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to find path %s in snapshot %v", dirPath, snapshotID)
+	}
+
+	dir, ok := entry.(fs.Directory)
+	if !ok {
+		return nil, errors.Errorf("path %s of snapshot %v is not a directory", dirPath, snapshotID)
+	}
+
+	var entries []uploader.SnapshotEntry
+	err = fs.IterateEntries(kopiaCtx, dir, func(ctx context.Context, e fs.Entry) error {
+		entries = append(entries, uploader.SnapshotEntry{
+			Name:    e.Name(),
+			Mode:    e.Mode(),
+			Size:    e.Size(),
+			ModTime: e.ModTime(),
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "Unable to list path %s of snapshot %v", dirPath, snapshotID)
+	}
+
+	return entries, nil
+}
@@ -39,6 +39,7 @@ import (
 // BackupFunc mainly used to make testing more convenient
 var BackupFunc = kopia.Backup
 var RestoreFunc = kopia.Restore
+var ListDirectoryFunc = kopia.ListDirectory
 var BackupRepoServiceCreateFunc = service.Create
 
 // kopiaProvider recorded info related with kopiaProvider
@@ -251,3 +252,20 @@ func (kp *kopiaProvider) RunRestore(
 
 	return nil
 }
+
+// ListDirectory which will list the entries of a directory of the snapshot
+func (kp *kopiaProvider) ListDirectory(
+	ctx context.Context,
+	snapshotID string,
+	dirPath string) ([]uploader.SnapshotEntry, error) {
+	log := kp.log.WithFields(logrus.Fields{
+		"snapshotID": snapshotID,
+		"path":       dirPath,
+	})
+
+	entries, err := ListDirectoryFunc(ctx, kopia.NewShimRepo(kp.bkRepo), snapshotID, dirPath, log)
+	if err != nil {
+		return nil, errors.Wrapf(err, "Failed to list kopia snapshot directory")
+	}
+	return entries, nil
+}
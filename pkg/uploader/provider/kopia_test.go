@@ -169,6 +169,25 @@ func TestRunRestore(t *testing.T) {
 	}
 }
 
+func TestListDirectory(t *testing.T) {
+	var kp kopiaProvider
+	kp.log = logrus.New()
+
+	ListDirectoryFunc = func(ctx context.Context, rep repo.Repository, snapshotID, dirPath string, log logrus.FieldLogger) ([]uploader.SnapshotEntry, error) {
+		if dirPath == "/missing" {
+			return nil, errors.New("path not found")
+		}
+		return []uploader.SnapshotEntry{{Name: "app.conf", Size: 2048}}, nil
+	}
+
+	entries, err := kp.ListDirectory(context.Background(), "snapshot-1", "/etc")
+	assert.NoError(t, err)
+	assert.Equal(t, []uploader.SnapshotEntry{{Name: "app.conf", Size: 2048}}, entries)
+
+	_, err = kp.ListDirectory(context.Background(), "snapshot-1", "/missing")
+	assert.EqualError(t, err, "Failed to list kopia snapshot directory: path not found")
+}
+
 func TestCheckContext(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -29,6 +29,32 @@ func (_m *Provider) Close(ctx context.Context) error {
 	return r0
 }
 
+// ListDirectory provides a mock function with given fields: ctx, snapshotID, dirPath
+func (_m *Provider) ListDirectory(ctx context.Context, snapshotID string, dirPath string) ([]uploader.SnapshotEntry, error) {
+	ret := _m.Called(ctx, snapshotID, dirPath)
+
+	var r0 []uploader.SnapshotEntry
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]uploader.SnapshotEntry, error)); ok {
+		return rf(ctx, snapshotID, dirPath)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []uploader.SnapshotEntry); ok {
+		r0 = rf(ctx, snapshotID, dirPath)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]uploader.SnapshotEntry)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, snapshotID, dirPath)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // RunBackup provides a mock function with given fields: ctx, path, realSource, tags, forceFull, parentSnapshot, volMode, uploaderCfg, updater
 func (_m *Provider) RunBackup(ctx context.Context, path string, realSource string, tags map[string]string, forceFull bool, parentSnapshot string, volMode uploader.PersistentVolumeMode, uploaderCfg map[string]string, updater uploader.ProgressUpdater) (string, bool, error) {
 	ret := _m.Called(ctx, path, realSource, tags, forceFull, parentSnapshot, volMode, uploaderCfg, updater)
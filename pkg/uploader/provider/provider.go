@@ -60,6 +60,11 @@ type Provider interface {
 		volMode uploader.PersistentVolumeMode,
 		uploaderConfig map[string]string,
 		updater uploader.ProgressUpdater) error
+	// ListDirectory which will list the entries of a directory of the snapshot with given snapshot id
+	ListDirectory(
+		ctx context.Context,
+		snapshotID string,
+		dirPath string) ([]uploader.SnapshotEntry, error)
 	// Close which will close related repository
 	Close(ctx context.Context) error
 }
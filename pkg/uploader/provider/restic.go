@@ -246,5 +246,22 @@ func (rp *resticProvider) parseRestoreExtraFlags(uploaderCfg map[string]string)
 		extraFlags = append(extraFlags, "--sparse")
 	}
 
+	includedPaths, err := uploaderutil.GetIncludedPaths(uploaderCfg)
+	if err != nil {
+		return extraFlags, errors.Wrap(err, "failed to get uploader config")
+	}
+
+	for _, path := range includedPaths {
+		extraFlags = append(extraFlags, fmt.Sprintf("--include=%s", path))
+	}
+
 	return extraFlags, nil
 }
+
+// ListDirectory is not supported for restic snapshots
+func (rp *resticProvider) ListDirectory(
+	ctx context.Context,
+	snapshotID string,
+	dirPath string) ([]uploader.SnapshotEntry, error) {
+	return nil, errors.New("listing snapshot directories is not supported by restic uploader")
+}
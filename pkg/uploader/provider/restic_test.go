@@ -434,6 +434,13 @@ func TestParseUploaderConfig(t *testing.T) {
 			},
 			expectedFlags: []string{},
 		},
+		{
+			name: "IncludedPaths",
+			uploaderConfig: map[string]string{
+				"IncludedPaths": `["/etc/app.conf","/data"]`,
+			},
+			expectedFlags: []string{"--include=/etc/app.conf", "--include=/data"},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -18,7 +18,9 @@ package uploader
 
 import (
 	"fmt"
+	"os"
 	"strings"
+	"time"
 )
 
 const (
@@ -52,6 +54,14 @@ type SnapshotInfo struct {
 	Size int64  `json:"Size"`
 }
 
+// SnapshotEntry is an entry of a directory in a snapshot
+type SnapshotEntry struct {
+	Name    string
+	Mode    os.FileMode
+	Size    int64
+	ModTime time.Time
+}
+
 // Progress which defined two variables to record progress
 type Progress struct {
 	TotalBytes int64 `json:"totalBytes,omitempty"`
@@ -17,6 +17,7 @@ limitations under the License.
 package util
 
 import (
+	"encoding/json"
 	"strconv"
 
 	"github.com/pkg/errors"
@@ -27,6 +28,7 @@ import (
 const (
 	ParallelFilesUpload = "ParallelFilesUpload"
 	WriteSparseFiles    = "WriteSparseFiles"
+	IncludedPaths       = "IncludedPaths"
 )
 
 func StoreBackupConfig(config *velerov1api.UploaderConfigForBackup) map[string]string {
@@ -68,3 +70,36 @@ func GetWriteSparseFiles(uploaderCfg map[string]string) (bool, error) {
 	}
 	return false, nil
 }
+
+// StoreIncludedPaths returns a copy of the uploader config with the paths to
+// restore from a snapshot, or the uploader config itself if there are none.
+func StoreIncludedPaths(uploaderCfg map[string]string, paths []string) (map[string]string, error) {
+	if len(paths) == 0 {
+		return uploaderCfg, nil
+	}
+
+	data := make(map[string]string)
+	for k, v := range uploaderCfg {
+		data[k] = v
+	}
+
+	encoded, err := json.Marshal(paths)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode IncludedPaths config")
+	}
+	data[IncludedPaths] = string(encoded)
+	return data, nil
+}
+
+func GetIncludedPaths(uploaderCfg map[string]string) ([]string, error) {
+	includedPaths, ok := uploaderCfg[IncludedPaths]
+	if !ok {
+		return nil, nil
+	}
+
+	var paths []string
+	if err := json.Unmarshal([]byte(includedPaths), &paths); err != nil {
+		return nil, errors.Wrap(err, "failed to parse IncludedPaths config")
+	}
+	return paths, nil
+}
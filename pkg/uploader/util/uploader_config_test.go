@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
 
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
@@ -180,3 +181,25 @@ func TestGetWriteSparseFiles(t *testing.T) {
 		})
 	}
 }
+
+func TestIncludedPaths(t *testing.T) {
+	cfg := map[string]string{WriteSparseFiles: "true"}
+
+	withPaths, err := StoreIncludedPaths(cfg, []string{"/etc/app.conf", "/data/dir with,comma"})
+	assert.NoError(t, err)
+	assert.Equal(t, map[string]string{WriteSparseFiles: "true"}, cfg)
+	assert.Equal(t, "true", withPaths[WriteSparseFiles])
+
+	paths, err := GetIncludedPaths(withPaths)
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"/etc/app.conf", "/data/dir with,comma"}, paths)
+
+	withoutPaths, err := StoreIncludedPaths(cfg, nil)
+	assert.NoError(t, err)
+	paths, err = GetIncludedPaths(withoutPaths)
+	assert.NoError(t, err)
+	assert.Nil(t, paths)
+
+	_, err = GetIncludedPaths(map[string]string{IncludedPaths: "/etc"})
+	assert.EqualError(t, err, "failed to parse IncludedPaths config: invalid character '/' looking for beginning of value")
+}
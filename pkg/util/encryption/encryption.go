@@ -0,0 +1,276 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package encryption implements the client-side envelope encryption used for
+// the objects Velero writes to a backup storage location.
+//
+// Every object is encrypted with its own random 256-bit data key using
+// AES-256-GCM. The data key is itself encrypted ("wrapped") with the key
+// configured for the backup storage location and stored in the object header.
+// The payload is split into fixed-size chunks which are sealed individually,
+// so objects of any size can be encrypted and decrypted as streams. The last
+// chunk is flagged in its nonce so a truncated object fails to decrypt.
+//
+// An encrypted object has the following layout:
+//
+//	magic (9 bytes) | version (1 byte) | wrap nonce (12 bytes) | wrapped data key (48 bytes) | chunks...
+//
+// Objects that don't start with the magic are treated as plaintext, which
+// keeps objects written before encryption was enabled readable.
+package encryption
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/binary"
+	"io"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// KeySize is the size in bytes of the key configured for a backup storage location.
+	KeySize = 32
+
+	version   byte = 1
+	chunkSize      = 64 * 1024
+	nonceSize      = 12
+	tagSize        = 16
+)
+
+var magic = []byte("VELEROENC")
+
+// ErrMissingKey is returned when an encrypted object is read without a key.
+var ErrMissingKey = errors.New("object is encrypted but no encryption key is configured")
+
+// ParseKey returns the encryption key held in data, which must be either
+// KeySize raw bytes or their base64 encoding. Surrounding whitespace is ignored.
+func ParseKey(data []byte) ([]byte, error) {
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == KeySize {
+		return trimmed, nil
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(string(trimmed))
+	if err != nil || len(decoded) != KeySize {
+		return nil, errors.Errorf("encryption key must be %d bytes, either raw or base64-encoded", KeySize)
+	}
+
+	return decoded, nil
+}
+
+// IsEncrypted reports whether the data buffered in r starts with the header of
+// an encrypted object. It does not consume any data from r.
+func IsEncrypted(r *bufio.Reader) bool {
+	header, err := r.Peek(len(magic) + 1)
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(header[:len(magic)], magic) && header[len(magic)] == version
+}
+
+// NewEncryptingReader returns a reader yielding the encrypted form of the data
+// read from r, using a fresh data key wrapped with key.
+func NewEncryptingReader(key []byte, r io.Reader) (io.Reader, error) {
+	kek, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey := make([]byte, KeySize)
+	if _, err := io.ReadFull(rand.Reader, dataKey); err != nil {
+		return nil, errors.Wrap(err, "error generating data key")
+	}
+	wrapNonce := make([]byte, nonceSize)
+	if _, err := io.ReadFull(rand.Reader, wrapNonce); err != nil {
+		return nil, errors.Wrap(err, "error generating nonce")
+	}
+
+	// the prefix authenticates the header, it's kept in its own slice since
+	// the additional data of Seal must not overlap its output
+	prefix := append(append([]byte{}, magic...), version)
+	header := append(append([]byte{}, prefix...), wrapNonce...)
+	header = kek.Seal(header, wrapNonce, dataKey, prefix)
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &encryptingReader{
+		src:     bufio.NewReaderSize(r, chunkSize),
+		aead:    aead,
+		plain:   make([]byte, chunkSize),
+		sealed:  make([]byte, 0, chunkSize+tagSize),
+		pending: header,
+	}, nil
+}
+
+// NewDecryptingReader returns a reader yielding the plaintext of the object read
+// from r. If the object isn't encrypted its data is returned unchanged, otherwise
+// key is used to unwrap its data key and ErrMissingKey is returned if key is empty.
+func NewDecryptingReader(key []byte, r io.Reader) (io.Reader, error) {
+	br := bufio.NewReaderSize(r, chunkSize+tagSize)
+	if !IsEncrypted(br) {
+		return br, nil
+	}
+	if len(key) == 0 {
+		return nil, ErrMissingKey
+	}
+
+	kek, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	header := make([]byte, len(magic)+1+nonceSize+KeySize+tagSize)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return nil, errors.Wrap(err, "error reading encryption header")
+	}
+	prefix := header[:len(magic)+1]
+	wrapNonce := header[len(prefix) : len(prefix)+nonceSize]
+
+	dataKey, err := kek.Open(nil, wrapNonce, header[len(prefix)+nonceSize:], prefix)
+	if err != nil {
+		return nil, errors.Wrap(err, "error unwrapping data key, the object may have been encrypted with a different key")
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &decryptingReader{
+		src:    br,
+		aead:   aead,
+		sealed: make([]byte, chunkSize+tagSize),
+	}, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+
+	return aead, nil
+}
+
+// chunkNonce returns the nonce for the chunk with the given index. Data keys
+// are never reused, so a counter is enough to keep nonces unique.
+func chunkNonce(index uint64, last bool) []byte {
+	nonce := make([]byte, nonceSize)
+	binary.BigEndian.PutUint64(nonce, index)
+	if last {
+		nonce[nonceSize-1] = 1
+	}
+	return nonce
+}
+
+// readChunk fills buf from r and reports whether r has no data left after it.
+func readChunk(r *bufio.Reader, buf []byte) (int, bool, error) {
+	n, err := io.ReadFull(r, buf)
+	switch err {
+	case nil:
+		if _, err := r.Peek(1); err != nil {
+			if err == io.EOF {
+				return n, true, nil
+			}
+			return n, false, err
+		}
+		return n, false, nil
+	case io.EOF, io.ErrUnexpectedEOF:
+		return n, true, nil
+	default:
+		return n, false, err
+	}
+}
+
+type encryptingReader struct {
+	src     *bufio.Reader
+	aead    cipher.AEAD
+	plain   []byte
+	sealed  []byte
+	pending []byte
+	index   uint64
+	done    bool
+}
+
+func (r *encryptingReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+
+		n, last, err := readChunk(r.src, r.plain)
+		if err != nil {
+			return 0, err
+		}
+
+		r.pending = r.aead.Seal(r.sealed[:0], chunkNonce(r.index, last), r.plain[:n], nil)
+		r.index++
+		r.done = last
+	}
+
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
+
+type decryptingReader struct {
+	src     *bufio.Reader
+	aead    cipher.AEAD
+	sealed  []byte
+	pending []byte
+	index   uint64
+	done    bool
+}
+
+func (r *decryptingReader) Read(p []byte) (int, error) {
+	for len(r.pending) == 0 {
+		if r.done {
+			return 0, io.EOF
+		}
+
+		n, last, err := readChunk(r.src, r.sealed)
+		if err != nil {
+			return 0, err
+		}
+
+		plain, err := r.aead.Open(r.sealed[:0], chunkNonce(r.index, last), r.sealed[:n], nil)
+		if err != nil {
+			return 0, errors.Wrap(err, "error decrypting object, it may be corrupted or truncated")
+		}
+
+		r.pending = plain
+		r.index++
+		r.done = last
+	}
+
+	n := copy(p, r.pending)
+	r.pending = r.pending[n:]
+	return n, nil
+}
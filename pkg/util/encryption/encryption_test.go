@@ -0,0 +1,129 @@
+/*
+Copyright the Velero contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package encryption
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/base64"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testKey = bytes.Repeat([]byte("k"), KeySize)
+
+func encrypt(t *testing.T, key, data []byte) []byte {
+	t.Helper()
+
+	r, err := NewEncryptingReader(key, bytes.NewReader(data))
+	require.NoError(t, err)
+	encrypted, err := io.ReadAll(r)
+	require.NoError(t, err)
+	return encrypted
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		size int
+	}{
+		{name: "empty", size: 0},
+		{name: "smaller than a chunk", size: 100},
+		{name: "exactly one chunk", size: chunkSize},
+		{name: "several chunks", size: 3*chunkSize + 17},
+		{name: "exact multiple of chunks", size: 2 * chunkSize},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			data := make([]byte, tc.size)
+			for i := range data {
+				data[i] = byte(i % 251)
+			}
+
+			encrypted := encrypt(t, testKey, data)
+			assert.True(t, IsEncrypted(bufio.NewReader(bytes.NewReader(encrypted))))
+			if tc.size > 0 {
+				assert.False(t, bytes.Contains(encrypted, data))
+			}
+
+			r, err := NewDecryptingReader(testKey, bytes.NewReader(encrypted))
+			require.NoError(t, err)
+			decrypted, err := io.ReadAll(r)
+			require.NoError(t, err)
+			assert.Equal(t, data, decrypted)
+		})
+	}
+}
+
+func TestDecryptPlaintextPassesThrough(t *testing.T) {
+	data := []byte(`{"kind":"Backup"}`)
+
+	r, err := NewDecryptingReader(nil, bytes.NewReader(data))
+	require.NoError(t, err)
+	res, err := io.ReadAll(r)
+	require.NoError(t, err)
+	assert.Equal(t, data, res)
+}
+
+func TestDecryptErrors(t *testing.T) {
+	data := bytes.Repeat([]byte("velero"), chunkSize)
+	encrypted := encrypt(t, testKey, data)
+
+	t.Run("missing key", func(t *testing.T) {
+		_, err := NewDecryptingReader(nil, bytes.NewReader(encrypted))
+		assert.Equal(t, ErrMissingKey, err)
+	})
+
+	t.Run("wrong key", func(t *testing.T) {
+		_, err := NewDecryptingReader(bytes.Repeat([]byte("x"), KeySize), bytes.NewReader(encrypted))
+		assert.ErrorContains(t, err, "error unwrapping data key")
+	})
+
+	t.Run("truncated at a chunk boundary", func(t *testing.T) {
+		headerSize := len(magic) + 1 + nonceSize + KeySize + tagSize
+		r, err := NewDecryptingReader(testKey, bytes.NewReader(encrypted[:headerSize+chunkSize+tagSize]))
+		require.NoError(t, err)
+		_, err = io.ReadAll(r)
+		assert.ErrorContains(t, err, "error decrypting object")
+	})
+
+	t.Run("tampered", func(t *testing.T) {
+		tampered := append([]byte{}, encrypted...)
+		tampered[len(tampered)-1] ^= 0xff
+		r, err := NewDecryptingReader(testKey, bytes.NewReader(tampered))
+		require.NoError(t, err)
+		_, err = io.ReadAll(r)
+		assert.ErrorContains(t, err, "error decrypting object")
+	})
+}
+
+func TestParseKey(t *testing.T) {
+	key, err := ParseKey(testKey)
+	require.NoError(t, err)
+	assert.Equal(t, testKey, key)
+
+	key, err = ParseKey([]byte(base64.StdEncoding.EncodeToString(testKey) + "\n"))
+	require.NoError(t, err)
+	assert.Equal(t, testKey, key)
+
+	_, err = ParseKey([]byte("too-short"))
+	assert.Error(t, err)
+}
@@ -35,6 +35,7 @@ import (
 
 	storagev1api "k8s.io/api/storage/v1"
 	storagev1 "k8s.io/client-go/kubernetes/typed/storage/v1"
+	crclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
@@ -338,6 +339,34 @@ func IsPVCBound(pvc *corev1api.PersistentVolumeClaim) bool {
 	return pvc.Spec.VolumeName != ""
 }
 
+// GetPodsUsingPVC returns the pods in the namespace of the specified PVC which mount it
+func GetPodsUsingPVC(ctx context.Context, crClient crclient.Client, pvcNamespace, pvcName string) ([]corev1api.Pod, error) {
+	podList := new(corev1api.PodList)
+	if err := crClient.List(ctx, podList, crclient.InNamespace(pvcNamespace)); err != nil {
+		return nil, errors.Wrapf(err, "error listing pods in namespace %s", pvcNamespace)
+	}
+
+	var pods []corev1api.Pod
+	for i := range podList.Items {
+		if IsPodUsingPVC(&podList.Items[i], pvcName) {
+			pods = append(pods, podList.Items[i])
+		}
+	}
+
+	return pods, nil
+}
+
+// IsPodUsingPVC returns true if the pod mounts the PVC of the specified name, which
+// is expected to be in the pod's namespace
+func IsPodUsingPVC(pod *corev1api.Pod, pvcName string) bool {
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil && volume.PersistentVolumeClaim.ClaimName == pvcName {
+			return true
+		}
+	}
+	return false
+}
+
 // MakePodPVCAttachment returns the volume mounts and devices for a pod needed to attach a PVC
 func MakePodPVCAttachment(volumeName string, volumeMode *corev1api.PersistentVolumeMode) ([]corev1api.VolumeMount, []corev1api.VolumeDevice) {
 	var volumeMounts []corev1api.VolumeMount = nil
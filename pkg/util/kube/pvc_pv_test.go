@@ -1119,3 +1119,37 @@ func TestIsPVCBound(t *testing.T) {
 		})
 	}
 }
+
+func TestGetPodsUsingPVC(t *testing.T) {
+	pod := func(ns, name, claimName string) *corev1api.Pod {
+		return &corev1api.Pod{
+			ObjectMeta: metav1.ObjectMeta{Namespace: ns, Name: name},
+			Spec: corev1api.PodSpec{
+				Volumes: []corev1api.Volume{
+					{
+						Name: "vol",
+						VolumeSource: corev1api.VolumeSource{
+							PersistentVolumeClaim: &corev1api.PersistentVolumeClaimVolumeSource{ClaimName: claimName},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	crClient := velerotest.NewFakeControllerRuntimeClient(t,
+		pod("fake-ns", "pod-1", "fake-pvc"),
+		pod("fake-ns", "pod-2", "other-pvc"),
+		pod("other-ns", "pod-3", "fake-pvc"),
+		pod("fake-ns", "pod-4", "fake-pvc"),
+	)
+
+	pods, err := GetPodsUsingPVC(context.Background(), crClient, "fake-ns", "fake-pvc")
+	assert.NoError(t, err)
+
+	var names []string
+	for _, pod := range pods {
+		names = append(names, pod.Name)
+	}
+	assert.Equal(t, []string{"pod-1", "pod-4"}, names)
+}
@@ -19,9 +19,12 @@ package podvolume
 import (
 	"strings"
 
+	"github.com/pkg/errors"
 	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kerrors "k8s.io/apimachinery/pkg/util/errors"
 
+	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
 
@@ -37,25 +40,7 @@ func GetVolumesByPod(pod *corev1api.Pod, defaultVolumesToFsBackup bool) ([]strin
 	volsToExclude := getVolumesToExclude(pod)
 	podVolumes := []string{}
 	for _, pv := range pod.Spec.Volumes {
-		// cannot backup hostpath volumes as they are not mounted into /var/lib/kubelet/pods
-		// and therefore not accessible to the node agent daemon set.
-		if pv.HostPath != nil {
-			continue
-		}
-		// don't backup volumes mounting secrets. Secrets will be backed up separately.
-		if pv.Secret != nil {
-			continue
-		}
-		// don't backup volumes mounting ConfigMaps. ConfigMaps will be backed up separately.
-		if pv.ConfigMap != nil {
-			continue
-		}
-		// don't backup volumes mounted as projected volumes, all data in those come from kube state.
-		if pv.Projected != nil {
-			continue
-		}
-		// don't backup DownwardAPI volumes, all data in those come from kube state.
-		if pv.DownwardAPI != nil {
+		if !isFsBackupApplicable(pv) {
 			continue
 		}
 		// don't backup volumes that are included in the exclude list.
@@ -63,15 +48,79 @@ func GetVolumesByPod(pod *corev1api.Pod, defaultVolumesToFsBackup bool) ([]strin
 			optedOutVolumes = append(optedOutVolumes, pv.Name)
 			continue
 		}
-		// don't include volumes that mount the default service account token.
-		if strings.HasPrefix(pv.Name, "default-token") {
-			continue
-		}
 		podVolumes = append(podVolumes, pv.Name)
 	}
 	return podVolumes, optedOutVolumes
 }
 
+// GetVolumesByPodAndPolicy returns the volumes of the provided pod to back up with
+// pod volume backup and the volumes opted out of it, like GetVolumesByPod, except that
+// the action of the resource policy matched by a volume takes precedence over the pod's
+// annotations and defaultVolumesToFsBackup: volumes matching a fs-backup action are backed
+// up, and volumes matching a snapshot or datamover action are opted out. getMatchAction
+// returns the action matched by a volume, or nil if the volume matches no policy. A volume
+// whose action can't be determined is handled as if it matched no policy, and the errors
+// are returned along with the volumes.
+func GetVolumesByPodAndPolicy(pod *corev1api.Pod, defaultVolumesToFsBackup bool,
+	getMatchAction func(*corev1api.Volume) (*resourcepolicies.Action, error)) ([]string, []string, error) {
+	podVolumes, optedOutVolumes := GetVolumesByPod(pod, defaultVolumesToFsBackup)
+
+	var errs []error
+	for i := range pod.Spec.Volumes {
+		vol := &pod.Spec.Volumes[i]
+		action, err := getMatchAction(vol)
+		if err != nil {
+			errs = append(errs, errors.Wrapf(err, "error matching the resource policies of volume %s", vol.Name))
+			continue
+		}
+		if action == nil {
+			continue
+		}
+
+		switch action.Type {
+		case resourcepolicies.FSBackup:
+			optedOutVolumes = remove(optedOutVolumes, vol.Name)
+			if isFsBackupApplicable(*vol) && !contains(podVolumes, vol.Name) {
+				podVolumes = append(podVolumes, vol.Name)
+			}
+		case resourcepolicies.Snapshot, resourcepolicies.DataMover:
+			if contains(podVolumes, vol.Name) {
+				podVolumes = remove(podVolumes, vol.Name)
+				optedOutVolumes = append(optedOutVolumes, vol.Name)
+			}
+		}
+	}
+
+	return podVolumes, optedOutVolumes, kerrors.NewAggregate(errs)
+}
+
+// isFsBackupApplicable returns false for the volumes which are never backed up with
+// pod volume backup by default.
+func isFsBackupApplicable(vol corev1api.Volume) bool {
+	switch {
+	// cannot backup hostpath volumes as they are not mounted into /var/lib/kubelet/pods
+	// and therefore not accessible to the node agent daemon set.
+	case vol.HostPath != nil:
+		return false
+	// don't backup volumes mounting secrets. Secrets will be backed up separately.
+	case vol.Secret != nil:
+		return false
+	// don't backup volumes mounting ConfigMaps. ConfigMaps will be backed up separately.
+	case vol.ConfigMap != nil:
+		return false
+	// don't backup volumes mounted as projected volumes, all data in those come from kube state.
+	case vol.Projected != nil:
+		return false
+	// don't backup DownwardAPI volumes, all data in those come from kube state.
+	case vol.DownwardAPI != nil:
+		return false
+	// don't include volumes that mount the default service account token.
+	case strings.HasPrefix(vol.Name, "default-token"):
+		return false
+	}
+	return true
+}
+
 // GetVolumesToBackup returns a list of volume names to backup for
 // the provided pod.
 // Deprecated: Use GetVolumesByPod instead.
@@ -106,3 +155,13 @@ func contains(list []string, k string) bool {
 	}
 	return false
 }
+
+func remove(list []string, k string) []string {
+	var res []string
+	for _, i := range list {
+		if i != k {
+			res = append(res, i)
+		}
+	}
+	return res
+}
@@ -20,10 +20,13 @@ import (
 	"sort"
 	"testing"
 
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	corev1api "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/vmware-tanzu/velero/internal/resourcepolicies"
 	velerov1api "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 )
 
@@ -345,3 +348,83 @@ func TestGetVolumesByPod(t *testing.T) {
 		})
 	}
 }
+
+func TestGetVolumesByPodAndPolicy(t *testing.T) {
+	pod := &corev1api.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				velerov1api.VolumesToBackupAnnotation:  "annotated,snapshotted",
+				velerov1api.VolumesToExcludeAnnotation: "excluded",
+			},
+		},
+		Spec: corev1api.PodSpec{
+			Volumes: []corev1api.Volume{
+				{Name: "annotated"},
+				{Name: "snapshotted"},
+				{Name: "excluded"},
+				{Name: "nfs", VolumeSource: corev1api.VolumeSource{NFS: &corev1api.NFSVolumeSource{Server: "server"}}},
+				{Name: "secret", VolumeSource: corev1api.VolumeSource{Secret: &corev1api.SecretVolumeSource{SecretName: "secret"}}},
+				{Name: "other"},
+			},
+		},
+	}
+
+	actions := map[string]resourcepolicies.VolumeActionType{
+		"snapshotted": resourcepolicies.Snapshot,
+		"excluded":    resourcepolicies.FSBackup,
+		"nfs":         resourcepolicies.FSBackup,
+		"secret":      resourcepolicies.FSBackup,
+	}
+	getMatchAction := func(vol *corev1api.Volume) (*resourcepolicies.Action, error) {
+		if action, found := actions[vol.Name]; found {
+			return &resourcepolicies.Action{Type: action}, nil
+		}
+		return nil, nil
+	}
+
+	testCases := []struct {
+		name                     string
+		defaultVolumesToFsBackup bool
+		expectedIncluded         []string
+		expectedOptedOut         []string
+	}{
+		{
+			name:             "opt-in approach",
+			expectedIncluded: []string{"annotated", "excluded", "nfs"},
+			expectedOptedOut: []string{"snapshotted"},
+		},
+		{
+			name:                     "opt-out approach",
+			defaultVolumesToFsBackup: true,
+			expectedIncluded:         []string{"annotated", "excluded", "nfs", "other"},
+			expectedOptedOut:         []string{"snapshotted"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			included, optedOut, err := GetVolumesByPodAndPolicy(pod, tc.defaultVolumesToFsBackup, getMatchAction)
+			require.NoError(t, err)
+
+			sort.Strings(included)
+			assert.Equal(t, tc.expectedIncluded, included)
+			sort.Strings(optedOut)
+			assert.Equal(t, tc.expectedOptedOut, optedOut)
+		})
+	}
+
+	t.Run("error getting matched action", func(t *testing.T) {
+		included, optedOut, err := GetVolumesByPodAndPolicy(pod, false, func(vol *corev1api.Volume) (*resourcepolicies.Action, error) {
+			if vol.Name == "snapshotted" {
+				return nil, errors.New("error")
+			}
+			return getMatchAction(vol)
+		})
+		assert.EqualError(t, err, "error matching the resource policies of volume snapshotted: error")
+
+		// the volume is handled as if it matched no policy
+		sort.Strings(included)
+		assert.Equal(t, []string{"annotated", "excluded", "nfs", "snapshotted"}, included)
+		assert.Empty(t, optedOut)
+	})
+}